@@ -512,17 +512,72 @@ func (x *_ClientState_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_ClientState_11_list)(nil)
+
+type _ClientState_11_list struct {
+	list *[]*MithrilCertificate
+}
+
+func (x *_ClientState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ClientState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ClientState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MithrilCertificate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ClientState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MithrilCertificate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ClientState_11_list) AppendMutable() protoreflect.Value {
+	v := new(MithrilCertificate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ClientState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ClientState_11_list) NewElement() protoreflect.Value {
+	v := new(MithrilCertificate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ClientState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ClientState                           protoreflect.MessageDescriptor
-	fd_ClientState_chain_id                  protoreflect.FieldDescriptor
-	fd_ClientState_latest_height             protoreflect.FieldDescriptor
-	fd_ClientState_frozen_height             protoreflect.FieldDescriptor
-	fd_ClientState_current_epoch             protoreflect.FieldDescriptor
-	fd_ClientState_trusting_period           protoreflect.FieldDescriptor
-	fd_ClientState_protocol_parameters       protoreflect.FieldDescriptor
-	fd_ClientState_upgrade_path              protoreflect.FieldDescriptor
-	fd_ClientState_host_state_nft_policy_id  protoreflect.FieldDescriptor
-	fd_ClientState_host_state_nft_token_name protoreflect.FieldDescriptor
+	md_ClientState                            protoreflect.MessageDescriptor
+	fd_ClientState_chain_id                   protoreflect.FieldDescriptor
+	fd_ClientState_latest_height              protoreflect.FieldDescriptor
+	fd_ClientState_frozen_height              protoreflect.FieldDescriptor
+	fd_ClientState_current_epoch              protoreflect.FieldDescriptor
+	fd_ClientState_trusting_period            protoreflect.FieldDescriptor
+	fd_ClientState_protocol_parameters        protoreflect.FieldDescriptor
+	fd_ClientState_upgrade_path               protoreflect.FieldDescriptor
+	fd_ClientState_host_state_nft_policy_id   protoreflect.FieldDescriptor
+	fd_ClientState_host_state_nft_token_name  protoreflect.FieldDescriptor
+	fd_ClientState_genesis_verification_key   protoreflect.FieldDescriptor
+	fd_ClientState_initial_certificate_chain  protoreflect.FieldDescriptor
+	fd_ClientState_consensus_state_key_format protoreflect.FieldDescriptor
+	fd_ClientState_host_state_shutdown_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -537,6 +592,10 @@ func init() {
 	fd_ClientState_upgrade_path = md_ClientState.Fields().ByName("upgrade_path")
 	fd_ClientState_host_state_nft_policy_id = md_ClientState.Fields().ByName("host_state_nft_policy_id")
 	fd_ClientState_host_state_nft_token_name = md_ClientState.Fields().ByName("host_state_nft_token_name")
+	fd_ClientState_genesis_verification_key = md_ClientState.Fields().ByName("genesis_verification_key")
+	fd_ClientState_initial_certificate_chain = md_ClientState.Fields().ByName("initial_certificate_chain")
+	fd_ClientState_consensus_state_key_format = md_ClientState.Fields().ByName("consensus_state_key_format")
+	fd_ClientState_host_state_shutdown_height = md_ClientState.Fields().ByName("host_state_shutdown_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_ClientState)(nil)
@@ -658,6 +717,30 @@ func (x *fastReflection_ClientState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.GenesisVerificationKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.GenesisVerificationKey)
+		if !f(fd_ClientState_genesis_verification_key, value) {
+			return
+		}
+	}
+	if len(x.InitialCertificateChain) != 0 {
+		value := protoreflect.ValueOfList(&_ClientState_11_list{list: &x.InitialCertificateChain})
+		if !f(fd_ClientState_initial_certificate_chain, value) {
+			return
+		}
+	}
+	if x.ConsensusStateKeyFormat != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ConsensusStateKeyFormat))
+		if !f(fd_ClientState_consensus_state_key_format, value) {
+			return
+		}
+	}
+	if x.HostStateShutdownHeight != nil {
+		value := protoreflect.ValueOfMessage(x.HostStateShutdownHeight.ProtoReflect())
+		if !f(fd_ClientState_host_state_shutdown_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -691,6 +774,14 @@ func (x *fastReflection_ClientState) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.HostStateNftPolicyId) != 0
 	case "ibc.lightclients.mithril.v1.ClientState.host_state_nft_token_name":
 		return len(x.HostStateNftTokenName) != 0
+	case "ibc.lightclients.mithril.v1.ClientState.genesis_verification_key":
+		return len(x.GenesisVerificationKey) != 0
+	case "ibc.lightclients.mithril.v1.ClientState.initial_certificate_chain":
+		return len(x.InitialCertificateChain) != 0
+	case "ibc.lightclients.mithril.v1.ClientState.consensus_state_key_format":
+		return x.ConsensusStateKeyFormat != 0
+	case "ibc.lightclients.mithril.v1.ClientState.host_state_shutdown_height":
+		return x.HostStateShutdownHeight != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ClientState"))
@@ -725,6 +816,14 @@ func (x *fastReflection_ClientState) Clear(fd protoreflect.FieldDescriptor) {
 		x.HostStateNftPolicyId = nil
 	case "ibc.lightclients.mithril.v1.ClientState.host_state_nft_token_name":
 		x.HostStateNftTokenName = nil
+	case "ibc.lightclients.mithril.v1.ClientState.genesis_verification_key":
+		x.GenesisVerificationKey = nil
+	case "ibc.lightclients.mithril.v1.ClientState.initial_certificate_chain":
+		x.InitialCertificateChain = nil
+	case "ibc.lightclients.mithril.v1.ClientState.consensus_state_key_format":
+		x.ConsensusStateKeyFormat = 0
+	case "ibc.lightclients.mithril.v1.ClientState.host_state_shutdown_height":
+		x.HostStateShutdownHeight = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ClientState"))
@@ -771,6 +870,21 @@ func (x *fastReflection_ClientState) Get(descriptor protoreflect.FieldDescriptor
 	case "ibc.lightclients.mithril.v1.ClientState.host_state_nft_token_name":
 		value := x.HostStateNftTokenName
 		return protoreflect.ValueOfBytes(value)
+	case "ibc.lightclients.mithril.v1.ClientState.genesis_verification_key":
+		value := x.GenesisVerificationKey
+		return protoreflect.ValueOfBytes(value)
+	case "ibc.lightclients.mithril.v1.ClientState.initial_certificate_chain":
+		if len(x.InitialCertificateChain) == 0 {
+			return protoreflect.ValueOfList(&_ClientState_11_list{})
+		}
+		listValue := &_ClientState_11_list{list: &x.InitialCertificateChain}
+		return protoreflect.ValueOfList(listValue)
+	case "ibc.lightclients.mithril.v1.ClientState.consensus_state_key_format":
+		value := x.ConsensusStateKeyFormat
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ibc.lightclients.mithril.v1.ClientState.host_state_shutdown_height":
+		value := x.HostStateShutdownHeight
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ClientState"))
@@ -811,6 +925,16 @@ func (x *fastReflection_ClientState) Set(fd protoreflect.FieldDescriptor, value
 		x.HostStateNftPolicyId = value.Bytes()
 	case "ibc.lightclients.mithril.v1.ClientState.host_state_nft_token_name":
 		x.HostStateNftTokenName = value.Bytes()
+	case "ibc.lightclients.mithril.v1.ClientState.genesis_verification_key":
+		x.GenesisVerificationKey = value.Bytes()
+	case "ibc.lightclients.mithril.v1.ClientState.initial_certificate_chain":
+		lv := value.List()
+		clv := lv.(*_ClientState_11_list)
+		x.InitialCertificateChain = *clv.list
+	case "ibc.lightclients.mithril.v1.ClientState.consensus_state_key_format":
+		x.ConsensusStateKeyFormat = (ConsensusStateKeyFormat)(value.Enum())
+	case "ibc.lightclients.mithril.v1.ClientState.host_state_shutdown_height":
+		x.HostStateShutdownHeight = value.Message().Interface().(*Height)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ClientState"))
@@ -857,6 +981,17 @@ func (x *fastReflection_ClientState) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_ClientState_7_list{list: &x.UpgradePath}
 		return protoreflect.ValueOfList(value)
+	case "ibc.lightclients.mithril.v1.ClientState.initial_certificate_chain":
+		if x.InitialCertificateChain == nil {
+			x.InitialCertificateChain = []*MithrilCertificate{}
+		}
+		value := &_ClientState_11_list{list: &x.InitialCertificateChain}
+		return protoreflect.ValueOfList(value)
+	case "ibc.lightclients.mithril.v1.ClientState.host_state_shutdown_height":
+		if x.HostStateShutdownHeight == nil {
+			x.HostStateShutdownHeight = new(Height)
+		}
+		return protoreflect.ValueOfMessage(x.HostStateShutdownHeight.ProtoReflect())
 	case "ibc.lightclients.mithril.v1.ClientState.chain_id":
 		panic(fmt.Errorf("field chain_id of message ibc.lightclients.mithril.v1.ClientState is not mutable"))
 	case "ibc.lightclients.mithril.v1.ClientState.current_epoch":
@@ -865,6 +1000,10 @@ func (x *fastReflection_ClientState) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field host_state_nft_policy_id of message ibc.lightclients.mithril.v1.ClientState is not mutable"))
 	case "ibc.lightclients.mithril.v1.ClientState.host_state_nft_token_name":
 		panic(fmt.Errorf("field host_state_nft_token_name of message ibc.lightclients.mithril.v1.ClientState is not mutable"))
+	case "ibc.lightclients.mithril.v1.ClientState.genesis_verification_key":
+		panic(fmt.Errorf("field genesis_verification_key of message ibc.lightclients.mithril.v1.ClientState is not mutable"))
+	case "ibc.lightclients.mithril.v1.ClientState.consensus_state_key_format":
+		panic(fmt.Errorf("field consensus_state_key_format of message ibc.lightclients.mithril.v1.ClientState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ClientState"))
@@ -901,6 +1040,16 @@ func (x *fastReflection_ClientState) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfBytes(nil)
 	case "ibc.lightclients.mithril.v1.ClientState.host_state_nft_token_name":
 		return protoreflect.ValueOfBytes(nil)
+	case "ibc.lightclients.mithril.v1.ClientState.genesis_verification_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "ibc.lightclients.mithril.v1.ClientState.initial_certificate_chain":
+		list := []*MithrilCertificate{}
+		return protoreflect.ValueOfList(&_ClientState_11_list{list: &list})
+	case "ibc.lightclients.mithril.v1.ClientState.consensus_state_key_format":
+		return protoreflect.ValueOfEnum(0)
+	case "ibc.lightclients.mithril.v1.ClientState.host_state_shutdown_height":
+		m := new(Height)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ClientState"))
@@ -1007,6 +1156,23 @@ func (x *fastReflection_ClientState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.GenesisVerificationKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.InitialCertificateChain) > 0 {
+			for _, e := range x.InitialCertificateChain {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ConsensusStateKeyFormat != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsensusStateKeyFormat))
+		}
+		if x.HostStateShutdownHeight != nil {
+			l = options.Size(x.HostStateShutdownHeight)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1036,6 +1202,48 @@ func (x *fastReflection_ClientState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.HostStateShutdownHeight != nil {
+			encoded, err := options.Marshal(x.HostStateShutdownHeight)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.ConsensusStateKeyFormat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsensusStateKeyFormat))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.InitialCertificateChain) > 0 {
+			for iNdEx := len(x.InitialCertificateChain) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InitialCertificateChain[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.GenesisVerificationKey) > 0 {
+			i -= len(x.GenesisVerificationKey)
+			copy(dAtA[i:], x.GenesisVerificationKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GenesisVerificationKey)))
+			i--
+			dAtA[i] = 0x52
+		}
 		if len(x.HostStateNftTokenName) > 0 {
 			i -= len(x.HostStateNftTokenName)
 			copy(dAtA[i:], x.HostStateNftTokenName)
@@ -1471,6 +1679,129 @@ func (x *fastReflection_ClientState) ProtoMethods() *protoiface.Methods {
 					x.HostStateNftTokenName = []byte{}
 				}
 				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisVerificationKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GenesisVerificationKey = append(x.GenesisVerificationKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.GenesisVerificationKey == nil {
+					x.GenesisVerificationKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialCertificateChain", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialCertificateChain = append(x.InitialCertificateChain, &MithrilCertificate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialCertificateChain[len(x.InitialCertificateChain)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusStateKeyFormat", wireType)
+				}
+				x.ConsensusStateKeyFormat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsensusStateKeyFormat |= ConsensusStateKeyFormat(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateShutdownHeight", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.HostStateShutdownHeight == nil {
+					x.HostStateShutdownHeight = &Height{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostStateShutdownHeight); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1507,11 +1838,16 @@ func (x *fastReflection_ClientState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ConsensusState                              protoreflect.MessageDescriptor
-	fd_ConsensusState_timestamp                    protoreflect.FieldDescriptor
-	fd_ConsensusState_first_cert_hash_latest_epoch protoreflect.FieldDescriptor
-	fd_ConsensusState_latest_cert_hash_tx_snapshot protoreflect.FieldDescriptor
-	fd_ConsensusState_ibc_state_root               protoreflect.FieldDescriptor
+	md_ConsensusState                                 protoreflect.MessageDescriptor
+	fd_ConsensusState_timestamp                       protoreflect.FieldDescriptor
+	fd_ConsensusState_first_cert_hash_latest_epoch    protoreflect.FieldDescriptor
+	fd_ConsensusState_latest_cert_hash_tx_snapshot    protoreflect.FieldDescriptor
+	fd_ConsensusState_ibc_state_root                  protoreflect.FieldDescriptor
+	fd_ConsensusState_transactions_merkle_root        protoreflect.FieldDescriptor
+	fd_ConsensusState_blocks_transactions_merkle_root protoreflect.FieldDescriptor
+	fd_ConsensusState_host_state_block_hash           protoreflect.FieldDescriptor
+	fd_ConsensusState_host_state_shutdown             protoreflect.FieldDescriptor
+	fd_ConsensusState_host_state_version              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1521,6 +1857,11 @@ func init() {
 	fd_ConsensusState_first_cert_hash_latest_epoch = md_ConsensusState.Fields().ByName("first_cert_hash_latest_epoch")
 	fd_ConsensusState_latest_cert_hash_tx_snapshot = md_ConsensusState.Fields().ByName("latest_cert_hash_tx_snapshot")
 	fd_ConsensusState_ibc_state_root = md_ConsensusState.Fields().ByName("ibc_state_root")
+	fd_ConsensusState_transactions_merkle_root = md_ConsensusState.Fields().ByName("transactions_merkle_root")
+	fd_ConsensusState_blocks_transactions_merkle_root = md_ConsensusState.Fields().ByName("blocks_transactions_merkle_root")
+	fd_ConsensusState_host_state_block_hash = md_ConsensusState.Fields().ByName("host_state_block_hash")
+	fd_ConsensusState_host_state_shutdown = md_ConsensusState.Fields().ByName("host_state_shutdown")
+	fd_ConsensusState_host_state_version = md_ConsensusState.Fields().ByName("host_state_version")
 }
 
 var _ protoreflect.Message = (*fastReflection_ConsensusState)(nil)
@@ -1612,6 +1953,36 @@ func (x *fastReflection_ConsensusState) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.TransactionsMerkleRoot != "" {
+		value := protoreflect.ValueOfString(x.TransactionsMerkleRoot)
+		if !f(fd_ConsensusState_transactions_merkle_root, value) {
+			return
+		}
+	}
+	if x.BlocksTransactionsMerkleRoot != "" {
+		value := protoreflect.ValueOfString(x.BlocksTransactionsMerkleRoot)
+		if !f(fd_ConsensusState_blocks_transactions_merkle_root, value) {
+			return
+		}
+	}
+	if x.HostStateBlockHash != "" {
+		value := protoreflect.ValueOfString(x.HostStateBlockHash)
+		if !f(fd_ConsensusState_host_state_block_hash, value) {
+			return
+		}
+	}
+	if x.HostStateShutdown != nil {
+		value := protoreflect.ValueOfMessage(x.HostStateShutdown.ProtoReflect())
+		if !f(fd_ConsensusState_host_state_shutdown, value) {
+			return
+		}
+	}
+	if x.HostStateVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HostStateVersion)
+		if !f(fd_ConsensusState_host_state_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1635,6 +2006,16 @@ func (x *fastReflection_ConsensusState) Has(fd protoreflect.FieldDescriptor) boo
 		return x.LatestCertHashTxSnapshot != ""
 	case "ibc.lightclients.mithril.v1.ConsensusState.ibc_state_root":
 		return len(x.IbcStateRoot) != 0
+	case "ibc.lightclients.mithril.v1.ConsensusState.transactions_merkle_root":
+		return x.TransactionsMerkleRoot != ""
+	case "ibc.lightclients.mithril.v1.ConsensusState.blocks_transactions_merkle_root":
+		return x.BlocksTransactionsMerkleRoot != ""
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_block_hash":
+		return x.HostStateBlockHash != ""
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_shutdown":
+		return x.HostStateShutdown != nil
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_version":
+		return x.HostStateVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ConsensusState"))
@@ -1659,6 +2040,16 @@ func (x *fastReflection_ConsensusState) Clear(fd protoreflect.FieldDescriptor) {
 		x.LatestCertHashTxSnapshot = ""
 	case "ibc.lightclients.mithril.v1.ConsensusState.ibc_state_root":
 		x.IbcStateRoot = nil
+	case "ibc.lightclients.mithril.v1.ConsensusState.transactions_merkle_root":
+		x.TransactionsMerkleRoot = ""
+	case "ibc.lightclients.mithril.v1.ConsensusState.blocks_transactions_merkle_root":
+		x.BlocksTransactionsMerkleRoot = ""
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_block_hash":
+		x.HostStateBlockHash = ""
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_shutdown":
+		x.HostStateShutdown = nil
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_version":
+		x.HostStateVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ConsensusState"))
@@ -1687,6 +2078,21 @@ func (x *fastReflection_ConsensusState) Get(descriptor protoreflect.FieldDescrip
 	case "ibc.lightclients.mithril.v1.ConsensusState.ibc_state_root":
 		value := x.IbcStateRoot
 		return protoreflect.ValueOfBytes(value)
+	case "ibc.lightclients.mithril.v1.ConsensusState.transactions_merkle_root":
+		value := x.TransactionsMerkleRoot
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.ConsensusState.blocks_transactions_merkle_root":
+		value := x.BlocksTransactionsMerkleRoot
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_block_hash":
+		value := x.HostStateBlockHash
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_shutdown":
+		value := x.HostStateShutdown
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_version":
+		value := x.HostStateVersion
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ConsensusState"))
@@ -1715,6 +2121,16 @@ func (x *fastReflection_ConsensusState) Set(fd protoreflect.FieldDescriptor, val
 		x.LatestCertHashTxSnapshot = value.Interface().(string)
 	case "ibc.lightclients.mithril.v1.ConsensusState.ibc_state_root":
 		x.IbcStateRoot = value.Bytes()
+	case "ibc.lightclients.mithril.v1.ConsensusState.transactions_merkle_root":
+		x.TransactionsMerkleRoot = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.ConsensusState.blocks_transactions_merkle_root":
+		x.BlocksTransactionsMerkleRoot = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_block_hash":
+		x.HostStateBlockHash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_shutdown":
+		x.HostStateShutdown = value.Message().Interface().(*HostStateShutdown)
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_version":
+		x.HostStateVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ConsensusState"))
@@ -1740,12 +2156,25 @@ func (x *fastReflection_ConsensusState) Mutable(fd protoreflect.FieldDescriptor)
 			x.FirstCertHashLatestEpoch = new(MithrilCertificate)
 		}
 		return protoreflect.ValueOfMessage(x.FirstCertHashLatestEpoch.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_shutdown":
+		if x.HostStateShutdown == nil {
+			x.HostStateShutdown = new(HostStateShutdown)
+		}
+		return protoreflect.ValueOfMessage(x.HostStateShutdown.ProtoReflect())
 	case "ibc.lightclients.mithril.v1.ConsensusState.timestamp":
 		panic(fmt.Errorf("field timestamp of message ibc.lightclients.mithril.v1.ConsensusState is not mutable"))
 	case "ibc.lightclients.mithril.v1.ConsensusState.latest_cert_hash_tx_snapshot":
 		panic(fmt.Errorf("field latest_cert_hash_tx_snapshot of message ibc.lightclients.mithril.v1.ConsensusState is not mutable"))
 	case "ibc.lightclients.mithril.v1.ConsensusState.ibc_state_root":
 		panic(fmt.Errorf("field ibc_state_root of message ibc.lightclients.mithril.v1.ConsensusState is not mutable"))
+	case "ibc.lightclients.mithril.v1.ConsensusState.transactions_merkle_root":
+		panic(fmt.Errorf("field transactions_merkle_root of message ibc.lightclients.mithril.v1.ConsensusState is not mutable"))
+	case "ibc.lightclients.mithril.v1.ConsensusState.blocks_transactions_merkle_root":
+		panic(fmt.Errorf("field blocks_transactions_merkle_root of message ibc.lightclients.mithril.v1.ConsensusState is not mutable"))
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_block_hash":
+		panic(fmt.Errorf("field host_state_block_hash of message ibc.lightclients.mithril.v1.ConsensusState is not mutable"))
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_version":
+		panic(fmt.Errorf("field host_state_version of message ibc.lightclients.mithril.v1.ConsensusState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ConsensusState"))
@@ -1768,6 +2197,17 @@ func (x *fastReflection_ConsensusState) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "ibc.lightclients.mithril.v1.ConsensusState.ibc_state_root":
 		return protoreflect.ValueOfBytes(nil)
+	case "ibc.lightclients.mithril.v1.ConsensusState.transactions_merkle_root":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.ConsensusState.blocks_transactions_merkle_root":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_block_hash":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_shutdown":
+		m := new(HostStateShutdown)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.ConsensusState.host_state_version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ConsensusState"))
@@ -1852,6 +2292,25 @@ func (x *fastReflection_ConsensusState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.TransactionsMerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlocksTransactionsMerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HostStateBlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HostStateShutdown != nil {
+			l = options.Size(x.HostStateShutdown)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HostStateVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.HostStateVersion))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1881,15 +2340,55 @@ func (x *fastReflection_ConsensusState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.IbcStateRoot) > 0 {
-			i -= len(x.IbcStateRoot)
-			copy(dAtA[i:], x.IbcStateRoot)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IbcStateRoot)))
+		if x.HostStateVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostStateVersion))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x48
 		}
-		if len(x.LatestCertHashTxSnapshot) > 0 {
-			i -= len(x.LatestCertHashTxSnapshot)
+		if x.HostStateShutdown != nil {
+			encoded, err := options.Marshal(x.HostStateShutdown)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.HostStateBlockHash) > 0 {
+			i -= len(x.HostStateBlockHash)
+			copy(dAtA[i:], x.HostStateBlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostStateBlockHash)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlocksTransactionsMerkleRoot) > 0 {
+			i -= len(x.BlocksTransactionsMerkleRoot)
+			copy(dAtA[i:], x.BlocksTransactionsMerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlocksTransactionsMerkleRoot)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.TransactionsMerkleRoot) > 0 {
+			i -= len(x.TransactionsMerkleRoot)
+			copy(dAtA[i:], x.TransactionsMerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TransactionsMerkleRoot)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.IbcStateRoot) > 0 {
+			i -= len(x.IbcStateRoot)
+			copy(dAtA[i:], x.IbcStateRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IbcStateRoot)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.LatestCertHashTxSnapshot) > 0 {
+			i -= len(x.LatestCertHashTxSnapshot)
 			copy(dAtA[i:], x.LatestCertHashTxSnapshot)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LatestCertHashTxSnapshot)))
 			i--
@@ -2084,6 +2583,157 @@ func (x *fastReflection_ConsensusState) ProtoMethods() *protoiface.Methods {
 					x.IbcStateRoot = []byte{}
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionsMerkleRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransactionsMerkleRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksTransactionsMerkleRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlocksTransactionsMerkleRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateBlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HostStateBlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateShutdown", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.HostStateShutdown == nil {
+					x.HostStateShutdown = &HostStateShutdown{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostStateShutdown); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateVersion", wireType)
+				}
+				x.HostStateVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HostStateVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2120,29 +2770,27 @@ func (x *fastReflection_ConsensusState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Misbehaviour                  protoreflect.MessageDescriptor
-	fd_Misbehaviour_client_id        protoreflect.FieldDescriptor
-	fd_Misbehaviour_mithril_header_1 protoreflect.FieldDescriptor
-	fd_Misbehaviour_mithril_header_2 protoreflect.FieldDescriptor
+	md_HostStateShutdown                     protoreflect.MessageDescriptor
+	fd_HostStateShutdown_initiated_at_ms     protoreflect.FieldDescriptor
+	fd_HostStateShutdown_grace_period_end_ms protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_Misbehaviour = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("Misbehaviour")
-	fd_Misbehaviour_client_id = md_Misbehaviour.Fields().ByName("client_id")
-	fd_Misbehaviour_mithril_header_1 = md_Misbehaviour.Fields().ByName("mithril_header_1")
-	fd_Misbehaviour_mithril_header_2 = md_Misbehaviour.Fields().ByName("mithril_header_2")
+	md_HostStateShutdown = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("HostStateShutdown")
+	fd_HostStateShutdown_initiated_at_ms = md_HostStateShutdown.Fields().ByName("initiated_at_ms")
+	fd_HostStateShutdown_grace_period_end_ms = md_HostStateShutdown.Fields().ByName("grace_period_end_ms")
 }
 
-var _ protoreflect.Message = (*fastReflection_Misbehaviour)(nil)
+var _ protoreflect.Message = (*fastReflection_HostStateShutdown)(nil)
 
-type fastReflection_Misbehaviour Misbehaviour
+type fastReflection_HostStateShutdown HostStateShutdown
 
-func (x *Misbehaviour) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Misbehaviour)(x)
+func (x *HostStateShutdown) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HostStateShutdown)(x)
 }
 
-func (x *Misbehaviour) slowProtoReflect() protoreflect.Message {
+func (x *HostStateShutdown) slowProtoReflect() protoreflect.Message {
 	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2154,43 +2802,43 @@ func (x *Misbehaviour) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Misbehaviour_messageType fastReflection_Misbehaviour_messageType
-var _ protoreflect.MessageType = fastReflection_Misbehaviour_messageType{}
+var _fastReflection_HostStateShutdown_messageType fastReflection_HostStateShutdown_messageType
+var _ protoreflect.MessageType = fastReflection_HostStateShutdown_messageType{}
 
-type fastReflection_Misbehaviour_messageType struct{}
+type fastReflection_HostStateShutdown_messageType struct{}
 
-func (x fastReflection_Misbehaviour_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Misbehaviour)(nil)
+func (x fastReflection_HostStateShutdown_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HostStateShutdown)(nil)
 }
-func (x fastReflection_Misbehaviour_messageType) New() protoreflect.Message {
-	return new(fastReflection_Misbehaviour)
+func (x fastReflection_HostStateShutdown_messageType) New() protoreflect.Message {
+	return new(fastReflection_HostStateShutdown)
 }
-func (x fastReflection_Misbehaviour_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Misbehaviour
+func (x fastReflection_HostStateShutdown_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HostStateShutdown
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Misbehaviour) Descriptor() protoreflect.MessageDescriptor {
-	return md_Misbehaviour
+func (x *fastReflection_HostStateShutdown) Descriptor() protoreflect.MessageDescriptor {
+	return md_HostStateShutdown
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Misbehaviour) Type() protoreflect.MessageType {
-	return _fastReflection_Misbehaviour_messageType
+func (x *fastReflection_HostStateShutdown) Type() protoreflect.MessageType {
+	return _fastReflection_HostStateShutdown_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Misbehaviour) New() protoreflect.Message {
-	return new(fastReflection_Misbehaviour)
+func (x *fastReflection_HostStateShutdown) New() protoreflect.Message {
+	return new(fastReflection_HostStateShutdown)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Misbehaviour) Interface() protoreflect.ProtoMessage {
-	return (*Misbehaviour)(x)
+func (x *fastReflection_HostStateShutdown) Interface() protoreflect.ProtoMessage {
+	return (*HostStateShutdown)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2198,22 +2846,16 @@ func (x *fastReflection_Misbehaviour) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Misbehaviour) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_Misbehaviour_client_id, value) {
-			return
-		}
-	}
-	if x.MithrilHeader_1 != nil {
-		value := protoreflect.ValueOfMessage(x.MithrilHeader_1.ProtoReflect())
-		if !f(fd_Misbehaviour_mithril_header_1, value) {
+func (x *fastReflection_HostStateShutdown) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InitiatedAtMs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InitiatedAtMs)
+		if !f(fd_HostStateShutdown_initiated_at_ms, value) {
 			return
 		}
 	}
-	if x.MithrilHeader_2 != nil {
-		value := protoreflect.ValueOfMessage(x.MithrilHeader_2.ProtoReflect())
-		if !f(fd_Misbehaviour_mithril_header_2, value) {
+	if x.GracePeriodEndMs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GracePeriodEndMs)
+		if !f(fd_HostStateShutdown_grace_period_end_ms, value) {
 			return
 		}
 	}
@@ -2230,19 +2872,17 @@ func (x *fastReflection_Misbehaviour) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Misbehaviour) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_HostStateShutdown) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
-		return x.ClientId != ""
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
-		return x.MithrilHeader_1 != nil
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
-		return x.MithrilHeader_2 != nil
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.initiated_at_ms":
+		return x.InitiatedAtMs != uint64(0)
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.grace_period_end_ms":
+		return x.GracePeriodEndMs != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.HostStateShutdown"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.HostStateShutdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2252,19 +2892,17 @@ func (x *fastReflection_Misbehaviour) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Misbehaviour) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_HostStateShutdown) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
-		x.ClientId = ""
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
-		x.MithrilHeader_1 = nil
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
-		x.MithrilHeader_2 = nil
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.initiated_at_ms":
+		x.InitiatedAtMs = uint64(0)
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.grace_period_end_ms":
+		x.GracePeriodEndMs = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.HostStateShutdown"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.HostStateShutdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2274,22 +2912,19 @@ func (x *fastReflection_Misbehaviour) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Misbehaviour) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_HostStateShutdown) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
-		value := x.MithrilHeader_1
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
-		value := x.MithrilHeader_2
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.initiated_at_ms":
+		value := x.InitiatedAtMs
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.grace_period_end_ms":
+		value := x.GracePeriodEndMs
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.HostStateShutdown"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.HostStateShutdown does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2303,19 +2938,17 @@ func (x *fastReflection_Misbehaviour) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Misbehaviour) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_HostStateShutdown) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
-		x.ClientId = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
-		x.MithrilHeader_1 = value.Message().Interface().(*MithrilHeader)
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
-		x.MithrilHeader_2 = value.Message().Interface().(*MithrilHeader)
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.initiated_at_ms":
+		x.InitiatedAtMs = value.Uint()
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.grace_period_end_ms":
+		x.GracePeriodEndMs = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.HostStateShutdown"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.HostStateShutdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2329,56 +2962,44 @@ func (x *fastReflection_Misbehaviour) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Misbehaviour) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_HostStateShutdown) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
-		if x.MithrilHeader_1 == nil {
-			x.MithrilHeader_1 = new(MithrilHeader)
-		}
-		return protoreflect.ValueOfMessage(x.MithrilHeader_1.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
-		if x.MithrilHeader_2 == nil {
-			x.MithrilHeader_2 = new(MithrilHeader)
-		}
-		return protoreflect.ValueOfMessage(x.MithrilHeader_2.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
-		panic(fmt.Errorf("field client_id of message ibc.lightclients.mithril.v1.Misbehaviour is not mutable"))
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.initiated_at_ms":
+		panic(fmt.Errorf("field initiated_at_ms of message ibc.lightclients.mithril.v1.HostStateShutdown is not mutable"))
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.grace_period_end_ms":
+		panic(fmt.Errorf("field grace_period_end_ms of message ibc.lightclients.mithril.v1.HostStateShutdown is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.HostStateShutdown"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.HostStateShutdown does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Misbehaviour) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_HostStateShutdown) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
-		m := new(MithrilHeader)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
-		m := new(MithrilHeader)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.initiated_at_ms":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.HostStateShutdown.grace_period_end_ms":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.HostStateShutdown"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.HostStateShutdown does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Misbehaviour) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_HostStateShutdown) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.Misbehaviour", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.HostStateShutdown", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2386,7 +3007,7 @@ func (x *fastReflection_Misbehaviour) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Misbehaviour) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_HostStateShutdown) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2397,7 +3018,7 @@ func (x *fastReflection_Misbehaviour) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Misbehaviour) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_HostStateShutdown) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2409,7 +3030,7 @@ func (x *fastReflection_Misbehaviour) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Misbehaviour) IsValid() bool {
+func (x *fastReflection_HostStateShutdown) IsValid() bool {
 	return x != nil
 }
 
@@ -2419,9 +3040,9 @@ func (x *fastReflection_Misbehaviour) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_HostStateShutdown) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Misbehaviour)
+		x := input.Message.Interface().(*HostStateShutdown)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2433,17 +3054,11 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.MithrilHeader_1 != nil {
-			l = options.Size(x.MithrilHeader_1)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.InitiatedAtMs != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitiatedAtMs))
 		}
-		if x.MithrilHeader_2 != nil {
-			l = options.Size(x.MithrilHeader_2)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GracePeriodEndMs != 0 {
+			n += 1 + runtime.Sov(uint64(x.GracePeriodEndMs))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2455,7 +3070,7 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Misbehaviour)
+		x := input.Message.Interface().(*HostStateShutdown)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2474,40 +3089,15 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MithrilHeader_2 != nil {
-			encoded, err := options.Marshal(x.MithrilHeader_2)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.MithrilHeader_1 != nil {
-			encoded, err := options.Marshal(x.MithrilHeader_1)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.GracePeriodEndMs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GracePeriodEndMs))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if x.InitiatedAtMs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitiatedAtMs))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2520,7 +3110,7 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Misbehaviour)
+		x := input.Message.Interface().(*HostStateShutdown)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2552,17 +3142,17 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HostStateShutdown: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HostStateShutdown: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitiatedAtMs", wireType)
 				}
-				var stringLen uint64
+				x.InitiatedAtMs = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2572,65 +3162,16 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.InitiatedAtMs |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilHeader_1", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MithrilHeader_1 == nil {
-					x.MithrilHeader_1 = &MithrilHeader{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilHeader_1); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilHeader_2", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GracePeriodEndMs", wireType)
 				}
-				var msglen int
+				x.GracePeriodEndMs = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2640,28 +3181,11 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.GracePeriodEndMs |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MithrilHeader_2 == nil {
-					x.MithrilHeader_2 = &MithrilHeader{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilHeader_2); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2697,93 +3221,34 @@ func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MithrilHeader_9_list)(nil)
-
-type _MithrilHeader_9_list struct {
-	list *[]*MithrilCertificate
-}
-
-func (x *_MithrilHeader_9_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MithrilHeader_9_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MithrilHeader_9_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MithrilCertificate)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MithrilHeader_9_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MithrilCertificate)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MithrilHeader_9_list) AppendMutable() protoreflect.Value {
-	v := new(MithrilCertificate)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MithrilHeader_9_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MithrilHeader_9_list) NewElement() protoreflect.Value {
-	v := new(MithrilCertificate)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MithrilHeader_9_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MithrilHeader                                                  protoreflect.MessageDescriptor
-	fd_MithrilHeader_mithril_stake_distribution                       protoreflect.FieldDescriptor
-	fd_MithrilHeader_mithril_stake_distribution_certificate           protoreflect.FieldDescriptor
-	fd_MithrilHeader_transaction_snapshot                             protoreflect.FieldDescriptor
-	fd_MithrilHeader_transaction_snapshot_certificate                 protoreflect.FieldDescriptor
-	fd_MithrilHeader_previous_mithril_stake_distribution_certificates protoreflect.FieldDescriptor
-	fd_MithrilHeader_host_state_tx_hash                               protoreflect.FieldDescriptor
-	fd_MithrilHeader_host_state_tx_body_cbor                          protoreflect.FieldDescriptor
-	fd_MithrilHeader_host_state_tx_output_index                       protoreflect.FieldDescriptor
-	fd_MithrilHeader_host_state_tx_proof                              protoreflect.FieldDescriptor
+	md_MithrilTransactionMembershipProof                   protoreflect.MessageDescriptor
+	fd_MithrilTransactionMembershipProof_transaction_proof protoreflect.FieldDescriptor
+	fd_MithrilTransactionMembershipProof_tx_hash           protoreflect.FieldDescriptor
+	fd_MithrilTransactionMembershipProof_tx_body_cbor      protoreflect.FieldDescriptor
+	fd_MithrilTransactionMembershipProof_output_index      protoreflect.FieldDescriptor
+	fd_MithrilTransactionMembershipProof_ibc_state_proof   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_MithrilHeader = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilHeader")
-	fd_MithrilHeader_mithril_stake_distribution = md_MithrilHeader.Fields().ByName("mithril_stake_distribution")
-	fd_MithrilHeader_mithril_stake_distribution_certificate = md_MithrilHeader.Fields().ByName("mithril_stake_distribution_certificate")
-	fd_MithrilHeader_transaction_snapshot = md_MithrilHeader.Fields().ByName("transaction_snapshot")
-	fd_MithrilHeader_transaction_snapshot_certificate = md_MithrilHeader.Fields().ByName("transaction_snapshot_certificate")
-	fd_MithrilHeader_previous_mithril_stake_distribution_certificates = md_MithrilHeader.Fields().ByName("previous_mithril_stake_distribution_certificates")
-	fd_MithrilHeader_host_state_tx_hash = md_MithrilHeader.Fields().ByName("host_state_tx_hash")
-	fd_MithrilHeader_host_state_tx_body_cbor = md_MithrilHeader.Fields().ByName("host_state_tx_body_cbor")
-	fd_MithrilHeader_host_state_tx_output_index = md_MithrilHeader.Fields().ByName("host_state_tx_output_index")
-	fd_MithrilHeader_host_state_tx_proof = md_MithrilHeader.Fields().ByName("host_state_tx_proof")
+	md_MithrilTransactionMembershipProof = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilTransactionMembershipProof")
+	fd_MithrilTransactionMembershipProof_transaction_proof = md_MithrilTransactionMembershipProof.Fields().ByName("transaction_proof")
+	fd_MithrilTransactionMembershipProof_tx_hash = md_MithrilTransactionMembershipProof.Fields().ByName("tx_hash")
+	fd_MithrilTransactionMembershipProof_tx_body_cbor = md_MithrilTransactionMembershipProof.Fields().ByName("tx_body_cbor")
+	fd_MithrilTransactionMembershipProof_output_index = md_MithrilTransactionMembershipProof.Fields().ByName("output_index")
+	fd_MithrilTransactionMembershipProof_ibc_state_proof = md_MithrilTransactionMembershipProof.Fields().ByName("ibc_state_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_MithrilHeader)(nil)
+var _ protoreflect.Message = (*fastReflection_MithrilTransactionMembershipProof)(nil)
 
-type fastReflection_MithrilHeader MithrilHeader
+type fastReflection_MithrilTransactionMembershipProof MithrilTransactionMembershipProof
 
-func (x *MithrilHeader) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MithrilHeader)(x)
+func (x *MithrilTransactionMembershipProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MithrilTransactionMembershipProof)(x)
 }
 
-func (x *MithrilHeader) slowProtoReflect() protoreflect.Message {
+func (x *MithrilTransactionMembershipProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2795,43 +3260,43 @@ func (x *MithrilHeader) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MithrilHeader_messageType fastReflection_MithrilHeader_messageType
-var _ protoreflect.MessageType = fastReflection_MithrilHeader_messageType{}
+var _fastReflection_MithrilTransactionMembershipProof_messageType fastReflection_MithrilTransactionMembershipProof_messageType
+var _ protoreflect.MessageType = fastReflection_MithrilTransactionMembershipProof_messageType{}
 
-type fastReflection_MithrilHeader_messageType struct{}
+type fastReflection_MithrilTransactionMembershipProof_messageType struct{}
 
-func (x fastReflection_MithrilHeader_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MithrilHeader)(nil)
+func (x fastReflection_MithrilTransactionMembershipProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MithrilTransactionMembershipProof)(nil)
 }
-func (x fastReflection_MithrilHeader_messageType) New() protoreflect.Message {
-	return new(fastReflection_MithrilHeader)
+func (x fastReflection_MithrilTransactionMembershipProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_MithrilTransactionMembershipProof)
 }
-func (x fastReflection_MithrilHeader_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilHeader
+func (x fastReflection_MithrilTransactionMembershipProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilTransactionMembershipProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MithrilHeader) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilHeader
+func (x *fastReflection_MithrilTransactionMembershipProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilTransactionMembershipProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MithrilHeader) Type() protoreflect.MessageType {
-	return _fastReflection_MithrilHeader_messageType
+func (x *fastReflection_MithrilTransactionMembershipProof) Type() protoreflect.MessageType {
+	return _fastReflection_MithrilTransactionMembershipProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MithrilHeader) New() protoreflect.Message {
-	return new(fastReflection_MithrilHeader)
+func (x *fastReflection_MithrilTransactionMembershipProof) New() protoreflect.Message {
+	return new(fastReflection_MithrilTransactionMembershipProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MithrilHeader) Interface() protoreflect.ProtoMessage {
-	return (*MithrilHeader)(x)
+func (x *fastReflection_MithrilTransactionMembershipProof) Interface() protoreflect.ProtoMessage {
+	return (*MithrilTransactionMembershipProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2839,58 +3304,34 @@ func (x *fastReflection_MithrilHeader) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MithrilHeader) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MithrilStakeDistribution != nil {
-		value := protoreflect.ValueOfMessage(x.MithrilStakeDistribution.ProtoReflect())
-		if !f(fd_MithrilHeader_mithril_stake_distribution, value) {
+func (x *fastReflection_MithrilTransactionMembershipProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TransactionProof) != 0 {
+		value := protoreflect.ValueOfBytes(x.TransactionProof)
+		if !f(fd_MithrilTransactionMembershipProof_transaction_proof, value) {
 			return
 		}
 	}
-	if x.MithrilStakeDistributionCertificate != nil {
-		value := protoreflect.ValueOfMessage(x.MithrilStakeDistributionCertificate.ProtoReflect())
-		if !f(fd_MithrilHeader_mithril_stake_distribution_certificate, value) {
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_MithrilTransactionMembershipProof_tx_hash, value) {
 			return
 		}
 	}
-	if x.TransactionSnapshot != nil {
-		value := protoreflect.ValueOfMessage(x.TransactionSnapshot.ProtoReflect())
-		if !f(fd_MithrilHeader_transaction_snapshot, value) {
-			return
-		}
-	}
-	if x.TransactionSnapshotCertificate != nil {
-		value := protoreflect.ValueOfMessage(x.TransactionSnapshotCertificate.ProtoReflect())
-		if !f(fd_MithrilHeader_transaction_snapshot_certificate, value) {
-			return
-		}
-	}
-	if len(x.PreviousMithrilStakeDistributionCertificates) != 0 {
-		value := protoreflect.ValueOfList(&_MithrilHeader_9_list{list: &x.PreviousMithrilStakeDistributionCertificates})
-		if !f(fd_MithrilHeader_previous_mithril_stake_distribution_certificates, value) {
-			return
-		}
-	}
-	if x.HostStateTxHash != "" {
-		value := protoreflect.ValueOfString(x.HostStateTxHash)
-		if !f(fd_MithrilHeader_host_state_tx_hash, value) {
-			return
-		}
-	}
-	if len(x.HostStateTxBodyCbor) != 0 {
-		value := protoreflect.ValueOfBytes(x.HostStateTxBodyCbor)
-		if !f(fd_MithrilHeader_host_state_tx_body_cbor, value) {
+	if len(x.TxBodyCbor) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBodyCbor)
+		if !f(fd_MithrilTransactionMembershipProof_tx_body_cbor, value) {
 			return
 		}
 	}
-	if x.HostStateTxOutputIndex != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.HostStateTxOutputIndex)
-		if !f(fd_MithrilHeader_host_state_tx_output_index, value) {
+	if x.OutputIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OutputIndex)
+		if !f(fd_MithrilTransactionMembershipProof_output_index, value) {
 			return
 		}
 	}
-	if len(x.HostStateTxProof) != 0 {
-		value := protoreflect.ValueOfBytes(x.HostStateTxProof)
-		if !f(fd_MithrilHeader_host_state_tx_proof, value) {
+	if len(x.IbcStateProof) != 0 {
+		value := protoreflect.ValueOfBytes(x.IbcStateProof)
+		if !f(fd_MithrilTransactionMembershipProof_ibc_state_proof, value) {
 			return
 		}
 	}
@@ -2907,31 +3348,23 @@ func (x *fastReflection_MithrilHeader) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MithrilHeader) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MithrilTransactionMembershipProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
-		return x.MithrilStakeDistribution != nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
-		return x.MithrilStakeDistributionCertificate != nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
-		return x.TransactionSnapshot != nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
-		return x.TransactionSnapshotCertificate != nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
-		return len(x.PreviousMithrilStakeDistributionCertificates) != 0
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
-		return x.HostStateTxHash != ""
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
-		return len(x.HostStateTxBodyCbor) != 0
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
-		return x.HostStateTxOutputIndex != uint32(0)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
-		return len(x.HostStateTxProof) != 0
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.transaction_proof":
+		return len(x.TransactionProof) != 0
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_hash":
+		return x.TxHash != ""
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_body_cbor":
+		return len(x.TxBodyCbor) != 0
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.output_index":
+		return x.OutputIndex != uint32(0)
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.ibc_state_proof":
+		return len(x.IbcStateProof) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2941,31 +3374,23 @@ func (x *fastReflection_MithrilHeader) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilHeader) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MithrilTransactionMembershipProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
-		x.MithrilStakeDistribution = nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
-		x.MithrilStakeDistributionCertificate = nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
-		x.TransactionSnapshot = nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
-		x.TransactionSnapshotCertificate = nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
-		x.PreviousMithrilStakeDistributionCertificates = nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
-		x.HostStateTxHash = ""
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
-		x.HostStateTxBodyCbor = nil
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
-		x.HostStateTxOutputIndex = uint32(0)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
-		x.HostStateTxProof = nil
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.transaction_proof":
+		x.TransactionProof = nil
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_hash":
+		x.TxHash = ""
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_body_cbor":
+		x.TxBodyCbor = nil
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.output_index":
+		x.OutputIndex = uint32(0)
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.ibc_state_proof":
+		x.IbcStateProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2975,43 +3400,28 @@ func (x *fastReflection_MithrilHeader) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MithrilHeader) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilTransactionMembershipProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
-		value := x.MithrilStakeDistribution
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
-		value := x.MithrilStakeDistributionCertificate
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
-		value := x.TransactionSnapshot
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
-		value := x.TransactionSnapshotCertificate
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
-		if len(x.PreviousMithrilStakeDistributionCertificates) == 0 {
-			return protoreflect.ValueOfList(&_MithrilHeader_9_list{})
-		}
-		listValue := &_MithrilHeader_9_list{list: &x.PreviousMithrilStakeDistributionCertificates}
-		return protoreflect.ValueOfList(listValue)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
-		value := x.HostStateTxHash
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.transaction_proof":
+		value := x.TransactionProof
+		return protoreflect.ValueOfBytes(value)
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_hash":
+		value := x.TxHash
 		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
-		value := x.HostStateTxBodyCbor
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_body_cbor":
+		value := x.TxBodyCbor
 		return protoreflect.ValueOfBytes(value)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
-		value := x.HostStateTxOutputIndex
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.output_index":
+		value := x.OutputIndex
 		return protoreflect.ValueOfUint32(value)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
-		value := x.HostStateTxProof
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.ibc_state_proof":
+		value := x.IbcStateProof
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3025,33 +3435,23 @@ func (x *fastReflection_MithrilHeader) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilHeader) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MithrilTransactionMembershipProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
-		x.MithrilStakeDistribution = value.Message().Interface().(*MithrilStakeDistribution)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
-		x.MithrilStakeDistributionCertificate = value.Message().Interface().(*MithrilCertificate)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
-		x.TransactionSnapshot = value.Message().Interface().(*CardanoTransactionSnapshot)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
-		x.TransactionSnapshotCertificate = value.Message().Interface().(*MithrilCertificate)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
-		lv := value.List()
-		clv := lv.(*_MithrilHeader_9_list)
-		x.PreviousMithrilStakeDistributionCertificates = *clv.list
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
-		x.HostStateTxHash = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
-		x.HostStateTxBodyCbor = value.Bytes()
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
-		x.HostStateTxOutputIndex = uint32(value.Uint())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
-		x.HostStateTxProof = value.Bytes()
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.transaction_proof":
+		x.TransactionProof = value.Bytes()
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_body_cbor":
+		x.TxBodyCbor = value.Bytes()
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.output_index":
+		x.OutputIndex = uint32(value.Uint())
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.ibc_state_proof":
+		x.IbcStateProof = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3065,93 +3465,56 @@ func (x *fastReflection_MithrilHeader) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilHeader) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilTransactionMembershipProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
-		if x.MithrilStakeDistribution == nil {
-			x.MithrilStakeDistribution = new(MithrilStakeDistribution)
-		}
-		return protoreflect.ValueOfMessage(x.MithrilStakeDistribution.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
-		if x.MithrilStakeDistributionCertificate == nil {
-			x.MithrilStakeDistributionCertificate = new(MithrilCertificate)
-		}
-		return protoreflect.ValueOfMessage(x.MithrilStakeDistributionCertificate.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
-		if x.TransactionSnapshot == nil {
-			x.TransactionSnapshot = new(CardanoTransactionSnapshot)
-		}
-		return protoreflect.ValueOfMessage(x.TransactionSnapshot.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
-		if x.TransactionSnapshotCertificate == nil {
-			x.TransactionSnapshotCertificate = new(MithrilCertificate)
-		}
-		return protoreflect.ValueOfMessage(x.TransactionSnapshotCertificate.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
-		if x.PreviousMithrilStakeDistributionCertificates == nil {
-			x.PreviousMithrilStakeDistributionCertificates = []*MithrilCertificate{}
-		}
-		value := &_MithrilHeader_9_list{list: &x.PreviousMithrilStakeDistributionCertificates}
-		return protoreflect.ValueOfList(value)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
-		panic(fmt.Errorf("field host_state_tx_hash of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
-		panic(fmt.Errorf("field host_state_tx_body_cbor of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
-		panic(fmt.Errorf("field host_state_tx_output_index of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
-		panic(fmt.Errorf("field host_state_tx_proof of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.transaction_proof":
+		panic(fmt.Errorf("field transaction_proof of message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_body_cbor":
+		panic(fmt.Errorf("field tx_body_cbor of message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.output_index":
+		panic(fmt.Errorf("field output_index of message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.ibc_state_proof":
+		panic(fmt.Errorf("field ibc_state_proof of message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MithrilHeader) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilTransactionMembershipProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
-		m := new(MithrilStakeDistribution)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
-		m := new(MithrilCertificate)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
-		m := new(CardanoTransactionSnapshot)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
-		m := new(MithrilCertificate)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
-		list := []*MithrilCertificate{}
-		return protoreflect.ValueOfList(&_MithrilHeader_9_list{list: &list})
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.transaction_proof":
+		return protoreflect.ValueOfBytes(nil)
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_hash":
 		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.tx_body_cbor":
 		return protoreflect.ValueOfBytes(nil)
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.output_index":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
+	case "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof.ibc_state_proof":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MithrilHeader) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MithrilTransactionMembershipProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilHeader", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3159,7 +3522,7 @@ func (x *fastReflection_MithrilHeader) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MithrilHeader) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MithrilTransactionMembershipProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3170,7 +3533,7 @@ func (x *fastReflection_MithrilHeader) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilHeader) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MithrilTransactionMembershipProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3182,7 +3545,7 @@ func (x *fastReflection_MithrilHeader) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MithrilHeader) IsValid() bool {
+func (x *fastReflection_MithrilTransactionMembershipProof) IsValid() bool {
 	return x != nil
 }
 
@@ -3192,9 +3555,9 @@ func (x *fastReflection_MithrilHeader) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MithrilTransactionMembershipProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MithrilHeader)
+		x := input.Message.Interface().(*MithrilTransactionMembershipProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3206,40 +3569,22 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MithrilStakeDistribution != nil {
-			l = options.Size(x.MithrilStakeDistribution)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.MithrilStakeDistributionCertificate != nil {
-			l = options.Size(x.MithrilStakeDistributionCertificate)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TransactionSnapshot != nil {
-			l = options.Size(x.TransactionSnapshot)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TransactionSnapshotCertificate != nil {
-			l = options.Size(x.TransactionSnapshotCertificate)
+		l = len(x.TransactionProof)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.PreviousMithrilStakeDistributionCertificates) > 0 {
-			for _, e := range x.PreviousMithrilStakeDistributionCertificates {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.HostStateTxHash)
+		l = len(x.TxHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.HostStateTxBodyCbor)
+		l = len(x.TxBodyCbor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.HostStateTxOutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.HostStateTxOutputIndex))
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
 		}
-		l = len(x.HostStateTxProof)
+		l = len(x.IbcStateProof)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3253,7 +3598,7 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilHeader)
+		x := input.Message.Interface().(*MithrilTransactionMembershipProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3272,101 +3617,36 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.PreviousMithrilStakeDistributionCertificates) > 0 {
-			for iNdEx := len(x.PreviousMithrilStakeDistributionCertificates) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousMithrilStakeDistributionCertificates[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4a
-			}
-		}
-		if len(x.HostStateTxProof) > 0 {
-			i -= len(x.HostStateTxProof)
-			copy(dAtA[i:], x.HostStateTxProof)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostStateTxProof)))
+		if len(x.IbcStateProof) > 0 {
+			i -= len(x.IbcStateProof)
+			copy(dAtA[i:], x.IbcStateProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IbcStateProof)))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x2a
 		}
-		if x.HostStateTxOutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostStateTxOutputIndex))
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
 			i--
-			dAtA[i] = 0x38
+			dAtA[i] = 0x20
 		}
-		if len(x.HostStateTxBodyCbor) > 0 {
-			i -= len(x.HostStateTxBodyCbor)
-			copy(dAtA[i:], x.HostStateTxBodyCbor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostStateTxBodyCbor)))
+		if len(x.TxBodyCbor) > 0 {
+			i -= len(x.TxBodyCbor)
+			copy(dAtA[i:], x.TxBodyCbor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBodyCbor)))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1a
 		}
-		if len(x.HostStateTxHash) > 0 {
-			i -= len(x.HostStateTxHash)
-			copy(dAtA[i:], x.HostStateTxHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostStateTxHash)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.TransactionSnapshotCertificate != nil {
-			encoded, err := options.Marshal(x.TransactionSnapshotCertificate)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.TransactionSnapshot != nil {
-			encoded, err := options.Marshal(x.TransactionSnapshot)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.MithrilStakeDistributionCertificate != nil {
-			encoded, err := options.Marshal(x.MithrilStakeDistributionCertificate)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.MithrilStakeDistribution != nil {
-			encoded, err := options.Marshal(x.MithrilStakeDistribution)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.TransactionProof) > 0 {
+			i -= len(x.TransactionProof)
+			copy(dAtA[i:], x.TransactionProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TransactionProof)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3381,7 +3661,7 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilHeader)
+		x := input.Message.Interface().(*MithrilTransactionMembershipProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3413,17 +3693,17 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilHeader: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilTransactionMembershipProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilTransactionMembershipProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilStakeDistribution", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionProof", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3433,173 +3713,29 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MithrilStakeDistribution == nil {
-					x.MithrilStakeDistribution = &MithrilStakeDistribution{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilStakeDistribution); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.TransactionProof = append(x.TransactionProof[:0], dAtA[iNdEx:postIndex]...)
+				if x.TransactionProof == nil {
+					x.TransactionProof = []byte{}
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilStakeDistributionCertificate", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MithrilStakeDistributionCertificate == nil {
-					x.MithrilStakeDistributionCertificate = &MithrilCertificate{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilStakeDistributionCertificate); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionSnapshot", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TransactionSnapshot == nil {
-					x.TransactionSnapshot = &CardanoTransactionSnapshot{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransactionSnapshot); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionSnapshotCertificate", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TransactionSnapshotCertificate == nil {
-					x.TransactionSnapshotCertificate = &MithrilCertificate{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransactionSnapshotCertificate); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousMithrilStakeDistributionCertificates", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PreviousMithrilStakeDistributionCertificates = append(x.PreviousMithrilStakeDistributionCertificates, &MithrilCertificate{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousMithrilStakeDistributionCertificates[len(x.PreviousMithrilStakeDistributionCertificates)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3627,11 +3763,11 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HostStateTxHash = string(dAtA[iNdEx:postIndex])
+				x.TxHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxBodyCbor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBodyCbor", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3658,16 +3794,16 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HostStateTxBodyCbor = append(x.HostStateTxBodyCbor[:0], dAtA[iNdEx:postIndex]...)
-				if x.HostStateTxBodyCbor == nil {
-					x.HostStateTxBodyCbor = []byte{}
+				x.TxBodyCbor = append(x.TxBodyCbor[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBodyCbor == nil {
+					x.TxBodyCbor = []byte{}
 				}
 				iNdEx = postIndex
-			case 7:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxOutputIndex", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 				}
-				x.HostStateTxOutputIndex = 0
+				x.OutputIndex = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3677,14 +3813,14 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.HostStateTxOutputIndex |= uint32(b&0x7F) << shift
+					x.OutputIndex |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 8:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcStateProof", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3711,9 +3847,9 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HostStateTxProof = append(x.HostStateTxProof[:0], dAtA[iNdEx:postIndex]...)
-				if x.HostStateTxProof == nil {
-					x.HostStateTxProof = []byte{}
+				x.IbcStateProof = append(x.IbcStateProof[:0], dAtA[iNdEx:postIndex]...)
+				if x.IbcStateProof == nil {
+					x.IbcStateProof = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -3751,87 +3887,30 @@ func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MithrilStakeDistribution_2_list)(nil)
+var (
+	md_Misbehaviour                  protoreflect.MessageDescriptor
+	fd_Misbehaviour_client_id        protoreflect.FieldDescriptor
+	fd_Misbehaviour_mithril_header_1 protoreflect.FieldDescriptor
+	fd_Misbehaviour_mithril_header_2 protoreflect.FieldDescriptor
+)
 
-type _MithrilStakeDistribution_2_list struct {
-	list *[]*SignerWithStake
+func init() {
+	file_ibc_lightclients_mithril_v1_mithril_proto_init()
+	md_Misbehaviour = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("Misbehaviour")
+	fd_Misbehaviour_client_id = md_Misbehaviour.Fields().ByName("client_id")
+	fd_Misbehaviour_mithril_header_1 = md_Misbehaviour.Fields().ByName("mithril_header_1")
+	fd_Misbehaviour_mithril_header_2 = md_Misbehaviour.Fields().ByName("mithril_header_2")
 }
 
-func (x *_MithrilStakeDistribution_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_Misbehaviour)(nil)
 
-func (x *_MithrilStakeDistribution_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MithrilStakeDistribution_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MithrilStakeDistribution_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MithrilStakeDistribution_2_list) AppendMutable() protoreflect.Value {
-	v := new(SignerWithStake)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MithrilStakeDistribution_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MithrilStakeDistribution_2_list) NewElement() protoreflect.Value {
-	v := new(SignerWithStake)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MithrilStakeDistribution_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_MithrilStakeDistribution                    protoreflect.MessageDescriptor
-	fd_MithrilStakeDistribution_epoch              protoreflect.FieldDescriptor
-	fd_MithrilStakeDistribution_signers_with_stake protoreflect.FieldDescriptor
-	fd_MithrilStakeDistribution_hash               protoreflect.FieldDescriptor
-	fd_MithrilStakeDistribution_certificate_hash   protoreflect.FieldDescriptor
-	fd_MithrilStakeDistribution_created_at         protoreflect.FieldDescriptor
-	fd_MithrilStakeDistribution_protocol_parameter protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_MithrilStakeDistribution = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilStakeDistribution")
-	fd_MithrilStakeDistribution_epoch = md_MithrilStakeDistribution.Fields().ByName("epoch")
-	fd_MithrilStakeDistribution_signers_with_stake = md_MithrilStakeDistribution.Fields().ByName("signers_with_stake")
-	fd_MithrilStakeDistribution_hash = md_MithrilStakeDistribution.Fields().ByName("hash")
-	fd_MithrilStakeDistribution_certificate_hash = md_MithrilStakeDistribution.Fields().ByName("certificate_hash")
-	fd_MithrilStakeDistribution_created_at = md_MithrilStakeDistribution.Fields().ByName("created_at")
-	fd_MithrilStakeDistribution_protocol_parameter = md_MithrilStakeDistribution.Fields().ByName("protocol_parameter")
-}
-
-var _ protoreflect.Message = (*fastReflection_MithrilStakeDistribution)(nil)
-
-type fastReflection_MithrilStakeDistribution MithrilStakeDistribution
+type fastReflection_Misbehaviour Misbehaviour
 
-func (x *MithrilStakeDistribution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MithrilStakeDistribution)(x)
+func (x *Misbehaviour) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Misbehaviour)(x)
 }
 
-func (x *MithrilStakeDistribution) slowProtoReflect() protoreflect.Message {
+func (x *Misbehaviour) slowProtoReflect() protoreflect.Message {
 	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3843,43 +3922,43 @@ func (x *MithrilStakeDistribution) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MithrilStakeDistribution_messageType fastReflection_MithrilStakeDistribution_messageType
-var _ protoreflect.MessageType = fastReflection_MithrilStakeDistribution_messageType{}
+var _fastReflection_Misbehaviour_messageType fastReflection_Misbehaviour_messageType
+var _ protoreflect.MessageType = fastReflection_Misbehaviour_messageType{}
 
-type fastReflection_MithrilStakeDistribution_messageType struct{}
+type fastReflection_Misbehaviour_messageType struct{}
 
-func (x fastReflection_MithrilStakeDistribution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MithrilStakeDistribution)(nil)
+func (x fastReflection_Misbehaviour_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Misbehaviour)(nil)
 }
-func (x fastReflection_MithrilStakeDistribution_messageType) New() protoreflect.Message {
-	return new(fastReflection_MithrilStakeDistribution)
+func (x fastReflection_Misbehaviour_messageType) New() protoreflect.Message {
+	return new(fastReflection_Misbehaviour)
 }
-func (x fastReflection_MithrilStakeDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilStakeDistribution
+func (x fastReflection_Misbehaviour_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Misbehaviour
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MithrilStakeDistribution) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilStakeDistribution
+func (x *fastReflection_Misbehaviour) Descriptor() protoreflect.MessageDescriptor {
+	return md_Misbehaviour
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MithrilStakeDistribution) Type() protoreflect.MessageType {
-	return _fastReflection_MithrilStakeDistribution_messageType
+func (x *fastReflection_Misbehaviour) Type() protoreflect.MessageType {
+	return _fastReflection_Misbehaviour_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MithrilStakeDistribution) New() protoreflect.Message {
-	return new(fastReflection_MithrilStakeDistribution)
+func (x *fastReflection_Misbehaviour) New() protoreflect.Message {
+	return new(fastReflection_Misbehaviour)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MithrilStakeDistribution) Interface() protoreflect.ProtoMessage {
-	return (*MithrilStakeDistribution)(x)
+func (x *fastReflection_Misbehaviour) Interface() protoreflect.ProtoMessage {
+	return (*Misbehaviour)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3887,40 +3966,22 @@ func (x *fastReflection_MithrilStakeDistribution) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MithrilStakeDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Epoch != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Epoch)
-		if !f(fd_MithrilStakeDistribution_epoch, value) {
-			return
-		}
-	}
-	if len(x.SignersWithStake) != 0 {
-		value := protoreflect.ValueOfList(&_MithrilStakeDistribution_2_list{list: &x.SignersWithStake})
-		if !f(fd_MithrilStakeDistribution_signers_with_stake, value) {
-			return
-		}
-	}
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_MithrilStakeDistribution_hash, value) {
-			return
-		}
-	}
-	if x.CertificateHash != "" {
-		value := protoreflect.ValueOfString(x.CertificateHash)
-		if !f(fd_MithrilStakeDistribution_certificate_hash, value) {
+func (x *fastReflection_Misbehaviour) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Misbehaviour_client_id, value) {
 			return
 		}
 	}
-	if x.CreatedAt != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.CreatedAt)
-		if !f(fd_MithrilStakeDistribution_created_at, value) {
+	if x.MithrilHeader_1 != nil {
+		value := protoreflect.ValueOfMessage(x.MithrilHeader_1.ProtoReflect())
+		if !f(fd_Misbehaviour_mithril_header_1, value) {
 			return
 		}
 	}
-	if x.ProtocolParameter != nil {
-		value := protoreflect.ValueOfMessage(x.ProtocolParameter.ProtoReflect())
-		if !f(fd_MithrilStakeDistribution_protocol_parameter, value) {
+	if x.MithrilHeader_2 != nil {
+		value := protoreflect.ValueOfMessage(x.MithrilHeader_2.ProtoReflect())
+		if !f(fd_Misbehaviour_mithril_header_2, value) {
 			return
 		}
 	}
@@ -3937,25 +3998,19 @@ func (x *fastReflection_MithrilStakeDistribution) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MithrilStakeDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Misbehaviour) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
-		return x.Epoch != uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
-		return len(x.SignersWithStake) != 0
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
-		return x.Hash != ""
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
-		return x.CertificateHash != ""
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
-		return x.CreatedAt != uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
-		return x.ProtocolParameter != nil
+	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
+		return x.ClientId != ""
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
+		return x.MithrilHeader_1 != nil
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
+		return x.MithrilHeader_2 != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3965,25 +4020,19 @@ func (x *fastReflection_MithrilStakeDistribution) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilStakeDistribution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Misbehaviour) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
-		x.Epoch = uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
-		x.SignersWithStake = nil
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
-		x.Hash = ""
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
-		x.CertificateHash = ""
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
-		x.CreatedAt = uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
-		x.ProtocolParameter = nil
+	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
+		x.ClientId = ""
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
+		x.MithrilHeader_1 = nil
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
+		x.MithrilHeader_2 = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3993,34 +4042,22 @@ func (x *fastReflection_MithrilStakeDistribution) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MithrilStakeDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Misbehaviour) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
-		value := x.Epoch
-		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
-		if len(x.SignersWithStake) == 0 {
-			return protoreflect.ValueOfList(&_MithrilStakeDistribution_2_list{})
-		}
-		listValue := &_MithrilStakeDistribution_2_list{list: &x.SignersWithStake}
-		return protoreflect.ValueOfList(listValue)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
-		value := x.Hash
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
-		value := x.CertificateHash
+	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
+		value := x.ClientId
 		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
-		value := x.CreatedAt
-		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
-		value := x.ProtocolParameter
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
+		value := x.MithrilHeader_1
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
+		value := x.MithrilHeader_2
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4034,27 +4071,19 @@ func (x *fastReflection_MithrilStakeDistribution) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilStakeDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Misbehaviour) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
-		x.Epoch = value.Uint()
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
-		lv := value.List()
-		clv := lv.(*_MithrilStakeDistribution_2_list)
-		x.SignersWithStake = *clv.list
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
-		x.Hash = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
-		x.CertificateHash = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
-		x.CreatedAt = value.Uint()
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
-		x.ProtocolParameter = value.Message().Interface().(*MithrilProtocolParameters)
+	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
+		x.ClientId = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
+		x.MithrilHeader_1 = value.Message().Interface().(*MithrilHeader)
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
+		x.MithrilHeader_2 = value.Message().Interface().(*MithrilHeader)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4068,69 +4097,56 @@ func (x *fastReflection_MithrilStakeDistribution) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilStakeDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Misbehaviour) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
-		if x.SignersWithStake == nil {
-			x.SignersWithStake = []*SignerWithStake{}
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
+		if x.MithrilHeader_1 == nil {
+			x.MithrilHeader_1 = new(MithrilHeader)
 		}
-		value := &_MithrilStakeDistribution_2_list{list: &x.SignersWithStake}
-		return protoreflect.ValueOfList(value)
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
-		if x.ProtocolParameter == nil {
-			x.ProtocolParameter = new(MithrilProtocolParameters)
+		return protoreflect.ValueOfMessage(x.MithrilHeader_1.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
+		if x.MithrilHeader_2 == nil {
+			x.MithrilHeader_2 = new(MithrilHeader)
 		}
-		return protoreflect.ValueOfMessage(x.ProtocolParameter.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
-		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
-		panic(fmt.Errorf("field hash of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
-		panic(fmt.Errorf("field certificate_hash of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
-		panic(fmt.Errorf("field created_at of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
+		return protoreflect.ValueOfMessage(x.MithrilHeader_2.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
+		panic(fmt.Errorf("field client_id of message ibc.lightclients.mithril.v1.Misbehaviour is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MithrilStakeDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Misbehaviour) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
-		list := []*SignerWithStake{}
-		return protoreflect.ValueOfList(&_MithrilStakeDistribution_2_list{list: &list})
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
+	case "ibc.lightclients.mithril.v1.Misbehaviour.client_id":
 		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
-		m := new(MithrilProtocolParameters)
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1":
+		m := new(MithrilHeader)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2":
+		m := new(MithrilHeader)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.Misbehaviour"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.Misbehaviour does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MithrilStakeDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Misbehaviour) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilStakeDistribution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.Misbehaviour", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4138,7 +4154,7 @@ func (x *fastReflection_MithrilStakeDistribution) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MithrilStakeDistribution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Misbehaviour) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4149,7 +4165,7 @@ func (x *fastReflection_MithrilStakeDistribution) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilStakeDistribution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Misbehaviour) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4161,7 +4177,7 @@ func (x *fastReflection_MithrilStakeDistribution) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MithrilStakeDistribution) IsValid() bool {
+func (x *fastReflection_Misbehaviour) IsValid() bool {
 	return x != nil
 }
 
@@ -4171,9 +4187,9 @@ func (x *fastReflection_MithrilStakeDistribution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Misbehaviour) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MithrilStakeDistribution)
+		x := input.Message.Interface().(*Misbehaviour)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4185,28 +4201,16 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.Epoch != 0 {
-			n += 1 + runtime.Sov(uint64(x.Epoch))
-		}
-		if len(x.SignersWithStake) > 0 {
-			for _, e := range x.SignersWithStake {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Hash)
+		l = len(x.ClientId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CertificateHash)
-		if l > 0 {
+		if x.MithrilHeader_1 != nil {
+			l = options.Size(x.MithrilHeader_1)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CreatedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreatedAt))
-		}
-		if x.ProtocolParameter != nil {
-			l = options.Size(x.ProtocolParameter)
+		if x.MithrilHeader_2 != nil {
+			l = options.Size(x.MithrilHeader_2)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4219,7 +4223,7 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilStakeDistribution)
+		x := input.Message.Interface().(*Misbehaviour)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4238,8 +4242,8 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ProtocolParameter != nil {
-			encoded, err := options.Marshal(x.ProtocolParameter)
+		if x.MithrilHeader_2 != nil {
+			encoded, err := options.Marshal(x.MithrilHeader_2)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4250,47 +4254,28 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x32
-		}
-		if x.CreatedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.CertificateHash) > 0 {
-			i -= len(x.CertificateHash)
-			copy(dAtA[i:], x.CertificateHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CertificateHash)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
-			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.SignersWithStake) > 0 {
-			for iNdEx := len(x.SignersWithStake) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SignersWithStake[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
+		if x.MithrilHeader_1 != nil {
+			encoded, err := options.Marshal(x.MithrilHeader_1)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
 		}
-		if x.Epoch != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4303,7 +4288,7 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilStakeDistribution)
+		x := input.Message.Interface().(*Misbehaviour)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4335,68 +4320,15 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilStakeDistribution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilStakeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-				}
-				x.Epoch = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Epoch |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignersWithStake", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SignersWithStake = append(x.SignersWithStake, &SignerWithStake{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignersWithStake[len(x.SignersWithStake)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4424,13 +4356,13 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.ClientId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CertificateHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilHeader_1", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4440,46 +4372,31 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CertificateHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				if x.MithrilHeader_1 == nil {
+					x.MithrilHeader_1 = &MithrilHeader{}
 				}
-				x.CreatedAt = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.CreatedAt |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilHeader_1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 6:
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolParameter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilHeader_2", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4506,10 +4423,10 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ProtocolParameter == nil {
-					x.ProtocolParameter = &MithrilProtocolParameters{}
+				if x.MithrilHeader_2 == nil {
+					x.MithrilHeader_2 = &MithrilHeader{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProtocolParameter); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilHeader_2); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4548,36 +4465,93 @@ func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Met
 	}
 }
 
-var (
-	md_CardanoTransactionSnapshot                  protoreflect.MessageDescriptor
-	fd_CardanoTransactionSnapshot_merkle_root      protoreflect.FieldDescriptor
-	fd_CardanoTransactionSnapshot_epoch            protoreflect.FieldDescriptor
-	fd_CardanoTransactionSnapshot_block_number     protoreflect.FieldDescriptor
-	fd_CardanoTransactionSnapshot_hash             protoreflect.FieldDescriptor
-	fd_CardanoTransactionSnapshot_certificate_hash protoreflect.FieldDescriptor
-	fd_CardanoTransactionSnapshot_created_at       protoreflect.FieldDescriptor
+var _ protoreflect.List = (*_MithrilHeader_9_list)(nil)
+
+type _MithrilHeader_9_list struct {
+	list *[]*MithrilCertificate
+}
+
+func (x *_MithrilHeader_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MithrilHeader_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MithrilHeader_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MithrilCertificate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MithrilHeader_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MithrilCertificate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MithrilHeader_9_list) AppendMutable() protoreflect.Value {
+	v := new(MithrilCertificate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MithrilHeader_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MithrilHeader_9_list) NewElement() protoreflect.Value {
+	v := new(MithrilCertificate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MithrilHeader_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MithrilHeader                                                  protoreflect.MessageDescriptor
+	fd_MithrilHeader_mithril_stake_distribution                       protoreflect.FieldDescriptor
+	fd_MithrilHeader_mithril_stake_distribution_certificate           protoreflect.FieldDescriptor
+	fd_MithrilHeader_transaction_snapshot                             protoreflect.FieldDescriptor
+	fd_MithrilHeader_transaction_snapshot_certificate                 protoreflect.FieldDescriptor
+	fd_MithrilHeader_previous_mithril_stake_distribution_certificates protoreflect.FieldDescriptor
+	fd_MithrilHeader_host_state_tx_hash                               protoreflect.FieldDescriptor
+	fd_MithrilHeader_host_state_tx_body_cbor                          protoreflect.FieldDescriptor
+	fd_MithrilHeader_host_state_tx_output_index                       protoreflect.FieldDescriptor
+	fd_MithrilHeader_host_state_tx_proof                              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_CardanoTransactionSnapshot = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoTransactionSnapshot")
-	fd_CardanoTransactionSnapshot_merkle_root = md_CardanoTransactionSnapshot.Fields().ByName("merkle_root")
-	fd_CardanoTransactionSnapshot_epoch = md_CardanoTransactionSnapshot.Fields().ByName("epoch")
-	fd_CardanoTransactionSnapshot_block_number = md_CardanoTransactionSnapshot.Fields().ByName("block_number")
-	fd_CardanoTransactionSnapshot_hash = md_CardanoTransactionSnapshot.Fields().ByName("hash")
-	fd_CardanoTransactionSnapshot_certificate_hash = md_CardanoTransactionSnapshot.Fields().ByName("certificate_hash")
-	fd_CardanoTransactionSnapshot_created_at = md_CardanoTransactionSnapshot.Fields().ByName("created_at")
+	md_MithrilHeader = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilHeader")
+	fd_MithrilHeader_mithril_stake_distribution = md_MithrilHeader.Fields().ByName("mithril_stake_distribution")
+	fd_MithrilHeader_mithril_stake_distribution_certificate = md_MithrilHeader.Fields().ByName("mithril_stake_distribution_certificate")
+	fd_MithrilHeader_transaction_snapshot = md_MithrilHeader.Fields().ByName("transaction_snapshot")
+	fd_MithrilHeader_transaction_snapshot_certificate = md_MithrilHeader.Fields().ByName("transaction_snapshot_certificate")
+	fd_MithrilHeader_previous_mithril_stake_distribution_certificates = md_MithrilHeader.Fields().ByName("previous_mithril_stake_distribution_certificates")
+	fd_MithrilHeader_host_state_tx_hash = md_MithrilHeader.Fields().ByName("host_state_tx_hash")
+	fd_MithrilHeader_host_state_tx_body_cbor = md_MithrilHeader.Fields().ByName("host_state_tx_body_cbor")
+	fd_MithrilHeader_host_state_tx_output_index = md_MithrilHeader.Fields().ByName("host_state_tx_output_index")
+	fd_MithrilHeader_host_state_tx_proof = md_MithrilHeader.Fields().ByName("host_state_tx_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_CardanoTransactionSnapshot)(nil)
+var _ protoreflect.Message = (*fastReflection_MithrilHeader)(nil)
 
-type fastReflection_CardanoTransactionSnapshot CardanoTransactionSnapshot
+type fastReflection_MithrilHeader MithrilHeader
 
-func (x *CardanoTransactionSnapshot) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CardanoTransactionSnapshot)(x)
+func (x *MithrilHeader) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MithrilHeader)(x)
 }
 
-func (x *CardanoTransactionSnapshot) slowProtoReflect() protoreflect.Message {
+func (x *MithrilHeader) slowProtoReflect() protoreflect.Message {
 	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4589,43 +4563,43 @@ func (x *CardanoTransactionSnapshot) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CardanoTransactionSnapshot_messageType fastReflection_CardanoTransactionSnapshot_messageType
-var _ protoreflect.MessageType = fastReflection_CardanoTransactionSnapshot_messageType{}
+var _fastReflection_MithrilHeader_messageType fastReflection_MithrilHeader_messageType
+var _ protoreflect.MessageType = fastReflection_MithrilHeader_messageType{}
 
-type fastReflection_CardanoTransactionSnapshot_messageType struct{}
+type fastReflection_MithrilHeader_messageType struct{}
 
-func (x fastReflection_CardanoTransactionSnapshot_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CardanoTransactionSnapshot)(nil)
+func (x fastReflection_MithrilHeader_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MithrilHeader)(nil)
 }
-func (x fastReflection_CardanoTransactionSnapshot_messageType) New() protoreflect.Message {
-	return new(fastReflection_CardanoTransactionSnapshot)
+func (x fastReflection_MithrilHeader_messageType) New() protoreflect.Message {
+	return new(fastReflection_MithrilHeader)
 }
-func (x fastReflection_CardanoTransactionSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoTransactionSnapshot
+func (x fastReflection_MithrilHeader_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilHeader
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CardanoTransactionSnapshot) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoTransactionSnapshot
+func (x *fastReflection_MithrilHeader) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilHeader
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CardanoTransactionSnapshot) Type() protoreflect.MessageType {
-	return _fastReflection_CardanoTransactionSnapshot_messageType
+func (x *fastReflection_MithrilHeader) Type() protoreflect.MessageType {
+	return _fastReflection_MithrilHeader_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CardanoTransactionSnapshot) New() protoreflect.Message {
-	return new(fastReflection_CardanoTransactionSnapshot)
+func (x *fastReflection_MithrilHeader) New() protoreflect.Message {
+	return new(fastReflection_MithrilHeader)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CardanoTransactionSnapshot) Interface() protoreflect.ProtoMessage {
-	return (*CardanoTransactionSnapshot)(x)
+func (x *fastReflection_MithrilHeader) Interface() protoreflect.ProtoMessage {
+	return (*MithrilHeader)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4633,40 +4607,58 @@ func (x *fastReflection_CardanoTransactionSnapshot) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CardanoTransactionSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MerkleRoot != "" {
-		value := protoreflect.ValueOfString(x.MerkleRoot)
-		if !f(fd_CardanoTransactionSnapshot_merkle_root, value) {
+func (x *fastReflection_MithrilHeader) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MithrilStakeDistribution != nil {
+		value := protoreflect.ValueOfMessage(x.MithrilStakeDistribution.ProtoReflect())
+		if !f(fd_MithrilHeader_mithril_stake_distribution, value) {
 			return
 		}
 	}
-	if x.Epoch != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Epoch)
-		if !f(fd_CardanoTransactionSnapshot_epoch, value) {
+	if x.MithrilStakeDistributionCertificate != nil {
+		value := protoreflect.ValueOfMessage(x.MithrilStakeDistributionCertificate.ProtoReflect())
+		if !f(fd_MithrilHeader_mithril_stake_distribution_certificate, value) {
 			return
 		}
 	}
-	if x.BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockNumber)
-		if !f(fd_CardanoTransactionSnapshot_block_number, value) {
+	if x.TransactionSnapshot != nil {
+		value := protoreflect.ValueOfMessage(x.TransactionSnapshot.ProtoReflect())
+		if !f(fd_MithrilHeader_transaction_snapshot, value) {
 			return
 		}
 	}
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_CardanoTransactionSnapshot_hash, value) {
+	if x.TransactionSnapshotCertificate != nil {
+		value := protoreflect.ValueOfMessage(x.TransactionSnapshotCertificate.ProtoReflect())
+		if !f(fd_MithrilHeader_transaction_snapshot_certificate, value) {
 			return
 		}
 	}
-	if x.CertificateHash != "" {
-		value := protoreflect.ValueOfString(x.CertificateHash)
-		if !f(fd_CardanoTransactionSnapshot_certificate_hash, value) {
+	if len(x.PreviousMithrilStakeDistributionCertificates) != 0 {
+		value := protoreflect.ValueOfList(&_MithrilHeader_9_list{list: &x.PreviousMithrilStakeDistributionCertificates})
+		if !f(fd_MithrilHeader_previous_mithril_stake_distribution_certificates, value) {
 			return
 		}
 	}
-	if x.CreatedAt != "" {
-		value := protoreflect.ValueOfString(x.CreatedAt)
-		if !f(fd_CardanoTransactionSnapshot_created_at, value) {
+	if x.HostStateTxHash != "" {
+		value := protoreflect.ValueOfString(x.HostStateTxHash)
+		if !f(fd_MithrilHeader_host_state_tx_hash, value) {
+			return
+		}
+	}
+	if len(x.HostStateTxBodyCbor) != 0 {
+		value := protoreflect.ValueOfBytes(x.HostStateTxBodyCbor)
+		if !f(fd_MithrilHeader_host_state_tx_body_cbor, value) {
+			return
+		}
+	}
+	if x.HostStateTxOutputIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.HostStateTxOutputIndex)
+		if !f(fd_MithrilHeader_host_state_tx_output_index, value) {
+			return
+		}
+	}
+	if len(x.HostStateTxProof) != 0 {
+		value := protoreflect.ValueOfBytes(x.HostStateTxProof)
+		if !f(fd_MithrilHeader_host_state_tx_proof, value) {
 			return
 		}
 	}
@@ -4683,25 +4675,31 @@ func (x *fastReflection_CardanoTransactionSnapshot) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CardanoTransactionSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MithrilHeader) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
-		return x.MerkleRoot != ""
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
-		return x.Epoch != uint64(0)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
-		return x.BlockNumber != uint64(0)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
-		return x.Hash != ""
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
-		return x.CertificateHash != ""
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
-		return x.CreatedAt != ""
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
+		return x.MithrilStakeDistribution != nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
+		return x.MithrilStakeDistributionCertificate != nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
+		return x.TransactionSnapshot != nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
+		return x.TransactionSnapshotCertificate != nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
+		return len(x.PreviousMithrilStakeDistributionCertificates) != 0
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
+		return x.HostStateTxHash != ""
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
+		return len(x.HostStateTxBodyCbor) != 0
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
+		return x.HostStateTxOutputIndex != uint32(0)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
+		return len(x.HostStateTxProof) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4711,25 +4709,31 @@ func (x *fastReflection_CardanoTransactionSnapshot) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactionSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MithrilHeader) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
-		x.MerkleRoot = ""
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
-		x.Epoch = uint64(0)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
-		x.BlockNumber = uint64(0)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
-		x.Hash = ""
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
-		x.CertificateHash = ""
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
-		x.CreatedAt = ""
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
+		x.MithrilStakeDistribution = nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
+		x.MithrilStakeDistributionCertificate = nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
+		x.TransactionSnapshot = nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
+		x.TransactionSnapshotCertificate = nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
+		x.PreviousMithrilStakeDistributionCertificates = nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
+		x.HostStateTxHash = ""
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
+		x.HostStateTxBodyCbor = nil
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
+		x.HostStateTxOutputIndex = uint32(0)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
+		x.HostStateTxProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4739,31 +4743,43 @@ func (x *fastReflection_CardanoTransactionSnapshot) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CardanoTransactionSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilHeader) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
-		value := x.MerkleRoot
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
-		value := x.Epoch
-		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
-		value := x.Hash
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
-		value := x.CertificateHash
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
-		value := x.CreatedAt
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
+		value := x.MithrilStakeDistribution
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
+		value := x.MithrilStakeDistributionCertificate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
+		value := x.TransactionSnapshot
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
+		value := x.TransactionSnapshotCertificate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
+		if len(x.PreviousMithrilStakeDistributionCertificates) == 0 {
+			return protoreflect.ValueOfList(&_MithrilHeader_9_list{})
+		}
+		listValue := &_MithrilHeader_9_list{list: &x.PreviousMithrilStakeDistributionCertificates}
+		return protoreflect.ValueOfList(listValue)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
+		value := x.HostStateTxHash
 		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
+		value := x.HostStateTxBodyCbor
+		return protoreflect.ValueOfBytes(value)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
+		value := x.HostStateTxOutputIndex
+		return protoreflect.ValueOfUint32(value)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
+		value := x.HostStateTxProof
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4777,25 +4793,33 @@ func (x *fastReflection_CardanoTransactionSnapshot) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactionSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MithrilHeader) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
-		x.MerkleRoot = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
-		x.Epoch = value.Uint()
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
-		x.BlockNumber = value.Uint()
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
-		x.Hash = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
-		x.CertificateHash = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
-		x.CreatedAt = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
+		x.MithrilStakeDistribution = value.Message().Interface().(*MithrilStakeDistribution)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
+		x.MithrilStakeDistributionCertificate = value.Message().Interface().(*MithrilCertificate)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
+		x.TransactionSnapshot = value.Message().Interface().(*CardanoTransactionSnapshot)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
+		x.TransactionSnapshotCertificate = value.Message().Interface().(*MithrilCertificate)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
+		lv := value.List()
+		clv := lv.(*_MithrilHeader_9_list)
+		x.PreviousMithrilStakeDistributionCertificates = *clv.list
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
+		x.HostStateTxHash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
+		x.HostStateTxBodyCbor = value.Bytes()
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
+		x.HostStateTxOutputIndex = uint32(value.Uint())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
+		x.HostStateTxProof = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4809,60 +4833,93 @@ func (x *fastReflection_CardanoTransactionSnapshot) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactionSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilHeader) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
-		panic(fmt.Errorf("field merkle_root of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
-		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
-		panic(fmt.Errorf("field block_number of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
-		panic(fmt.Errorf("field hash of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
-		panic(fmt.Errorf("field certificate_hash of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
-		panic(fmt.Errorf("field created_at of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
+		if x.MithrilStakeDistribution == nil {
+			x.MithrilStakeDistribution = new(MithrilStakeDistribution)
+		}
+		return protoreflect.ValueOfMessage(x.MithrilStakeDistribution.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
+		if x.MithrilStakeDistributionCertificate == nil {
+			x.MithrilStakeDistributionCertificate = new(MithrilCertificate)
+		}
+		return protoreflect.ValueOfMessage(x.MithrilStakeDistributionCertificate.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
+		if x.TransactionSnapshot == nil {
+			x.TransactionSnapshot = new(CardanoTransactionSnapshot)
+		}
+		return protoreflect.ValueOfMessage(x.TransactionSnapshot.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
+		if x.TransactionSnapshotCertificate == nil {
+			x.TransactionSnapshotCertificate = new(MithrilCertificate)
+		}
+		return protoreflect.ValueOfMessage(x.TransactionSnapshotCertificate.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
+		if x.PreviousMithrilStakeDistributionCertificates == nil {
+			x.PreviousMithrilStakeDistributionCertificates = []*MithrilCertificate{}
+		}
+		value := &_MithrilHeader_9_list{list: &x.PreviousMithrilStakeDistributionCertificates}
+		return protoreflect.ValueOfList(value)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
+		panic(fmt.Errorf("field host_state_tx_hash of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
+		panic(fmt.Errorf("field host_state_tx_body_cbor of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
+		panic(fmt.Errorf("field host_state_tx_output_index of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
+		panic(fmt.Errorf("field host_state_tx_proof of message ibc.lightclients.mithril.v1.MithrilHeader is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CardanoTransactionSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilHeader) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution":
+		m := new(MithrilStakeDistribution)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate":
+		m := new(MithrilCertificate)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot":
+		m := new(CardanoTransactionSnapshot)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate":
+		m := new(MithrilCertificate)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates":
+		list := []*MithrilCertificate{}
+		return protoreflect.ValueOfList(&_MithrilHeader_9_list{list: &list})
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_hash":
 		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_body_cbor":
+		return protoreflect.ValueOfBytes(nil)
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_output_index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ibc.lightclients.mithril.v1.MithrilHeader.host_state_tx_proof":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilHeader"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilHeader does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CardanoTransactionSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MithrilHeader) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoTransactionSnapshot", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilHeader", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4870,7 +4927,7 @@ func (x *fastReflection_CardanoTransactionSnapshot) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CardanoTransactionSnapshot) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MithrilHeader) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4881,7 +4938,7 @@ func (x *fastReflection_CardanoTransactionSnapshot) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactionSnapshot) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MithrilHeader) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4893,7 +4950,7 @@ func (x *fastReflection_CardanoTransactionSnapshot) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CardanoTransactionSnapshot) IsValid() bool {
+func (x *fastReflection_MithrilHeader) IsValid() bool {
 	return x != nil
 }
 
@@ -4903,9 +4960,9 @@ func (x *fastReflection_CardanoTransactionSnapshot) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MithrilHeader) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CardanoTransactionSnapshot)
+		x := input.Message.Interface().(*MithrilHeader)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4917,25 +4974,40 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.MerkleRoot)
-		if l > 0 {
+		if x.MithrilStakeDistribution != nil {
+			l = options.Size(x.MithrilStakeDistribution)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Epoch != 0 {
-			n += 1 + runtime.Sov(uint64(x.Epoch))
+		if x.MithrilStakeDistributionCertificate != nil {
+			l = options.Size(x.MithrilStakeDistributionCertificate)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		if x.TransactionSnapshot != nil {
+			l = options.Size(x.TransactionSnapshot)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Hash)
+		if x.TransactionSnapshotCertificate != nil {
+			l = options.Size(x.TransactionSnapshotCertificate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PreviousMithrilStakeDistributionCertificates) > 0 {
+			for _, e := range x.PreviousMithrilStakeDistributionCertificates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.HostStateTxHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CertificateHash)
+		l = len(x.HostStateTxBodyCbor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CreatedAt)
+		if x.HostStateTxOutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.HostStateTxOutputIndex))
+		}
+		l = len(x.HostStateTxProof)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4949,7 +5021,7 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoTransactionSnapshot)
+		x := input.Message.Interface().(*MithrilHeader)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4968,41 +5040,101 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CreatedAt) > 0 {
-			i -= len(x.CreatedAt)
-			copy(dAtA[i:], x.CreatedAt)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreatedAt)))
-			i--
-			dAtA[i] = 0x32
+		if len(x.PreviousMithrilStakeDistributionCertificates) > 0 {
+			for iNdEx := len(x.PreviousMithrilStakeDistributionCertificates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousMithrilStakeDistributionCertificates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
 		}
-		if len(x.CertificateHash) > 0 {
-			i -= len(x.CertificateHash)
-			copy(dAtA[i:], x.CertificateHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CertificateHash)))
+		if len(x.HostStateTxProof) > 0 {
+			i -= len(x.HostStateTxProof)
+			copy(dAtA[i:], x.HostStateTxProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostStateTxProof)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.HostStateTxOutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostStateTxOutputIndex))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.HostStateTxBodyCbor) > 0 {
+			i -= len(x.HostStateTxBodyCbor)
+			copy(dAtA[i:], x.HostStateTxBodyCbor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostStateTxBodyCbor)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.HostStateTxHash) > 0 {
+			i -= len(x.HostStateTxHash)
+			copy(dAtA[i:], x.HostStateTxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostStateTxHash)))
 			i--
 			dAtA[i] = 0x2a
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if x.TransactionSnapshotCertificate != nil {
+			encoded, err := options.Marshal(x.TransactionSnapshotCertificate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+		if x.TransactionSnapshot != nil {
+			encoded, err := options.Marshal(x.TransactionSnapshot)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if x.Epoch != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+		if x.MithrilStakeDistributionCertificate != nil {
+			encoded, err := options.Marshal(x.MithrilStakeDistributionCertificate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.MerkleRoot) > 0 {
-			i -= len(x.MerkleRoot)
-			copy(dAtA[i:], x.MerkleRoot)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+		if x.MithrilStakeDistribution != nil {
+			encoded, err := options.Marshal(x.MithrilStakeDistribution)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5017,7 +5149,7 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoTransactionSnapshot)
+		x := input.Message.Interface().(*MithrilHeader)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5049,17 +5181,17 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactionSnapshot: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilHeader: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactionSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilStakeDistribution", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5069,29 +5201,33 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MerkleRoot = string(dAtA[iNdEx:postIndex])
+				if x.MithrilStakeDistribution == nil {
+					x.MithrilStakeDistribution = &MithrilStakeDistribution{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilStakeDistribution); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilStakeDistributionCertificate", wireType)
 				}
-				x.Epoch = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5101,16 +5237,33 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Epoch |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MithrilStakeDistributionCertificate == nil {
+					x.MithrilStakeDistributionCertificate = &MithrilCertificate{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MithrilStakeDistributionCertificate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionSnapshot", wireType)
 				}
-				x.BlockNumber = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5120,16 +5273,33 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockNumber |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TransactionSnapshot == nil {
+					x.TransactionSnapshot = &CardanoTransactionSnapshot{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransactionSnapshot); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionSnapshotCertificate", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5139,29 +5309,33 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				if x.TransactionSnapshotCertificate == nil {
+					x.TransactionSnapshotCertificate = &MithrilCertificate{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransactionSnapshotCertificate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 5:
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CertificateHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousMithrilStakeDistributionCertificates", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5171,27 +5345,29 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CertificateHash = string(dAtA[iNdEx:postIndex])
+				x.PreviousMithrilStakeDistributionCertificates = append(x.PreviousMithrilStakeDistributionCertificates, &MithrilCertificate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousMithrilStakeDistributionCertificates[len(x.PreviousMithrilStakeDistributionCertificates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 6:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5219,48 +5395,1640 @@ func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CreatedAt = string(dAtA[iNdEx:postIndex])
+				x.HostStateTxHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxBodyCbor", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.HostStateTxBodyCbor = append(x.HostStateTxBodyCbor[:0], dAtA[iNdEx:postIndex]...)
+				if x.HostStateTxBodyCbor == nil {
+					x.HostStateTxBodyCbor = []byte{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MithrilCertificate                            protoreflect.MessageDescriptor
-	fd_MithrilCertificate_hash                       protoreflect.FieldDescriptor
-	fd_MithrilCertificate_previous_hash              protoreflect.FieldDescriptor
-	fd_MithrilCertificate_epoch                      protoreflect.FieldDescriptor
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxOutputIndex", wireType)
+				}
+				x.HostStateTxOutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HostStateTxOutputIndex |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostStateTxProof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HostStateTxProof = append(x.HostStateTxProof[:0], dAtA[iNdEx:postIndex]...)
+				if x.HostStateTxProof == nil {
+					x.HostStateTxProof = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MithrilStakeDistribution_2_list)(nil)
+
+type _MithrilStakeDistribution_2_list struct {
+	list *[]*SignerWithStake
+}
+
+func (x *_MithrilStakeDistribution_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MithrilStakeDistribution_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MithrilStakeDistribution_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MithrilStakeDistribution_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MithrilStakeDistribution_2_list) AppendMutable() protoreflect.Value {
+	v := new(SignerWithStake)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MithrilStakeDistribution_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MithrilStakeDistribution_2_list) NewElement() protoreflect.Value {
+	v := new(SignerWithStake)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MithrilStakeDistribution_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MithrilStakeDistribution                    protoreflect.MessageDescriptor
+	fd_MithrilStakeDistribution_epoch              protoreflect.FieldDescriptor
+	fd_MithrilStakeDistribution_signers_with_stake protoreflect.FieldDescriptor
+	fd_MithrilStakeDistribution_hash               protoreflect.FieldDescriptor
+	fd_MithrilStakeDistribution_certificate_hash   protoreflect.FieldDescriptor
+	fd_MithrilStakeDistribution_created_at         protoreflect.FieldDescriptor
+	fd_MithrilStakeDistribution_protocol_parameter protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ibc_lightclients_mithril_v1_mithril_proto_init()
+	md_MithrilStakeDistribution = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilStakeDistribution")
+	fd_MithrilStakeDistribution_epoch = md_MithrilStakeDistribution.Fields().ByName("epoch")
+	fd_MithrilStakeDistribution_signers_with_stake = md_MithrilStakeDistribution.Fields().ByName("signers_with_stake")
+	fd_MithrilStakeDistribution_hash = md_MithrilStakeDistribution.Fields().ByName("hash")
+	fd_MithrilStakeDistribution_certificate_hash = md_MithrilStakeDistribution.Fields().ByName("certificate_hash")
+	fd_MithrilStakeDistribution_created_at = md_MithrilStakeDistribution.Fields().ByName("created_at")
+	fd_MithrilStakeDistribution_protocol_parameter = md_MithrilStakeDistribution.Fields().ByName("protocol_parameter")
+}
+
+var _ protoreflect.Message = (*fastReflection_MithrilStakeDistribution)(nil)
+
+type fastReflection_MithrilStakeDistribution MithrilStakeDistribution
+
+func (x *MithrilStakeDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MithrilStakeDistribution)(x)
+}
+
+func (x *MithrilStakeDistribution) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MithrilStakeDistribution_messageType fastReflection_MithrilStakeDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_MithrilStakeDistribution_messageType{}
+
+type fastReflection_MithrilStakeDistribution_messageType struct{}
+
+func (x fastReflection_MithrilStakeDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MithrilStakeDistribution)(nil)
+}
+func (x fastReflection_MithrilStakeDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MithrilStakeDistribution)
+}
+func (x fastReflection_MithrilStakeDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilStakeDistribution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MithrilStakeDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilStakeDistribution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MithrilStakeDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_MithrilStakeDistribution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MithrilStakeDistribution) New() protoreflect.Message {
+	return new(fastReflection_MithrilStakeDistribution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MithrilStakeDistribution) Interface() protoreflect.ProtoMessage {
+	return (*MithrilStakeDistribution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MithrilStakeDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_MithrilStakeDistribution_epoch, value) {
+			return
+		}
+	}
+	if len(x.SignersWithStake) != 0 {
+		value := protoreflect.ValueOfList(&_MithrilStakeDistribution_2_list{list: &x.SignersWithStake})
+		if !f(fd_MithrilStakeDistribution_signers_with_stake, value) {
+			return
+		}
+	}
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_MithrilStakeDistribution_hash, value) {
+			return
+		}
+	}
+	if x.CertificateHash != "" {
+		value := protoreflect.ValueOfString(x.CertificateHash)
+		if !f(fd_MithrilStakeDistribution_certificate_hash, value) {
+			return
+		}
+	}
+	if x.CreatedAt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreatedAt)
+		if !f(fd_MithrilStakeDistribution_created_at, value) {
+			return
+		}
+	}
+	if x.ProtocolParameter != nil {
+		value := protoreflect.ValueOfMessage(x.ProtocolParameter.ProtoReflect())
+		if !f(fd_MithrilStakeDistribution_protocol_parameter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MithrilStakeDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
+		return x.Epoch != uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
+		return len(x.SignersWithStake) != 0
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
+		return x.Hash != ""
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
+		return x.CertificateHash != ""
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
+		return x.CreatedAt != uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
+		return x.ProtocolParameter != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilStakeDistribution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
+		x.Epoch = uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
+		x.SignersWithStake = nil
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
+		x.Hash = ""
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
+		x.CertificateHash = ""
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
+		x.CreatedAt = uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
+		x.ProtocolParameter = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MithrilStakeDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
+		if len(x.SignersWithStake) == 0 {
+			return protoreflect.ValueOfList(&_MithrilStakeDistribution_2_list{})
+		}
+		listValue := &_MithrilStakeDistribution_2_list{list: &x.SignersWithStake}
+		return protoreflect.ValueOfList(listValue)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
+		value := x.CertificateHash
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
+		value := x.ProtocolParameter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilStakeDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
+		x.Epoch = value.Uint()
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
+		lv := value.List()
+		clv := lv.(*_MithrilStakeDistribution_2_list)
+		x.SignersWithStake = *clv.list
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
+		x.Hash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
+		x.CertificateHash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
+		x.CreatedAt = value.Uint()
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
+		x.ProtocolParameter = value.Message().Interface().(*MithrilProtocolParameters)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilStakeDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
+		if x.SignersWithStake == nil {
+			x.SignersWithStake = []*SignerWithStake{}
+		}
+		value := &_MithrilStakeDistribution_2_list{list: &x.SignersWithStake}
+		return protoreflect.ValueOfList(value)
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
+		if x.ProtocolParameter == nil {
+			x.ProtocolParameter = new(MithrilProtocolParameters)
+		}
+		return protoreflect.ValueOfMessage(x.ProtocolParameter.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
+		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
+		panic(fmt.Errorf("field hash of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
+		panic(fmt.Errorf("field certificate_hash of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
+		panic(fmt.Errorf("field created_at of message ibc.lightclients.mithril.v1.MithrilStakeDistribution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MithrilStakeDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake":
+		list := []*SignerWithStake{}
+		return protoreflect.ValueOfList(&_MithrilStakeDistribution_2_list{list: &list})
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.hash":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.certificate_hash":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.created_at":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter":
+		m := new(MithrilProtocolParameters)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilStakeDistribution"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilStakeDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MithrilStakeDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilStakeDistribution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MithrilStakeDistribution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilStakeDistribution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MithrilStakeDistribution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MithrilStakeDistribution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MithrilStakeDistribution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if len(x.SignersWithStake) > 0 {
+			for _, e := range x.SignersWithStake {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CertificateHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.ProtocolParameter != nil {
+			l = options.Size(x.ProtocolParameter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MithrilStakeDistribution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProtocolParameter != nil {
+			encoded, err := options.Marshal(x.ProtocolParameter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.CertificateHash) > 0 {
+			i -= len(x.CertificateHash)
+			copy(dAtA[i:], x.CertificateHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CertificateHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SignersWithStake) > 0 {
+			for iNdEx := len(x.SignersWithStake) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignersWithStake[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MithrilStakeDistribution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilStakeDistribution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilStakeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignersWithStake", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignersWithStake = append(x.SignersWithStake, &SignerWithStake{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignersWithStake[len(x.SignersWithStake)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CertificateHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CertificateHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolParameter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ProtocolParameter == nil {
+					x.ProtocolParameter = &MithrilProtocolParameters{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProtocolParameter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CardanoTransactionSnapshot                  protoreflect.MessageDescriptor
+	fd_CardanoTransactionSnapshot_merkle_root      protoreflect.FieldDescriptor
+	fd_CardanoTransactionSnapshot_epoch            protoreflect.FieldDescriptor
+	fd_CardanoTransactionSnapshot_block_number     protoreflect.FieldDescriptor
+	fd_CardanoTransactionSnapshot_hash             protoreflect.FieldDescriptor
+	fd_CardanoTransactionSnapshot_certificate_hash protoreflect.FieldDescriptor
+	fd_CardanoTransactionSnapshot_created_at       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ibc_lightclients_mithril_v1_mithril_proto_init()
+	md_CardanoTransactionSnapshot = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoTransactionSnapshot")
+	fd_CardanoTransactionSnapshot_merkle_root = md_CardanoTransactionSnapshot.Fields().ByName("merkle_root")
+	fd_CardanoTransactionSnapshot_epoch = md_CardanoTransactionSnapshot.Fields().ByName("epoch")
+	fd_CardanoTransactionSnapshot_block_number = md_CardanoTransactionSnapshot.Fields().ByName("block_number")
+	fd_CardanoTransactionSnapshot_hash = md_CardanoTransactionSnapshot.Fields().ByName("hash")
+	fd_CardanoTransactionSnapshot_certificate_hash = md_CardanoTransactionSnapshot.Fields().ByName("certificate_hash")
+	fd_CardanoTransactionSnapshot_created_at = md_CardanoTransactionSnapshot.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_CardanoTransactionSnapshot)(nil)
+
+type fastReflection_CardanoTransactionSnapshot CardanoTransactionSnapshot
+
+func (x *CardanoTransactionSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CardanoTransactionSnapshot)(x)
+}
+
+func (x *CardanoTransactionSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CardanoTransactionSnapshot_messageType fastReflection_CardanoTransactionSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_CardanoTransactionSnapshot_messageType{}
+
+type fastReflection_CardanoTransactionSnapshot_messageType struct{}
+
+func (x fastReflection_CardanoTransactionSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CardanoTransactionSnapshot)(nil)
+}
+func (x fastReflection_CardanoTransactionSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_CardanoTransactionSnapshot)
+}
+func (x fastReflection_CardanoTransactionSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoTransactionSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CardanoTransactionSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoTransactionSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CardanoTransactionSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_CardanoTransactionSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CardanoTransactionSnapshot) New() protoreflect.Message {
+	return new(fastReflection_CardanoTransactionSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CardanoTransactionSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*CardanoTransactionSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CardanoTransactionSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MerkleRoot != "" {
+		value := protoreflect.ValueOfString(x.MerkleRoot)
+		if !f(fd_CardanoTransactionSnapshot_merkle_root, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_CardanoTransactionSnapshot_epoch, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_CardanoTransactionSnapshot_block_number, value) {
+			return
+		}
+	}
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_CardanoTransactionSnapshot_hash, value) {
+			return
+		}
+	}
+	if x.CertificateHash != "" {
+		value := protoreflect.ValueOfString(x.CertificateHash)
+		if !f(fd_CardanoTransactionSnapshot_certificate_hash, value) {
+			return
+		}
+	}
+	if x.CreatedAt != "" {
+		value := protoreflect.ValueOfString(x.CreatedAt)
+		if !f(fd_CardanoTransactionSnapshot_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CardanoTransactionSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
+		return x.MerkleRoot != ""
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
+		return x.Epoch != uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
+		return x.BlockNumber != uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
+		return x.Hash != ""
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
+		return x.CertificateHash != ""
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
+		return x.CreatedAt != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CardanoTransactionSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
+		x.MerkleRoot = ""
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
+		x.Epoch = uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
+		x.BlockNumber = uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
+		x.Hash = ""
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
+		x.CertificateHash = ""
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
+		x.CreatedAt = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CardanoTransactionSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
+		value := x.MerkleRoot
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
+		value := x.CertificateHash
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CardanoTransactionSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
+		x.MerkleRoot = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
+		x.Epoch = value.Uint()
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
+		x.BlockNumber = value.Uint()
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
+		x.Hash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
+		x.CertificateHash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
+		x.CreatedAt = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CardanoTransactionSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
+		panic(fmt.Errorf("field merkle_root of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
+		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
+		panic(fmt.Errorf("field block_number of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
+		panic(fmt.Errorf("field hash of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
+		panic(fmt.Errorf("field certificate_hash of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
+		panic(fmt.Errorf("field created_at of message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CardanoTransactionSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.merkle_root":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.hash":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.certificate_hash":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.CardanoTransactionSnapshot.created_at":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactionSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CardanoTransactionSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoTransactionSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CardanoTransactionSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CardanoTransactionSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CardanoTransactionSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CardanoTransactionSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CardanoTransactionSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CertificateHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CreatedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CardanoTransactionSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CreatedAt) > 0 {
+			i -= len(x.CreatedAt)
+			copy(dAtA[i:], x.CreatedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreatedAt)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.CertificateHash) > 0 {
+			i -= len(x.CertificateHash)
+			copy(dAtA[i:], x.CertificateHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CertificateHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MerkleRoot) > 0 {
+			i -= len(x.MerkleRoot)
+			copy(dAtA[i:], x.MerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CardanoTransactionSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactionSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactionSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerkleRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CertificateHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CertificateHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreatedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MithrilCertificate                            protoreflect.MessageDescriptor
+	fd_MithrilCertificate_hash                       protoreflect.FieldDescriptor
+	fd_MithrilCertificate_previous_hash              protoreflect.FieldDescriptor
+	fd_MithrilCertificate_epoch                      protoreflect.FieldDescriptor
 	fd_MithrilCertificate_signed_entity_type         protoreflect.FieldDescriptor
 	fd_MithrilCertificate_metadata                   protoreflect.FieldDescriptor
 	fd_MithrilCertificate_protocol_message           protoreflect.FieldDescriptor
@@ -5272,29 +7040,1097 @@ var (
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_MithrilCertificate = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilCertificate")
-	fd_MithrilCertificate_hash = md_MithrilCertificate.Fields().ByName("hash")
-	fd_MithrilCertificate_previous_hash = md_MithrilCertificate.Fields().ByName("previous_hash")
-	fd_MithrilCertificate_epoch = md_MithrilCertificate.Fields().ByName("epoch")
-	fd_MithrilCertificate_signed_entity_type = md_MithrilCertificate.Fields().ByName("signed_entity_type")
-	fd_MithrilCertificate_metadata = md_MithrilCertificate.Fields().ByName("metadata")
-	fd_MithrilCertificate_protocol_message = md_MithrilCertificate.Fields().ByName("protocol_message")
-	fd_MithrilCertificate_signed_message = md_MithrilCertificate.Fields().ByName("signed_message")
-	fd_MithrilCertificate_aggregate_verification_key = md_MithrilCertificate.Fields().ByName("aggregate_verification_key")
-	fd_MithrilCertificate_multi_signature = md_MithrilCertificate.Fields().ByName("multi_signature")
-	fd_MithrilCertificate_genesis_signature = md_MithrilCertificate.Fields().ByName("genesis_signature")
+	md_MithrilCertificate = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilCertificate")
+	fd_MithrilCertificate_hash = md_MithrilCertificate.Fields().ByName("hash")
+	fd_MithrilCertificate_previous_hash = md_MithrilCertificate.Fields().ByName("previous_hash")
+	fd_MithrilCertificate_epoch = md_MithrilCertificate.Fields().ByName("epoch")
+	fd_MithrilCertificate_signed_entity_type = md_MithrilCertificate.Fields().ByName("signed_entity_type")
+	fd_MithrilCertificate_metadata = md_MithrilCertificate.Fields().ByName("metadata")
+	fd_MithrilCertificate_protocol_message = md_MithrilCertificate.Fields().ByName("protocol_message")
+	fd_MithrilCertificate_signed_message = md_MithrilCertificate.Fields().ByName("signed_message")
+	fd_MithrilCertificate_aggregate_verification_key = md_MithrilCertificate.Fields().ByName("aggregate_verification_key")
+	fd_MithrilCertificate_multi_signature = md_MithrilCertificate.Fields().ByName("multi_signature")
+	fd_MithrilCertificate_genesis_signature = md_MithrilCertificate.Fields().ByName("genesis_signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_MithrilCertificate)(nil)
+
+type fastReflection_MithrilCertificate MithrilCertificate
+
+func (x *MithrilCertificate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MithrilCertificate)(x)
+}
+
+func (x *MithrilCertificate) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MithrilCertificate_messageType fastReflection_MithrilCertificate_messageType
+var _ protoreflect.MessageType = fastReflection_MithrilCertificate_messageType{}
+
+type fastReflection_MithrilCertificate_messageType struct{}
+
+func (x fastReflection_MithrilCertificate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MithrilCertificate)(nil)
+}
+func (x fastReflection_MithrilCertificate_messageType) New() protoreflect.Message {
+	return new(fastReflection_MithrilCertificate)
+}
+func (x fastReflection_MithrilCertificate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilCertificate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MithrilCertificate) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilCertificate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MithrilCertificate) Type() protoreflect.MessageType {
+	return _fastReflection_MithrilCertificate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MithrilCertificate) New() protoreflect.Message {
+	return new(fastReflection_MithrilCertificate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MithrilCertificate) Interface() protoreflect.ProtoMessage {
+	return (*MithrilCertificate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MithrilCertificate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_MithrilCertificate_hash, value) {
+			return
+		}
+	}
+	if x.PreviousHash != "" {
+		value := protoreflect.ValueOfString(x.PreviousHash)
+		if !f(fd_MithrilCertificate_previous_hash, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_MithrilCertificate_epoch, value) {
+			return
+		}
+	}
+	if x.SignedEntityType != nil {
+		value := protoreflect.ValueOfMessage(x.SignedEntityType.ProtoReflect())
+		if !f(fd_MithrilCertificate_signed_entity_type, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_MithrilCertificate_metadata, value) {
+			return
+		}
+	}
+	if x.ProtocolMessage != nil {
+		value := protoreflect.ValueOfMessage(x.ProtocolMessage.ProtoReflect())
+		if !f(fd_MithrilCertificate_protocol_message, value) {
+			return
+		}
+	}
+	if x.SignedMessage != "" {
+		value := protoreflect.ValueOfString(x.SignedMessage)
+		if !f(fd_MithrilCertificate_signed_message, value) {
+			return
+		}
+	}
+	if x.AggregateVerificationKey != "" {
+		value := protoreflect.ValueOfString(x.AggregateVerificationKey)
+		if !f(fd_MithrilCertificate_aggregate_verification_key, value) {
+			return
+		}
+	}
+	if x.MultiSignature != "" {
+		value := protoreflect.ValueOfString(x.MultiSignature)
+		if !f(fd_MithrilCertificate_multi_signature, value) {
+			return
+		}
+	}
+	if x.GenesisSignature != "" {
+		value := protoreflect.ValueOfString(x.GenesisSignature)
+		if !f(fd_MithrilCertificate_genesis_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MithrilCertificate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
+		return x.Hash != ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
+		return x.PreviousHash != ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
+		return x.Epoch != uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
+		return x.SignedEntityType != nil
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
+		return x.Metadata != nil
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
+		return x.ProtocolMessage != nil
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
+		return x.SignedMessage != ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
+		return x.AggregateVerificationKey != ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
+		return x.MultiSignature != ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
+		return x.GenesisSignature != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilCertificate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
+		x.Hash = ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
+		x.PreviousHash = ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
+		x.Epoch = uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
+		x.SignedEntityType = nil
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
+		x.Metadata = nil
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
+		x.ProtocolMessage = nil
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
+		x.SignedMessage = ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
+		x.AggregateVerificationKey = ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
+		x.MultiSignature = ""
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
+		x.GenesisSignature = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MithrilCertificate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
+		value := x.PreviousHash
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
+		value := x.SignedEntityType
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
+		value := x.ProtocolMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
+		value := x.SignedMessage
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
+		value := x.AggregateVerificationKey
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
+		value := x.MultiSignature
+		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
+		value := x.GenesisSignature
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilCertificate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
+		x.Hash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
+		x.PreviousHash = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
+		x.Epoch = value.Uint()
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
+		x.SignedEntityType = value.Message().Interface().(*SignedEntityType)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
+		x.Metadata = value.Message().Interface().(*CertificateMetadata)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
+		x.ProtocolMessage = value.Message().Interface().(*ProtocolMessage)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
+		x.SignedMessage = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
+		x.AggregateVerificationKey = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
+		x.MultiSignature = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
+		x.GenesisSignature = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilCertificate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
+		if x.SignedEntityType == nil {
+			x.SignedEntityType = new(SignedEntityType)
+		}
+		return protoreflect.ValueOfMessage(x.SignedEntityType.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(CertificateMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
+		if x.ProtocolMessage == nil {
+			x.ProtocolMessage = new(ProtocolMessage)
+		}
+		return protoreflect.ValueOfMessage(x.ProtocolMessage.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
+		panic(fmt.Errorf("field hash of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
+		panic(fmt.Errorf("field previous_hash of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
+		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
+		panic(fmt.Errorf("field signed_message of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
+		panic(fmt.Errorf("field aggregate_verification_key of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
+		panic(fmt.Errorf("field multi_signature of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
+		panic(fmt.Errorf("field genesis_signature of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MithrilCertificate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
+		m := new(SignedEntityType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
+		m := new(CertificateMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
+		m := new(ProtocolMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
+		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+		}
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MithrilCertificate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilCertificate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MithrilCertificate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MithrilCertificate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MithrilCertificate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MithrilCertificate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.SignedEntityType != nil {
+			l = options.Size(x.SignedEntityType)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProtocolMessage != nil {
+			l = options.Size(x.ProtocolMessage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SignedMessage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AggregateVerificationKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MultiSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GenesisSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MithrilCertificate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GenesisSignature) > 0 {
+			i -= len(x.GenesisSignature)
+			copy(dAtA[i:], x.GenesisSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GenesisSignature)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.MultiSignature) > 0 {
+			i -= len(x.MultiSignature)
+			copy(dAtA[i:], x.MultiSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultiSignature)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.AggregateVerificationKey) > 0 {
+			i -= len(x.AggregateVerificationKey)
+			copy(dAtA[i:], x.AggregateVerificationKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AggregateVerificationKey)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.SignedMessage) > 0 {
+			i -= len(x.SignedMessage)
+			copy(dAtA[i:], x.SignedMessage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignedMessage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ProtocolMessage != nil {
+			encoded, err := options.Marshal(x.ProtocolMessage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SignedEntityType != nil {
+			encoded, err := options.Marshal(x.SignedEntityType)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.PreviousHash) > 0 {
+			i -= len(x.PreviousHash)
+			copy(dAtA[i:], x.PreviousHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MithrilCertificate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilCertificate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedEntityType", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SignedEntityType == nil {
+					x.SignedEntityType = &SignedEntityType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignedEntityType); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = &CertificateMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolMessage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ProtocolMessage == nil {
+					x.ProtocolMessage = &ProtocolMessage{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProtocolMessage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedMessage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignedMessage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregateVerificationKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AggregateVerificationKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiSignature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultiSignature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisSignature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GenesisSignature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CertificateMetadata_6_list)(nil)
+
+type _CertificateMetadata_6_list struct {
+	list *[]*SignerWithStake
+}
+
+func (x *_CertificateMetadata_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CertificateMetadata_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CertificateMetadata_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CertificateMetadata_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CertificateMetadata_6_list) AppendMutable() protoreflect.Value {
+	v := new(SignerWithStake)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CertificateMetadata_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CertificateMetadata_6_list) NewElement() protoreflect.Value {
+	v := new(SignerWithStake)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CertificateMetadata_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CertificateMetadata                     protoreflect.MessageDescriptor
+	fd_CertificateMetadata_network             protoreflect.FieldDescriptor
+	fd_CertificateMetadata_protocol_version    protoreflect.FieldDescriptor
+	fd_CertificateMetadata_protocol_parameters protoreflect.FieldDescriptor
+	fd_CertificateMetadata_initiated_at        protoreflect.FieldDescriptor
+	fd_CertificateMetadata_sealed_at           protoreflect.FieldDescriptor
+	fd_CertificateMetadata_signers             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ibc_lightclients_mithril_v1_mithril_proto_init()
+	md_CertificateMetadata = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CertificateMetadata")
+	fd_CertificateMetadata_network = md_CertificateMetadata.Fields().ByName("network")
+	fd_CertificateMetadata_protocol_version = md_CertificateMetadata.Fields().ByName("protocol_version")
+	fd_CertificateMetadata_protocol_parameters = md_CertificateMetadata.Fields().ByName("protocol_parameters")
+	fd_CertificateMetadata_initiated_at = md_CertificateMetadata.Fields().ByName("initiated_at")
+	fd_CertificateMetadata_sealed_at = md_CertificateMetadata.Fields().ByName("sealed_at")
+	fd_CertificateMetadata_signers = md_CertificateMetadata.Fields().ByName("signers")
 }
 
-var _ protoreflect.Message = (*fastReflection_MithrilCertificate)(nil)
+var _ protoreflect.Message = (*fastReflection_CertificateMetadata)(nil)
 
-type fastReflection_MithrilCertificate MithrilCertificate
+type fastReflection_CertificateMetadata CertificateMetadata
 
-func (x *MithrilCertificate) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MithrilCertificate)(x)
+func (x *CertificateMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CertificateMetadata)(x)
 }
 
-func (x *MithrilCertificate) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[7]
+func (x *CertificateMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5305,43 +8141,43 @@ func (x *MithrilCertificate) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MithrilCertificate_messageType fastReflection_MithrilCertificate_messageType
-var _ protoreflect.MessageType = fastReflection_MithrilCertificate_messageType{}
+var _fastReflection_CertificateMetadata_messageType fastReflection_CertificateMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_CertificateMetadata_messageType{}
 
-type fastReflection_MithrilCertificate_messageType struct{}
+type fastReflection_CertificateMetadata_messageType struct{}
 
-func (x fastReflection_MithrilCertificate_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MithrilCertificate)(nil)
+func (x fastReflection_CertificateMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CertificateMetadata)(nil)
 }
-func (x fastReflection_MithrilCertificate_messageType) New() protoreflect.Message {
-	return new(fastReflection_MithrilCertificate)
+func (x fastReflection_CertificateMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_CertificateMetadata)
 }
-func (x fastReflection_MithrilCertificate_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilCertificate
+func (x fastReflection_CertificateMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CertificateMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MithrilCertificate) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilCertificate
+func (x *fastReflection_CertificateMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_CertificateMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MithrilCertificate) Type() protoreflect.MessageType {
-	return _fastReflection_MithrilCertificate_messageType
+func (x *fastReflection_CertificateMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_CertificateMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MithrilCertificate) New() protoreflect.Message {
-	return new(fastReflection_MithrilCertificate)
+func (x *fastReflection_CertificateMetadata) New() protoreflect.Message {
+	return new(fastReflection_CertificateMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MithrilCertificate) Interface() protoreflect.ProtoMessage {
-	return (*MithrilCertificate)(x)
+func (x *fastReflection_CertificateMetadata) Interface() protoreflect.ProtoMessage {
+	return (*CertificateMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5349,64 +8185,40 @@ func (x *fastReflection_MithrilCertificate) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MithrilCertificate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_MithrilCertificate_hash, value) {
-			return
-		}
-	}
-	if x.PreviousHash != "" {
-		value := protoreflect.ValueOfString(x.PreviousHash)
-		if !f(fd_MithrilCertificate_previous_hash, value) {
-			return
-		}
-	}
-	if x.Epoch != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Epoch)
-		if !f(fd_MithrilCertificate_epoch, value) {
-			return
-		}
-	}
-	if x.SignedEntityType != nil {
-		value := protoreflect.ValueOfMessage(x.SignedEntityType.ProtoReflect())
-		if !f(fd_MithrilCertificate_signed_entity_type, value) {
-			return
-		}
-	}
-	if x.Metadata != nil {
-		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
-		if !f(fd_MithrilCertificate_metadata, value) {
+func (x *fastReflection_CertificateMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Network != "" {
+		value := protoreflect.ValueOfString(x.Network)
+		if !f(fd_CertificateMetadata_network, value) {
 			return
 		}
 	}
-	if x.ProtocolMessage != nil {
-		value := protoreflect.ValueOfMessage(x.ProtocolMessage.ProtoReflect())
-		if !f(fd_MithrilCertificate_protocol_message, value) {
+	if x.ProtocolVersion != "" {
+		value := protoreflect.ValueOfString(x.ProtocolVersion)
+		if !f(fd_CertificateMetadata_protocol_version, value) {
 			return
 		}
 	}
-	if x.SignedMessage != "" {
-		value := protoreflect.ValueOfString(x.SignedMessage)
-		if !f(fd_MithrilCertificate_signed_message, value) {
+	if x.ProtocolParameters != nil {
+		value := protoreflect.ValueOfMessage(x.ProtocolParameters.ProtoReflect())
+		if !f(fd_CertificateMetadata_protocol_parameters, value) {
 			return
 		}
 	}
-	if x.AggregateVerificationKey != "" {
-		value := protoreflect.ValueOfString(x.AggregateVerificationKey)
-		if !f(fd_MithrilCertificate_aggregate_verification_key, value) {
+	if x.InitiatedAt != "" {
+		value := protoreflect.ValueOfString(x.InitiatedAt)
+		if !f(fd_CertificateMetadata_initiated_at, value) {
 			return
 		}
 	}
-	if x.MultiSignature != "" {
-		value := protoreflect.ValueOfString(x.MultiSignature)
-		if !f(fd_MithrilCertificate_multi_signature, value) {
+	if x.SealedAt != "" {
+		value := protoreflect.ValueOfString(x.SealedAt)
+		if !f(fd_CertificateMetadata_sealed_at, value) {
 			return
 		}
 	}
-	if x.GenesisSignature != "" {
-		value := protoreflect.ValueOfString(x.GenesisSignature)
-		if !f(fd_MithrilCertificate_genesis_signature, value) {
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_CertificateMetadata_6_list{list: &x.Signers})
+		if !f(fd_CertificateMetadata_signers, value) {
 			return
 		}
 	}
@@ -5423,33 +8235,25 @@ func (x *fastReflection_MithrilCertificate) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MithrilCertificate) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CertificateMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
-		return x.Hash != ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
-		return x.PreviousHash != ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
-		return x.Epoch != uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
-		return x.SignedEntityType != nil
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
-		return x.Metadata != nil
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
-		return x.ProtocolMessage != nil
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
-		return x.SignedMessage != ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
-		return x.AggregateVerificationKey != ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
-		return x.MultiSignature != ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
-		return x.GenesisSignature != ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
+		return x.Network != ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
+		return x.ProtocolVersion != ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
+		return x.ProtocolParameters != nil
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
+		return x.InitiatedAt != ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
+		return x.SealedAt != ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
+		return len(x.Signers) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5459,33 +8263,25 @@ func (x *fastReflection_MithrilCertificate) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilCertificate) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CertificateMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
-		x.Hash = ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
-		x.PreviousHash = ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
-		x.Epoch = uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
-		x.SignedEntityType = nil
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
-		x.Metadata = nil
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
-		x.ProtocolMessage = nil
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
-		x.SignedMessage = ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
-		x.AggregateVerificationKey = ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
-		x.MultiSignature = ""
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
-		x.GenesisSignature = ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
+		x.Network = ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
+		x.ProtocolVersion = ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
+		x.ProtocolParameters = nil
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
+		x.InitiatedAt = ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
+		x.SealedAt = ""
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
+		x.Signers = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5495,43 +8291,34 @@ func (x *fastReflection_MithrilCertificate) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MithrilCertificate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CertificateMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
-		value := x.Hash
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
+		value := x.Network
 		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
-		value := x.PreviousHash
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
+		value := x.ProtocolVersion
 		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
-		value := x.Epoch
-		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
-		value := x.SignedEntityType
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
-		value := x.ProtocolMessage
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
+		value := x.ProtocolParameters
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
-		value := x.SignedMessage
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
-		value := x.AggregateVerificationKey
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
-		value := x.MultiSignature
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
+		value := x.InitiatedAt
 		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
-		value := x.GenesisSignature
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
+		value := x.SealedAt
 		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_CertificateMetadata_6_list{})
+		}
+		listValue := &_CertificateMetadata_6_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5545,33 +8332,27 @@ func (x *fastReflection_MithrilCertificate) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilCertificate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CertificateMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
-		x.Hash = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
-		x.PreviousHash = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
-		x.Epoch = value.Uint()
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
-		x.SignedEntityType = value.Message().Interface().(*SignedEntityType)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
-		x.Metadata = value.Message().Interface().(*CertificateMetadata)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
-		x.ProtocolMessage = value.Message().Interface().(*ProtocolMessage)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
-		x.SignedMessage = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
-		x.AggregateVerificationKey = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
-		x.MultiSignature = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
-		x.GenesisSignature = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
+		x.Network = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
+		x.ProtocolVersion = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
+		x.ProtocolParameters = value.Message().Interface().(*MithrilProtocolParameters)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
+		x.InitiatedAt = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
+		x.SealedAt = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
+		lv := value.List()
+		clv := lv.(*_CertificateMetadata_6_list)
+		x.Signers = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5585,88 +8366,69 @@ func (x *fastReflection_MithrilCertificate) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilCertificate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CertificateMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
-		if x.SignedEntityType == nil {
-			x.SignedEntityType = new(SignedEntityType)
-		}
-		return protoreflect.ValueOfMessage(x.SignedEntityType.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
-		if x.Metadata == nil {
-			x.Metadata = new(CertificateMetadata)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
+		if x.ProtocolParameters == nil {
+			x.ProtocolParameters = new(MithrilProtocolParameters)
 		}
-		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
-		if x.ProtocolMessage == nil {
-			x.ProtocolMessage = new(ProtocolMessage)
+		return protoreflect.ValueOfMessage(x.ProtocolParameters.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
+		if x.Signers == nil {
+			x.Signers = []*SignerWithStake{}
 		}
-		return protoreflect.ValueOfMessage(x.ProtocolMessage.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
-		panic(fmt.Errorf("field hash of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
-		panic(fmt.Errorf("field previous_hash of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
-		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
-		panic(fmt.Errorf("field signed_message of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
-		panic(fmt.Errorf("field aggregate_verification_key of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
-		panic(fmt.Errorf("field multi_signature of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
-		panic(fmt.Errorf("field genesis_signature of message ibc.lightclients.mithril.v1.MithrilCertificate is not mutable"))
+		value := &_CertificateMetadata_6_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
+		panic(fmt.Errorf("field network of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
+		panic(fmt.Errorf("field protocol_version of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
+		panic(fmt.Errorf("field initiated_at of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
+		panic(fmt.Errorf("field sealed_at of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MithrilCertificate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CertificateMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.hash":
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
 		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.previous_hash":
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
 		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.epoch":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type":
-		m := new(SignedEntityType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.metadata":
-		m := new(CertificateMetadata)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message":
-		m := new(ProtocolMessage)
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
+		m := new(MithrilProtocolParameters)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.signed_message":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.aggregate_verification_key":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.multi_signature":
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
 		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.MithrilCertificate.genesis_signature":
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
 		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
+		list := []*SignerWithStake{}
+		return protoreflect.ValueOfList(&_CertificateMetadata_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilCertificate"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilCertificate does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MithrilCertificate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CertificateMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilCertificate", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CertificateMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5674,7 +8436,7 @@ func (x *fastReflection_MithrilCertificate) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MithrilCertificate) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CertificateMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5685,7 +8447,7 @@ func (x *fastReflection_MithrilCertificate) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilCertificate) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CertificateMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5697,7 +8459,7 @@ func (x *fastReflection_MithrilCertificate) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MithrilCertificate) IsValid() bool {
+func (x *fastReflection_CertificateMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -5707,9 +8469,9 @@ func (x *fastReflection_MithrilCertificate) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MithrilCertificate)
+		x := input.Message.Interface().(*CertificateMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5721,44 +8483,31 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Network)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PreviousHash)
+		l = len(x.ProtocolVersion)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Epoch != 0 {
-			n += 1 + runtime.Sov(uint64(x.Epoch))
-		}
-		if x.SignedEntityType != nil {
-			l = options.Size(x.SignedEntityType)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Metadata != nil {
-			l = options.Size(x.Metadata)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ProtocolMessage != nil {
-			l = options.Size(x.ProtocolMessage)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.SignedMessage)
-		if l > 0 {
+		if x.ProtocolParameters != nil {
+			l = options.Size(x.ProtocolParameters)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AggregateVerificationKey)
+		l = len(x.InitiatedAt)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MultiSignature)
+		l = len(x.SealedAt)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.GenesisSignature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Signers) > 0 {
+			for _, e := range x.Signers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5770,7 +8519,7 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilCertificate)
+		x := input.Message.Interface().(*CertificateMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5789,64 +8538,38 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.GenesisSignature) > 0 {
-			i -= len(x.GenesisSignature)
-			copy(dAtA[i:], x.GenesisSignature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GenesisSignature)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.MultiSignature) > 0 {
-			i -= len(x.MultiSignature)
-			copy(dAtA[i:], x.MultiSignature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultiSignature)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.AggregateVerificationKey) > 0 {
-			i -= len(x.AggregateVerificationKey)
-			copy(dAtA[i:], x.AggregateVerificationKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AggregateVerificationKey)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.SignedMessage) > 0 {
-			i -= len(x.SignedMessage)
-			copy(dAtA[i:], x.SignedMessage)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignedMessage)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.ProtocolMessage != nil {
-			encoded, err := options.Marshal(x.ProtocolMessage)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
 		}
-		if x.Metadata != nil {
-			encoded, err := options.Marshal(x.Metadata)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.SealedAt) > 0 {
+			i -= len(x.SealedAt)
+			copy(dAtA[i:], x.SealedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SealedAt)))
 			i--
 			dAtA[i] = 0x2a
 		}
-		if x.SignedEntityType != nil {
-			encoded, err := options.Marshal(x.SignedEntityType)
+		if len(x.InitiatedAt) > 0 {
+			i -= len(x.InitiatedAt)
+			copy(dAtA[i:], x.InitiatedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitiatedAt)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ProtocolParameters != nil {
+			encoded, err := options.Marshal(x.ProtocolParameters)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5857,24 +8580,19 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
-		}
-		if x.Epoch != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
-			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.PreviousHash) > 0 {
-			i -= len(x.PreviousHash)
-			copy(dAtA[i:], x.PreviousHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousHash)))
+		if len(x.ProtocolVersion) > 0 {
+			i -= len(x.ProtocolVersion)
+			copy(dAtA[i:], x.ProtocolVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolVersion)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.Network) > 0 {
+			i -= len(x.Network)
+			copy(dAtA[i:], x.Network)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Network)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5889,7 +8607,7 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilCertificate)
+		x := input.Message.Interface().(*CertificateMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5921,15 +8639,15 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilCertificate: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CertificateMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CertificateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5957,11 +8675,11 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.Network = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5989,102 +8707,11 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousHash = string(dAtA[iNdEx:postIndex])
+				x.ProtocolVersion = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-				}
-				x.Epoch = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Epoch |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedEntityType", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.SignedEntityType == nil {
-					x.SignedEntityType = &SignedEntityType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignedEntityType); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Metadata == nil {
-					x.Metadata = &CertificateMetadata{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolMessage", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolParameters", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6111,16 +8738,16 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ProtocolMessage == nil {
-					x.ProtocolMessage = &ProtocolMessage{}
+				if x.ProtocolParameters == nil {
+					x.ProtocolParameters = &MithrilProtocolParameters{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProtocolMessage); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProtocolParameters); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedMessage", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitiatedAt", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6148,11 +8775,11 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignedMessage = string(dAtA[iNdEx:postIndex])
+				x.InitiatedAt = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregateVerificationKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SealedAt", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6180,13 +8807,13 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AggregateVerificationKey = string(dAtA[iNdEx:postIndex])
+				x.SealedAt = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 9:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiSignature", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6196,55 +8823,25 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MultiSignature = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisSignature", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Signers = append(x.Signers, &SignerWithStake{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signers[len(x.Signers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.GenesisSignature = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6272,97 +8869,38 @@ func (x *fastReflection_MithrilCertificate) ProtoMethods() *protoiface.Methods {
 	}
 	return &protoiface.Methods{
 		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_CertificateMetadata_6_list)(nil)
-
-type _CertificateMetadata_6_list struct {
-	list *[]*SignerWithStake
-}
-
-func (x *_CertificateMetadata_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CertificateMetadata_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_CertificateMetadata_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CertificateMetadata_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SignerWithStake)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CertificateMetadata_6_list) AppendMutable() protoreflect.Value {
-	v := new(SignerWithStake)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CertificateMetadata_6_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CertificateMetadata_6_list) NewElement() protoreflect.Value {
-	v := new(SignerWithStake)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CertificateMetadata_6_list) IsValid() bool {
-	return x.list != nil
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_CertificateMetadata                     protoreflect.MessageDescriptor
-	fd_CertificateMetadata_network             protoreflect.FieldDescriptor
-	fd_CertificateMetadata_protocol_version    protoreflect.FieldDescriptor
-	fd_CertificateMetadata_protocol_parameters protoreflect.FieldDescriptor
-	fd_CertificateMetadata_initiated_at        protoreflect.FieldDescriptor
-	fd_CertificateMetadata_sealed_at           protoreflect.FieldDescriptor
-	fd_CertificateMetadata_signers             protoreflect.FieldDescriptor
+	md_SignerWithStake          protoreflect.MessageDescriptor
+	fd_SignerWithStake_party_id protoreflect.FieldDescriptor
+	fd_SignerWithStake_stake    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_CertificateMetadata = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CertificateMetadata")
-	fd_CertificateMetadata_network = md_CertificateMetadata.Fields().ByName("network")
-	fd_CertificateMetadata_protocol_version = md_CertificateMetadata.Fields().ByName("protocol_version")
-	fd_CertificateMetadata_protocol_parameters = md_CertificateMetadata.Fields().ByName("protocol_parameters")
-	fd_CertificateMetadata_initiated_at = md_CertificateMetadata.Fields().ByName("initiated_at")
-	fd_CertificateMetadata_sealed_at = md_CertificateMetadata.Fields().ByName("sealed_at")
-	fd_CertificateMetadata_signers = md_CertificateMetadata.Fields().ByName("signers")
+	md_SignerWithStake = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("SignerWithStake")
+	fd_SignerWithStake_party_id = md_SignerWithStake.Fields().ByName("party_id")
+	fd_SignerWithStake_stake = md_SignerWithStake.Fields().ByName("stake")
 }
 
-var _ protoreflect.Message = (*fastReflection_CertificateMetadata)(nil)
+var _ protoreflect.Message = (*fastReflection_SignerWithStake)(nil)
 
-type fastReflection_CertificateMetadata CertificateMetadata
+type fastReflection_SignerWithStake SignerWithStake
 
-func (x *CertificateMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CertificateMetadata)(x)
+func (x *SignerWithStake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignerWithStake)(x)
 }
 
-func (x *CertificateMetadata) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[8]
+func (x *SignerWithStake) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6373,43 +8911,43 @@ func (x *CertificateMetadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CertificateMetadata_messageType fastReflection_CertificateMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_CertificateMetadata_messageType{}
+var _fastReflection_SignerWithStake_messageType fastReflection_SignerWithStake_messageType
+var _ protoreflect.MessageType = fastReflection_SignerWithStake_messageType{}
 
-type fastReflection_CertificateMetadata_messageType struct{}
+type fastReflection_SignerWithStake_messageType struct{}
 
-func (x fastReflection_CertificateMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CertificateMetadata)(nil)
+func (x fastReflection_SignerWithStake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignerWithStake)(nil)
 }
-func (x fastReflection_CertificateMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_CertificateMetadata)
+func (x fastReflection_SignerWithStake_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignerWithStake)
 }
-func (x fastReflection_CertificateMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CertificateMetadata
+func (x fastReflection_SignerWithStake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerWithStake
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CertificateMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_CertificateMetadata
+func (x *fastReflection_SignerWithStake) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerWithStake
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CertificateMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_CertificateMetadata_messageType
+func (x *fastReflection_SignerWithStake) Type() protoreflect.MessageType {
+	return _fastReflection_SignerWithStake_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CertificateMetadata) New() protoreflect.Message {
-	return new(fastReflection_CertificateMetadata)
+func (x *fastReflection_SignerWithStake) New() protoreflect.Message {
+	return new(fastReflection_SignerWithStake)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CertificateMetadata) Interface() protoreflect.ProtoMessage {
-	return (*CertificateMetadata)(x)
+func (x *fastReflection_SignerWithStake) Interface() protoreflect.ProtoMessage {
+	return (*SignerWithStake)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6417,40 +8955,16 @@ func (x *fastReflection_CertificateMetadata) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CertificateMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Network != "" {
-		value := protoreflect.ValueOfString(x.Network)
-		if !f(fd_CertificateMetadata_network, value) {
-			return
-		}
-	}
-	if x.ProtocolVersion != "" {
-		value := protoreflect.ValueOfString(x.ProtocolVersion)
-		if !f(fd_CertificateMetadata_protocol_version, value) {
-			return
-		}
-	}
-	if x.ProtocolParameters != nil {
-		value := protoreflect.ValueOfMessage(x.ProtocolParameters.ProtoReflect())
-		if !f(fd_CertificateMetadata_protocol_parameters, value) {
-			return
-		}
-	}
-	if x.InitiatedAt != "" {
-		value := protoreflect.ValueOfString(x.InitiatedAt)
-		if !f(fd_CertificateMetadata_initiated_at, value) {
-			return
-		}
-	}
-	if x.SealedAt != "" {
-		value := protoreflect.ValueOfString(x.SealedAt)
-		if !f(fd_CertificateMetadata_sealed_at, value) {
+func (x *fastReflection_SignerWithStake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PartyId != "" {
+		value := protoreflect.ValueOfString(x.PartyId)
+		if !f(fd_SignerWithStake_party_id, value) {
 			return
 		}
 	}
-	if len(x.Signers) != 0 {
-		value := protoreflect.ValueOfList(&_CertificateMetadata_6_list{list: &x.Signers})
-		if !f(fd_CertificateMetadata_signers, value) {
+	if x.Stake != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Stake)
+		if !f(fd_SignerWithStake_stake, value) {
 			return
 		}
 	}
@@ -6467,25 +8981,17 @@ func (x *fastReflection_CertificateMetadata) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CertificateMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SignerWithStake) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
-		return x.Network != ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
-		return x.ProtocolVersion != ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
-		return x.ProtocolParameters != nil
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
-		return x.InitiatedAt != ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
-		return x.SealedAt != ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
-		return len(x.Signers) != 0
+	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
+		return x.PartyId != ""
+	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
+		return x.Stake != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6495,25 +9001,17 @@ func (x *fastReflection_CertificateMetadata) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CertificateMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SignerWithStake) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
-		x.Network = ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
-		x.ProtocolVersion = ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
-		x.ProtocolParameters = nil
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
-		x.InitiatedAt = ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
-		x.SealedAt = ""
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
-		x.Signers = nil
+	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
+		x.PartyId = ""
+	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
+		x.Stake = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6523,34 +9021,19 @@ func (x *fastReflection_CertificateMetadata) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CertificateMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignerWithStake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
-		value := x.Network
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
-		value := x.ProtocolVersion
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
-		value := x.ProtocolParameters
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
-		value := x.InitiatedAt
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
-		value := x.SealedAt
+	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
+		value := x.PartyId
 		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
-		if len(x.Signers) == 0 {
-			return protoreflect.ValueOfList(&_CertificateMetadata_6_list{})
-		}
-		listValue := &_CertificateMetadata_6_list{list: &x.Signers}
-		return protoreflect.ValueOfList(listValue)
+	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
+		value := x.Stake
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6564,27 +9047,17 @@ func (x *fastReflection_CertificateMetadata) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CertificateMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SignerWithStake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
-		x.Network = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
-		x.ProtocolVersion = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
-		x.ProtocolParameters = value.Message().Interface().(*MithrilProtocolParameters)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
-		x.InitiatedAt = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
-		x.SealedAt = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
-		lv := value.List()
-		clv := lv.(*_CertificateMetadata_6_list)
-		x.Signers = *clv.list
+	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
+		x.PartyId = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
+		x.Stake = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6598,69 +9071,44 @@ func (x *fastReflection_CertificateMetadata) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CertificateMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignerWithStake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
-		if x.ProtocolParameters == nil {
-			x.ProtocolParameters = new(MithrilProtocolParameters)
-		}
-		return protoreflect.ValueOfMessage(x.ProtocolParameters.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
-		if x.Signers == nil {
-			x.Signers = []*SignerWithStake{}
-		}
-		value := &_CertificateMetadata_6_list{list: &x.Signers}
-		return protoreflect.ValueOfList(value)
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
-		panic(fmt.Errorf("field network of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
-		panic(fmt.Errorf("field protocol_version of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
-		panic(fmt.Errorf("field initiated_at of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
-		panic(fmt.Errorf("field sealed_at of message ibc.lightclients.mithril.v1.CertificateMetadata is not mutable"))
+	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
+		panic(fmt.Errorf("field party_id of message ibc.lightclients.mithril.v1.SignerWithStake is not mutable"))
+	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
+		panic(fmt.Errorf("field stake of message ibc.lightclients.mithril.v1.SignerWithStake is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CertificateMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.network":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_version":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters":
-		m := new(MithrilProtocolParameters)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.initiated_at":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.sealed_at":
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignerWithStake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
 		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.CertificateMetadata.signers":
-		list := []*SignerWithStake{}
-		return protoreflect.ValueOfList(&_CertificateMetadata_6_list{list: &list})
+	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CertificateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CertificateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CertificateMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SignerWithStake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CertificateMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.SignerWithStake", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6668,7 +9116,7 @@ func (x *fastReflection_CertificateMetadata) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CertificateMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SignerWithStake) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6679,7 +9127,7 @@ func (x *fastReflection_CertificateMetadata) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CertificateMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SignerWithStake) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6691,7 +9139,7 @@ func (x *fastReflection_CertificateMetadata) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CertificateMetadata) IsValid() bool {
+func (x *fastReflection_SignerWithStake) IsValid() bool {
 	return x != nil
 }
 
@@ -6701,9 +9149,9 @@ func (x *fastReflection_CertificateMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CertificateMetadata)
+		x := input.Message.Interface().(*SignerWithStake)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6715,31 +9163,12 @@ func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Network)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProtocolVersion)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ProtocolParameters != nil {
-			l = options.Size(x.ProtocolParameters)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.InitiatedAt)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.SealedAt)
+		l = len(x.PartyId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signers) > 0 {
-			for _, e := range x.Signers {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Stake != 0 {
+			n += 1 + runtime.Sov(uint64(x.Stake))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6751,7 +9180,7 @@ func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CertificateMetadata)
+		x := input.Message.Interface().(*SignerWithStake)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6770,248 +9199,70 @@ func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signers) > 0 {
-			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Signers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if len(x.SealedAt) > 0 {
-			i -= len(x.SealedAt)
-			copy(dAtA[i:], x.SealedAt)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SealedAt)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.InitiatedAt) > 0 {
-			i -= len(x.InitiatedAt)
-			copy(dAtA[i:], x.InitiatedAt)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitiatedAt)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.ProtocolParameters != nil {
-			encoded, err := options.Marshal(x.ProtocolParameters)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.ProtocolVersion) > 0 {
-			i -= len(x.ProtocolVersion)
-			copy(dAtA[i:], x.ProtocolVersion)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolVersion)))
+		if x.Stake != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Stake))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Network) > 0 {
-			i -= len(x.Network)
-			copy(dAtA[i:], x.Network)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Network)))
+		if len(x.PartyId) > 0 {
+			i -= len(x.PartyId)
+			copy(dAtA[i:], x.PartyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PartyId)))
 			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CertificateMetadata)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CertificateMetadata: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CertificateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Network = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ProtocolVersion = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolParameters", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignerWithStake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ProtocolParameters == nil {
-					x.ProtocolParameters = &MithrilProtocolParameters{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProtocolParameters); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitiatedAt", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.InitiatedAt = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerWithStake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerWithStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SealedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7039,13 +9290,13 @@ func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SealedAt = string(dAtA[iNdEx:postIndex])
+				x.PartyId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 				}
-				var msglen int
+				x.Stake = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7055,26 +9306,11 @@ func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Stake |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signers = append(x.Signers, &SignerWithStake{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signers[len(x.Signers)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7110,29 +9346,78 @@ func (x *fastReflection_CertificateMetadata) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_ProtocolMessage_1_list)(nil)
+
+type _ProtocolMessage_1_list struct {
+	list *[]*MessagePart
+}
+
+func (x *_ProtocolMessage_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProtocolMessage_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ProtocolMessage_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MessagePart)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProtocolMessage_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MessagePart)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProtocolMessage_1_list) AppendMutable() protoreflect.Value {
+	v := new(MessagePart)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProtocolMessage_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProtocolMessage_1_list) NewElement() protoreflect.Value {
+	v := new(MessagePart)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProtocolMessage_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SignerWithStake          protoreflect.MessageDescriptor
-	fd_SignerWithStake_party_id protoreflect.FieldDescriptor
-	fd_SignerWithStake_stake    protoreflect.FieldDescriptor
+	md_ProtocolMessage               protoreflect.MessageDescriptor
+	fd_ProtocolMessage_message_parts protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_SignerWithStake = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("SignerWithStake")
-	fd_SignerWithStake_party_id = md_SignerWithStake.Fields().ByName("party_id")
-	fd_SignerWithStake_stake = md_SignerWithStake.Fields().ByName("stake")
+	md_ProtocolMessage = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("ProtocolMessage")
+	fd_ProtocolMessage_message_parts = md_ProtocolMessage.Fields().ByName("message_parts")
 }
 
-var _ protoreflect.Message = (*fastReflection_SignerWithStake)(nil)
+var _ protoreflect.Message = (*fastReflection_ProtocolMessage)(nil)
 
-type fastReflection_SignerWithStake SignerWithStake
+type fastReflection_ProtocolMessage ProtocolMessage
 
-func (x *SignerWithStake) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignerWithStake)(x)
+func (x *ProtocolMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProtocolMessage)(x)
 }
 
-func (x *SignerWithStake) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[9]
+func (x *ProtocolMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7143,43 +9428,43 @@ func (x *SignerWithStake) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SignerWithStake_messageType fastReflection_SignerWithStake_messageType
-var _ protoreflect.MessageType = fastReflection_SignerWithStake_messageType{}
+var _fastReflection_ProtocolMessage_messageType fastReflection_ProtocolMessage_messageType
+var _ protoreflect.MessageType = fastReflection_ProtocolMessage_messageType{}
 
-type fastReflection_SignerWithStake_messageType struct{}
+type fastReflection_ProtocolMessage_messageType struct{}
 
-func (x fastReflection_SignerWithStake_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignerWithStake)(nil)
+func (x fastReflection_ProtocolMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProtocolMessage)(nil)
 }
-func (x fastReflection_SignerWithStake_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignerWithStake)
+func (x fastReflection_ProtocolMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProtocolMessage)
 }
-func (x fastReflection_SignerWithStake_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignerWithStake
+func (x fastReflection_ProtocolMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolMessage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SignerWithStake) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignerWithStake
+func (x *fastReflection_ProtocolMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolMessage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignerWithStake) Type() protoreflect.MessageType {
-	return _fastReflection_SignerWithStake_messageType
+func (x *fastReflection_ProtocolMessage) Type() protoreflect.MessageType {
+	return _fastReflection_ProtocolMessage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignerWithStake) New() protoreflect.Message {
-	return new(fastReflection_SignerWithStake)
+func (x *fastReflection_ProtocolMessage) New() protoreflect.Message {
+	return new(fastReflection_ProtocolMessage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignerWithStake) Interface() protoreflect.ProtoMessage {
-	return (*SignerWithStake)(x)
+func (x *fastReflection_ProtocolMessage) Interface() protoreflect.ProtoMessage {
+	return (*ProtocolMessage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7187,16 +9472,10 @@ func (x *fastReflection_SignerWithStake) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignerWithStake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PartyId != "" {
-		value := protoreflect.ValueOfString(x.PartyId)
-		if !f(fd_SignerWithStake_party_id, value) {
-			return
-		}
-	}
-	if x.Stake != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Stake)
-		if !f(fd_SignerWithStake_stake, value) {
+func (x *fastReflection_ProtocolMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MessageParts) != 0 {
+		value := protoreflect.ValueOfList(&_ProtocolMessage_1_list{list: &x.MessageParts})
+		if !f(fd_ProtocolMessage_message_parts, value) {
 			return
 		}
 	}
@@ -7213,17 +9492,15 @@ func (x *fastReflection_SignerWithStake) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignerWithStake) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProtocolMessage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
-		return x.PartyId != ""
-	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
-		return x.Stake != uint64(0)
+	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
+		return len(x.MessageParts) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7233,17 +9510,15 @@ func (x *fastReflection_SignerWithStake) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignerWithStake) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProtocolMessage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
-		x.PartyId = ""
-	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
-		x.Stake = uint64(0)
+	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
+		x.MessageParts = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7253,19 +9528,19 @@ func (x *fastReflection_SignerWithStake) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignerWithStake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
-		value := x.PartyId
-		return protoreflect.ValueOfString(value)
-	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
-		value := x.Stake
-		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
+		if len(x.MessageParts) == 0 {
+			return protoreflect.ValueOfList(&_ProtocolMessage_1_list{})
+		}
+		listValue := &_ProtocolMessage_1_list{list: &x.MessageParts}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7279,17 +9554,17 @@ func (x *fastReflection_SignerWithStake) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignerWithStake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProtocolMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
-		x.PartyId = value.Interface().(string)
-	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
-		x.Stake = value.Uint()
+	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
+		lv := value.List()
+		clv := lv.(*_ProtocolMessage_1_list)
+		x.MessageParts = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7303,44 +9578,45 @@ func (x *fastReflection_SignerWithStake) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignerWithStake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
-		panic(fmt.Errorf("field party_id of message ibc.lightclients.mithril.v1.SignerWithStake is not mutable"))
-	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
-		panic(fmt.Errorf("field stake of message ibc.lightclients.mithril.v1.SignerWithStake is not mutable"))
+	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
+		if x.MessageParts == nil {
+			x.MessageParts = []*MessagePart{}
+		}
+		value := &_ProtocolMessage_1_list{list: &x.MessageParts}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignerWithStake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignerWithStake.party_id":
-		return protoreflect.ValueOfString("")
-	case "ibc.lightclients.mithril.v1.SignerWithStake.stake":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
+		list := []*MessagePart{}
+		return protoreflect.ValueOfList(&_ProtocolMessage_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignerWithStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignerWithStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignerWithStake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProtocolMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.SignerWithStake", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.ProtocolMessage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7348,7 +9624,7 @@ func (x *fastReflection_SignerWithStake) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignerWithStake) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProtocolMessage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7359,7 +9635,7 @@ func (x *fastReflection_SignerWithStake) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignerWithStake) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProtocolMessage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7371,7 +9647,7 @@ func (x *fastReflection_SignerWithStake) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignerWithStake) IsValid() bool {
+func (x *fastReflection_ProtocolMessage) IsValid() bool {
 	return x != nil
 }
 
@@ -7381,9 +9657,9 @@ func (x *fastReflection_SignerWithStake) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignerWithStake)
+		x := input.Message.Interface().(*ProtocolMessage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7395,12 +9671,11 @@ func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.PartyId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Stake != 0 {
-			n += 1 + runtime.Sov(uint64(x.Stake))
+		if len(x.MessageParts) > 0 {
+			for _, e := range x.MessageParts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7412,7 +9687,7 @@ func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignerWithStake)
+		x := input.Message.Interface().(*ProtocolMessage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7431,17 +9706,21 @@ func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Stake != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Stake))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.PartyId) > 0 {
-			i -= len(x.PartyId)
-			copy(dAtA[i:], x.PartyId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PartyId)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.MessageParts) > 0 {
+			for iNdEx := len(x.MessageParts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MessageParts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7454,7 +9733,7 @@ func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignerWithStake)
+		x := input.Message.Interface().(*ProtocolMessage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7486,17 +9765,17 @@ func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerWithStake: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolMessage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerWithStake: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MessageParts", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7506,43 +9785,26 @@ func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PartyId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
-				}
-				x.Stake = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Stake |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.MessageParts = append(x.MessageParts, &MessagePart{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MessageParts[len(x.MessageParts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7578,78 +9840,29 @@ func (x *fastReflection_SignerWithStake) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ProtocolMessage_1_list)(nil)
-
-type _ProtocolMessage_1_list struct {
-	list *[]*MessagePart
-}
-
-func (x *_ProtocolMessage_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ProtocolMessage_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_ProtocolMessage_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MessagePart)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ProtocolMessage_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MessagePart)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ProtocolMessage_1_list) AppendMutable() protoreflect.Value {
-	v := new(MessagePart)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ProtocolMessage_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ProtocolMessage_1_list) NewElement() protoreflect.Value {
-	v := new(MessagePart)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ProtocolMessage_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ProtocolMessage               protoreflect.MessageDescriptor
-	fd_ProtocolMessage_message_parts protoreflect.FieldDescriptor
+	md_MessagePart                             protoreflect.MessageDescriptor
+	fd_MessagePart_protocol_message_part_key   protoreflect.FieldDescriptor
+	fd_MessagePart_protocol_message_part_value protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_ProtocolMessage = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("ProtocolMessage")
-	fd_ProtocolMessage_message_parts = md_ProtocolMessage.Fields().ByName("message_parts")
+	md_MessagePart = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MessagePart")
+	fd_MessagePart_protocol_message_part_key = md_MessagePart.Fields().ByName("protocol_message_part_key")
+	fd_MessagePart_protocol_message_part_value = md_MessagePart.Fields().ByName("protocol_message_part_value")
 }
 
-var _ protoreflect.Message = (*fastReflection_ProtocolMessage)(nil)
+var _ protoreflect.Message = (*fastReflection_MessagePart)(nil)
 
-type fastReflection_ProtocolMessage ProtocolMessage
+type fastReflection_MessagePart MessagePart
 
-func (x *ProtocolMessage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ProtocolMessage)(x)
+func (x *MessagePart) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MessagePart)(x)
 }
 
-func (x *ProtocolMessage) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[10]
+func (x *MessagePart) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7660,43 +9873,43 @@ func (x *ProtocolMessage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ProtocolMessage_messageType fastReflection_ProtocolMessage_messageType
-var _ protoreflect.MessageType = fastReflection_ProtocolMessage_messageType{}
+var _fastReflection_MessagePart_messageType fastReflection_MessagePart_messageType
+var _ protoreflect.MessageType = fastReflection_MessagePart_messageType{}
 
-type fastReflection_ProtocolMessage_messageType struct{}
+type fastReflection_MessagePart_messageType struct{}
 
-func (x fastReflection_ProtocolMessage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ProtocolMessage)(nil)
+func (x fastReflection_MessagePart_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MessagePart)(nil)
 }
-func (x fastReflection_ProtocolMessage_messageType) New() protoreflect.Message {
-	return new(fastReflection_ProtocolMessage)
+func (x fastReflection_MessagePart_messageType) New() protoreflect.Message {
+	return new(fastReflection_MessagePart)
 }
-func (x fastReflection_ProtocolMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolMessage
+func (x fastReflection_MessagePart_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessagePart
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ProtocolMessage) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolMessage
+func (x *fastReflection_MessagePart) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessagePart
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ProtocolMessage) Type() protoreflect.MessageType {
-	return _fastReflection_ProtocolMessage_messageType
+func (x *fastReflection_MessagePart) Type() protoreflect.MessageType {
+	return _fastReflection_MessagePart_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ProtocolMessage) New() protoreflect.Message {
-	return new(fastReflection_ProtocolMessage)
+func (x *fastReflection_MessagePart) New() protoreflect.Message {
+	return new(fastReflection_MessagePart)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ProtocolMessage) Interface() protoreflect.ProtoMessage {
-	return (*ProtocolMessage)(x)
+func (x *fastReflection_MessagePart) Interface() protoreflect.ProtoMessage {
+	return (*MessagePart)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7704,10 +9917,16 @@ func (x *fastReflection_ProtocolMessage) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ProtocolMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MessageParts) != 0 {
-		value := protoreflect.ValueOfList(&_ProtocolMessage_1_list{list: &x.MessageParts})
-		if !f(fd_ProtocolMessage_message_parts, value) {
+func (x *fastReflection_MessagePart) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProtocolMessagePartKey != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ProtocolMessagePartKey))
+		if !f(fd_MessagePart_protocol_message_part_key, value) {
+			return
+		}
+	}
+	if x.ProtocolMessagePartValue != "" {
+		value := protoreflect.ValueOfString(x.ProtocolMessagePartValue)
+		if !f(fd_MessagePart_protocol_message_part_value, value) {
 			return
 		}
 	}
@@ -7724,15 +9943,17 @@ func (x *fastReflection_ProtocolMessage) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ProtocolMessage) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MessagePart) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
-		return len(x.MessageParts) != 0
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
+		return x.ProtocolMessagePartKey != 0
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
+		return x.ProtocolMessagePartValue != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7742,15 +9963,17 @@ func (x *fastReflection_ProtocolMessage) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolMessage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MessagePart) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
-		x.MessageParts = nil
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
+		x.ProtocolMessagePartKey = 0
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
+		x.ProtocolMessagePartValue = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7760,19 +9983,19 @@ func (x *fastReflection_ProtocolMessage) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ProtocolMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessagePart) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
-		if len(x.MessageParts) == 0 {
-			return protoreflect.ValueOfList(&_ProtocolMessage_1_list{})
-		}
-		listValue := &_ProtocolMessage_1_list{list: &x.MessageParts}
-		return protoreflect.ValueOfList(listValue)
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
+		value := x.ProtocolMessagePartKey
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
+		value := x.ProtocolMessagePartValue
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7786,17 +10009,17 @@ func (x *fastReflection_ProtocolMessage) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MessagePart) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
-		lv := value.List()
-		clv := lv.(*_ProtocolMessage_1_list)
-		x.MessageParts = *clv.list
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
+		x.ProtocolMessagePartKey = (ProtocolMessagePartKey)(value.Enum())
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
+		x.ProtocolMessagePartValue = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7810,45 +10033,44 @@ func (x *fastReflection_ProtocolMessage) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessagePart) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
-		if x.MessageParts == nil {
-			x.MessageParts = []*MessagePart{}
-		}
-		value := &_ProtocolMessage_1_list{list: &x.MessageParts}
-		return protoreflect.ValueOfList(value)
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
+		panic(fmt.Errorf("field protocol_message_part_key of message ibc.lightclients.mithril.v1.MessagePart is not mutable"))
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
+		panic(fmt.Errorf("field protocol_message_part_value of message ibc.lightclients.mithril.v1.MessagePart is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProtocolMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessagePart) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolMessage.message_parts":
-		list := []*MessagePart{}
-		return protoreflect.ValueOfList(&_ProtocolMessage_1_list{list: &list})
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
+		return protoreflect.ValueOfEnum(0)
+	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProtocolMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MessagePart) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.ProtocolMessage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MessagePart", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7856,7 +10078,7 @@ func (x *fastReflection_ProtocolMessage) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProtocolMessage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MessagePart) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7867,7 +10089,7 @@ func (x *fastReflection_ProtocolMessage) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolMessage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MessagePart) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7879,7 +10101,7 @@ func (x *fastReflection_ProtocolMessage) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProtocolMessage) IsValid() bool {
+func (x *fastReflection_MessagePart) IsValid() bool {
 	return x != nil
 }
 
@@ -7889,9 +10111,9 @@ func (x *fastReflection_ProtocolMessage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProtocolMessage)
+		x := input.Message.Interface().(*MessagePart)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7903,11 +10125,12 @@ func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.MessageParts) > 0 {
-			for _, e := range x.MessageParts {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.ProtocolMessagePartKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProtocolMessagePartKey))
+		}
+		l = len(x.ProtocolMessagePartValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7919,7 +10142,7 @@ func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolMessage)
+		x := input.Message.Interface().(*MessagePart)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7938,21 +10161,17 @@ func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MessageParts) > 0 {
-			for iNdEx := len(x.MessageParts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MessageParts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.ProtocolMessagePartValue) > 0 {
+			i -= len(x.ProtocolMessagePartValue)
+			copy(dAtA[i:], x.ProtocolMessagePartValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolMessagePartValue)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProtocolMessagePartKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolMessagePartKey))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7965,7 +10184,7 @@ func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolMessage)
+		x := input.Message.Interface().(*MessagePart)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7997,17 +10216,36 @@ func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolMessage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessagePart: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessagePart: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolMessagePartKey", wireType)
+				}
+				x.ProtocolMessagePartKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProtocolMessagePartKey |= ProtocolMessagePartKey(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MessageParts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolMessagePartValue", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8017,25 +10255,23 @@ func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MessageParts = append(x.MessageParts, &MessagePart{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MessageParts[len(x.MessageParts)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ProtocolMessagePartValue = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8073,28 +10309,30 @@ func (x *fastReflection_ProtocolMessage) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MessagePart                             protoreflect.MessageDescriptor
-	fd_MessagePart_protocol_message_part_key   protoreflect.FieldDescriptor
-	fd_MessagePart_protocol_message_part_value protoreflect.FieldDescriptor
+	md_MithrilProtocolParameters       protoreflect.MessageDescriptor
+	fd_MithrilProtocolParameters_k     protoreflect.FieldDescriptor
+	fd_MithrilProtocolParameters_m     protoreflect.FieldDescriptor
+	fd_MithrilProtocolParameters_phi_f protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_MessagePart = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MessagePart")
-	fd_MessagePart_protocol_message_part_key = md_MessagePart.Fields().ByName("protocol_message_part_key")
-	fd_MessagePart_protocol_message_part_value = md_MessagePart.Fields().ByName("protocol_message_part_value")
+	md_MithrilProtocolParameters = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilProtocolParameters")
+	fd_MithrilProtocolParameters_k = md_MithrilProtocolParameters.Fields().ByName("k")
+	fd_MithrilProtocolParameters_m = md_MithrilProtocolParameters.Fields().ByName("m")
+	fd_MithrilProtocolParameters_phi_f = md_MithrilProtocolParameters.Fields().ByName("phi_f")
 }
 
-var _ protoreflect.Message = (*fastReflection_MessagePart)(nil)
+var _ protoreflect.Message = (*fastReflection_MithrilProtocolParameters)(nil)
 
-type fastReflection_MessagePart MessagePart
+type fastReflection_MithrilProtocolParameters MithrilProtocolParameters
 
-func (x *MessagePart) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MessagePart)(x)
+func (x *MithrilProtocolParameters) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MithrilProtocolParameters)(x)
 }
 
-func (x *MessagePart) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[11]
+func (x *MithrilProtocolParameters) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8105,43 +10343,43 @@ func (x *MessagePart) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MessagePart_messageType fastReflection_MessagePart_messageType
-var _ protoreflect.MessageType = fastReflection_MessagePart_messageType{}
+var _fastReflection_MithrilProtocolParameters_messageType fastReflection_MithrilProtocolParameters_messageType
+var _ protoreflect.MessageType = fastReflection_MithrilProtocolParameters_messageType{}
 
-type fastReflection_MessagePart_messageType struct{}
+type fastReflection_MithrilProtocolParameters_messageType struct{}
 
-func (x fastReflection_MessagePart_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MessagePart)(nil)
+func (x fastReflection_MithrilProtocolParameters_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MithrilProtocolParameters)(nil)
 }
-func (x fastReflection_MessagePart_messageType) New() protoreflect.Message {
-	return new(fastReflection_MessagePart)
+func (x fastReflection_MithrilProtocolParameters_messageType) New() protoreflect.Message {
+	return new(fastReflection_MithrilProtocolParameters)
 }
-func (x fastReflection_MessagePart_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MessagePart
+func (x fastReflection_MithrilProtocolParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilProtocolParameters
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MessagePart) Descriptor() protoreflect.MessageDescriptor {
-	return md_MessagePart
+func (x *fastReflection_MithrilProtocolParameters) Descriptor() protoreflect.MessageDescriptor {
+	return md_MithrilProtocolParameters
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MessagePart) Type() protoreflect.MessageType {
-	return _fastReflection_MessagePart_messageType
+func (x *fastReflection_MithrilProtocolParameters) Type() protoreflect.MessageType {
+	return _fastReflection_MithrilProtocolParameters_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MessagePart) New() protoreflect.Message {
-	return new(fastReflection_MessagePart)
+func (x *fastReflection_MithrilProtocolParameters) New() protoreflect.Message {
+	return new(fastReflection_MithrilProtocolParameters)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MessagePart) Interface() protoreflect.ProtoMessage {
-	return (*MessagePart)(x)
+func (x *fastReflection_MithrilProtocolParameters) Interface() protoreflect.ProtoMessage {
+	return (*MithrilProtocolParameters)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8149,16 +10387,22 @@ func (x *fastReflection_MessagePart) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MessagePart) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProtocolMessagePartKey != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ProtocolMessagePartKey))
-		if !f(fd_MessagePart_protocol_message_part_key, value) {
+func (x *fastReflection_MithrilProtocolParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.K != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.K)
+		if !f(fd_MithrilProtocolParameters_k, value) {
 			return
 		}
 	}
-	if x.ProtocolMessagePartValue != "" {
-		value := protoreflect.ValueOfString(x.ProtocolMessagePartValue)
-		if !f(fd_MessagePart_protocol_message_part_value, value) {
+	if x.M != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.M)
+		if !f(fd_MithrilProtocolParameters_m, value) {
+			return
+		}
+	}
+	if x.PhiF != nil {
+		value := protoreflect.ValueOfMessage(x.PhiF.ProtoReflect())
+		if !f(fd_MithrilProtocolParameters_phi_f, value) {
 			return
 		}
 	}
@@ -8175,17 +10419,19 @@ func (x *fastReflection_MessagePart) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MessagePart) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MithrilProtocolParameters) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
-		return x.ProtocolMessagePartKey != 0
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
-		return x.ProtocolMessagePartValue != ""
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
+		return x.K != uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
+		return x.M != uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
+		return x.PhiF != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8195,17 +10441,19 @@ func (x *fastReflection_MessagePart) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessagePart) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MithrilProtocolParameters) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
-		x.ProtocolMessagePartKey = 0
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
-		x.ProtocolMessagePartValue = ""
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
+		x.K = uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
+		x.M = uint64(0)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
+		x.PhiF = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8215,19 +10463,22 @@ func (x *fastReflection_MessagePart) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MessagePart) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilProtocolParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
-		value := x.ProtocolMessagePartKey
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
-		value := x.ProtocolMessagePartValue
-		return protoreflect.ValueOfString(value)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
+		value := x.K
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
+		value := x.M
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
+		value := x.PhiF
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8241,17 +10492,19 @@ func (x *fastReflection_MessagePart) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessagePart) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MithrilProtocolParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
-		x.ProtocolMessagePartKey = (ProtocolMessagePartKey)(value.Enum())
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
-		x.ProtocolMessagePartValue = value.Interface().(string)
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
+		x.K = value.Uint()
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
+		x.M = value.Uint()
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
+		x.PhiF = value.Message().Interface().(*Fraction)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8265,44 +10518,52 @@ func (x *fastReflection_MessagePart) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessagePart) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilProtocolParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
-		panic(fmt.Errorf("field protocol_message_part_key of message ibc.lightclients.mithril.v1.MessagePart is not mutable"))
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
-		panic(fmt.Errorf("field protocol_message_part_value of message ibc.lightclients.mithril.v1.MessagePart is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
+		if x.PhiF == nil {
+			x.PhiF = new(Fraction)
+		}
+		return protoreflect.ValueOfMessage(x.PhiF.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
+		panic(fmt.Errorf("field k of message ibc.lightclients.mithril.v1.MithrilProtocolParameters is not mutable"))
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
+		panic(fmt.Errorf("field m of message ibc.lightclients.mithril.v1.MithrilProtocolParameters is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MessagePart) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MithrilProtocolParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key":
-		return protoreflect.ValueOfEnum(0)
-	case "ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_value":
-		return protoreflect.ValueOfString("")
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
+		m := new(Fraction)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MessagePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MessagePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MessagePart) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MithrilProtocolParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MessagePart", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilProtocolParameters", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8310,7 +10571,7 @@ func (x *fastReflection_MessagePart) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MessagePart) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MithrilProtocolParameters) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8321,7 +10582,7 @@ func (x *fastReflection_MessagePart) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessagePart) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MithrilProtocolParameters) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8333,7 +10594,7 @@ func (x *fastReflection_MessagePart) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MessagePart) IsValid() bool {
+func (x *fastReflection_MithrilProtocolParameters) IsValid() bool {
 	return x != nil
 }
 
@@ -8343,9 +10604,9 @@ func (x *fastReflection_MessagePart) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MessagePart)
+		x := input.Message.Interface().(*MithrilProtocolParameters)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8357,11 +10618,14 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProtocolMessagePartKey != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProtocolMessagePartKey))
+		if x.K != 0 {
+			n += 1 + runtime.Sov(uint64(x.K))
 		}
-		l = len(x.ProtocolMessagePartValue)
-		if l > 0 {
+		if x.M != 0 {
+			n += 1 + runtime.Sov(uint64(x.M))
+		}
+		if x.PhiF != nil {
+			l = options.Size(x.PhiF)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8374,7 +10638,7 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MessagePart)
+		x := input.Message.Interface().(*MithrilProtocolParameters)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8393,15 +10657,27 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ProtocolMessagePartValue) > 0 {
-			i -= len(x.ProtocolMessagePartValue)
-			copy(dAtA[i:], x.ProtocolMessagePartValue)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolMessagePartValue)))
+		if x.PhiF != nil {
+			encoded, err := options.Marshal(x.PhiF)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
-		if x.ProtocolMessagePartKey != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolMessagePartKey))
+		if x.M != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.M))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.K != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.K))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -8416,7 +10692,7 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MessagePart)
+		x := input.Message.Interface().(*MithrilProtocolParameters)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8448,17 +10724,17 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessagePart: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilProtocolParameters: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessagePart: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilProtocolParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolMessagePartKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
 				}
-				x.ProtocolMessagePartKey = 0
+				x.K = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8468,16 +10744,35 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProtocolMessagePartKey |= ProtocolMessagePartKey(b&0x7F) << shift
+					x.K |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+				}
+				x.M = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.M |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolMessagePartValue", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PhiF", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8487,23 +10782,27 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProtocolMessagePartValue = string(dAtA[iNdEx:postIndex])
+				if x.PhiF == nil {
+					x.PhiF = &Fraction{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PhiF); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8541,30 +10840,26 @@ func (x *fastReflection_MessagePart) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MithrilProtocolParameters       protoreflect.MessageDescriptor
-	fd_MithrilProtocolParameters_k     protoreflect.FieldDescriptor
-	fd_MithrilProtocolParameters_m     protoreflect.FieldDescriptor
-	fd_MithrilProtocolParameters_phi_f protoreflect.FieldDescriptor
+	md_ProtocolGenesisSignature           protoreflect.MessageDescriptor
+	fd_ProtocolGenesisSignature_signature protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_MithrilProtocolParameters = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("MithrilProtocolParameters")
-	fd_MithrilProtocolParameters_k = md_MithrilProtocolParameters.Fields().ByName("k")
-	fd_MithrilProtocolParameters_m = md_MithrilProtocolParameters.Fields().ByName("m")
-	fd_MithrilProtocolParameters_phi_f = md_MithrilProtocolParameters.Fields().ByName("phi_f")
+	md_ProtocolGenesisSignature = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("ProtocolGenesisSignature")
+	fd_ProtocolGenesisSignature_signature = md_ProtocolGenesisSignature.Fields().ByName("signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_MithrilProtocolParameters)(nil)
+var _ protoreflect.Message = (*fastReflection_ProtocolGenesisSignature)(nil)
 
-type fastReflection_MithrilProtocolParameters MithrilProtocolParameters
+type fastReflection_ProtocolGenesisSignature ProtocolGenesisSignature
 
-func (x *MithrilProtocolParameters) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MithrilProtocolParameters)(x)
+func (x *ProtocolGenesisSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProtocolGenesisSignature)(x)
 }
 
-func (x *MithrilProtocolParameters) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[12]
+func (x *ProtocolGenesisSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8575,43 +10870,43 @@ func (x *MithrilProtocolParameters) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MithrilProtocolParameters_messageType fastReflection_MithrilProtocolParameters_messageType
-var _ protoreflect.MessageType = fastReflection_MithrilProtocolParameters_messageType{}
+var _fastReflection_ProtocolGenesisSignature_messageType fastReflection_ProtocolGenesisSignature_messageType
+var _ protoreflect.MessageType = fastReflection_ProtocolGenesisSignature_messageType{}
 
-type fastReflection_MithrilProtocolParameters_messageType struct{}
+type fastReflection_ProtocolGenesisSignature_messageType struct{}
 
-func (x fastReflection_MithrilProtocolParameters_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MithrilProtocolParameters)(nil)
+func (x fastReflection_ProtocolGenesisSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProtocolGenesisSignature)(nil)
 }
-func (x fastReflection_MithrilProtocolParameters_messageType) New() protoreflect.Message {
-	return new(fastReflection_MithrilProtocolParameters)
+func (x fastReflection_ProtocolGenesisSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProtocolGenesisSignature)
 }
-func (x fastReflection_MithrilProtocolParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilProtocolParameters
+func (x fastReflection_ProtocolGenesisSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolGenesisSignature
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MithrilProtocolParameters) Descriptor() protoreflect.MessageDescriptor {
-	return md_MithrilProtocolParameters
+func (x *fastReflection_ProtocolGenesisSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProtocolGenesisSignature
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MithrilProtocolParameters) Type() protoreflect.MessageType {
-	return _fastReflection_MithrilProtocolParameters_messageType
+func (x *fastReflection_ProtocolGenesisSignature) Type() protoreflect.MessageType {
+	return _fastReflection_ProtocolGenesisSignature_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MithrilProtocolParameters) New() protoreflect.Message {
-	return new(fastReflection_MithrilProtocolParameters)
+func (x *fastReflection_ProtocolGenesisSignature) New() protoreflect.Message {
+	return new(fastReflection_ProtocolGenesisSignature)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MithrilProtocolParameters) Interface() protoreflect.ProtoMessage {
-	return (*MithrilProtocolParameters)(x)
+func (x *fastReflection_ProtocolGenesisSignature) Interface() protoreflect.ProtoMessage {
+	return (*ProtocolGenesisSignature)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8619,22 +10914,10 @@ func (x *fastReflection_MithrilProtocolParameters) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MithrilProtocolParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.K != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.K)
-		if !f(fd_MithrilProtocolParameters_k, value) {
-			return
-		}
-	}
-	if x.M != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.M)
-		if !f(fd_MithrilProtocolParameters_m, value) {
-			return
-		}
-	}
-	if x.PhiF != nil {
-		value := protoreflect.ValueOfMessage(x.PhiF.ProtoReflect())
-		if !f(fd_MithrilProtocolParameters_phi_f, value) {
+func (x *fastReflection_ProtocolGenesisSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_ProtocolGenesisSignature_signature, value) {
 			return
 		}
 	}
@@ -8651,19 +10934,15 @@ func (x *fastReflection_MithrilProtocolParameters) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MithrilProtocolParameters) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProtocolGenesisSignature) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
-		return x.K != uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
-		return x.M != uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
-		return x.PhiF != nil
+	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
+		return len(x.Signature) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8673,19 +10952,15 @@ func (x *fastReflection_MithrilProtocolParameters) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilProtocolParameters) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProtocolGenesisSignature) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
-		x.K = uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
-		x.M = uint64(0)
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
-		x.PhiF = nil
+	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
+		x.Signature = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8695,22 +10970,16 @@ func (x *fastReflection_MithrilProtocolParameters) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MithrilProtocolParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolGenesisSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
-		value := x.K
-		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
-		value := x.M
-		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
-		value := x.PhiF
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8724,19 +10993,15 @@ func (x *fastReflection_MithrilProtocolParameters) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilProtocolParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProtocolGenesisSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
-		x.K = value.Uint()
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
-		x.M = value.Uint()
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
-		x.PhiF = value.Message().Interface().(*Fraction)
+	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
+		x.Signature = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8750,52 +11015,40 @@ func (x *fastReflection_MithrilProtocolParameters) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilProtocolParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolGenesisSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
-		if x.PhiF == nil {
-			x.PhiF = new(Fraction)
-		}
-		return protoreflect.ValueOfMessage(x.PhiF.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
-		panic(fmt.Errorf("field k of message ibc.lightclients.mithril.v1.MithrilProtocolParameters is not mutable"))
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
-		panic(fmt.Errorf("field m of message ibc.lightclients.mithril.v1.MithrilProtocolParameters is not mutable"))
+	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
+		panic(fmt.Errorf("field signature of message ibc.lightclients.mithril.v1.ProtocolGenesisSignature is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MithrilProtocolParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProtocolGenesisSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.k":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.m":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f":
-		m := new(Fraction)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.MithrilProtocolParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.MithrilProtocolParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MithrilProtocolParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProtocolGenesisSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.MithrilProtocolParameters", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.ProtocolGenesisSignature", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8803,7 +11056,7 @@ func (x *fastReflection_MithrilProtocolParameters) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MithrilProtocolParameters) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProtocolGenesisSignature) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8814,7 +11067,7 @@ func (x *fastReflection_MithrilProtocolParameters) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MithrilProtocolParameters) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProtocolGenesisSignature) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8826,7 +11079,7 @@ func (x *fastReflection_MithrilProtocolParameters) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MithrilProtocolParameters) IsValid() bool {
+func (x *fastReflection_ProtocolGenesisSignature) IsValid() bool {
 	return x != nil
 }
 
@@ -8836,9 +11089,9 @@ func (x *fastReflection_MithrilProtocolParameters) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProtocolGenesisSignature) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MithrilProtocolParameters)
+		x := input.Message.Interface().(*ProtocolGenesisSignature)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8850,14 +11103,8 @@ func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.K != 0 {
-			n += 1 + runtime.Sov(uint64(x.K))
-		}
-		if x.M != 0 {
-			n += 1 + runtime.Sov(uint64(x.M))
-		}
-		if x.PhiF != nil {
-			l = options.Size(x.PhiF)
+		l = len(x.Signature)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8870,7 +11117,7 @@ func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilProtocolParameters)
+		x := input.Message.Interface().(*ProtocolGenesisSignature)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8889,29 +11136,12 @@ func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PhiF != nil {
-			encoded, err := options.Marshal(x.PhiF)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.M != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.M))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.K != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.K))
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8924,7 +11154,7 @@ func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MithrilProtocolParameters)
+		x := input.Message.Interface().(*ProtocolGenesisSignature)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8944,67 +11174,29 @@ func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Me
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
 				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilProtocolParameters: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MithrilProtocolParameters: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
-				}
-				x.K = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.K |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
-				}
-				x.M = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.M |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 3:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolGenesisSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolGenesisSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PhiF", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9014,26 +11206,24 @@ func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.PhiF == nil {
-					x.PhiF = &Fraction{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PhiF); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -9072,26 +11262,34 @@ func (x *fastReflection_MithrilProtocolParameters) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_ProtocolGenesisSignature           protoreflect.MessageDescriptor
-	fd_ProtocolGenesisSignature_signature protoreflect.FieldDescriptor
+	md_SignedEntityType                              protoreflect.MessageDescriptor
+	fd_SignedEntityType_mithril_stake_distribution   protoreflect.FieldDescriptor
+	fd_SignedEntityType_cardano_stake_distribution   protoreflect.FieldDescriptor
+	fd_SignedEntityType_cardano_immutable_files_full protoreflect.FieldDescriptor
+	fd_SignedEntityType_cardano_transactions         protoreflect.FieldDescriptor
+	fd_SignedEntityType_cardano_blocks_transactions  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_ProtocolGenesisSignature = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("ProtocolGenesisSignature")
-	fd_ProtocolGenesisSignature_signature = md_ProtocolGenesisSignature.Fields().ByName("signature")
+	md_SignedEntityType = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("SignedEntityType")
+	fd_SignedEntityType_mithril_stake_distribution = md_SignedEntityType.Fields().ByName("mithril_stake_distribution")
+	fd_SignedEntityType_cardano_stake_distribution = md_SignedEntityType.Fields().ByName("cardano_stake_distribution")
+	fd_SignedEntityType_cardano_immutable_files_full = md_SignedEntityType.Fields().ByName("cardano_immutable_files_full")
+	fd_SignedEntityType_cardano_transactions = md_SignedEntityType.Fields().ByName("cardano_transactions")
+	fd_SignedEntityType_cardano_blocks_transactions = md_SignedEntityType.Fields().ByName("cardano_blocks_transactions")
 }
 
-var _ protoreflect.Message = (*fastReflection_ProtocolGenesisSignature)(nil)
+var _ protoreflect.Message = (*fastReflection_SignedEntityType)(nil)
 
-type fastReflection_ProtocolGenesisSignature ProtocolGenesisSignature
+type fastReflection_SignedEntityType SignedEntityType
 
-func (x *ProtocolGenesisSignature) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ProtocolGenesisSignature)(x)
+func (x *SignedEntityType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignedEntityType)(x)
 }
 
-func (x *ProtocolGenesisSignature) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[13]
+func (x *SignedEntityType) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9102,43 +11300,43 @@ func (x *ProtocolGenesisSignature) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ProtocolGenesisSignature_messageType fastReflection_ProtocolGenesisSignature_messageType
-var _ protoreflect.MessageType = fastReflection_ProtocolGenesisSignature_messageType{}
+var _fastReflection_SignedEntityType_messageType fastReflection_SignedEntityType_messageType
+var _ protoreflect.MessageType = fastReflection_SignedEntityType_messageType{}
 
-type fastReflection_ProtocolGenesisSignature_messageType struct{}
+type fastReflection_SignedEntityType_messageType struct{}
 
-func (x fastReflection_ProtocolGenesisSignature_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ProtocolGenesisSignature)(nil)
+func (x fastReflection_SignedEntityType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignedEntityType)(nil)
 }
-func (x fastReflection_ProtocolGenesisSignature_messageType) New() protoreflect.Message {
-	return new(fastReflection_ProtocolGenesisSignature)
+func (x fastReflection_SignedEntityType_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignedEntityType)
 }
-func (x fastReflection_ProtocolGenesisSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolGenesisSignature
+func (x fastReflection_SignedEntityType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignedEntityType
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ProtocolGenesisSignature) Descriptor() protoreflect.MessageDescriptor {
-	return md_ProtocolGenesisSignature
+func (x *fastReflection_SignedEntityType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignedEntityType
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ProtocolGenesisSignature) Type() protoreflect.MessageType {
-	return _fastReflection_ProtocolGenesisSignature_messageType
+func (x *fastReflection_SignedEntityType) Type() protoreflect.MessageType {
+	return _fastReflection_SignedEntityType_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ProtocolGenesisSignature) New() protoreflect.Message {
-	return new(fastReflection_ProtocolGenesisSignature)
+func (x *fastReflection_SignedEntityType) New() protoreflect.Message {
+	return new(fastReflection_SignedEntityType)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ProtocolGenesisSignature) Interface() protoreflect.ProtoMessage {
-	return (*ProtocolGenesisSignature)(x)
+func (x *fastReflection_SignedEntityType) Interface() protoreflect.ProtoMessage {
+	return (*SignedEntityType)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9146,11 +11344,39 @@ func (x *fastReflection_ProtocolGenesisSignature) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ProtocolGenesisSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Signature) != 0 {
-		value := protoreflect.ValueOfBytes(x.Signature)
-		if !f(fd_ProtocolGenesisSignature_signature, value) {
-			return
+func (x *fastReflection_SignedEntityType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Entity != nil {
+		switch o := x.Entity.(type) {
+		case *SignedEntityType_MithrilStakeDistribution:
+			v := o.MithrilStakeDistribution
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SignedEntityType_mithril_stake_distribution, value) {
+				return
+			}
+		case *SignedEntityType_CardanoStakeDistribution:
+			v := o.CardanoStakeDistribution
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SignedEntityType_cardano_stake_distribution, value) {
+				return
+			}
+		case *SignedEntityType_CardanoImmutableFilesFull:
+			v := o.CardanoImmutableFilesFull
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SignedEntityType_cardano_immutable_files_full, value) {
+				return
+			}
+		case *SignedEntityType_CardanoTransactions:
+			v := o.CardanoTransactions
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SignedEntityType_cardano_transactions, value) {
+				return
+			}
+		case *SignedEntityType_CardanoBlocksTransactions:
+			v := o.CardanoBlocksTransactions
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SignedEntityType_cardano_blocks_transactions, value) {
+				return
+			}
 		}
 	}
 }
@@ -9166,15 +11392,53 @@ func (x *fastReflection_ProtocolGenesisSignature) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ProtocolGenesisSignature) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SignedEntityType) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
-		return len(x.Signature) != 0
+	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
+		if x.Entity == nil {
+			return false
+		} else if _, ok := x.Entity.(*SignedEntityType_MithrilStakeDistribution); ok {
+			return true
+		} else {
+			return false
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
+		if x.Entity == nil {
+			return false
+		} else if _, ok := x.Entity.(*SignedEntityType_CardanoStakeDistribution); ok {
+			return true
+		} else {
+			return false
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
+		if x.Entity == nil {
+			return false
+		} else if _, ok := x.Entity.(*SignedEntityType_CardanoImmutableFilesFull); ok {
+			return true
+		} else {
+			return false
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
+		if x.Entity == nil {
+			return false
+		} else if _, ok := x.Entity.(*SignedEntityType_CardanoTransactions); ok {
+			return true
+		} else {
+			return false
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_blocks_transactions":
+		if x.Entity == nil {
+			return false
+		} else if _, ok := x.Entity.(*SignedEntityType_CardanoBlocksTransactions); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9184,15 +11448,23 @@ func (x *fastReflection_ProtocolGenesisSignature) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolGenesisSignature) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SignedEntityType) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
-		x.Signature = nil
+	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
+		x.Entity = nil
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
+		x.Entity = nil
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
+		x.Entity = nil
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
+		x.Entity = nil
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_blocks_transactions":
+		x.Entity = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9202,16 +11474,53 @@ func (x *fastReflection_ProtocolGenesisSignature) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ProtocolGenesisSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignedEntityType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
-		value := x.Signature
-		return protoreflect.ValueOfBytes(value)
+	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
+		if x.Entity == nil {
+			return protoreflect.ValueOfMessage((*MithrilStakeDistribution)(nil).ProtoReflect())
+		} else if v, ok := x.Entity.(*SignedEntityType_MithrilStakeDistribution); ok {
+			return protoreflect.ValueOfMessage(v.MithrilStakeDistribution.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MithrilStakeDistribution)(nil).ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
+		if x.Entity == nil {
+			return protoreflect.ValueOfMessage((*CardanoStakeDistribution)(nil).ProtoReflect())
+		} else if v, ok := x.Entity.(*SignedEntityType_CardanoStakeDistribution); ok {
+			return protoreflect.ValueOfMessage(v.CardanoStakeDistribution.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*CardanoStakeDistribution)(nil).ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
+		if x.Entity == nil {
+			return protoreflect.ValueOfMessage((*CardanoImmutableFilesFull)(nil).ProtoReflect())
+		} else if v, ok := x.Entity.(*SignedEntityType_CardanoImmutableFilesFull); ok {
+			return protoreflect.ValueOfMessage(v.CardanoImmutableFilesFull.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*CardanoImmutableFilesFull)(nil).ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
+		if x.Entity == nil {
+			return protoreflect.ValueOfMessage((*CardanoTransactions)(nil).ProtoReflect())
+		} else if v, ok := x.Entity.(*SignedEntityType_CardanoTransactions); ok {
+			return protoreflect.ValueOfMessage(v.CardanoTransactions.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*CardanoTransactions)(nil).ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_blocks_transactions":
+		if x.Entity == nil {
+			return protoreflect.ValueOfMessage((*CardanoBlocksTransactions)(nil).ProtoReflect())
+		} else if v, ok := x.Entity.(*SignedEntityType_CardanoBlocksTransactions); ok {
+			return protoreflect.ValueOfMessage(v.CardanoBlocksTransactions.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*CardanoBlocksTransactions)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9225,15 +11534,28 @@ func (x *fastReflection_ProtocolGenesisSignature) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolGenesisSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SignedEntityType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
-		x.Signature = value.Bytes()
+	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
+		cv := value.Message().Interface().(*MithrilStakeDistribution)
+		x.Entity = &SignedEntityType_MithrilStakeDistribution{MithrilStakeDistribution: cv}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
+		cv := value.Message().Interface().(*CardanoStakeDistribution)
+		x.Entity = &SignedEntityType_CardanoStakeDistribution{CardanoStakeDistribution: cv}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
+		cv := value.Message().Interface().(*CardanoImmutableFilesFull)
+		x.Entity = &SignedEntityType_CardanoImmutableFilesFull{CardanoImmutableFilesFull: cv}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
+		cv := value.Message().Interface().(*CardanoTransactions)
+		x.Entity = &SignedEntityType_CardanoTransactions{CardanoTransactions: cv}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_blocks_transactions":
+		cv := value.Message().Interface().(*CardanoBlocksTransactions)
+		x.Entity = &SignedEntityType_CardanoBlocksTransactions{CardanoBlocksTransactions: cv}
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9247,40 +11569,147 @@ func (x *fastReflection_ProtocolGenesisSignature) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolGenesisSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignedEntityType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
-		panic(fmt.Errorf("field signature of message ibc.lightclients.mithril.v1.ProtocolGenesisSignature is not mutable"))
+	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
+		if x.Entity == nil {
+			value := &MithrilStakeDistribution{}
+			oneofValue := &SignedEntityType_MithrilStakeDistribution{MithrilStakeDistribution: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Entity.(type) {
+		case *SignedEntityType_MithrilStakeDistribution:
+			return protoreflect.ValueOfMessage(m.MithrilStakeDistribution.ProtoReflect())
+		default:
+			value := &MithrilStakeDistribution{}
+			oneofValue := &SignedEntityType_MithrilStakeDistribution{MithrilStakeDistribution: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
+		if x.Entity == nil {
+			value := &CardanoStakeDistribution{}
+			oneofValue := &SignedEntityType_CardanoStakeDistribution{CardanoStakeDistribution: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Entity.(type) {
+		case *SignedEntityType_CardanoStakeDistribution:
+			return protoreflect.ValueOfMessage(m.CardanoStakeDistribution.ProtoReflect())
+		default:
+			value := &CardanoStakeDistribution{}
+			oneofValue := &SignedEntityType_CardanoStakeDistribution{CardanoStakeDistribution: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
+		if x.Entity == nil {
+			value := &CardanoImmutableFilesFull{}
+			oneofValue := &SignedEntityType_CardanoImmutableFilesFull{CardanoImmutableFilesFull: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Entity.(type) {
+		case *SignedEntityType_CardanoImmutableFilesFull:
+			return protoreflect.ValueOfMessage(m.CardanoImmutableFilesFull.ProtoReflect())
+		default:
+			value := &CardanoImmutableFilesFull{}
+			oneofValue := &SignedEntityType_CardanoImmutableFilesFull{CardanoImmutableFilesFull: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
+		if x.Entity == nil {
+			value := &CardanoTransactions{}
+			oneofValue := &SignedEntityType_CardanoTransactions{CardanoTransactions: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Entity.(type) {
+		case *SignedEntityType_CardanoTransactions:
+			return protoreflect.ValueOfMessage(m.CardanoTransactions.ProtoReflect())
+		default:
+			value := &CardanoTransactions{}
+			oneofValue := &SignedEntityType_CardanoTransactions{CardanoTransactions: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_blocks_transactions":
+		if x.Entity == nil {
+			value := &CardanoBlocksTransactions{}
+			oneofValue := &SignedEntityType_CardanoBlocksTransactions{CardanoBlocksTransactions: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Entity.(type) {
+		case *SignedEntityType_CardanoBlocksTransactions:
+			return protoreflect.ValueOfMessage(m.CardanoBlocksTransactions.ProtoReflect())
+		default:
+			value := &CardanoBlocksTransactions{}
+			oneofValue := &SignedEntityType_CardanoBlocksTransactions{CardanoBlocksTransactions: value}
+			x.Entity = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProtocolGenesisSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignedEntityType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.ProtocolGenesisSignature.signature":
-		return protoreflect.ValueOfBytes(nil)
+	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
+		value := &MithrilStakeDistribution{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
+		value := &CardanoStakeDistribution{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
+		value := &CardanoImmutableFilesFull{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
+		value := &CardanoTransactions{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_blocks_transactions":
+		value := &CardanoBlocksTransactions{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.ProtocolGenesisSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.ProtocolGenesisSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProtocolGenesisSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SignedEntityType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
+	case "ibc.lightclients.mithril.v1.SignedEntityType.entity":
+		if x.Entity == nil {
+			return nil
+		}
+		switch x.Entity.(type) {
+		case *SignedEntityType_MithrilStakeDistribution:
+			return x.Descriptor().Fields().ByName("mithril_stake_distribution")
+		case *SignedEntityType_CardanoStakeDistribution:
+			return x.Descriptor().Fields().ByName("cardano_stake_distribution")
+		case *SignedEntityType_CardanoImmutableFilesFull:
+			return x.Descriptor().Fields().ByName("cardano_immutable_files_full")
+		case *SignedEntityType_CardanoTransactions:
+			return x.Descriptor().Fields().ByName("cardano_transactions")
+		case *SignedEntityType_CardanoBlocksTransactions:
+			return x.Descriptor().Fields().ByName("cardano_blocks_transactions")
+		}
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.ProtocolGenesisSignature", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.SignedEntityType", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9288,7 +11717,7 @@ func (x *fastReflection_ProtocolGenesisSignature) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProtocolGenesisSignature) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SignedEntityType) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9299,7 +11728,7 @@ func (x *fastReflection_ProtocolGenesisSignature) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProtocolGenesisSignature) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SignedEntityType) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9311,7 +11740,7 @@ func (x *fastReflection_ProtocolGenesisSignature) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProtocolGenesisSignature) IsValid() bool {
+func (x *fastReflection_SignedEntityType) IsValid() bool {
 	return x != nil
 }
 
@@ -9321,59 +11750,147 @@ func (x *fastReflection_ProtocolGenesisSignature) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProtocolGenesisSignature) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SignedEntityType) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProtocolGenesisSignature)
+		x := input.Message.Interface().(*SignedEntityType)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Size:              0,
 			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolGenesisSignature)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Entity.(type) {
+		case *SignedEntityType_MithrilStakeDistribution:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.MithrilStakeDistribution)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SignedEntityType_CardanoStakeDistribution:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.CardanoStakeDistribution)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SignedEntityType_CardanoImmutableFilesFull:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.CardanoImmutableFilesFull)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SignedEntityType_CardanoTransactions:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.CardanoTransactions)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SignedEntityType_CardanoBlocksTransactions:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.CardanoBlocksTransactions)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignedEntityType)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Entity.(type) {
+		case *SignedEntityType_MithrilStakeDistribution:
+			encoded, err := options.Marshal(x.MithrilStakeDistribution)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *SignedEntityType_CardanoStakeDistribution:
+			encoded, err := options.Marshal(x.CardanoStakeDistribution)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		case *SignedEntityType_CardanoImmutableFilesFull:
+			encoded, err := options.Marshal(x.CardanoImmutableFilesFull)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		case *SignedEntityType_CardanoTransactions:
+			encoded, err := options.Marshal(x.CardanoTransactions)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		case *SignedEntityType_CardanoBlocksTransactions:
+			encoded, err := options.Marshal(x.CardanoBlocksTransactions)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9386,7 +11903,7 @@ func (x *fastReflection_ProtocolGenesisSignature) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProtocolGenesisSignature)
+		x := input.Message.Interface().(*SignedEntityType)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9418,17 +11935,17 @@ func (x *fastReflection_ProtocolGenesisSignature) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolGenesisSignature: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignedEntityType: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProtocolGenesisSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignedEntityType: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilStakeDistribution", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9438,25 +11955,166 @@ func (x *fastReflection_ProtocolGenesisSignature) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
-				if x.Signature == nil {
-					x.Signature = []byte{}
+				v := &MithrilStakeDistribution{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Entity = &SignedEntityType_MithrilStakeDistribution{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CardanoStakeDistribution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &CardanoStakeDistribution{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Entity = &SignedEntityType_CardanoStakeDistribution{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CardanoImmutableFilesFull", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &CardanoImmutableFilesFull{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Entity = &SignedEntityType_CardanoImmutableFilesFull{v}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CardanoTransactions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &CardanoTransactions{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Entity = &SignedEntityType_CardanoTransactions{v}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CardanoBlocksTransactions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &CardanoBlocksTransactions{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				x.Entity = &SignedEntityType_CardanoBlocksTransactions{v}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9494,32 +12152,26 @@ func (x *fastReflection_ProtocolGenesisSignature) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_SignedEntityType                              protoreflect.MessageDescriptor
-	fd_SignedEntityType_mithril_stake_distribution   protoreflect.FieldDescriptor
-	fd_SignedEntityType_cardano_stake_distribution   protoreflect.FieldDescriptor
-	fd_SignedEntityType_cardano_immutable_files_full protoreflect.FieldDescriptor
-	fd_SignedEntityType_cardano_transactions         protoreflect.FieldDescriptor
+	md_CardanoStakeDistribution       protoreflect.MessageDescriptor
+	fd_CardanoStakeDistribution_epoch protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_SignedEntityType = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("SignedEntityType")
-	fd_SignedEntityType_mithril_stake_distribution = md_SignedEntityType.Fields().ByName("mithril_stake_distribution")
-	fd_SignedEntityType_cardano_stake_distribution = md_SignedEntityType.Fields().ByName("cardano_stake_distribution")
-	fd_SignedEntityType_cardano_immutable_files_full = md_SignedEntityType.Fields().ByName("cardano_immutable_files_full")
-	fd_SignedEntityType_cardano_transactions = md_SignedEntityType.Fields().ByName("cardano_transactions")
+	md_CardanoStakeDistribution = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoStakeDistribution")
+	fd_CardanoStakeDistribution_epoch = md_CardanoStakeDistribution.Fields().ByName("epoch")
 }
 
-var _ protoreflect.Message = (*fastReflection_SignedEntityType)(nil)
+var _ protoreflect.Message = (*fastReflection_CardanoStakeDistribution)(nil)
 
-type fastReflection_SignedEntityType SignedEntityType
+type fastReflection_CardanoStakeDistribution CardanoStakeDistribution
 
-func (x *SignedEntityType) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignedEntityType)(x)
+func (x *CardanoStakeDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CardanoStakeDistribution)(x)
 }
 
-func (x *SignedEntityType) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[14]
+func (x *CardanoStakeDistribution) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9530,43 +12182,43 @@ func (x *SignedEntityType) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SignedEntityType_messageType fastReflection_SignedEntityType_messageType
-var _ protoreflect.MessageType = fastReflection_SignedEntityType_messageType{}
+var _fastReflection_CardanoStakeDistribution_messageType fastReflection_CardanoStakeDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_CardanoStakeDistribution_messageType{}
 
-type fastReflection_SignedEntityType_messageType struct{}
+type fastReflection_CardanoStakeDistribution_messageType struct{}
 
-func (x fastReflection_SignedEntityType_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignedEntityType)(nil)
+func (x fastReflection_CardanoStakeDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CardanoStakeDistribution)(nil)
 }
-func (x fastReflection_SignedEntityType_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignedEntityType)
+func (x fastReflection_CardanoStakeDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_CardanoStakeDistribution)
 }
-func (x fastReflection_SignedEntityType_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignedEntityType
+func (x fastReflection_CardanoStakeDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoStakeDistribution
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_SignedEntityType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignedEntityType
+// type information for the message.
+func (x *fastReflection_CardanoStakeDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoStakeDistribution
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignedEntityType) Type() protoreflect.MessageType {
-	return _fastReflection_SignedEntityType_messageType
+func (x *fastReflection_CardanoStakeDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_CardanoStakeDistribution_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignedEntityType) New() protoreflect.Message {
-	return new(fastReflection_SignedEntityType)
+func (x *fastReflection_CardanoStakeDistribution) New() protoreflect.Message {
+	return new(fastReflection_CardanoStakeDistribution)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignedEntityType) Interface() protoreflect.ProtoMessage {
-	return (*SignedEntityType)(x)
+func (x *fastReflection_CardanoStakeDistribution) Interface() protoreflect.ProtoMessage {
+	return (*CardanoStakeDistribution)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9574,33 +12226,11 @@ func (x *fastReflection_SignedEntityType) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignedEntityType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Entity != nil {
-		switch o := x.Entity.(type) {
-		case *SignedEntityType_MithrilStakeDistribution:
-			v := o.MithrilStakeDistribution
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SignedEntityType_mithril_stake_distribution, value) {
-				return
-			}
-		case *SignedEntityType_CardanoStakeDistribution:
-			v := o.CardanoStakeDistribution
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SignedEntityType_cardano_stake_distribution, value) {
-				return
-			}
-		case *SignedEntityType_CardanoImmutableFilesFull:
-			v := o.CardanoImmutableFilesFull
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SignedEntityType_cardano_immutable_files_full, value) {
-				return
-			}
-		case *SignedEntityType_CardanoTransactions:
-			v := o.CardanoTransactions
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SignedEntityType_cardano_transactions, value) {
-				return
-			}
+func (x *fastReflection_CardanoStakeDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_CardanoStakeDistribution_epoch, value) {
+			return
 		}
 	}
 }
@@ -9616,45 +12246,15 @@ func (x *fastReflection_SignedEntityType) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignedEntityType) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CardanoStakeDistribution) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
-		if x.Entity == nil {
-			return false
-		} else if _, ok := x.Entity.(*SignedEntityType_MithrilStakeDistribution); ok {
-			return true
-		} else {
-			return false
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
-		if x.Entity == nil {
-			return false
-		} else if _, ok := x.Entity.(*SignedEntityType_CardanoStakeDistribution); ok {
-			return true
-		} else {
-			return false
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
-		if x.Entity == nil {
-			return false
-		} else if _, ok := x.Entity.(*SignedEntityType_CardanoImmutableFilesFull); ok {
-			return true
-		} else {
-			return false
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
-		if x.Entity == nil {
-			return false
-		} else if _, ok := x.Entity.(*SignedEntityType_CardanoTransactions); ok {
-			return true
-		} else {
-			return false
-		}
+	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
+		return x.Epoch != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9664,21 +12264,15 @@ func (x *fastReflection_SignedEntityType) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignedEntityType) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CardanoStakeDistribution) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
-		x.Entity = nil
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
-		x.Entity = nil
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
-		x.Entity = nil
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
-		x.Entity = nil
+	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
+		x.Epoch = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9688,45 +12282,16 @@ func (x *fastReflection_SignedEntityType) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignedEntityType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoStakeDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
-		if x.Entity == nil {
-			return protoreflect.ValueOfMessage((*MithrilStakeDistribution)(nil).ProtoReflect())
-		} else if v, ok := x.Entity.(*SignedEntityType_MithrilStakeDistribution); ok {
-			return protoreflect.ValueOfMessage(v.MithrilStakeDistribution.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*MithrilStakeDistribution)(nil).ProtoReflect())
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
-		if x.Entity == nil {
-			return protoreflect.ValueOfMessage((*CardanoStakeDistribution)(nil).ProtoReflect())
-		} else if v, ok := x.Entity.(*SignedEntityType_CardanoStakeDistribution); ok {
-			return protoreflect.ValueOfMessage(v.CardanoStakeDistribution.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*CardanoStakeDistribution)(nil).ProtoReflect())
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
-		if x.Entity == nil {
-			return protoreflect.ValueOfMessage((*CardanoImmutableFilesFull)(nil).ProtoReflect())
-		} else if v, ok := x.Entity.(*SignedEntityType_CardanoImmutableFilesFull); ok {
-			return protoreflect.ValueOfMessage(v.CardanoImmutableFilesFull.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*CardanoImmutableFilesFull)(nil).ProtoReflect())
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
-		if x.Entity == nil {
-			return protoreflect.ValueOfMessage((*CardanoTransactions)(nil).ProtoReflect())
-		} else if v, ok := x.Entity.(*SignedEntityType_CardanoTransactions); ok {
-			return protoreflect.ValueOfMessage(v.CardanoTransactions.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*CardanoTransactions)(nil).ProtoReflect())
-		}
+	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9740,25 +12305,15 @@ func (x *fastReflection_SignedEntityType) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignedEntityType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CardanoStakeDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
-		cv := value.Message().Interface().(*MithrilStakeDistribution)
-		x.Entity = &SignedEntityType_MithrilStakeDistribution{MithrilStakeDistribution: cv}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
-		cv := value.Message().Interface().(*CardanoStakeDistribution)
-		x.Entity = &SignedEntityType_CardanoStakeDistribution{CardanoStakeDistribution: cv}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
-		cv := value.Message().Interface().(*CardanoImmutableFilesFull)
-		x.Entity = &SignedEntityType_CardanoImmutableFilesFull{CardanoImmutableFilesFull: cv}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
-		cv := value.Message().Interface().(*CardanoTransactions)
-		x.Entity = &SignedEntityType_CardanoTransactions{CardanoTransactions: cv}
+	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
+		x.Epoch = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9772,126 +12327,40 @@ func (x *fastReflection_SignedEntityType) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignedEntityType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoStakeDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
-		if x.Entity == nil {
-			value := &MithrilStakeDistribution{}
-			oneofValue := &SignedEntityType_MithrilStakeDistribution{MithrilStakeDistribution: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Entity.(type) {
-		case *SignedEntityType_MithrilStakeDistribution:
-			return protoreflect.ValueOfMessage(m.MithrilStakeDistribution.ProtoReflect())
-		default:
-			value := &MithrilStakeDistribution{}
-			oneofValue := &SignedEntityType_MithrilStakeDistribution{MithrilStakeDistribution: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
-		if x.Entity == nil {
-			value := &CardanoStakeDistribution{}
-			oneofValue := &SignedEntityType_CardanoStakeDistribution{CardanoStakeDistribution: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Entity.(type) {
-		case *SignedEntityType_CardanoStakeDistribution:
-			return protoreflect.ValueOfMessage(m.CardanoStakeDistribution.ProtoReflect())
-		default:
-			value := &CardanoStakeDistribution{}
-			oneofValue := &SignedEntityType_CardanoStakeDistribution{CardanoStakeDistribution: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
-		if x.Entity == nil {
-			value := &CardanoImmutableFilesFull{}
-			oneofValue := &SignedEntityType_CardanoImmutableFilesFull{CardanoImmutableFilesFull: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Entity.(type) {
-		case *SignedEntityType_CardanoImmutableFilesFull:
-			return protoreflect.ValueOfMessage(m.CardanoImmutableFilesFull.ProtoReflect())
-		default:
-			value := &CardanoImmutableFilesFull{}
-			oneofValue := &SignedEntityType_CardanoImmutableFilesFull{CardanoImmutableFilesFull: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
-		if x.Entity == nil {
-			value := &CardanoTransactions{}
-			oneofValue := &SignedEntityType_CardanoTransactions{CardanoTransactions: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Entity.(type) {
-		case *SignedEntityType_CardanoTransactions:
-			return protoreflect.ValueOfMessage(m.CardanoTransactions.ProtoReflect())
-		default:
-			value := &CardanoTransactions{}
-			oneofValue := &SignedEntityType_CardanoTransactions{CardanoTransactions: value}
-			x.Entity = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
+	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
+		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.CardanoStakeDistribution is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignedEntityType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoStakeDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution":
-		value := &MithrilStakeDistribution{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution":
-		value := &CardanoStakeDistribution{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full":
-		value := &CardanoImmutableFilesFull{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions":
-		value := &CardanoTransactions{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.SignedEntityType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.SignedEntityType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignedEntityType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CardanoStakeDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
-	case "ibc.lightclients.mithril.v1.SignedEntityType.entity":
-		if x.Entity == nil {
-			return nil
-		}
-		switch x.Entity.(type) {
-		case *SignedEntityType_MithrilStakeDistribution:
-			return x.Descriptor().Fields().ByName("mithril_stake_distribution")
-		case *SignedEntityType_CardanoStakeDistribution:
-			return x.Descriptor().Fields().ByName("cardano_stake_distribution")
-		case *SignedEntityType_CardanoImmutableFilesFull:
-			return x.Descriptor().Fields().ByName("cardano_immutable_files_full")
-		case *SignedEntityType_CardanoTransactions:
-			return x.Descriptor().Fields().ByName("cardano_transactions")
-		}
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.SignedEntityType", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoStakeDistribution", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9899,7 +12368,7 @@ func (x *fastReflection_SignedEntityType) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignedEntityType) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CardanoStakeDistribution) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9910,7 +12379,7 @@ func (x *fastReflection_SignedEntityType) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignedEntityType) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CardanoStakeDistribution) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9922,7 +12391,7 @@ func (x *fastReflection_SignedEntityType) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignedEntityType) IsValid() bool {
+func (x *fastReflection_CardanoStakeDistribution) IsValid() bool {
 	return x != nil
 }
 
@@ -9932,9 +12401,9 @@ func (x *fastReflection_SignedEntityType) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignedEntityType) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignedEntityType)
+		x := input.Message.Interface().(*CardanoStakeDistribution)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9946,31 +12415,8 @@ func (x *fastReflection_SignedEntityType) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		switch x := x.Entity.(type) {
-		case *SignedEntityType_MithrilStakeDistribution:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.MithrilStakeDistribution)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SignedEntityType_CardanoStakeDistribution:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.CardanoStakeDistribution)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SignedEntityType_CardanoImmutableFilesFull:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.CardanoImmutableFilesFull)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SignedEntityType_CardanoTransactions:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.CardanoTransactions)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9982,7 +12428,7 @@ func (x *fastReflection_SignedEntityType) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignedEntityType)
+		x := input.Message.Interface().(*CardanoStakeDistribution)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10001,219 +12447,65 @@ func (x *fastReflection_SignedEntityType) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		switch x := x.Entity.(type) {
-		case *SignedEntityType_MithrilStakeDistribution:
-			encoded, err := options.Marshal(x.MithrilStakeDistribution)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		case *SignedEntityType_CardanoStakeDistribution:
-			encoded, err := options.Marshal(x.CardanoStakeDistribution)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		case *SignedEntityType_CardanoImmutableFilesFull:
-			encoded, err := options.Marshal(x.CardanoImmutableFilesFull)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		case *SignedEntityType_CardanoTransactions:
-			encoded, err := options.Marshal(x.CardanoTransactions)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
 			i--
-			dAtA[i] = 0x22
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignedEntityType)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignedEntityType: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignedEntityType: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MithrilStakeDistribution", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &MithrilStakeDistribution{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Entity = &SignedEntityType_MithrilStakeDistribution{v}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CardanoStakeDistribution", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &CardanoStakeDistribution{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Entity = &SignedEntityType_CardanoStakeDistribution{v}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CardanoImmutableFilesFull", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CardanoStakeDistribution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &CardanoImmutableFilesFull{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Entity = &SignedEntityType_CardanoImmutableFilesFull{v}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CardanoTransactions", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoStakeDistribution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoStakeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 				}
-				var msglen int
+				x.Epoch = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10223,27 +12515,11 @@ func (x *fastReflection_SignedEntityType) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Epoch |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &CardanoTransactions{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Entity = &SignedEntityType_CardanoTransactions{v}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10280,26 +12556,26 @@ func (x *fastReflection_SignedEntityType) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CardanoStakeDistribution       protoreflect.MessageDescriptor
-	fd_CardanoStakeDistribution_epoch protoreflect.FieldDescriptor
+	md_CardanoImmutableFilesFull        protoreflect.MessageDescriptor
+	fd_CardanoImmutableFilesFull_beacon protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_CardanoStakeDistribution = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoStakeDistribution")
-	fd_CardanoStakeDistribution_epoch = md_CardanoStakeDistribution.Fields().ByName("epoch")
+	md_CardanoImmutableFilesFull = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoImmutableFilesFull")
+	fd_CardanoImmutableFilesFull_beacon = md_CardanoImmutableFilesFull.Fields().ByName("beacon")
 }
 
-var _ protoreflect.Message = (*fastReflection_CardanoStakeDistribution)(nil)
+var _ protoreflect.Message = (*fastReflection_CardanoImmutableFilesFull)(nil)
 
-type fastReflection_CardanoStakeDistribution CardanoStakeDistribution
+type fastReflection_CardanoImmutableFilesFull CardanoImmutableFilesFull
 
-func (x *CardanoStakeDistribution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CardanoStakeDistribution)(x)
+func (x *CardanoImmutableFilesFull) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CardanoImmutableFilesFull)(x)
 }
 
-func (x *CardanoStakeDistribution) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[15]
+func (x *CardanoImmutableFilesFull) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10310,43 +12586,43 @@ func (x *CardanoStakeDistribution) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CardanoStakeDistribution_messageType fastReflection_CardanoStakeDistribution_messageType
-var _ protoreflect.MessageType = fastReflection_CardanoStakeDistribution_messageType{}
+var _fastReflection_CardanoImmutableFilesFull_messageType fastReflection_CardanoImmutableFilesFull_messageType
+var _ protoreflect.MessageType = fastReflection_CardanoImmutableFilesFull_messageType{}
 
-type fastReflection_CardanoStakeDistribution_messageType struct{}
+type fastReflection_CardanoImmutableFilesFull_messageType struct{}
 
-func (x fastReflection_CardanoStakeDistribution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CardanoStakeDistribution)(nil)
+func (x fastReflection_CardanoImmutableFilesFull_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CardanoImmutableFilesFull)(nil)
 }
-func (x fastReflection_CardanoStakeDistribution_messageType) New() protoreflect.Message {
-	return new(fastReflection_CardanoStakeDistribution)
+func (x fastReflection_CardanoImmutableFilesFull_messageType) New() protoreflect.Message {
+	return new(fastReflection_CardanoImmutableFilesFull)
 }
-func (x fastReflection_CardanoStakeDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoStakeDistribution
+func (x fastReflection_CardanoImmutableFilesFull_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoImmutableFilesFull
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CardanoStakeDistribution) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoStakeDistribution
+func (x *fastReflection_CardanoImmutableFilesFull) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoImmutableFilesFull
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CardanoStakeDistribution) Type() protoreflect.MessageType {
-	return _fastReflection_CardanoStakeDistribution_messageType
+func (x *fastReflection_CardanoImmutableFilesFull) Type() protoreflect.MessageType {
+	return _fastReflection_CardanoImmutableFilesFull_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CardanoStakeDistribution) New() protoreflect.Message {
-	return new(fastReflection_CardanoStakeDistribution)
+func (x *fastReflection_CardanoImmutableFilesFull) New() protoreflect.Message {
+	return new(fastReflection_CardanoImmutableFilesFull)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CardanoStakeDistribution) Interface() protoreflect.ProtoMessage {
-	return (*CardanoStakeDistribution)(x)
+func (x *fastReflection_CardanoImmutableFilesFull) Interface() protoreflect.ProtoMessage {
+	return (*CardanoImmutableFilesFull)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10354,10 +12630,10 @@ func (x *fastReflection_CardanoStakeDistribution) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CardanoStakeDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Epoch != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Epoch)
-		if !f(fd_CardanoStakeDistribution_epoch, value) {
+func (x *fastReflection_CardanoImmutableFilesFull) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Beacon != nil {
+		value := protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
+		if !f(fd_CardanoImmutableFilesFull_beacon, value) {
 			return
 		}
 	}
@@ -10374,15 +12650,15 @@ func (x *fastReflection_CardanoStakeDistribution) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CardanoStakeDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CardanoImmutableFilesFull) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
-		return x.Epoch != uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
+		return x.Beacon != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10392,15 +12668,15 @@ func (x *fastReflection_CardanoStakeDistribution) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoStakeDistribution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CardanoImmutableFilesFull) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
-		x.Epoch = uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
+		x.Beacon = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10410,16 +12686,16 @@ func (x *fastReflection_CardanoStakeDistribution) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CardanoStakeDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoImmutableFilesFull) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
-		value := x.Epoch
-		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
+		value := x.Beacon
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10433,15 +12709,15 @@ func (x *fastReflection_CardanoStakeDistribution) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoStakeDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CardanoImmutableFilesFull) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
-		x.Epoch = value.Uint()
+	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
+		x.Beacon = value.Message().Interface().(*CardanoDbBeacon)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10455,40 +12731,44 @@ func (x *fastReflection_CardanoStakeDistribution) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoStakeDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoImmutableFilesFull) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
-		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.CardanoStakeDistribution is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
+		if x.Beacon == nil {
+			x.Beacon = new(CardanoDbBeacon)
+		}
+		return protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CardanoStakeDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoImmutableFilesFull) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoStakeDistribution.epoch":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
+		m := new(CardanoDbBeacon)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoStakeDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoStakeDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CardanoStakeDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CardanoImmutableFilesFull) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoStakeDistribution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoImmutableFilesFull", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10496,7 +12776,7 @@ func (x *fastReflection_CardanoStakeDistribution) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CardanoStakeDistribution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CardanoImmutableFilesFull) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10507,7 +12787,7 @@ func (x *fastReflection_CardanoStakeDistribution) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoStakeDistribution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CardanoImmutableFilesFull) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10519,7 +12799,7 @@ func (x *fastReflection_CardanoStakeDistribution) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CardanoStakeDistribution) IsValid() bool {
+func (x *fastReflection_CardanoImmutableFilesFull) IsValid() bool {
 	return x != nil
 }
 
@@ -10529,9 +12809,9 @@ func (x *fastReflection_CardanoStakeDistribution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CardanoStakeDistribution)
+		x := input.Message.Interface().(*CardanoImmutableFilesFull)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10543,8 +12823,9 @@ func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.Epoch != 0 {
-			n += 1 + runtime.Sov(uint64(x.Epoch))
+		if x.Beacon != nil {
+			l = options.Size(x.Beacon)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10556,7 +12837,7 @@ func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoStakeDistribution)
+		x := input.Message.Interface().(*CardanoImmutableFilesFull)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10575,10 +12856,19 @@ func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Epoch != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+		if x.Beacon != nil {
+			encoded, err := options.Marshal(x.Beacon)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10591,7 +12881,7 @@ func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoStakeDistribution)
+		x := input.Message.Interface().(*CardanoImmutableFilesFull)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10623,17 +12913,17 @@ func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoStakeDistribution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoImmutableFilesFull: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoStakeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoImmutableFilesFull: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
 				}
-				x.Epoch = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10643,11 +12933,28 @@ func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Epoch |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Beacon == nil {
+					x.Beacon = &CardanoDbBeacon{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Beacon); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10684,26 +12991,28 @@ func (x *fastReflection_CardanoStakeDistribution) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_CardanoImmutableFilesFull        protoreflect.MessageDescriptor
-	fd_CardanoImmutableFilesFull_beacon protoreflect.FieldDescriptor
+	md_CardanoTransactions              protoreflect.MessageDescriptor
+	fd_CardanoTransactions_epoch        protoreflect.FieldDescriptor
+	fd_CardanoTransactions_block_number protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_CardanoImmutableFilesFull = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoImmutableFilesFull")
-	fd_CardanoImmutableFilesFull_beacon = md_CardanoImmutableFilesFull.Fields().ByName("beacon")
+	md_CardanoTransactions = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoTransactions")
+	fd_CardanoTransactions_epoch = md_CardanoTransactions.Fields().ByName("epoch")
+	fd_CardanoTransactions_block_number = md_CardanoTransactions.Fields().ByName("block_number")
 }
 
-var _ protoreflect.Message = (*fastReflection_CardanoImmutableFilesFull)(nil)
+var _ protoreflect.Message = (*fastReflection_CardanoTransactions)(nil)
 
-type fastReflection_CardanoImmutableFilesFull CardanoImmutableFilesFull
+type fastReflection_CardanoTransactions CardanoTransactions
 
-func (x *CardanoImmutableFilesFull) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CardanoImmutableFilesFull)(x)
+func (x *CardanoTransactions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CardanoTransactions)(x)
 }
 
-func (x *CardanoImmutableFilesFull) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[16]
+func (x *CardanoTransactions) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10714,43 +13023,43 @@ func (x *CardanoImmutableFilesFull) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CardanoImmutableFilesFull_messageType fastReflection_CardanoImmutableFilesFull_messageType
-var _ protoreflect.MessageType = fastReflection_CardanoImmutableFilesFull_messageType{}
+var _fastReflection_CardanoTransactions_messageType fastReflection_CardanoTransactions_messageType
+var _ protoreflect.MessageType = fastReflection_CardanoTransactions_messageType{}
 
-type fastReflection_CardanoImmutableFilesFull_messageType struct{}
+type fastReflection_CardanoTransactions_messageType struct{}
 
-func (x fastReflection_CardanoImmutableFilesFull_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CardanoImmutableFilesFull)(nil)
+func (x fastReflection_CardanoTransactions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CardanoTransactions)(nil)
 }
-func (x fastReflection_CardanoImmutableFilesFull_messageType) New() protoreflect.Message {
-	return new(fastReflection_CardanoImmutableFilesFull)
+func (x fastReflection_CardanoTransactions_messageType) New() protoreflect.Message {
+	return new(fastReflection_CardanoTransactions)
 }
-func (x fastReflection_CardanoImmutableFilesFull_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoImmutableFilesFull
+func (x fastReflection_CardanoTransactions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoTransactions
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CardanoImmutableFilesFull) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoImmutableFilesFull
+func (x *fastReflection_CardanoTransactions) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoTransactions
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CardanoImmutableFilesFull) Type() protoreflect.MessageType {
-	return _fastReflection_CardanoImmutableFilesFull_messageType
+func (x *fastReflection_CardanoTransactions) Type() protoreflect.MessageType {
+	return _fastReflection_CardanoTransactions_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CardanoImmutableFilesFull) New() protoreflect.Message {
-	return new(fastReflection_CardanoImmutableFilesFull)
+func (x *fastReflection_CardanoTransactions) New() protoreflect.Message {
+	return new(fastReflection_CardanoTransactions)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CardanoImmutableFilesFull) Interface() protoreflect.ProtoMessage {
-	return (*CardanoImmutableFilesFull)(x)
+func (x *fastReflection_CardanoTransactions) Interface() protoreflect.ProtoMessage {
+	return (*CardanoTransactions)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10758,10 +13067,16 @@ func (x *fastReflection_CardanoImmutableFilesFull) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CardanoImmutableFilesFull) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Beacon != nil {
-		value := protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
-		if !f(fd_CardanoImmutableFilesFull_beacon, value) {
+func (x *fastReflection_CardanoTransactions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_CardanoTransactions_epoch, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_CardanoTransactions_block_number, value) {
 			return
 		}
 	}
@@ -10778,15 +13093,17 @@ func (x *fastReflection_CardanoImmutableFilesFull) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CardanoImmutableFilesFull) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CardanoTransactions) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
-		return x.Beacon != nil
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+		return x.Epoch != uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+		return x.BlockNumber != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10796,15 +13113,17 @@ func (x *fastReflection_CardanoImmutableFilesFull) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoImmutableFilesFull) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CardanoTransactions) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
-		x.Beacon = nil
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+		x.Epoch = uint64(0)
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+		x.BlockNumber = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10814,16 +13133,19 @@ func (x *fastReflection_CardanoImmutableFilesFull) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CardanoImmutableFilesFull) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoTransactions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
-		value := x.Beacon
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10837,15 +13159,17 @@ func (x *fastReflection_CardanoImmutableFilesFull) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoImmutableFilesFull) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CardanoTransactions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
-		x.Beacon = value.Message().Interface().(*CardanoDbBeacon)
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+		x.Epoch = value.Uint()
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+		x.BlockNumber = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10859,44 +13183,44 @@ func (x *fastReflection_CardanoImmutableFilesFull) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoImmutableFilesFull) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoTransactions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
-		if x.Beacon == nil {
-			x.Beacon = new(CardanoDbBeacon)
-		}
-		return protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.CardanoTransactions is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+		panic(fmt.Errorf("field block_number of message ibc.lightclients.mithril.v1.CardanoTransactions is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CardanoImmutableFilesFull) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoTransactions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon":
-		m := new(CardanoDbBeacon)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoImmutableFilesFull does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CardanoImmutableFilesFull) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CardanoTransactions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoImmutableFilesFull", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoTransactions", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10904,7 +13228,7 @@ func (x *fastReflection_CardanoImmutableFilesFull) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CardanoImmutableFilesFull) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CardanoTransactions) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10915,7 +13239,7 @@ func (x *fastReflection_CardanoImmutableFilesFull) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoImmutableFilesFull) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CardanoTransactions) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10927,7 +13251,7 @@ func (x *fastReflection_CardanoImmutableFilesFull) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CardanoImmutableFilesFull) IsValid() bool {
+func (x *fastReflection_CardanoTransactions) IsValid() bool {
 	return x != nil
 }
 
@@ -10937,9 +13261,9 @@ func (x *fastReflection_CardanoImmutableFilesFull) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CardanoTransactions) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CardanoImmutableFilesFull)
+		x := input.Message.Interface().(*CardanoTransactions)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10951,9 +13275,11 @@ func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.Beacon != nil {
-			l = options.Size(x.Beacon)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10965,7 +13291,7 @@ func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoImmutableFilesFull)
+		x := input.Message.Interface().(*CardanoTransactions)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10984,19 +13310,15 @@ func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Beacon != nil {
-			encoded, err := options.Marshal(x.Beacon)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x10
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11009,7 +13331,7 @@ func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoImmutableFilesFull)
+		x := input.Message.Interface().(*CardanoTransactions)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11041,17 +13363,17 @@ func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoImmutableFilesFull: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactions: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoImmutableFilesFull: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactions: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 				}
-				var msglen int
+				x.Epoch = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11061,28 +13383,30 @@ func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Epoch |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Beacon == nil {
-					x.Beacon = &CardanoDbBeacon{}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Beacon); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11119,28 +13443,28 @@ func (x *fastReflection_CardanoImmutableFilesFull) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_CardanoTransactions              protoreflect.MessageDescriptor
-	fd_CardanoTransactions_epoch        protoreflect.FieldDescriptor
-	fd_CardanoTransactions_block_number protoreflect.FieldDescriptor
+	md_CardanoBlocksTransactions              protoreflect.MessageDescriptor
+	fd_CardanoBlocksTransactions_epoch        protoreflect.FieldDescriptor
+	fd_CardanoBlocksTransactions_block_number protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ibc_lightclients_mithril_v1_mithril_proto_init()
-	md_CardanoTransactions = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoTransactions")
-	fd_CardanoTransactions_epoch = md_CardanoTransactions.Fields().ByName("epoch")
-	fd_CardanoTransactions_block_number = md_CardanoTransactions.Fields().ByName("block_number")
+	md_CardanoBlocksTransactions = File_ibc_lightclients_mithril_v1_mithril_proto.Messages().ByName("CardanoBlocksTransactions")
+	fd_CardanoBlocksTransactions_epoch = md_CardanoBlocksTransactions.Fields().ByName("epoch")
+	fd_CardanoBlocksTransactions_block_number = md_CardanoBlocksTransactions.Fields().ByName("block_number")
 }
 
-var _ protoreflect.Message = (*fastReflection_CardanoTransactions)(nil)
+var _ protoreflect.Message = (*fastReflection_CardanoBlocksTransactions)(nil)
 
-type fastReflection_CardanoTransactions CardanoTransactions
+type fastReflection_CardanoBlocksTransactions CardanoBlocksTransactions
 
-func (x *CardanoTransactions) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CardanoTransactions)(x)
+func (x *CardanoBlocksTransactions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CardanoBlocksTransactions)(x)
 }
 
-func (x *CardanoTransactions) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[17]
+func (x *CardanoBlocksTransactions) slowProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11151,43 +13475,43 @@ func (x *CardanoTransactions) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CardanoTransactions_messageType fastReflection_CardanoTransactions_messageType
-var _ protoreflect.MessageType = fastReflection_CardanoTransactions_messageType{}
+var _fastReflection_CardanoBlocksTransactions_messageType fastReflection_CardanoBlocksTransactions_messageType
+var _ protoreflect.MessageType = fastReflection_CardanoBlocksTransactions_messageType{}
 
-type fastReflection_CardanoTransactions_messageType struct{}
+type fastReflection_CardanoBlocksTransactions_messageType struct{}
 
-func (x fastReflection_CardanoTransactions_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CardanoTransactions)(nil)
+func (x fastReflection_CardanoBlocksTransactions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CardanoBlocksTransactions)(nil)
 }
-func (x fastReflection_CardanoTransactions_messageType) New() protoreflect.Message {
-	return new(fastReflection_CardanoTransactions)
+func (x fastReflection_CardanoBlocksTransactions_messageType) New() protoreflect.Message {
+	return new(fastReflection_CardanoBlocksTransactions)
 }
-func (x fastReflection_CardanoTransactions_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoTransactions
+func (x fastReflection_CardanoBlocksTransactions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoBlocksTransactions
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CardanoTransactions) Descriptor() protoreflect.MessageDescriptor {
-	return md_CardanoTransactions
+func (x *fastReflection_CardanoBlocksTransactions) Descriptor() protoreflect.MessageDescriptor {
+	return md_CardanoBlocksTransactions
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CardanoTransactions) Type() protoreflect.MessageType {
-	return _fastReflection_CardanoTransactions_messageType
+func (x *fastReflection_CardanoBlocksTransactions) Type() protoreflect.MessageType {
+	return _fastReflection_CardanoBlocksTransactions_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CardanoTransactions) New() protoreflect.Message {
-	return new(fastReflection_CardanoTransactions)
+func (x *fastReflection_CardanoBlocksTransactions) New() protoreflect.Message {
+	return new(fastReflection_CardanoBlocksTransactions)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CardanoTransactions) Interface() protoreflect.ProtoMessage {
-	return (*CardanoTransactions)(x)
+func (x *fastReflection_CardanoBlocksTransactions) Interface() protoreflect.ProtoMessage {
+	return (*CardanoBlocksTransactions)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11195,16 +13519,16 @@ func (x *fastReflection_CardanoTransactions) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CardanoTransactions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_CardanoBlocksTransactions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Epoch != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Epoch)
-		if !f(fd_CardanoTransactions_epoch, value) {
+		if !f(fd_CardanoBlocksTransactions_epoch, value) {
 			return
 		}
 	}
 	if x.BlockNumber != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockNumber)
-		if !f(fd_CardanoTransactions_block_number, value) {
+		if !f(fd_CardanoBlocksTransactions_block_number, value) {
 			return
 		}
 	}
@@ -11221,17 +13545,17 @@ func (x *fastReflection_CardanoTransactions) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CardanoTransactions) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CardanoBlocksTransactions) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.epoch":
 		return x.Epoch != uint64(0)
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.block_number":
 		return x.BlockNumber != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoBlocksTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoBlocksTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11241,17 +13565,17 @@ func (x *fastReflection_CardanoTransactions) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactions) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CardanoBlocksTransactions) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.epoch":
 		x.Epoch = uint64(0)
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.block_number":
 		x.BlockNumber = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoBlocksTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoBlocksTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11261,19 +13585,19 @@ func (x *fastReflection_CardanoTransactions) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CardanoTransactions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoBlocksTransactions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.epoch":
 		value := x.Epoch
 		return protoreflect.ValueOfUint64(value)
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.block_number":
 		value := x.BlockNumber
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoBlocksTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoBlocksTransactions does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11287,17 +13611,17 @@ func (x *fastReflection_CardanoTransactions) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CardanoBlocksTransactions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.epoch":
 		x.Epoch = value.Uint()
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.block_number":
 		x.BlockNumber = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoBlocksTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoBlocksTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11311,44 +13635,44 @@ func (x *fastReflection_CardanoTransactions) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoBlocksTransactions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
-		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.CardanoTransactions is not mutable"))
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
-		panic(fmt.Errorf("field block_number of message ibc.lightclients.mithril.v1.CardanoTransactions is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.epoch":
+		panic(fmt.Errorf("field epoch of message ibc.lightclients.mithril.v1.CardanoBlocksTransactions is not mutable"))
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.block_number":
+		panic(fmt.Errorf("field block_number of message ibc.lightclients.mithril.v1.CardanoBlocksTransactions is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoBlocksTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoBlocksTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CardanoTransactions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CardanoBlocksTransactions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.epoch":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.epoch":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "ibc.lightclients.mithril.v1.CardanoTransactions.block_number":
+	case "ibc.lightclients.mithril.v1.CardanoBlocksTransactions.block_number":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoTransactions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ibc.lightclients.mithril.v1.CardanoBlocksTransactions"))
 		}
-		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoTransactions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ibc.lightclients.mithril.v1.CardanoBlocksTransactions does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CardanoTransactions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CardanoBlocksTransactions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoTransactions", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ibc.lightclients.mithril.v1.CardanoBlocksTransactions", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11356,7 +13680,7 @@ func (x *fastReflection_CardanoTransactions) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CardanoTransactions) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CardanoBlocksTransactions) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11367,7 +13691,7 @@ func (x *fastReflection_CardanoTransactions) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CardanoTransactions) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CardanoBlocksTransactions) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11379,7 +13703,7 @@ func (x *fastReflection_CardanoTransactions) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CardanoTransactions) IsValid() bool {
+func (x *fastReflection_CardanoBlocksTransactions) IsValid() bool {
 	return x != nil
 }
 
@@ -11389,9 +13713,9 @@ func (x *fastReflection_CardanoTransactions) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CardanoTransactions) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CardanoBlocksTransactions) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CardanoTransactions)
+		x := input.Message.Interface().(*CardanoBlocksTransactions)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11419,7 +13743,7 @@ func (x *fastReflection_CardanoTransactions) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoTransactions)
+		x := input.Message.Interface().(*CardanoBlocksTransactions)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11459,7 +13783,7 @@ func (x *fastReflection_CardanoTransactions) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CardanoTransactions)
+		x := input.Message.Interface().(*CardanoBlocksTransactions)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11491,10 +13815,10 @@ func (x *fastReflection_CardanoTransactions) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactions: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoBlocksTransactions: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoTransactions: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CardanoBlocksTransactions: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -11594,7 +13918,7 @@ func (x *CardanoDbBeacon) ProtoReflect() protoreflect.Message {
 }
 
 func (x *CardanoDbBeacon) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[18]
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12108,7 +14432,7 @@ func (x *Fraction) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Fraction) slowProtoReflect() protoreflect.Message {
-	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[19]
+	mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12551,6 +14875,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Consensus State Key Format
+type ConsensusStateKeyFormat int32
+
+const (
+	// key "clients/<client-id>/consensusStates/<revisionHeight>"
+	ConsensusStateKeyFormat_CONSENSUS_STATE_KEY_FORMAT_LEGACY ConsensusStateKeyFormat = 0
+	// key "clients/<client-id>/consensusStates/<revisionNumber>-<revisionHeight>"
+	ConsensusStateKeyFormat_CONSENSUS_STATE_KEY_FORMAT_CANONICAL ConsensusStateKeyFormat = 1
+)
+
+// Enum value maps for ConsensusStateKeyFormat.
+var (
+	ConsensusStateKeyFormat_name = map[int32]string{
+		0: "CONSENSUS_STATE_KEY_FORMAT_LEGACY",
+		1: "CONSENSUS_STATE_KEY_FORMAT_CANONICAL",
+	}
+	ConsensusStateKeyFormat_value = map[string]int32{
+		"CONSENSUS_STATE_KEY_FORMAT_LEGACY":    0,
+		"CONSENSUS_STATE_KEY_FORMAT_CANONICAL": 1,
+	}
+)
+
+func (x ConsensusStateKeyFormat) Enum() *ConsensusStateKeyFormat {
+	p := new(ConsensusStateKeyFormat)
+	*p = x
+	return p
+}
+
+func (x ConsensusStateKeyFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsensusStateKeyFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes[0].Descriptor()
+}
+
+func (ConsensusStateKeyFormat) Type() protoreflect.EnumType {
+	return &file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes[0]
+}
+
+func (x ConsensusStateKeyFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsensusStateKeyFormat.Descriptor instead.
+func (ConsensusStateKeyFormat) EnumDescriptor() ([]byte, []int) {
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{0}
+}
+
 // Protocol Message Part Key
 type ProtocolMessagePartKey int32
 
@@ -12567,25 +14940,45 @@ const (
 	ProtocolMessagePartKey_PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER ProtocolMessagePartKey = 4
 	// key "latest_block_number"
 	ProtocolMessagePartKey_PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER ProtocolMessagePartKey = 5
+	// key "next_protocol_parameters"
+	ProtocolMessagePartKey_PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS ProtocolMessagePartKey = 6
+	// key "current_epoch"
+	ProtocolMessagePartKey_PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH ProtocolMessagePartKey = 7
+	// key "cardano_stake_distribution_epoch"
+	ProtocolMessagePartKey_PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH ProtocolMessagePartKey = 8
+	// key "cardano_stake_distribution_merkle_root"
+	ProtocolMessagePartKey_PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT ProtocolMessagePartKey = 9
+	// key "cardano_blocks_transactions_merkle_root"
+	ProtocolMessagePartKey_PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT ProtocolMessagePartKey = 10
 )
 
 // Enum value maps for ProtocolMessagePartKey.
 var (
 	ProtocolMessagePartKey_name = map[int32]string{
-		0: "PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED",
-		1: "PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST",
-		2: "PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT",
-		3: "PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY",
-		4: "PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER",
-		5: "PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER",
+		0:  "PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED",
+		1:  "PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST",
+		2:  "PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT",
+		3:  "PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY",
+		4:  "PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER",
+		5:  "PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER",
+		6:  "PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS",
+		7:  "PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH",
+		8:  "PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH",
+		9:  "PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT",
+		10: "PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT",
 	}
 	ProtocolMessagePartKey_value = map[string]int32{
-		"PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED":                      0,
-		"PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST":                  1,
-		"PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT": 2,
-		"PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY":  3,
-		"PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER":     4,
-		"PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER":              5,
+		"PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED":                             0,
+		"PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST":                         1,
+		"PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT":        2,
+		"PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY":         3,
+		"PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER":            4,
+		"PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER":                     5,
+		"PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS":                6,
+		"PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH":                           7,
+		"PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH":        8,
+		"PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT":  9,
+		"PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT": 10,
 	}
 )
 
@@ -12600,11 +14993,11 @@ func (x ProtocolMessagePartKey) String() string {
 }
 
 func (ProtocolMessagePartKey) Descriptor() protoreflect.EnumDescriptor {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes[0].Descriptor()
+	return file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes[1].Descriptor()
 }
 
 func (ProtocolMessagePartKey) Type() protoreflect.EnumType {
-	return &file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes[0]
+	return &file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes[1]
 }
 
 func (x ProtocolMessagePartKey) Number() protoreflect.EnumNumber {
@@ -12613,7 +15006,7 @@ func (x ProtocolMessagePartKey) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProtocolMessagePartKey.Descriptor instead.
 func (ProtocolMessagePartKey) EnumDescriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{0}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{1}
 }
 
 // Currently, the height of the certificate corresponds to the immutable file number in Cardano node
@@ -12693,6 +15086,21 @@ type ClientState struct {
 	// `ibc_state_root` from its inline datum.
 	HostStateNftPolicyId  []byte `protobuf:"bytes,8,opt,name=host_state_nft_policy_id,json=hostStateNftPolicyId,proto3" json:"host_state_nft_policy_id,omitempty"`
 	HostStateNftTokenName []byte `protobuf:"bytes,9,opt,name=host_state_nft_token_name,json=hostStateNftTokenName,proto3" json:"host_state_nft_token_name,omitempty"`
+	// Ed25519 Mithril genesis verification key of the Cardano network. The
+	// client only trusts certificate chains that end in a genesis certificate
+	// signed by this key.
+	GenesisVerificationKey []byte `protobuf:"bytes,10,opt,name=genesis_verification_key,json=genesisVerificationKey,proto3" json:"genesis_verification_key,omitempty"`
+	// Certificates linking the initial stake distribution certificate back to
+	// the genesis certificate, in any order. Only read when the client is
+	// created; it is cleared before the client state is stored.
+	InitialCertificateChain []*MithrilCertificate `protobuf:"bytes,11,rep,name=initial_certificate_chain,json=initialCertificateChain,proto3" json:"initial_certificate_chain,omitempty"`
+	// Format of the consensus state keys committed under the Cardano
+	// `ibc_state_root`. The revision number of latest_height is the Cardano
+	// revision (e.g. a hard-fork counter) assigned to new consensus states.
+	ConsensusStateKeyFormat ConsensusStateKeyFormat `protobuf:"varint,12,opt,name=consensus_state_key_format,json=consensusStateKeyFormat,proto3,enum=ibc.lightclients.mithril.v1.ConsensusStateKeyFormat" json:"consensus_state_key_format,omitempty"`
+	// Lowest height whose consensus state records a shut-down HostState. Once
+	// set, the client is terminal and proofs at or above it are rejected.
+	HostStateShutdownHeight *Height `protobuf:"bytes,13,opt,name=host_state_shutdown_height,json=hostStateShutdownHeight,proto3" json:"host_state_shutdown_height,omitempty"`
 }
 
 func (x *ClientState) Reset() {
@@ -12764,87 +15172,285 @@ func (x *ClientState) GetUpgradePath() []string {
 	return nil
 }
 
-func (x *ClientState) GetHostStateNftPolicyId() []byte {
+func (x *ClientState) GetHostStateNftPolicyId() []byte {
+	if x != nil {
+		return x.HostStateNftPolicyId
+	}
+	return nil
+}
+
+func (x *ClientState) GetHostStateNftTokenName() []byte {
+	if x != nil {
+		return x.HostStateNftTokenName
+	}
+	return nil
+}
+
+func (x *ClientState) GetGenesisVerificationKey() []byte {
+	if x != nil {
+		return x.GenesisVerificationKey
+	}
+	return nil
+}
+
+func (x *ClientState) GetInitialCertificateChain() []*MithrilCertificate {
+	if x != nil {
+		return x.InitialCertificateChain
+	}
+	return nil
+}
+
+func (x *ClientState) GetConsensusStateKeyFormat() ConsensusStateKeyFormat {
+	if x != nil {
+		return x.ConsensusStateKeyFormat
+	}
+	return ConsensusStateKeyFormat_CONSENSUS_STATE_KEY_FORMAT_LEGACY
+}
+
+func (x *ClientState) GetHostStateShutdownHeight() *Height {
+	if x != nil {
+		return x.HostStateShutdownHeight
+	}
+	return nil
+}
+
+// MithrilConsensusState represents the consensus state in the Mithril system.
+// This message stores the latest transaction snapshot hash and the first certificate hash of the latest epoch.
+// These are used to verify the latest transaction snapshot.
+//
+// The ibc_state_root field contains the ICS-23 Merkle root extracted from the Handler UTXO datum
+// at the certified height. This root commits to all IBC state (clients, connections, channels, packets)
+// and is used for VerifyMembership/VerifyNonMembership proof verification.
+type ConsensusState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp                uint64              `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FirstCertHashLatestEpoch *MithrilCertificate `protobuf:"bytes,2,opt,name=first_cert_hash_latest_epoch,json=firstCertHashLatestEpoch,proto3" json:"first_cert_hash_latest_epoch,omitempty"`
+	LatestCertHashTxSnapshot string              `protobuf:"bytes,3,opt,name=latest_cert_hash_tx_snapshot,json=latestCertHashTxSnapshot,proto3" json:"latest_cert_hash_tx_snapshot,omitempty"`
+	// Authenticated commitment root for Cardano IBC state at this height.
+	//
+	// This is the exact 32-byte `ibc_state_root` extracted from the on-chain
+	// HostState datum whose creating transaction is proven to be included in the
+	// Mithril-certified transaction snapshot for this height.
+	IbcStateRoot []byte `protobuf:"bytes,4,opt,name=ibc_state_root,json=ibcStateRoot,proto3" json:"ibc_state_root,omitempty"`
+	// Cardano transactions merkle root of the Mithril-certified transaction
+	// snapshot for this height. Transaction proofs against it authenticate any
+	// transaction up to this height; empty for consensus states that predate it.
+	TransactionsMerkleRoot string `protobuf:"bytes,5,opt,name=transactions_merkle_root,json=transactionsMerkleRoot,proto3" json:"transactions_merkle_root,omitempty"`
+	// Cardano blocks and transactions merkle root, set instead of
+	// transactions_merkle_root when the snapshot for this height is a
+	// CardanoBlocksTransactions snapshot.
+	BlocksTransactionsMerkleRoot string `protobuf:"bytes,6,opt,name=blocks_transactions_merkle_root,json=blocksTransactionsMerkleRoot,proto3" json:"blocks_transactions_merkle_root,omitempty"`
+	// Hash of the Cardano block holding the HostState transaction, when the
+	// snapshot for this height certifies blocks.
+	HostStateBlockHash string `protobuf:"bytes,7,opt,name=host_state_block_hash,json=hostStateBlockHash,proto3" json:"host_state_block_hash,omitempty"`
+	// Set when the HostState datum the root was read from is shutting down.
+	HostStateShutdown *HostStateShutdown `protobuf:"bytes,8,opt,name=host_state_shutdown,json=hostStateShutdown,proto3" json:"host_state_shutdown,omitempty"`
+	// Version of the HostState datum the root was read from. Transaction
+	// membership proofs cannot read a HostState output older than this one.
+	HostStateVersion uint64 `protobuf:"varint,9,opt,name=host_state_version,json=hostStateVersion,proto3" json:"host_state_version,omitempty"`
+}
+
+func (x *ConsensusState) Reset() {
+	*x = ConsensusState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusState) ProtoMessage() {}
+
+// Deprecated: Use ConsensusState.ProtoReflect.Descriptor instead.
+func (*ConsensusState) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConsensusState) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ConsensusState) GetFirstCertHashLatestEpoch() *MithrilCertificate {
+	if x != nil {
+		return x.FirstCertHashLatestEpoch
+	}
+	return nil
+}
+
+func (x *ConsensusState) GetLatestCertHashTxSnapshot() string {
+	if x != nil {
+		return x.LatestCertHashTxSnapshot
+	}
+	return ""
+}
+
+func (x *ConsensusState) GetIbcStateRoot() []byte {
+	if x != nil {
+		return x.IbcStateRoot
+	}
+	return nil
+}
+
+func (x *ConsensusState) GetTransactionsMerkleRoot() string {
+	if x != nil {
+		return x.TransactionsMerkleRoot
+	}
+	return ""
+}
+
+func (x *ConsensusState) GetBlocksTransactionsMerkleRoot() string {
+	if x != nil {
+		return x.BlocksTransactionsMerkleRoot
+	}
+	return ""
+}
+
+func (x *ConsensusState) GetHostStateBlockHash() string {
+	if x != nil {
+		return x.HostStateBlockHash
+	}
+	return ""
+}
+
+func (x *ConsensusState) GetHostStateShutdown() *HostStateShutdown {
+	if x != nil {
+		return x.HostStateShutdown
+	}
+	return nil
+}
+
+func (x *ConsensusState) GetHostStateVersion() uint64 {
+	if x != nil {
+		return x.HostStateVersion
+	}
+	return 0
+}
+
+// HostStateShutdown is the ShuttingDown mode of a HostState datum, in POSIX
+// milliseconds.
+type HostStateShutdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitiatedAtMs    uint64 `protobuf:"varint,1,opt,name=initiated_at_ms,json=initiatedAtMs,proto3" json:"initiated_at_ms,omitempty"`
+	GracePeriodEndMs uint64 `protobuf:"varint,2,opt,name=grace_period_end_ms,json=gracePeriodEndMs,proto3" json:"grace_period_end_ms,omitempty"`
+}
+
+func (x *HostStateShutdown) Reset() {
+	*x = HostStateShutdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostStateShutdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostStateShutdown) ProtoMessage() {}
+
+// Deprecated: Use HostStateShutdown.ProtoReflect.Descriptor instead.
+func (*HostStateShutdown) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HostStateShutdown) GetInitiatedAtMs() uint64 {
 	if x != nil {
-		return x.HostStateNftPolicyId
+		return x.InitiatedAtMs
 	}
-	return nil
+	return 0
 }
 
-func (x *ClientState) GetHostStateNftTokenName() []byte {
+func (x *HostStateShutdown) GetGracePeriodEndMs() uint64 {
 	if x != nil {
-		return x.HostStateNftTokenName
+		return x.GracePeriodEndMs
 	}
-	return nil
+	return 0
 }
 
-// MithrilConsensusState represents the consensus state in the Mithril system.
-// This message stores the latest transaction snapshot hash and the first certificate hash of the latest epoch.
-// These are used to verify the latest transaction snapshot.
-//
-// The ibc_state_root field contains the ICS-23 Merkle root extracted from the Handler UTXO datum
-// at the certified height. This root commits to all IBC state (clients, connections, channels, packets)
-// and is used for VerifyMembership/VerifyNonMembership proof verification.
-type ConsensusState struct {
+// MithrilTransactionMembershipProof proves a packet-level IBC value from the
+// HostState output of a transaction certified by the transaction snapshot of a
+// trusted consensus state. It lets relayers prove state committed between two
+// client updates without another update.
+type MithrilTransactionMembershipProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp                uint64              `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	FirstCertHashLatestEpoch *MithrilCertificate `protobuf:"bytes,2,opt,name=first_cert_hash_latest_epoch,json=firstCertHashLatestEpoch,proto3" json:"first_cert_hash_latest_epoch,omitempty"`
-	LatestCertHashTxSnapshot string              `protobuf:"bytes,3,opt,name=latest_cert_hash_tx_snapshot,json=latestCertHashTxSnapshot,proto3" json:"latest_cert_hash_tx_snapshot,omitempty"`
-	// Authenticated commitment root for Cardano IBC state at this height.
-	//
-	// This is the exact 32-byte `ibc_state_root` extracted from the on-chain
-	// HostState datum whose creating transaction is proven to be included in the
-	// Mithril-certified transaction snapshot for this height.
-	IbcStateRoot []byte `protobuf:"bytes,4,opt,name=ibc_state_root,json=ibcStateRoot,proto3" json:"ibc_state_root,omitempty"`
+	// Mithril transaction-set proof (Gateway JSON encoding) for tx_hash.
+	TransactionProof []byte `protobuf:"bytes,1,opt,name=transaction_proof,json=transactionProof,proto3" json:"transaction_proof,omitempty"`
+	TxHash           string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxBodyCbor       []byte `protobuf:"bytes,3,opt,name=tx_body_cbor,json=txBodyCbor,proto3" json:"tx_body_cbor,omitempty"`
+	OutputIndex      uint32 `protobuf:"varint,4,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// ICS-23 proof of the value under the `ibc_state_root` of the HostState
+	// output datum.
+	IbcStateProof []byte `protobuf:"bytes,5,opt,name=ibc_state_proof,json=ibcStateProof,proto3" json:"ibc_state_proof,omitempty"`
 }
 
-func (x *ConsensusState) Reset() {
-	*x = ConsensusState{}
+func (x *MithrilTransactionMembershipProof) Reset() {
+	*x = MithrilTransactionMembershipProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[2]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConsensusState) String() string {
+func (x *MithrilTransactionMembershipProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsensusState) ProtoMessage() {}
+func (*MithrilTransactionMembershipProof) ProtoMessage() {}
 
-// Deprecated: Use ConsensusState.ProtoReflect.Descriptor instead.
-func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MithrilTransactionMembershipProof.ProtoReflect.Descriptor instead.
+func (*MithrilTransactionMembershipProof) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ConsensusState) GetTimestamp() uint64 {
+func (x *MithrilTransactionMembershipProof) GetTransactionProof() []byte {
 	if x != nil {
-		return x.Timestamp
+		return x.TransactionProof
 	}
-	return 0
+	return nil
 }
 
-func (x *ConsensusState) GetFirstCertHashLatestEpoch() *MithrilCertificate {
+func (x *MithrilTransactionMembershipProof) GetTxHash() string {
 	if x != nil {
-		return x.FirstCertHashLatestEpoch
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *MithrilTransactionMembershipProof) GetTxBodyCbor() []byte {
+	if x != nil {
+		return x.TxBodyCbor
 	}
 	return nil
 }
 
-func (x *ConsensusState) GetLatestCertHashTxSnapshot() string {
+func (x *MithrilTransactionMembershipProof) GetOutputIndex() uint32 {
 	if x != nil {
-		return x.LatestCertHashTxSnapshot
+		return x.OutputIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *ConsensusState) GetIbcStateRoot() []byte {
+func (x *MithrilTransactionMembershipProof) GetIbcStateProof() []byte {
 	if x != nil {
-		return x.IbcStateRoot
+		return x.IbcStateProof
 	}
 	return nil
 }
@@ -12866,7 +15472,7 @@ type Misbehaviour struct {
 func (x *Misbehaviour) Reset() {
 	*x = Misbehaviour{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[3]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12880,7 +15486,7 @@ func (*Misbehaviour) ProtoMessage() {}
 
 // Deprecated: Use Misbehaviour.ProtoReflect.Descriptor instead.
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{3}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{5}
 }
 
 // Deprecated: Do not use.
@@ -12936,13 +15542,15 @@ type MithrilHeader struct {
 	HostStateTxHash        string `protobuf:"bytes,5,opt,name=host_state_tx_hash,json=hostStateTxHash,proto3" json:"host_state_tx_hash,omitempty"`
 	HostStateTxBodyCbor    []byte `protobuf:"bytes,6,opt,name=host_state_tx_body_cbor,json=hostStateTxBodyCbor,proto3" json:"host_state_tx_body_cbor,omitempty"`
 	HostStateTxOutputIndex uint32 `protobuf:"varint,7,opt,name=host_state_tx_output_index,json=hostStateTxOutputIndex,proto3" json:"host_state_tx_output_index,omitempty"`
-	HostStateTxProof       []byte `protobuf:"bytes,8,opt,name=host_state_tx_proof,json=hostStateTxProof,proto3" json:"host_state_tx_proof,omitempty"`
+	// Gateway JSON proof of host_state_tx_hash. For a CardanoBlocksTransactions
+	// snapshot it also proves the block holding the transaction.
+	HostStateTxProof []byte `protobuf:"bytes,8,opt,name=host_state_tx_proof,json=hostStateTxProof,proto3" json:"host_state_tx_proof,omitempty"`
 }
 
 func (x *MithrilHeader) Reset() {
 	*x = MithrilHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[4]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12956,7 +15564,7 @@ func (*MithrilHeader) ProtoMessage() {}
 
 // Deprecated: Use MithrilHeader.ProtoReflect.Descriptor instead.
 func (*MithrilHeader) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{4}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MithrilHeader) GetMithrilStakeDistribution() *MithrilStakeDistribution {
@@ -13039,7 +15647,7 @@ type MithrilStakeDistribution struct {
 func (x *MithrilStakeDistribution) Reset() {
 	*x = MithrilStakeDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[5]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13053,7 +15661,7 @@ func (*MithrilStakeDistribution) ProtoMessage() {}
 
 // Deprecated: Use MithrilStakeDistribution.ProtoReflect.Descriptor instead.
 func (*MithrilStakeDistribution) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{5}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MithrilStakeDistribution) GetEpoch() uint64 {
@@ -13115,7 +15723,7 @@ type CardanoTransactionSnapshot struct {
 func (x *CardanoTransactionSnapshot) Reset() {
 	*x = CardanoTransactionSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[6]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13129,7 +15737,7 @@ func (*CardanoTransactionSnapshot) ProtoMessage() {}
 
 // Deprecated: Use CardanoTransactionSnapshot.ProtoReflect.Descriptor instead.
 func (*CardanoTransactionSnapshot) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{6}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CardanoTransactionSnapshot) GetMerkleRoot() string {
@@ -13195,7 +15803,7 @@ type MithrilCertificate struct {
 func (x *MithrilCertificate) Reset() {
 	*x = MithrilCertificate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[7]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13209,7 +15817,7 @@ func (*MithrilCertificate) ProtoMessage() {}
 
 // Deprecated: Use MithrilCertificate.ProtoReflect.Descriptor instead.
 func (*MithrilCertificate) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{7}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MithrilCertificate) GetHash() string {
@@ -13299,7 +15907,7 @@ type CertificateMetadata struct {
 func (x *CertificateMetadata) Reset() {
 	*x = CertificateMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[8]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13313,7 +15921,7 @@ func (*CertificateMetadata) ProtoMessage() {}
 
 // Deprecated: Use CertificateMetadata.ProtoReflect.Descriptor instead.
 func (*CertificateMetadata) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{8}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CertificateMetadata) GetNetwork() string {
@@ -13371,7 +15979,7 @@ type SignerWithStake struct {
 func (x *SignerWithStake) Reset() {
 	*x = SignerWithStake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[9]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13385,7 +15993,7 @@ func (*SignerWithStake) ProtoMessage() {}
 
 // Deprecated: Use SignerWithStake.ProtoReflect.Descriptor instead.
 func (*SignerWithStake) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{9}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SignerWithStake) GetPartyId() string {
@@ -13414,7 +16022,7 @@ type ProtocolMessage struct {
 func (x *ProtocolMessage) Reset() {
 	*x = ProtocolMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[10]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13428,7 +16036,7 @@ func (*ProtocolMessage) ProtoMessage() {}
 
 // Deprecated: Use ProtocolMessage.ProtoReflect.Descriptor instead.
 func (*ProtocolMessage) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{10}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ProtocolMessage) GetMessageParts() []*MessagePart {
@@ -13451,7 +16059,7 @@ type MessagePart struct {
 func (x *MessagePart) Reset() {
 	*x = MessagePart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[11]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13465,7 +16073,7 @@ func (*MessagePart) ProtoMessage() {}
 
 // Deprecated: Use MessagePart.ProtoReflect.Descriptor instead.
 func (*MessagePart) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{11}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MessagePart) GetProtocolMessagePartKey() ProtocolMessagePartKey {
@@ -13499,7 +16107,7 @@ type MithrilProtocolParameters struct {
 func (x *MithrilProtocolParameters) Reset() {
 	*x = MithrilProtocolParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[12]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13513,7 +16121,7 @@ func (*MithrilProtocolParameters) ProtoMessage() {}
 
 // Deprecated: Use MithrilProtocolParameters.ProtoReflect.Descriptor instead.
 func (*MithrilProtocolParameters) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{12}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MithrilProtocolParameters) GetK() uint64 {
@@ -13549,7 +16157,7 @@ type ProtocolGenesisSignature struct {
 func (x *ProtocolGenesisSignature) Reset() {
 	*x = ProtocolGenesisSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[13]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13563,7 +16171,7 @@ func (*ProtocolGenesisSignature) ProtoMessage() {}
 
 // Deprecated: Use ProtocolGenesisSignature.ProtoReflect.Descriptor instead.
 func (*ProtocolGenesisSignature) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{13}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ProtocolGenesisSignature) GetSignature() []byte {
@@ -13579,7 +16187,7 @@ type SignedEntityType struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// MithrilStakeDistribution(epoch), CardanoStakeDistribution(epoch), CardanoImmutableFilesFull(CardanoDbBeacon), CardanoTransactions(CardanoDbBeacon)
+	// MithrilStakeDistribution(epoch), CardanoStakeDistribution(epoch), CardanoImmutableFilesFull(CardanoDbBeacon), CardanoTransactions(CardanoDbBeacon), CardanoBlocksTransactions(epoch, block_number)
 	//
 	// Types that are assignable to Entity:
 	//
@@ -13587,13 +16195,14 @@ type SignedEntityType struct {
 	//	*SignedEntityType_CardanoStakeDistribution
 	//	*SignedEntityType_CardanoImmutableFilesFull
 	//	*SignedEntityType_CardanoTransactions
+	//	*SignedEntityType_CardanoBlocksTransactions
 	Entity isSignedEntityType_Entity `protobuf_oneof:"entity"`
 }
 
 func (x *SignedEntityType) Reset() {
 	*x = SignedEntityType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[14]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13607,7 +16216,7 @@ func (*SignedEntityType) ProtoMessage() {}
 
 // Deprecated: Use SignedEntityType.ProtoReflect.Descriptor instead.
 func (*SignedEntityType) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{14}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SignedEntityType) GetEntity() isSignedEntityType_Entity {
@@ -13645,6 +16254,13 @@ func (x *SignedEntityType) GetCardanoTransactions() *CardanoTransactions {
 	return nil
 }
 
+func (x *SignedEntityType) GetCardanoBlocksTransactions() *CardanoBlocksTransactions {
+	if x, ok := x.GetEntity().(*SignedEntityType_CardanoBlocksTransactions); ok {
+		return x.CardanoBlocksTransactions
+	}
+	return nil
+}
+
 type isSignedEntityType_Entity interface {
 	isSignedEntityType_Entity()
 }
@@ -13665,6 +16281,10 @@ type SignedEntityType_CardanoTransactions struct {
 	CardanoTransactions *CardanoTransactions `protobuf:"bytes,4,opt,name=cardano_transactions,json=cardanoTransactions,proto3,oneof"`
 }
 
+type SignedEntityType_CardanoBlocksTransactions struct {
+	CardanoBlocksTransactions *CardanoBlocksTransactions `protobuf:"bytes,5,opt,name=cardano_blocks_transactions,json=cardanoBlocksTransactions,proto3,oneof"`
+}
+
 func (*SignedEntityType_MithrilStakeDistribution) isSignedEntityType_Entity() {}
 
 func (*SignedEntityType_CardanoStakeDistribution) isSignedEntityType_Entity() {}
@@ -13673,6 +16293,8 @@ func (*SignedEntityType_CardanoImmutableFilesFull) isSignedEntityType_Entity() {
 
 func (*SignedEntityType_CardanoTransactions) isSignedEntityType_Entity() {}
 
+func (*SignedEntityType_CardanoBlocksTransactions) isSignedEntityType_Entity() {}
+
 // Cardano stake distribution
 type CardanoStakeDistribution struct {
 	state         protoimpl.MessageState
@@ -13685,7 +16307,7 @@ type CardanoStakeDistribution struct {
 func (x *CardanoStakeDistribution) Reset() {
 	*x = CardanoStakeDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[15]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13699,7 +16321,7 @@ func (*CardanoStakeDistribution) ProtoMessage() {}
 
 // Deprecated: Use CardanoStakeDistribution.ProtoReflect.Descriptor instead.
 func (*CardanoStakeDistribution) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{15}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CardanoStakeDistribution) GetEpoch() uint64 {
@@ -13721,7 +16343,7 @@ type CardanoImmutableFilesFull struct {
 func (x *CardanoImmutableFilesFull) Reset() {
 	*x = CardanoImmutableFilesFull{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[16]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13735,7 +16357,7 @@ func (*CardanoImmutableFilesFull) ProtoMessage() {}
 
 // Deprecated: Use CardanoImmutableFilesFull.ProtoReflect.Descriptor instead.
 func (*CardanoImmutableFilesFull) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{16}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CardanoImmutableFilesFull) GetBeacon() *CardanoDbBeacon {
@@ -13758,7 +16380,7 @@ type CardanoTransactions struct {
 func (x *CardanoTransactions) Reset() {
 	*x = CardanoTransactions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[17]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13772,7 +16394,7 @@ func (*CardanoTransactions) ProtoMessage() {}
 
 // Deprecated: Use CardanoTransactions.ProtoReflect.Descriptor instead.
 func (*CardanoTransactions) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{17}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CardanoTransactions) GetEpoch() uint64 {
@@ -13789,6 +16411,50 @@ func (x *CardanoTransactions) GetBlockNumber() uint64 {
 	return 0
 }
 
+// Cardano blocks and transactions
+type CardanoBlocksTransactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch       uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *CardanoBlocksTransactions) Reset() {
+	*x = CardanoBlocksTransactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardanoBlocksTransactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardanoBlocksTransactions) ProtoMessage() {}
+
+// Deprecated: Use CardanoBlocksTransactions.ProtoReflect.Descriptor instead.
+func (*CardanoBlocksTransactions) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CardanoBlocksTransactions) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CardanoBlocksTransactions) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
 // Cardano db beacon
 type CardanoDbBeacon struct {
 	state         protoimpl.MessageState
@@ -13803,7 +16469,7 @@ type CardanoDbBeacon struct {
 func (x *CardanoDbBeacon) Reset() {
 	*x = CardanoDbBeacon{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[18]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13817,7 +16483,7 @@ func (*CardanoDbBeacon) ProtoMessage() {}
 
 // Deprecated: Use CardanoDbBeacon.ProtoReflect.Descriptor instead.
 func (*CardanoDbBeacon) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{18}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CardanoDbBeacon) GetNetwork() string {
@@ -13855,7 +16521,7 @@ type Fraction struct {
 func (x *Fraction) Reset() {
 	*x = Fraction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[19]
+		mi := &file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13869,7 +16535,7 @@ func (*Fraction) ProtoMessage() {}
 
 // Deprecated: Use Fraction.ProtoReflect.Descriptor instead.
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{19}
+	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Fraction) GetNumerator() uint64 {
@@ -13903,7 +16569,7 @@ var file_ibc_lightclients_mithril_v1_mithril_proto_rawDesc = []byte{
 	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65,
 	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69,
 	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
-	0x98, 0xa0, 0x1f, 0x00, 0x22, 0xb3, 0x04, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x98, 0xa0, 0x1f, 0x00, 0x22, 0xaf, 0x07, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
 	0x48, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
@@ -13938,322 +16604,427 @@ var file_ibc_lightclients_mithril_v1_mithril_proto_rawDesc = []byte{
 	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x66, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x68,
 	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x66, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x8b, 0x02, 0x0a, 0x0e, 0x43,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x6f, 0x0a, 0x1c, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x6b,
+	0x0a, 0x19, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
 	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
 	0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x52, 0x18, 0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3e, 0x0a, 0x1c,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x5f, 0x74, 0x78, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x18, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x48, 0x61,
-	0x73, 0x68, 0x54, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x69, 0x62, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x62, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x89, 0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x73,
-	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x12, 0x1f, 0x0a, 0x09, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x10, 0x6d, 0x69,
-	0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x31, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x42, 0x12, 0xe2, 0xde, 0x1f, 0x0e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x31, 0x52, 0x0e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x31, 0x12, 0x68, 0x0a, 0x10, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74,
-	0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x12, 0xe2, 0xde, 0x1f, 0x0e,
-	0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x0e,
-	0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x3a, 0x04,
-	0x88, 0xa0, 0x1f, 0x00, 0x22, 0xe0, 0x06, 0x0a, 0x0d, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x1a, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69,
-	0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x62, 0x63,
-	0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69,
-	0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x18, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44,
-	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x26,
-	0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69,
-	0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72,
-	0x69, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x23, 0x6d,
-	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x12, 0x6a, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x37, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x79,
-	0x0a, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68,
-	0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x43, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x1e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x30, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f, 0x73,
-	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x2c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4d,
-	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x34, 0x0a, 0x17, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x74,
-	0x78, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x63, 0x62, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x42, 0x6f,
-	0x64, 0x79, 0x43, 0x62, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x1a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x68, 0x6f, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x2d, 0x0a, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x10, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xd7, 0x02, 0x0a, 0x18, 0x4d, 0x69, 0x74, 0x68,
-	0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5a, 0x0a, 0x12, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x36, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f,
-	0x00, 0x22, 0xda, 0x01, 0x0a, 0x1a, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29,
-	0x0a, 0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa8,
-	0x04, 0x0a, 0x12, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x12, 0x5b, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63,
+	0x74, 0x65, 0x52, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x71, 0x0a, 0x1a, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x34, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x17, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x60,
+	0x0a, 0x1a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x17, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xcd, 0x04, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x6f, 0x0a, 0x1c, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68,
+	0x72, 0x69, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x18,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3e, 0x0a, 0x1c, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x78, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x54, 0x78,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x62, 0x63, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x69, 0x62, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x38,
+	0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x45, 0x0a, 0x1f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x1c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x31, 0x0a, 0x15, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x5e, 0x0a, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x11, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x70, 0x0a, 0x11, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64,
+	0x4d, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xdc, 0x01, 0x0a, 0x21, 0x4d, 0x69, 0x74,
+	0x68, 0x72, 0x69, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2b,
+	0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f,
+	0x63, 0x62, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x74, 0x78, 0x42, 0x6f,
+	0x64, 0x79, 0x43, 0x62, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x62, 0x63,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x69, 0x62, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x89, 0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x73, 0x62,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x12, 0x1f, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x10, 0x6d, 0x69, 0x74,
+	0x68, 0x72, 0x69, 0x6c, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x31, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x57, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x62, 0x63, 0x2e,
+	0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42,
+	0x12, 0xe2, 0xde, 0x1f, 0x0e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x31, 0x52, 0x0e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x31, 0x12, 0x68, 0x0a, 0x10, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68,
+	0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x12, 0xe2, 0xde, 0x1f, 0x0e, 0x4d,
+	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x0e, 0x6d,
+	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x3a, 0x04, 0x88,
+	0xa0, 0x1f, 0x00, 0x22, 0xe0, 0x06, 0x0a, 0x0d, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x1a, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x62, 0x63, 0x2e,
 	0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74,
-	0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x3c, 0x0a, 0x1a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x18, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a,
-	0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xd1, 0x02, 0x0a, 0x13, 0x43, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63,
+	0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x18, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x26, 0x6d,
+	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x62,
+	0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d,
+	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69,
+	0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x23, 0x6d, 0x69,
+	0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x6a, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x79, 0x0a,
+	0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72,
+	0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x1e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x30, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x12, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x46, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x07,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x48, 0x0a,
-	0x0f, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x66, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x52, 0x0c, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22,
-	0xc2, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12,
-	0x6e, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x33, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c,
+	0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x2c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4d, 0x69,
+	0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x34, 0x0a, 0x17, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x78,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x63, 0x62, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x42, 0x6f, 0x64,
+	0x79, 0x43, 0x62, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x1a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x68, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2d, 0x0a, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xd7, 0x02, 0x0a, 0x18, 0x4d, 0x69, 0x74, 0x68, 0x72,
+	0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5a, 0x0a, 0x12, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69,
+	0x74, 0x68, 0x72, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
+	0x22, 0xda, 0x01, 0x0a, 0x1a, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa8, 0x04,
+	0x0a, 0x12, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x5b, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c,
 	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x50, 0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12,
-	0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04,
-	0x88, 0xa0, 0x1f, 0x00, 0x22, 0x7f, 0x0a, 0x19, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x6b, 0x12,
-	0x0c, 0x0a, 0x01, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x6d, 0x12, 0x40, 0x0a,
-	0x05, 0x70, 0x68, 0x69, 0x5f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69,
-	0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x68, 0x69, 0x46, 0x3a,
-	0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x38, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0xec, 0x03, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x75, 0x0a, 0x1a, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f,
-	0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68,
-	0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74,
-	0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x18, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44,
-	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x75, 0x0a, 0x1a, 0x63,
-	0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x35, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x72, 0x64, 0x61, 0x6e, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x18, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e,
-	0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x79, 0x0a, 0x1c, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x5f, 0x69, 0x6d,
-	0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x66, 0x75,
-	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x57,
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
 	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68,
-	0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x49, 0x6d,
-	0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x75, 0x6c, 0x6c,
-	0x48, 0x00, 0x52, 0x19, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x49, 0x6d, 0x6d, 0x75, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x65, 0x0a,
-	0x14, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x62,
+	0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c,
+	0x0a, 0x1a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x18, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xd1, 0x02, 0x0a, 0x13, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x46,
+	0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x48, 0x0a, 0x0f,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x66, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xc2,
+	0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x6e,
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x33, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x3d,
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0x88,
+	0xa0, 0x1f, 0x00, 0x22, 0x7f, 0x0a, 0x19, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x6b, 0x12, 0x0c,
+	0x0a, 0x01, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x6d, 0x12, 0x40, 0x0a, 0x05,
+	0x70, 0x68, 0x69, 0x5f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x62,
 	0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d,
-	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e,
-	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
-	0x13, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x30,
-	0x0a, 0x18, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x22, 0x61, 0x0a, 0x19, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x49, 0x6d, 0x6d, 0x75, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x44, 0x0a,
-	0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64,
-	0x61, 0x6e, 0x6f, 0x44, 0x62, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x06, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x13, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x44, 0x62,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x08, 0x46, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2a, 0xe6, 0x02, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x4b, 0x65,
-	0x79, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45,
-	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29,
+	0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x68, 0x69, 0x46, 0x3a, 0x04,
+	0x88, 0xa0, 0x1f, 0x00, 0x22, 0x38, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe6,
+	0x04, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x75, 0x0a, 0x1a, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5f, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72,
+	0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x18, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x75, 0x0a, 0x1a, 0x63, 0x61,
+	0x72, 0x64, 0x61, 0x6e, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x61, 0x6e, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x18, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x79, 0x0a, 0x1c, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x5f, 0x69, 0x6d, 0x6d,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72,
+	0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x49, 0x6d, 0x6d,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x48,
+	0x00, 0x52, 0x19, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x49, 0x6d, 0x6d, 0x75, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x65, 0x0a, 0x14,
+	0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x62, 0x63,
+	0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69,
+	0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x13,
+	0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x78, 0x0a, 0x1b, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68,
+	0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x48, 0x00, 0x52, 0x19, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x61, 0x72, 0x64, 0x61,
+	0x6e, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x61, 0x0a, 0x19, 0x43, 0x61, 0x72,
+	0x64, 0x61, 0x6e, 0x6f, 0x49, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x44, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x44, 0x62, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x13,
+	0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x19,
+	0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x75, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x44, 0x62, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x08, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2a, 0x70, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x53, 0x55, 0x53, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4c,
+	0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4f, 0x4e, 0x53, 0x45,
+	0x4e, 0x53, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x4f, 0x4e, 0x49, 0x43, 0x41, 0x4c, 0x10,
+	0x01, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x98, 0x05, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a,
+	0x29, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53,
+	0x48, 0x4f, 0x54, 0x5f, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x3e, 0x0a, 0x3a,
 	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
-	0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48,
-	0x4f, 0x54, 0x5f, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x3e, 0x0a, 0x3a, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
-	0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x41, 0x4e, 0x4f,
-	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x4d, 0x45,
-	0x52, 0x4b, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x02, 0x12, 0x3d, 0x0a, 0x39, 0x50,
+	0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x41, 0x4e,
+	0x4f, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x4d,
+	0x45, 0x52, 0x4b, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x02, 0x12, 0x3d, 0x0a, 0x39,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x41,
+	0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x3a, 0x0a, 0x36, 0x50,
 	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
-	0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x41, 0x47,
-	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x3a, 0x0a, 0x36, 0x50, 0x52,
+	0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x49, 0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e,
+	0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x04, 0x12, 0x31, 0x0a, 0x2d, 0x50, 0x52, 0x4f, 0x54, 0x4f,
+	0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54,
+	0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x05, 0x12, 0x36, 0x0a, 0x32, 0x50, 0x52,
 	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50,
-	0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x49,
-	0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x55,
-	0x4d, 0x42, 0x45, 0x52, 0x10, 0x04, 0x12, 0x31, 0x0a, 0x2d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f,
-	0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x05, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42,
-	0xc6, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c,
-	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x85, 0x01, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x2d, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x2d, 0x69, 0x62, 0x63, 0x2d,
-	0x69, 0x6e, 0x63, 0x75, 0x62, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x2d, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c,
-	0x2d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2d, 0x76, 0x31,
-	0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2f, 0x76,
-	0x31, 0x3b, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4c,
-	0x4d, 0xaa, 0x02, 0x1b, 0x49, 0x62, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x1b, 0x49, 0x62, 0x63, 0x5c, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x5c, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27,
-	0x49, 0x62, 0x63, 0x5c, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
-	0x5c, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x49, 0x62, 0x63, 0x3a, 0x3a, 0x4c,
-	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x4d, 0x69, 0x74,
-	0x68, 0x72, 0x69, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x53,
+	0x10, 0x06, 0x12, 0x2b, 0x0a, 0x27, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x07, 0x12,
+	0x3e, 0x0a, 0x3a, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x52,
+	0x44, 0x41, 0x4e, 0x4f, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x52,
+	0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x08, 0x12,
+	0x44, 0x0a, 0x40, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x52,
+	0x44, 0x41, 0x4e, 0x4f, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x52,
+	0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x52, 0x4b, 0x4c, 0x45, 0x5f, 0x52,
+	0x4f, 0x4f, 0x54, 0x10, 0x09, 0x12, 0x45, 0x0a, 0x41, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x41, 0x4e, 0x4f, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
+	0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x4d,
+	0x45, 0x52, 0x4b, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x0a, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x42, 0xc6, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6d, 0x69, 0x74, 0x68,
+	0x72, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x85, 0x01, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x2d, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x2d, 0x69,
+	0x62, 0x63, 0x2d, 0x69, 0x6e, 0x63, 0x75, 0x62, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x61, 0x6e, 0x6f, 0x2d, 0x6d, 0x69, 0x74, 0x68,
+	0x72, 0x69, 0x6c, 0x2d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2d, 0x76, 0x31, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69,
+	0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x49, 0x4c, 0x4d, 0xaa, 0x02, 0x1b, 0x49, 0x62, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x1b, 0x49, 0x62, 0x63, 0x5c, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x27, 0x49, 0x62, 0x63, 0x5c, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x5c, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x49, 0x62, 0x63,
+	0x3a, 0x3a, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x3a,
+	0x4d, 0x69, 0x74, 0x68, 0x72, 0x69, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14268,65 +17039,74 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_rawDescGZIP() []byte {
 	return file_ibc_lightclients_mithril_v1_mithril_proto_rawDescData
 }
 
-var file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_ibc_lightclients_mithril_v1_mithril_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_ibc_lightclients_mithril_v1_mithril_proto_goTypes = []interface{}{
-	(ProtocolMessagePartKey)(0),        // 0: ibc.lightclients.mithril.v1.ProtocolMessagePartKey
-	(*Height)(nil),                     // 1: ibc.lightclients.mithril.v1.Height
-	(*ClientState)(nil),                // 2: ibc.lightclients.mithril.v1.ClientState
-	(*ConsensusState)(nil),             // 3: ibc.lightclients.mithril.v1.ConsensusState
-	(*Misbehaviour)(nil),               // 4: ibc.lightclients.mithril.v1.Misbehaviour
-	(*MithrilHeader)(nil),              // 5: ibc.lightclients.mithril.v1.MithrilHeader
-	(*MithrilStakeDistribution)(nil),   // 6: ibc.lightclients.mithril.v1.MithrilStakeDistribution
-	(*CardanoTransactionSnapshot)(nil), // 7: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot
-	(*MithrilCertificate)(nil),         // 8: ibc.lightclients.mithril.v1.MithrilCertificate
-	(*CertificateMetadata)(nil),        // 9: ibc.lightclients.mithril.v1.CertificateMetadata
-	(*SignerWithStake)(nil),            // 10: ibc.lightclients.mithril.v1.SignerWithStake
-	(*ProtocolMessage)(nil),            // 11: ibc.lightclients.mithril.v1.ProtocolMessage
-	(*MessagePart)(nil),                // 12: ibc.lightclients.mithril.v1.MessagePart
-	(*MithrilProtocolParameters)(nil),  // 13: ibc.lightclients.mithril.v1.MithrilProtocolParameters
-	(*ProtocolGenesisSignature)(nil),   // 14: ibc.lightclients.mithril.v1.ProtocolGenesisSignature
-	(*SignedEntityType)(nil),           // 15: ibc.lightclients.mithril.v1.SignedEntityType
-	(*CardanoStakeDistribution)(nil),   // 16: ibc.lightclients.mithril.v1.CardanoStakeDistribution
-	(*CardanoImmutableFilesFull)(nil),  // 17: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull
-	(*CardanoTransactions)(nil),        // 18: ibc.lightclients.mithril.v1.CardanoTransactions
-	(*CardanoDbBeacon)(nil),            // 19: ibc.lightclients.mithril.v1.CardanoDbBeacon
-	(*Fraction)(nil),                   // 20: ibc.lightclients.mithril.v1.Fraction
-	(*durationpb.Duration)(nil),        // 21: google.protobuf.Duration
+	(ConsensusStateKeyFormat)(0),              // 0: ibc.lightclients.mithril.v1.ConsensusStateKeyFormat
+	(ProtocolMessagePartKey)(0),               // 1: ibc.lightclients.mithril.v1.ProtocolMessagePartKey
+	(*Height)(nil),                            // 2: ibc.lightclients.mithril.v1.Height
+	(*ClientState)(nil),                       // 3: ibc.lightclients.mithril.v1.ClientState
+	(*ConsensusState)(nil),                    // 4: ibc.lightclients.mithril.v1.ConsensusState
+	(*HostStateShutdown)(nil),                 // 5: ibc.lightclients.mithril.v1.HostStateShutdown
+	(*MithrilTransactionMembershipProof)(nil), // 6: ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof
+	(*Misbehaviour)(nil),                      // 7: ibc.lightclients.mithril.v1.Misbehaviour
+	(*MithrilHeader)(nil),                     // 8: ibc.lightclients.mithril.v1.MithrilHeader
+	(*MithrilStakeDistribution)(nil),          // 9: ibc.lightclients.mithril.v1.MithrilStakeDistribution
+	(*CardanoTransactionSnapshot)(nil),        // 10: ibc.lightclients.mithril.v1.CardanoTransactionSnapshot
+	(*MithrilCertificate)(nil),                // 11: ibc.lightclients.mithril.v1.MithrilCertificate
+	(*CertificateMetadata)(nil),               // 12: ibc.lightclients.mithril.v1.CertificateMetadata
+	(*SignerWithStake)(nil),                   // 13: ibc.lightclients.mithril.v1.SignerWithStake
+	(*ProtocolMessage)(nil),                   // 14: ibc.lightclients.mithril.v1.ProtocolMessage
+	(*MessagePart)(nil),                       // 15: ibc.lightclients.mithril.v1.MessagePart
+	(*MithrilProtocolParameters)(nil),         // 16: ibc.lightclients.mithril.v1.MithrilProtocolParameters
+	(*ProtocolGenesisSignature)(nil),          // 17: ibc.lightclients.mithril.v1.ProtocolGenesisSignature
+	(*SignedEntityType)(nil),                  // 18: ibc.lightclients.mithril.v1.SignedEntityType
+	(*CardanoStakeDistribution)(nil),          // 19: ibc.lightclients.mithril.v1.CardanoStakeDistribution
+	(*CardanoImmutableFilesFull)(nil),         // 20: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull
+	(*CardanoTransactions)(nil),               // 21: ibc.lightclients.mithril.v1.CardanoTransactions
+	(*CardanoBlocksTransactions)(nil),         // 22: ibc.lightclients.mithril.v1.CardanoBlocksTransactions
+	(*CardanoDbBeacon)(nil),                   // 23: ibc.lightclients.mithril.v1.CardanoDbBeacon
+	(*Fraction)(nil),                          // 24: ibc.lightclients.mithril.v1.Fraction
+	(*durationpb.Duration)(nil),               // 25: google.protobuf.Duration
 }
 var file_ibc_lightclients_mithril_v1_mithril_proto_depIdxs = []int32{
-	1,  // 0: ibc.lightclients.mithril.v1.ClientState.latest_height:type_name -> ibc.lightclients.mithril.v1.Height
-	1,  // 1: ibc.lightclients.mithril.v1.ClientState.frozen_height:type_name -> ibc.lightclients.mithril.v1.Height
-	21, // 2: ibc.lightclients.mithril.v1.ClientState.trusting_period:type_name -> google.protobuf.Duration
-	13, // 3: ibc.lightclients.mithril.v1.ClientState.protocol_parameters:type_name -> ibc.lightclients.mithril.v1.MithrilProtocolParameters
-	8,  // 4: ibc.lightclients.mithril.v1.ConsensusState.first_cert_hash_latest_epoch:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
-	5,  // 5: ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1:type_name -> ibc.lightclients.mithril.v1.MithrilHeader
-	5,  // 6: ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2:type_name -> ibc.lightclients.mithril.v1.MithrilHeader
-	6,  // 7: ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution:type_name -> ibc.lightclients.mithril.v1.MithrilStakeDistribution
-	8,  // 8: ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
-	7,  // 9: ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot:type_name -> ibc.lightclients.mithril.v1.CardanoTransactionSnapshot
-	8,  // 10: ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
-	8,  // 11: ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
-	10, // 12: ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake:type_name -> ibc.lightclients.mithril.v1.SignerWithStake
-	13, // 13: ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter:type_name -> ibc.lightclients.mithril.v1.MithrilProtocolParameters
-	15, // 14: ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type:type_name -> ibc.lightclients.mithril.v1.SignedEntityType
-	9,  // 15: ibc.lightclients.mithril.v1.MithrilCertificate.metadata:type_name -> ibc.lightclients.mithril.v1.CertificateMetadata
-	11, // 16: ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message:type_name -> ibc.lightclients.mithril.v1.ProtocolMessage
-	13, // 17: ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters:type_name -> ibc.lightclients.mithril.v1.MithrilProtocolParameters
-	10, // 18: ibc.lightclients.mithril.v1.CertificateMetadata.signers:type_name -> ibc.lightclients.mithril.v1.SignerWithStake
-	12, // 19: ibc.lightclients.mithril.v1.ProtocolMessage.message_parts:type_name -> ibc.lightclients.mithril.v1.MessagePart
-	0,  // 20: ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key:type_name -> ibc.lightclients.mithril.v1.ProtocolMessagePartKey
-	20, // 21: ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f:type_name -> ibc.lightclients.mithril.v1.Fraction
-	6,  // 22: ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution:type_name -> ibc.lightclients.mithril.v1.MithrilStakeDistribution
-	16, // 23: ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution:type_name -> ibc.lightclients.mithril.v1.CardanoStakeDistribution
-	17, // 24: ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full:type_name -> ibc.lightclients.mithril.v1.CardanoImmutableFilesFull
-	18, // 25: ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions:type_name -> ibc.lightclients.mithril.v1.CardanoTransactions
-	19, // 26: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon:type_name -> ibc.lightclients.mithril.v1.CardanoDbBeacon
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	2,  // 0: ibc.lightclients.mithril.v1.ClientState.latest_height:type_name -> ibc.lightclients.mithril.v1.Height
+	2,  // 1: ibc.lightclients.mithril.v1.ClientState.frozen_height:type_name -> ibc.lightclients.mithril.v1.Height
+	25, // 2: ibc.lightclients.mithril.v1.ClientState.trusting_period:type_name -> google.protobuf.Duration
+	16, // 3: ibc.lightclients.mithril.v1.ClientState.protocol_parameters:type_name -> ibc.lightclients.mithril.v1.MithrilProtocolParameters
+	11, // 4: ibc.lightclients.mithril.v1.ClientState.initial_certificate_chain:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
+	0,  // 5: ibc.lightclients.mithril.v1.ClientState.consensus_state_key_format:type_name -> ibc.lightclients.mithril.v1.ConsensusStateKeyFormat
+	2,  // 6: ibc.lightclients.mithril.v1.ClientState.host_state_shutdown_height:type_name -> ibc.lightclients.mithril.v1.Height
+	11, // 7: ibc.lightclients.mithril.v1.ConsensusState.first_cert_hash_latest_epoch:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
+	5,  // 8: ibc.lightclients.mithril.v1.ConsensusState.host_state_shutdown:type_name -> ibc.lightclients.mithril.v1.HostStateShutdown
+	8,  // 9: ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_1:type_name -> ibc.lightclients.mithril.v1.MithrilHeader
+	8,  // 10: ibc.lightclients.mithril.v1.Misbehaviour.mithril_header_2:type_name -> ibc.lightclients.mithril.v1.MithrilHeader
+	9,  // 11: ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution:type_name -> ibc.lightclients.mithril.v1.MithrilStakeDistribution
+	11, // 12: ibc.lightclients.mithril.v1.MithrilHeader.mithril_stake_distribution_certificate:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
+	10, // 13: ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot:type_name -> ibc.lightclients.mithril.v1.CardanoTransactionSnapshot
+	11, // 14: ibc.lightclients.mithril.v1.MithrilHeader.transaction_snapshot_certificate:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
+	11, // 15: ibc.lightclients.mithril.v1.MithrilHeader.previous_mithril_stake_distribution_certificates:type_name -> ibc.lightclients.mithril.v1.MithrilCertificate
+	13, // 16: ibc.lightclients.mithril.v1.MithrilStakeDistribution.signers_with_stake:type_name -> ibc.lightclients.mithril.v1.SignerWithStake
+	16, // 17: ibc.lightclients.mithril.v1.MithrilStakeDistribution.protocol_parameter:type_name -> ibc.lightclients.mithril.v1.MithrilProtocolParameters
+	18, // 18: ibc.lightclients.mithril.v1.MithrilCertificate.signed_entity_type:type_name -> ibc.lightclients.mithril.v1.SignedEntityType
+	12, // 19: ibc.lightclients.mithril.v1.MithrilCertificate.metadata:type_name -> ibc.lightclients.mithril.v1.CertificateMetadata
+	14, // 20: ibc.lightclients.mithril.v1.MithrilCertificate.protocol_message:type_name -> ibc.lightclients.mithril.v1.ProtocolMessage
+	16, // 21: ibc.lightclients.mithril.v1.CertificateMetadata.protocol_parameters:type_name -> ibc.lightclients.mithril.v1.MithrilProtocolParameters
+	13, // 22: ibc.lightclients.mithril.v1.CertificateMetadata.signers:type_name -> ibc.lightclients.mithril.v1.SignerWithStake
+	15, // 23: ibc.lightclients.mithril.v1.ProtocolMessage.message_parts:type_name -> ibc.lightclients.mithril.v1.MessagePart
+	1,  // 24: ibc.lightclients.mithril.v1.MessagePart.protocol_message_part_key:type_name -> ibc.lightclients.mithril.v1.ProtocolMessagePartKey
+	24, // 25: ibc.lightclients.mithril.v1.MithrilProtocolParameters.phi_f:type_name -> ibc.lightclients.mithril.v1.Fraction
+	9,  // 26: ibc.lightclients.mithril.v1.SignedEntityType.mithril_stake_distribution:type_name -> ibc.lightclients.mithril.v1.MithrilStakeDistribution
+	19, // 27: ibc.lightclients.mithril.v1.SignedEntityType.cardano_stake_distribution:type_name -> ibc.lightclients.mithril.v1.CardanoStakeDistribution
+	20, // 28: ibc.lightclients.mithril.v1.SignedEntityType.cardano_immutable_files_full:type_name -> ibc.lightclients.mithril.v1.CardanoImmutableFilesFull
+	21, // 29: ibc.lightclients.mithril.v1.SignedEntityType.cardano_transactions:type_name -> ibc.lightclients.mithril.v1.CardanoTransactions
+	22, // 30: ibc.lightclients.mithril.v1.SignedEntityType.cardano_blocks_transactions:type_name -> ibc.lightclients.mithril.v1.CardanoBlocksTransactions
+	23, // 31: ibc.lightclients.mithril.v1.CardanoImmutableFilesFull.beacon:type_name -> ibc.lightclients.mithril.v1.CardanoDbBeacon
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_ibc_lightclients_mithril_v1_mithril_proto_init() }
@@ -14372,7 +17152,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Misbehaviour); i {
+			switch v := v.(*HostStateShutdown); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14384,7 +17164,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MithrilHeader); i {
+			switch v := v.(*MithrilTransactionMembershipProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14396,7 +17176,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MithrilStakeDistribution); i {
+			switch v := v.(*Misbehaviour); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14408,7 +17188,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardanoTransactionSnapshot); i {
+			switch v := v.(*MithrilHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14420,7 +17200,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MithrilCertificate); i {
+			switch v := v.(*MithrilStakeDistribution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14432,7 +17212,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CertificateMetadata); i {
+			switch v := v.(*CardanoTransactionSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14444,7 +17224,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignerWithStake); i {
+			switch v := v.(*MithrilCertificate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14456,7 +17236,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolMessage); i {
+			switch v := v.(*CertificateMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14468,7 +17248,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagePart); i {
+			switch v := v.(*SignerWithStake); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14480,7 +17260,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MithrilProtocolParameters); i {
+			switch v := v.(*ProtocolMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14492,7 +17272,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolGenesisSignature); i {
+			switch v := v.(*MessagePart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14504,7 +17284,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedEntityType); i {
+			switch v := v.(*MithrilProtocolParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14516,7 +17296,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardanoStakeDistribution); i {
+			switch v := v.(*ProtocolGenesisSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14528,7 +17308,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardanoImmutableFilesFull); i {
+			switch v := v.(*SignedEntityType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14540,7 +17320,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardanoTransactions); i {
+			switch v := v.(*CardanoStakeDistribution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14552,7 +17332,7 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardanoDbBeacon); i {
+			switch v := v.(*CardanoImmutableFilesFull); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14564,6 +17344,42 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoBlocksTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardanoDbBeacon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Fraction); i {
 			case 0:
 				return &v.state
@@ -14576,19 +17392,20 @@ func file_ibc_lightclients_mithril_v1_mithril_proto_init() {
 			}
 		}
 	}
-	file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_ibc_lightclients_mithril_v1_mithril_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*SignedEntityType_MithrilStakeDistribution)(nil),
 		(*SignedEntityType_CardanoStakeDistribution)(nil),
 		(*SignedEntityType_CardanoImmutableFilesFull)(nil),
 		(*SignedEntityType_CardanoTransactions)(nil),
+		(*SignedEntityType_CardanoBlocksTransactions)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ibc_lightclients_mithril_v1_mithril_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      2,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
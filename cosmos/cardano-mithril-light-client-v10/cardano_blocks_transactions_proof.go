@@ -0,0 +1,158 @@
+package mithril
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/cryptohelpers"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/entities"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// CardanoBlocksTransactionsProofsMessage proves transactions certified by a
+// CardanoBlocksTransactions snapshot. Each proof is a Merkle map from block
+// ranges to Merkle maps from blocks to the Merkle tree of the transactions of
+// each block, so a certified transaction is bound to the block holding it.
+type CardanoBlocksTransactionsProofsMessage struct {
+	CertificateHash          string                                          `json:"certificate_hash"`
+	CertifiedTransactions    []*CardanoBlocksTransactionsSetProofMessagePart `json:"certified_transactions"`
+	NonCertifiedTransactions []entities.TransactionHash                      `json:"non_certified_transactions"`
+	LatestBlockNumber        entities.BlockNumber                            `json:"latest_block_number"`
+}
+
+type CardanoBlocksTransactionsSetProofMessagePart struct {
+	TransactionsHashes []entities.TransactionHash `json:"transactions_hashes"`
+	Proof              entities.HexEncodedKey     `json:"proof"`
+}
+
+// CertifiedBlockTransaction is a certified transaction and the block holding it.
+type CertifiedBlockTransaction struct {
+	TransactionHash entities.TransactionHash
+	BlockNumber     entities.BlockNumber
+	BlockHash       entities.BlockHash
+}
+
+type VerifiedCardanoBlocksTransactions struct {
+	CertificateHash       string
+	MerkleRoot            string
+	CertifiedTransactions []*CertifiedBlockTransaction
+	LatestBlockNumber     entities.BlockNumber
+}
+
+func (pm *CardanoBlocksTransactionsProofsMessage) Verify() (*VerifiedCardanoBlocksTransactions, error) {
+	var merkleRoot string
+	var certifiedTransactions []*CertifiedBlockTransaction
+
+	for _, part := range pm.CertifiedTransactions {
+		proof, err := new(entities.ProtocolMkProof).FromJSONHex(part.Proof)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "MalformedData %v", err)
+		}
+
+		if err := proof.Key.Verify(); err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "InvalidSetProof, Transaction Hashes: %v, Source: %v", part.TransactionsHashes, err)
+		}
+
+		for _, txHash := range part.TransactionsHashes {
+			certified, err := findBlockTransaction(proof.Key, txHash)
+			if err != nil {
+				return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "InvalidSetProof, Transaction Hash: %v, Source: %v", txHash, err)
+			}
+			certifiedTransactions = append(certifiedTransactions, certified)
+		}
+
+		partMerkleRoot := hex.EncodeToString(proof.Key.ComputeRoot().Hash)
+		if merkleRoot == "" {
+			merkleRoot = partMerkleRoot
+		} else if merkleRoot != partMerkleRoot {
+			return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "NonMatchingMerkleRoot")
+		}
+	}
+
+	if merkleRoot == "" {
+		return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "NoCertifiedTransaction")
+	}
+
+	return &VerifiedCardanoBlocksTransactions{
+		CertificateHash:       pm.CertificateHash,
+		MerkleRoot:            merkleRoot,
+		CertifiedTransactions: certifiedTransactions,
+		LatestBlockNumber:     pm.LatestBlockNumber,
+	}, nil
+}
+
+// findBlockTransaction returns the block holding txHash in a verified block
+// range map proof. The transaction must be a leaf of the transactions tree of a
+// block keyed inside a block range that contains the block number.
+func findBlockTransaction(proof *cryptohelpers.MKMapProof, txHash entities.TransactionHash) (*CertifiedBlockTransaction, error) {
+	leaf := []*cryptohelpers.MKTreeNode{{Hash: []byte(txHash)}}
+	for _, rangeProof := range proof.SubProofs {
+		if rangeProof.BlockRange == nil || rangeProof.MKMapProof == nil {
+			continue
+		}
+		for _, blockProof := range rangeProof.SubProofs {
+			if blockProof.Block == nil || blockProof.MKMapProof == nil || len(blockProof.SubProofs) > 0 {
+				continue
+			}
+			if blockProof.MasterProof.Contains(leaf) != nil {
+				continue
+			}
+			blockNumber := blockProof.Block.BlockNumber
+			if blockNumber < rangeProof.InnerRange.Start || blockNumber >= rangeProof.InnerRange.End {
+				return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "block %d is outside block range %d-%d", blockNumber, rangeProof.InnerRange.Start, rangeProof.InnerRange.End)
+			}
+			return &CertifiedBlockTransaction{
+				TransactionHash: txHash,
+				BlockNumber:     blockNumber,
+				BlockHash:       blockProof.Block.BlockHash,
+			}, nil
+		}
+	}
+	return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "transaction %s is not in a certified block", txHash)
+}
+
+// verifyCertifiedBlockTransaction checks that the Gateway-encoded blocks and
+// transactions proof certifies txHash under merkleRoot, the Cardano blocks and
+// transactions merkle root of the snapshot certified by certificateHash, and
+// returns the block holding it.
+func verifyCertifiedBlockTransaction(proofBytes []byte, merkleRoot, certificateHash, txHash string) (*CertifiedBlockTransaction, error) {
+	var proofs CardanoBlocksTransactionsProofsMessage
+	if err := json.Unmarshal(proofBytes, &proofs); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "malformed host state tx proof: %v", err)
+	}
+
+	verified, err := proofs.Verify()
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(verified.MerkleRoot, merkleRoot) {
+		return nil, errorsmod.Wrapf(
+			ErrInvalidCardanoTransactionsProofs,
+			"blocks transactions proof merkle root mismatch: expected %s, got %s",
+			merkleRoot,
+			verified.MerkleRoot,
+		)
+	}
+
+	if proofs.CertificateHash != "" && proofs.CertificateHash != certificateHash {
+		return nil, errorsmod.Wrapf(
+			ErrInvalidCardanoTransactionsProofs,
+			"blocks transactions proof certificate hash mismatch: expected %s, got %s",
+			certificateHash,
+			proofs.CertificateHash,
+		)
+	}
+
+	for _, certified := range verified.CertifiedTransactions {
+		if strings.EqualFold(certified.TransactionHash, txHash) {
+			return certified, nil
+		}
+	}
+	return nil, errorsmod.Wrapf(
+		ErrInvalidCardanoTransactionsProofs,
+		"host state tx hash not certified by blocks transactions proof",
+	)
+}
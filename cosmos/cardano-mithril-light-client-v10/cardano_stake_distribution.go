@@ -0,0 +1,30 @@
+package mithril
+
+import (
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/cryptohelpers"
+)
+
+// CardanoStakeDistributionMerkleRoot returns the hex-encoded root signed in the
+// cardano_stake_distribution_merkle_root message part. As in the aggregator,
+// each leaf is the string "{pool_id}{stake}", ordered by pool id.
+func CardanoStakeDistributionMerkleRoot(stakes map[string]uint64) (string, error) {
+	poolIDs := make([]string, 0, len(stakes))
+	for poolID := range stakes {
+		poolIDs = append(poolIDs, poolID)
+	}
+	sort.Strings(poolIDs)
+
+	leaves := make([]*cryptohelpers.MKTreeNode, 0, len(poolIDs))
+	for _, poolID := range poolIDs {
+		leaves = append(leaves, &cryptohelpers.MKTreeNode{Hash: []byte(poolID + strconv.FormatUint(stakes[poolID], 10))})
+	}
+	root, err := cryptohelpers.ComputeMKTreeRoot(leaves)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(root.Hash), nil
+}
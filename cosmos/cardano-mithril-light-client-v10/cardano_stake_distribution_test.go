@@ -0,0 +1,43 @@
+package mithril
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2s"
+)
+
+func TestCardanoStakeDistributionMerkleRoot(t *testing.T) {
+	// Pools and stakes as the aggregator's signable builder reads them from
+	// the ledger; the root is computed from the upstream leaf encoding.
+	root, err := CardanoStakeDistributionMerkleRoot(map[string]uint64{
+		"pool1qzlwlpcsgflr9z3f24fg836tyq45p0kf5cnrp20s8y3w7wlv4yc": 25000000000,
+		"pool1qqqqqdk4zhsjuxxd8jyvwncf5eucfskz0xjjj64fdmlgj735lr9": 9497432569,
+		"pool1qqgwkcz8mfh5wwdtusrcrmkgxwcuuq9jnu8e5cuwfgr6ahh3d9y": 1056165876,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "5a1f596bd0089faa95753567ac2e960349f290ace9776e0b28408f61365c2674", root)
+}
+
+func TestCardanoStakeDistributionMerkleRootBagsPeaksRightToLeft(t *testing.T) {
+	merge := func(left, right string) string {
+		hash := blake2s.Sum256([]byte(left + right))
+		return string(hash[:])
+	}
+
+	root, err := CardanoStakeDistributionMerkleRoot(map[string]uint64{"pool-a": 10})
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("pool-a10")), root)
+
+	root, err = CardanoStakeDistributionMerkleRoot(map[string]uint64{
+		"pool-c": 30,
+		"pool-a": 10,
+		"pool-b": 20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString([]byte(merge("pool-c30", merge("pool-a10", "pool-b20")))), root)
+
+	_, err = CardanoStakeDistributionMerkleRoot(nil)
+	require.Error(t, err)
+}
@@ -1,6 +1,9 @@
 package mithril
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/entities"
 
 	errorsmod "cosmossdk.io/errors"
@@ -76,3 +79,47 @@ func (pm *CardanoTransactionsProofsMessage) Verify() (*VerifiedCardanoTransactio
 		LatestBlockNumber:     pm.LatestBlockNumber,
 	}, nil
 }
+
+// verifyCertifiedTransaction checks that the Gateway-encoded transaction proof
+// certifies txHash under merkleRoot, the Cardano transactions merkle root of
+// the transaction snapshot certified by certificateHash.
+func verifyCertifiedTransaction(proofBytes []byte, merkleRoot, certificateHash, txHash string) error {
+	var proofs CardanoTransactionsProofsMessage
+	if err := json.Unmarshal(proofBytes, &proofs); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "malformed host state tx proof: %v", err)
+	}
+
+	verified, err := proofs.Verify()
+	if err != nil {
+		return err
+	}
+
+	if strings.ToLower(verified.MerkleRoot) != strings.ToLower(merkleRoot) {
+		return errorsmod.Wrapf(
+			ErrInvalidCardanoTransactionsProofs,
+			"transaction proof merkle root mismatch: expected %s, got %s",
+			merkleRoot,
+			verified.MerkleRoot,
+		)
+	}
+
+	// The proof must be tied to the same snapshot certificate.
+	if proofs.CertificateHash != "" && proofs.CertificateHash != certificateHash {
+		return errorsmod.Wrapf(
+			ErrInvalidCardanoTransactionsProofs,
+			"transaction proof certificate hash mismatch: expected %s, got %s",
+			certificateHash,
+			proofs.CertificateHash,
+		)
+	}
+
+	for _, certified := range verified.CertifiedTransactions {
+		if strings.ToLower(certified) == strings.ToLower(txHash) {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(
+		ErrInvalidCardanoTransactionsProofs,
+		"host state tx hash not certified by transaction proof",
+	)
+}
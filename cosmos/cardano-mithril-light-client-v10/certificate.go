@@ -6,7 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/entities"
-	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/crypto"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
 	"hash"
 	"time"
 
@@ -127,6 +127,13 @@ func FromCertificateProto(mc *MithrilCertificate) (*Certificate, error) {
 		return nil, err
 	}
 
+	// The hash is what certificates chain by and what the client stores, so it
+	// is recomputed rather than taken from the relayer.
+	hash := computeCertificateProtoHash(mc, metadata, pm, signature)
+	if hash != mc.Hash {
+		return nil, errorsmod.Wrapf(ErrInvalidCertificate, "certificate hash %s does not match its computed hash %s", mc.Hash, hash)
+	}
+
 	cert := &Certificate{
 		Hash:                     mc.Hash,
 		PreviousHash:             mc.PreviousHash,
@@ -141,6 +148,47 @@ func FromCertificateProto(mc *MithrilCertificate) (*Certificate, error) {
 	return cert, nil
 }
 
+// ComputeHash returns the hash of the certificate as computed by the Mithril
+// aggregator. The aggregate verification key and the multi-signature are
+// hashed in their wire encoding.
+func (mc *MithrilCertificate) ComputeHash() (string, error) {
+	metadata, err := FromCertificateMetadataProto(mc.Metadata)
+	if err != nil {
+		return "", err
+	}
+	pm, err := FromProtocolMessageProto(mc.ProtocolMessage)
+	if err != nil {
+		return "", err
+	}
+	signature, err := FromCertificateSignatureProto(mc.SignedEntityType, mc.MultiSignature, mc.GenesisSignature)
+	if err != nil {
+		return "", err
+	}
+	return computeCertificateProtoHash(mc, metadata, pm, signature), nil
+}
+
+func computeCertificateProtoHash(mc *MithrilCertificate, metadata *entities.CertificateMetadata, pm *entities.ProtocolMessage, signature *CertificateSignature) string {
+	hasher := sha256.New()
+	hasher.Write([]byte(mc.PreviousHash))
+
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, mc.Epoch)
+	hasher.Write(epochBytes)
+
+	hasher.Write([]byte(metadata.ComputeHash()))
+	hasher.Write([]byte(pm.ComputeHash()))
+	hasher.Write([]byte(mc.SignedMessage))
+	hasher.Write([]byte(mc.AggregateVerificationKey))
+
+	if signature.MultiSignature != nil {
+		signature.MultiSignature.SignedEntityType.FeedHash(hasher)
+		hasher.Write([]byte(mc.MultiSignature))
+	} else {
+		hasher.Write([]byte(mc.GenesisSignature))
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
 func FromCertificateMetadataProto(metadata *CertificateMetadata) (*entities.CertificateMetadata, error) {
 	pp, err := FromProtocolParametersProto(metadata.ProtocolParameters)
 	if err != nil {
@@ -198,16 +246,28 @@ func FromProtocolMessageProto(pmProto *ProtocolMessage) (*entities.ProtocolMessa
 
 	for _, mp := range pmProto.MessageParts {
 		switch mp.ProtocolMessagePartKey {
-		case 0:
+		case PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED:
 			return nil, errorsmod.Wrapf(ErrInvalidMessagePartKey, "message part key shouldn't be unspecified")
-		case 1:
-			pm.MessageParts["snapshot_digest"] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
-		case 2:
-			pm.MessageParts["cardano_transactions_merkle_root"] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
-		case 3:
-			pm.MessageParts["next_aggregate_verification_key"] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
-		case 4:
-			pm.MessageParts["latest_immutable_file_number"] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST:
+			pm.MessageParts[entities.SnapshotDigest] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT:
+			pm.MessageParts[entities.CardanoTransactionsMerkleRoot] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY:
+			pm.MessageParts[entities.NextAggregateVerificationKey] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER:
+			pm.MessageParts[entities.LatestImmutableFileNumber] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER:
+			pm.MessageParts[entities.LatestBlockNumber] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS:
+			pm.MessageParts[entities.NextProtocolParameters] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH:
+			pm.MessageParts[entities.CurrentEpoch] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH:
+			pm.MessageParts[entities.CardanoStakeDistributionEpoch] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT:
+			pm.MessageParts[entities.CardanoStakeDistributionMerkleRoot] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
+		case PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT:
+			pm.MessageParts[entities.CardanoBlocksTransactionsMerkleRoot] = entities.ProtocolMessagePartValue(mp.ProtocolMessagePartValue)
 		}
 	}
 	return pm, nil
@@ -237,6 +297,9 @@ func FromAvkProto(avkProto string) (*entities.ProtocolAggregateVerificationKey,
 
 func FromCertificateSignatureProto(setProto *SignedEntityType, multiSigProto string, genesisSigProto string) (*CertificateSignature, error) {
 	if multiSigProto != "" {
+		if setProto == nil {
+			return nil, errorsmod.Wrapf(ErrInvalidCertificate, "multi-signed certificate has no signed entity type")
+		}
 		set := &entities.SignedEntityType{}
 		switch s := setProto.Entity.(type) {
 		case *SignedEntityType_MithrilStakeDistribution:
@@ -260,6 +323,11 @@ func FromCertificateSignatureProto(setProto *SignedEntityType, multiSigProto str
 				Epoch:       entities.Epoch(s.CardanoTransactions.Epoch),
 				BlockNumber: s.CardanoTransactions.BlockNumber,
 			}
+		case *SignedEntityType_CardanoBlocksTransactions:
+			set.CardanoBlocksTransactions = &entities.CardanoBlocksTransactions{
+				Epoch:       entities.Epoch(s.CardanoBlocksTransactions.Epoch),
+				BlockNumber: s.CardanoBlocksTransactions.BlockNumber,
+			}
 		}
 
 		stmAggrSig := &crypto.StmAggrSig{}
@@ -286,8 +354,15 @@ func FromCertificateSignatureProto(setProto *SignedEntityType, multiSigProto str
 				},
 			},
 		}, nil
+	} else if genesisSigProto != "" {
+		genesisSignature, err := (&entities.ProtocolGenesisSignature{}).FromByteHex(genesisSigProto)
+		if err != nil {
+			return nil, err
+		}
+		return &CertificateSignature{
+			GenesisSignature: &GenesisSignature{ProtocolGenesisSignature: genesisSignature},
+		}, nil
 	} else {
-		// TO-DO: support genesis signature later
-		return nil, errorsmod.Wrapf(ErrUnsupportedGenesisSignature, "genesis signature is not supported")
+		return nil, errorsmod.Wrapf(ErrInvalidCertificate, "certificate has neither a multi-signature nor a genesis signature")
 	}
 }
@@ -0,0 +1,37 @@
+package mithril
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolMessageComputeHashUsesAggregatorKeyOrder(t *testing.T) {
+	pm, err := FromProtocolMessageProto(&ProtocolMessage{
+		MessageParts: []*MessagePart{
+			{ProtocolMessagePartKey: PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT, ProtocolMessagePartValue: "root"},
+			{ProtocolMessagePartKey: PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY, ProtocolMessagePartValue: "avk"},
+			{ProtocolMessagePartKey: PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH, ProtocolMessagePartValue: "42"},
+		},
+	})
+	require.NoError(t, err)
+
+	epoch, ok := pm.GetMessagePart(entities.CardanoStakeDistributionEpoch)
+	require.True(t, ok)
+	require.Equal(t, entities.ProtocolMessagePartValue("42"), epoch)
+
+	hasher := sha256.New()
+	hasher.Write([]byte("next_aggregate_verification_key"))
+	hasher.Write([]byte("avk"))
+	hasher.Write([]byte("cardano_stake_distribution_epoch"))
+	hasher.Write([]byte("42"))
+	hasher.Write([]byte("cardano_stake_distribution_merkle_root"))
+	hasher.Write([]byte("root"))
+	expected := hex.EncodeToString(hasher.Sum(nil))
+	for i := 0; i < 8; i++ {
+		require.Equal(t, expected, pm.ComputeHash())
+	}
+}
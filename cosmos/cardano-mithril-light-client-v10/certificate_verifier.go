@@ -1,10 +1,12 @@
 package mithril
 
 import (
-	storetypes "cosmossdk.io/store/types"
+	"crypto/ed25519"
 	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/entities"
-	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/crypto"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
 
 	errorsmod "cosmossdk.io/errors"
 )
@@ -15,6 +17,52 @@ type MithrilCertificateRetriever interface {
 
 type MithrilCertificateVerifier struct {
 	CertificateRetriever MithrilCertificateRetriever
+	// GenesisVerificationKey verifies the genesis certificate that ends every
+	// trusted certificate chain.
+	GenesisVerificationKey ed25519.PublicKey
+	// batch, when set, collects the multi-signatures of standard certificates
+	// instead of verifying them one at a time.
+	batch *multiSignatureBatch
+}
+
+// multiSignatureBatch collects certificate multi-signatures so they can be
+// verified with a single batched pairing check.
+type multiSignatureBatch struct {
+	certificateHashes []string
+	signatures        []*crypto.StmAggrSig
+	messages          [][]byte
+	avks              []*crypto.StmAggrVerificationKey
+	parameters        []*crypto.StmParameters
+}
+
+func (b *multiSignatureBatch) add(certificate *Certificate, signature *entities.ProtocolMultiSignature) {
+	b.certificateHashes = append(b.certificateHashes, certificate.Hash)
+	b.signatures = append(b.signatures, signature.Key)
+	b.messages = append(b.messages, []byte(certificate.SignedMessage))
+	b.avks = append(b.avks, certificate.AggregateVerificationKey.Key)
+	b.parameters = append(b.parameters, &crypto.StmParameters{
+		M:    certificate.Metadata.ProtocolParameters.M,
+		K:    certificate.Metadata.ProtocolParameters.K,
+		PhiF: certificate.Metadata.ProtocolParameters.PhiF,
+	})
+}
+
+// verify checks every collected multi-signature in one batch. When the batch
+// fails, the signatures are verified one by one so the error names the
+// offending certificate.
+func (b *multiSignatureBatch) verify() error {
+	if len(b.signatures) == 0 {
+		return nil
+	}
+	if err := new(crypto.StmAggrSig).BatchVerify(b.signatures, b.messages, b.avks, b.parameters); err == nil {
+		return nil
+	}
+	for i, signature := range b.signatures {
+		if err := signature.Verify(b.messages[i], b.avks[i], b.parameters[i]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidCertificate, "multi-signature of certificate %s is invalid: %v", b.certificateHashes[i], err)
+		}
+	}
+	return nil
 }
 
 type MSDCertificateRetriever struct {
@@ -34,6 +82,30 @@ func (m *MSDCertificateRetriever) GetCertificateDetails(hash string) (*Certifica
 	return certData, nil
 }
 
+// certificateChainRetriever serves certificates supplied alongside a message,
+// such as the chain back to genesis given at client creation.
+type certificateChainRetriever struct {
+	certificates map[string]*MithrilCertificate
+}
+
+func newCertificateChainRetriever(certificates []*MithrilCertificate) *certificateChainRetriever {
+	retriever := &certificateChainRetriever{certificates: make(map[string]*MithrilCertificate, len(certificates))}
+	for _, cert := range certificates {
+		if cert != nil {
+			retriever.certificates[cert.Hash] = cert
+		}
+	}
+	return retriever
+}
+
+func (r *certificateChainRetriever) GetCertificateDetails(hash string) (*Certificate, error) {
+	cert, ok := r.certificates[hash]
+	if !ok {
+		return nil, fmt.Errorf("hash not found: %v", hash)
+	}
+	return FromCertificateProto(cert)
+}
+
 func (v *MithrilCertificateVerifier) VerifyMultiSignature(
 	message []byte,
 	multiSignature *entities.ProtocolMultiSignature,
@@ -55,7 +127,19 @@ func (v *MithrilCertificateVerifier) VerifyStandardCertificate(
 	certificate *Certificate,
 	signature *entities.ProtocolMultiSignature,
 ) (*Certificate, error) {
-	if err := v.VerifyMultiSignature(
+	if err := verifySignedEntityTypeEpoch(certificate); err != nil {
+		return nil, err
+	}
+
+	// The multi-signature only covers the signed message, so the protocol
+	// message parts are bound to it through their hash.
+	if !certificate.MatchMessage(certificate.ProtocolMessage) {
+		return nil, errorsmod.Wrap(ErrInvalidCertificate, "certificate signed message does not match its protocol message")
+	}
+
+	if v.batch != nil {
+		v.batch.add(certificate, signature)
+	} else if err := v.VerifyMultiSignature(
 		[]byte(certificate.SignedMessage),
 		signature,
 		certificate.AggregateVerificationKey,
@@ -72,24 +156,39 @@ func (v *MithrilCertificateVerifier) VerifyStandardCertificate(
 		return nil, fmt.Errorf("certificate chain previous hash unmatch")
 	}
 
+	// Walking back to genesis, epochs never increase and never skip an epoch:
+	// a certificate links to one of its own epoch or of the epoch before.
+	if previousCertificate.Epoch != certificate.Epoch && previousCertificate.Epoch+1 != certificate.Epoch {
+		return nil, errorsmod.Wrapf(
+			ErrInvalidCertificate,
+			"certificate of epoch %d cannot follow a certificate of epoch %d",
+			certificate.Epoch,
+			previousCertificate.Epoch,
+		)
+	}
+
 	currentCertificateAVK, err := certificate.AggregateVerificationKey.ToJsonHex()
 	if err != nil {
 		return nil, err
 	}
-	previousCertificateAVK, err := certificate.AggregateVerificationKey.ToJsonHex()
+	previousCertificateAVK, err := previousCertificate.AggregateVerificationKey.ToJsonHex()
 	if err != nil {
 		return nil, err
 	}
 
-	validCertificateHasDifferentEpochAsPrevious := func(nextAggregateVerificationKey string) bool {
-		return nextAggregateVerificationKey == currentCertificateAVK && previousCertificate.Epoch != certificate.Epoch
-	}
-	validCertificateHasSameEpochAsPrevious := func() bool {
-		return previousCertificateAVK == currentCertificateAVK && previousCertificate.Epoch == certificate.Epoch
+	nextAggregateVerificationKey, ok := previousCertificate.ProtocolMessage.GetMessagePart(entities.NextAggregateVerificationKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidCertificate, "can not get nextAvk from previous certificate")
 	}
 
-	nextAggregateVerificationKey, ok := previousCertificate.ProtocolMessage.GetMessagePart("next_aggregate_verification_key")
-	if ok {
+	if previousCertificate.Epoch == certificate.Epoch {
+		// Within an epoch every certificate is signed by the same signers.
+		if previousCertificateAVK != currentCertificateAVK {
+			return nil, errorsmod.Wrapf(ErrInvalidCertificate, "currentAvk and previousAvk are not match in epoch %d", certificate.Epoch)
+		}
+	} else {
+		// The first certificate of an epoch is signed by the signers its
+		// predecessor committed to.
 		nextAvk, err := FromAvkProto(string(nextAggregateVerificationKey))
 		if err != nil {
 			return nil, err
@@ -98,14 +197,109 @@ func (v *MithrilCertificateVerifier) VerifyStandardCertificate(
 		if err != nil {
 			return nil, err
 		}
+		if nextAvkJson != currentCertificateAVK {
+			return nil, errorsmod.Wrapf(ErrInvalidCertificate, "currentAvk and nextAvk are not match")
+		}
+	}
+
+	if err := verifyProtocolParametersContinuity(previousCertificate, certificate); err != nil {
+		return nil, err
+	}
+
+	return previousCertificate, nil
+}
+
+// verifyProtocolParametersContinuity checks that certificate is signed under
+// the protocol parameters its predecessor allows: the committed
+// next_protocol_parameters across epochs, and unchanged parameters otherwise.
+func verifyProtocolParametersContinuity(previousCertificate, certificate *Certificate) error {
+	currentParameters := certificate.Metadata.ProtocolParameters.ComputeHash()
+
+	if previousCertificate.Epoch != certificate.Epoch {
+		if nextParameters, ok := previousCertificate.ProtocolMessage.GetMessagePart(entities.NextProtocolParameters); ok {
+			if string(nextParameters) != currentParameters {
+				return errorsmod.Wrapf(ErrInvalidCertificate, "protocol parameters do not match next_protocol_parameters of the previous certificate")
+			}
+			return nil
+		}
+	}
+
+	if previousCertificate.Metadata.ProtocolParameters.ComputeHash() != currentParameters {
+		return errorsmod.Wrapf(ErrInvalidCertificate, "protocol parameters differ from the previous certificate")
+	}
+	return nil
+}
+
+// verifySignedEntityTypeEpoch checks that the signed entity type of a
+// certificate belongs to the certificate's epoch. A CardanoStakeDistribution
+// signs the stake distribution of the epoch preceding its certificate.
+func verifySignedEntityTypeEpoch(certificate *Certificate) error {
+	if certificate.Signature.MultiSignature == nil || certificate.Signature.MultiSignature.SignedEntityType == nil {
+		return errorsmod.Wrap(ErrInvalidCertificate, "certificate has no signed entity type")
+	}
 
-		if validCertificateHasDifferentEpochAsPrevious(nextAvkJson) {
-			return previousCertificate, nil
+	signedEntityType := certificate.Signature.MultiSignature.SignedEntityType
+	var epoch entities.Epoch
+	switch {
+	case signedEntityType.MithrilStakeDistribution != nil:
+		epoch = signedEntityType.MithrilStakeDistribution.Epoch
+	case signedEntityType.CardanoStakeDistribution != nil:
+		epoch = signedEntityType.CardanoStakeDistribution.Epoch + 1
+	case signedEntityType.CardanoImmutableFilesFull != nil && signedEntityType.CardanoImmutableFilesFull.CardanoDbBeacon != nil:
+		epoch = signedEntityType.CardanoImmutableFilesFull.CardanoDbBeacon.Epoch
+	case signedEntityType.CardanoTransactions != nil:
+		epoch = signedEntityType.CardanoTransactions.Epoch
+	case signedEntityType.CardanoBlocksTransactions != nil:
+		epoch = signedEntityType.CardanoBlocksTransactions.Epoch
+	default:
+		return errorsmod.Wrap(ErrInvalidCertificate, "certificate has no signed entity type")
+	}
+
+	if epoch != certificate.Epoch {
+		return errorsmod.Wrapf(ErrInvalidCertificate, "signed entity type epoch %d does not match certificate epoch %d", epoch, certificate.Epoch)
+	}
+	return nil
+}
+
+// VerifyGenesisCertificate checks that certificate carries a genesis signature
+// over its signed message made with the genesis verification key.
+func (v *MithrilCertificateVerifier) VerifyGenesisCertificate(certificate *Certificate) error {
+	if !certificate.IsGenesis() {
+		return errorsmod.Wrap(ErrInvalidCertificate, "certificate is not a genesis certificate")
+	}
+	if len(v.GenesisVerificationKey) != ed25519.PublicKeySize {
+		return errorsmod.Wrap(ErrUnsupportedGenesisSignature, "genesis verification key is not configured")
+	}
+	if !certificate.MatchMessage(certificate.ProtocolMessage) {
+		return errorsmod.Wrap(ErrInvalidCertificate, "genesis certificate signed message does not match its protocol message")
+	}
+	if !ed25519.Verify(v.GenesisVerificationKey, []byte(certificate.SignedMessage), certificate.Signature.GenesisSignature.Key) {
+		return errorsmod.Wrap(ErrInvalidCertificate, "invalid genesis signature")
+	}
+	return nil
+}
+
+// VerifyCertificateChain verifies certificate and every certificate it chains
+// from through previous_hash, until it reaches a genesis certificate.
+func (v *MithrilCertificateVerifier) VerifyCertificateChain(certificate *Certificate) error {
+	visited := make(map[string]bool)
+	for {
+		if visited[certificate.Hash] {
+			return errorsmod.Wrapf(ErrInvalidCertificate, "certificate chain loops at %s", certificate.Hash)
+		}
+		visited[certificate.Hash] = true
+
+		if certificate.IsGenesis() {
+			return v.VerifyGenesisCertificate(certificate)
+		}
+		if certificate.Signature.MultiSignature == nil {
+			return errorsmod.Wrapf(ErrInvalidCertificate, "certificate %s has no signature", certificate.Hash)
 		}
-		if validCertificateHasSameEpochAsPrevious() {
-			return previousCertificate, nil
+
+		previousCertificate, err := v.VerifyStandardCertificate(certificate, certificate.Signature.MultiSignature.ProtocolMultiSignature)
+		if err != nil {
+			return err
 		}
-		return nil, errorsmod.Wrapf(ErrInvalidCertificate, "currentAvk and nextAvk are not match")
+		certificate = previousCertificate
 	}
-	return nil, errorsmod.Wrapf(ErrInvalidCertificate, "can not get nextAvk from previous certificate")
 }
@@ -1,9 +1,13 @@
 package mithril
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/entities"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -218,6 +222,327 @@ func (s *CertificateVerifierSuite) TestVerifyStandardCertificate() {
 	})
 }
 
+func (s *CertificateVerifierSuite) TestVerifyStandardCertificateContinuity() {
+	verify := func(certificate MithrilCertificate, chain ...*MithrilCertificate) error {
+		parsed, err := FromCertificateProto(&certificate)
+		s.Require().NoError(err)
+		verifier := &MithrilCertificateVerifier{CertificateRetriever: newCertificateChainRetriever(chain)}
+		_, err = verifier.VerifyStandardCertificate(parsed, parsed.Signature.MultiSignature.ProtocolMultiSignature)
+		return err
+	}
+
+	s.Run("same epoch certificate signed by a different aggregate verification key", func() {
+		previous := cloneTestCertificate(certificates[1])
+		previous.AggregateVerificationKey = certificates[2].AggregateVerificationKey
+		err := verify(linkTestCertificate(s.T(), certificates[0], previous), previous)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "currentAvk and previousAvk are not match")
+	})
+
+	s.Run("new epoch certificate not signed by next aggregate verification key", func() {
+		previous := cloneTestCertificate(certificates[2])
+		setTestMessagePart(previous, PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY, certificates[2].AggregateVerificationKey)
+		err := verify(linkTestCertificate(s.T(), certificates[1], previous), previous)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "currentAvk and nextAvk are not match")
+	})
+
+	s.Run("new epoch protocol parameters differ from next_protocol_parameters", func() {
+		nextParameters := entities.ProtocolParameters{K: 2422, M: 20973, PhiF: 0.2}
+		previous := cloneTestCertificate(certificates[2])
+		setTestMessagePart(previous, PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS, nextParameters.ComputeHash())
+		s.Require().NoError(verify(linkTestCertificate(s.T(), certificates[1], previous), previous))
+
+		nextParameters.K++
+		setTestMessagePart(previous, PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS, nextParameters.ComputeHash())
+		err := verify(linkTestCertificate(s.T(), certificates[1], previous), previous)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "do not match next_protocol_parameters")
+	})
+
+	s.Run("same epoch protocol parameters change", func() {
+		previous := cloneTestCertificate(certificates[1])
+		previous.Metadata.ProtocolParameters.K++
+		err := verify(linkTestCertificate(s.T(), certificates[0], previous), previous)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "protocol parameters differ from the previous certificate")
+	})
+
+	s.Run("certificate skips an epoch", func() {
+		previous := cloneTestCertificate(certificates[2])
+		previous.Epoch--
+		err := verify(linkTestCertificate(s.T(), certificates[1], previous), previous)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "certificate of epoch 582 cannot follow a certificate of epoch 580")
+	})
+
+	s.Run("certificate follows a later epoch", func() {
+		previous := cloneTestCertificate(certificates[1])
+		previous.Epoch++
+		err := verify(linkTestCertificate(s.T(), certificates[0], previous), previous)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "certificate of epoch 582 cannot follow a certificate of epoch 583")
+	})
+
+	s.Run("tampered protocol message part", func() {
+		certificate := cloneTestCertificate(certificates[0])
+		setTestMessagePart(certificate, PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST, testHashHex(0x01))
+		*certificate = linkTestCertificate(s.T(), *certificate, nil)
+		err := verify(*certificate, &certificates[1])
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "signed message does not match its protocol message")
+
+		verifier := &MithrilCertificateVerifier{
+			CertificateRetriever: newCertificateChainRetriever([]*MithrilCertificate{&certificates[1]}),
+			batch:                &multiSignatureBatch{},
+		}
+		parsed, err := FromCertificateProto(certificate)
+		s.Require().NoError(err)
+		_, err = verifier.VerifyStandardCertificate(parsed, parsed.Signature.MultiSignature.ProtocolMultiSignature)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().Empty(verifier.batch.signatures)
+	})
+
+	s.Run("signed entity type epoch differs from certificate epoch", func() {
+		certificate := cloneTestCertificate(certificates[0])
+		certificate.SignedEntityType.GetCardanoImmutableFilesFull().Beacon.Epoch++
+		err := verify(linkTestCertificate(s.T(), *certificate, nil), &certificates[1])
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "signed entity type epoch 583 does not match certificate epoch 582")
+	})
+}
+
+func (s *CertificateVerifierSuite) TestVerifySignedEntityTypeEpoch() {
+	newCertificate := func(epoch entities.Epoch, signedEntityType *entities.SignedEntityType) *Certificate {
+		return &Certificate{
+			Epoch:     epoch,
+			Signature: CertificateSignature{MultiSignature: &MultiSignature{SignedEntityType: signedEntityType}},
+		}
+	}
+
+	s.Require().NoError(verifySignedEntityTypeEpoch(newCertificate(10, &entities.SignedEntityType{
+		MithrilStakeDistribution: &entities.MithrilStakeDistribution{Epoch: 10},
+	})))
+	s.Require().NoError(verifySignedEntityTypeEpoch(newCertificate(10, &entities.SignedEntityType{
+		CardanoTransactions: &entities.CardanoTransactions{Epoch: 10, BlockNumber: 100},
+	})))
+	// A Cardano stake distribution is certified in the epoch after it was computed.
+	s.Require().NoError(verifySignedEntityTypeEpoch(newCertificate(10, &entities.SignedEntityType{
+		CardanoStakeDistribution: &entities.CardanoStakeDistribution{Epoch: 9},
+	})))
+	s.Require().ErrorIs(verifySignedEntityTypeEpoch(newCertificate(10, &entities.SignedEntityType{
+		CardanoStakeDistribution: &entities.CardanoStakeDistribution{Epoch: 10},
+	})), ErrInvalidCertificate)
+	s.Require().ErrorIs(verifySignedEntityTypeEpoch(newCertificate(10, &entities.SignedEntityType{})), ErrInvalidCertificate)
+}
+
+func (s *CertificateVerifierSuite) TestVerifyMultiSignatureBatch() {
+	retriever := SetUpCertificateRetriever()
+	first, err := retriever.GetCertificateDetails(certificates[0].Hash)
+	s.Require().NoError(err)
+	second, err := retriever.GetCertificateDetails(certificates[1].Hash)
+	s.Require().NoError(err)
+
+	s.Run("deferred to the batch", func() {
+		verifier := SetUpCertificateVerifier()
+		verifier.batch = &multiSignatureBatch{}
+		_, err := verifier.VerifyStandardCertificate(first, first.Signature.MultiSignature.ProtocolMultiSignature)
+		s.Require().NoError(err)
+		_, err = verifier.VerifyStandardCertificate(second, second.Signature.MultiSignature.ProtocolMultiSignature)
+		s.Require().NoError(err)
+		s.Require().Len(verifier.batch.signatures, 2)
+		s.Require().NoError(verifier.batch.verify())
+	})
+
+	s.Run("fallback names the invalid certificate", func() {
+		batch := &multiSignatureBatch{}
+		batch.add(first, first.Signature.MultiSignature.ProtocolMultiSignature)
+		batch.add(second, first.Signature.MultiSignature.ProtocolMultiSignature)
+		err := batch.verify()
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "multi-signature of certificate "+second.Hash+" is invalid")
+	})
+
+	s.Run("empty batch", func() {
+		s.Require().NoError((&multiSignatureBatch{}).verify())
+	})
+}
+
+func cloneTestCertificate(certificate MithrilCertificate) *MithrilCertificate {
+	return proto.Clone(&certificate).(*MithrilCertificate)
+}
+
+// linkTestCertificate recomputes the hash of previous, when given, after the
+// test changed it and returns a copy of certificate chained to it with its own
+// hash recomputed.
+func linkTestCertificate(t *testing.T, certificate MithrilCertificate, previous *MithrilCertificate) MithrilCertificate {
+	t.Helper()
+
+	linked := cloneTestCertificate(certificate)
+	if previous != nil {
+		previous.Hash = computeTestCertificateHash(t, previous)
+		linked.PreviousHash = previous.Hash
+	}
+	linked.Hash = computeTestCertificateHash(t, linked)
+	return *linked
+}
+
+func computeTestCertificateHash(t *testing.T, certificate *MithrilCertificate) string {
+	t.Helper()
+
+	hash, err := certificate.ComputeHash()
+	if err != nil {
+		t.Fatalf("failed to hash certificate: %v", err)
+	}
+	return hash
+}
+
+// newTestCertificateChain returns the fixture certificates rebuilt on top of a
+// genesis certificate signed by privateKey, from the latest certificate back
+// to genesis.
+func newTestCertificateChain(t *testing.T, privateKey ed25519.PrivateKey) []MithrilCertificate {
+	t.Helper()
+
+	genesis := newTestGenesisCertificate(t, privateKey, certificates[2])
+	middle := linkTestCertificate(t, certificates[1], &genesis)
+	latest := linkTestCertificate(t, certificates[0], &middle)
+	return []MithrilCertificate{latest, middle, genesis}
+}
+
+func setTestMessagePart(certificate *MithrilCertificate, key ProtocolMessagePartKey, value string) {
+	for _, part := range certificate.ProtocolMessage.MessageParts {
+		if part.ProtocolMessagePartKey == key {
+			part.ProtocolMessagePartValue = value
+			return
+		}
+	}
+	certificate.ProtocolMessage.MessageParts = append(certificate.ProtocolMessage.MessageParts, &MessagePart{
+		ProtocolMessagePartKey:   key,
+		ProtocolMessagePartValue: value,
+	})
+}
+
+func (s *CertificateVerifierSuite) TestVerifyCertificateChain() {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	chain := newTestCertificateChain(s.T(), privateKey)
+	middleCertificate, genesisCertificate := chain[1], chain[2]
+
+	latestCertificate, err := FromCertificateProto(&chain[0])
+	s.Require().NoError(err)
+
+	s.Run("chains back to genesis", func() {
+		verifier := &MithrilCertificateVerifier{
+			CertificateRetriever:   newCertificateChainRetriever([]*MithrilCertificate{&middleCertificate, &genesisCertificate}),
+			GenesisVerificationKey: publicKey,
+		}
+		s.Require().NoError(verifier.VerifyCertificateChain(latestCertificate))
+	})
+
+	s.Run("missing link", func() {
+		verifier := &MithrilCertificateVerifier{
+			CertificateRetriever:   newCertificateChainRetriever([]*MithrilCertificate{&genesisCertificate}),
+			GenesisVerificationKey: publicKey,
+		}
+		s.Require().ErrorContains(verifier.VerifyCertificateChain(latestCertificate), "hash not found")
+	})
+
+	s.Run("chain ends without genesis", func() {
+		verifier := &MithrilCertificateVerifier{
+			CertificateRetriever:   newCertificateChainRetriever([]*MithrilCertificate{&middleCertificate, &certificates[2]}),
+			GenesisVerificationKey: publicKey,
+		}
+		s.Require().Error(verifier.VerifyCertificateChain(latestCertificate))
+	})
+
+	s.Run("epoch gap before genesis", func() {
+		genesisProto := cloneTestCertificate(certificates[2])
+		genesisProto.Epoch--
+		genesis := newTestGenesisCertificate(s.T(), privateKey, *genesisProto)
+		middle := linkTestCertificate(s.T(), certificates[1], &genesis)
+		latest := linkTestCertificate(s.T(), certificates[0], &middle)
+		parsed, err := FromCertificateProto(&latest)
+		s.Require().NoError(err)
+
+		verifier := &MithrilCertificateVerifier{
+			CertificateRetriever:   newCertificateChainRetriever([]*MithrilCertificate{&middle, &genesis}),
+			GenesisVerificationKey: publicKey,
+		}
+		err = verifier.VerifyCertificateChain(parsed)
+		s.Require().ErrorIs(err, ErrInvalidCertificate)
+		s.Require().ErrorContains(err, "cannot follow a certificate of epoch 580")
+	})
+
+	s.Run("relabelled certificate hash", func() {
+		relabelled := *cloneTestCertificate(middleCertificate)
+		relabelled.Hash = testHashHex(0x01)
+		latest := cloneTestCertificate(chain[0])
+		latest.PreviousHash = relabelled.Hash
+		linked := linkTestCertificate(s.T(), *latest, nil)
+		parsed, err := FromCertificateProto(&linked)
+		s.Require().NoError(err)
+
+		verifier := &MithrilCertificateVerifier{
+			CertificateRetriever:   newCertificateChainRetriever([]*MithrilCertificate{&relabelled, &genesisCertificate}),
+			GenesisVerificationKey: publicKey,
+		}
+		s.Require().ErrorContains(verifier.VerifyCertificateChain(parsed), "does not match its computed hash")
+	})
+}
+
+func (s *CertificateVerifierSuite) TestVerifyGenesisCertificate() {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	otherPublicKey, _, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+
+	genesisProto := newTestGenesisCertificate(s.T(), privateKey, certificates[2])
+	genesisCertificate, err := FromCertificateProto(&genesisProto)
+	s.Require().NoError(err)
+	s.Require().True(genesisCertificate.IsGenesis())
+
+	verifier := &MithrilCertificateVerifier{GenesisVerificationKey: publicKey}
+	s.Require().NoError(verifier.VerifyGenesisCertificate(genesisCertificate))
+
+	s.Run("wrong genesis verification key", func() {
+		verifier := &MithrilCertificateVerifier{GenesisVerificationKey: otherPublicKey}
+		s.Require().ErrorContains(verifier.VerifyGenesisCertificate(genesisCertificate), "invalid genesis signature")
+	})
+
+	s.Run("missing genesis verification key", func() {
+		verifier := &MithrilCertificateVerifier{}
+		s.Require().ErrorIs(verifier.VerifyGenesisCertificate(genesisCertificate), ErrUnsupportedGenesisSignature)
+	})
+
+	s.Run("signed message does not match protocol message", func() {
+		tampered := *genesisCertificate
+		tampered.SignedMessage = testHashHex(0x01)
+		s.Require().ErrorContains(verifier.VerifyGenesisCertificate(&tampered), "does not match its protocol message")
+	})
+
+	s.Run("standard certificate", func() {
+		standardCertificate, err := FromCertificateProto(&certificates[0])
+		s.Require().NoError(err)
+		s.Require().ErrorContains(verifier.VerifyGenesisCertificate(standardCertificate), "not a genesis certificate")
+	})
+}
+
+// newTestGenesisCertificate turns certificate into a genesis certificate
+// signed by privateKey.
+func newTestGenesisCertificate(t *testing.T, privateKey ed25519.PrivateKey, certificate MithrilCertificate) MithrilCertificate {
+	t.Helper()
+
+	protocolMessage, err := FromProtocolMessageProto(certificate.ProtocolMessage)
+	if err != nil {
+		t.Fatalf("failed to parse protocol message: %v", err)
+	}
+	certificate.MultiSignature = ""
+	certificate.SignedMessage = protocolMessage.ComputeHash()
+	certificate.GenesisSignature = hex.EncodeToString(ed25519.Sign(privateKey, []byte(certificate.SignedMessage)))
+	certificate.Hash = computeTestCertificateHash(t, &certificate)
+	return certificate
+}
+
 type MockCertificateRetriever struct{}
 
 func (m *MockCertificateRetriever) GetCertificateDetails(hash string) (*Certificate, error) {
@@ -1,6 +1,7 @@
 package mithril
 
 import (
+	"crypto/ed25519"
 	"fmt"
 	"strings"
 	"time"
@@ -70,7 +71,8 @@ func (ClientState) GetTimestampAtHeight(
 // Status returns the status of the mithril client.
 // The client may be:
 // - Active: FrozenHeight is zero and client is not expired
-// - Frozen: Frozen Height is not zero
+// - Frozen: Frozen Height is not zero, or the grace period of a HostState
+// shutdown has ended
 // - Expired: the latest consensus state timestamp + trusting period <= current time
 //
 // A frozen client will become expired, so the Frozen status
@@ -85,6 +87,12 @@ func (cs ClientState) Status(
 	if cs.FrozenHeight != nil && !cs.FrozenHeight.IsZero() {
 		return exported.Frozen
 	}
+	// A shut-down HostState never returns to Active. Proofs below the shutdown
+	// height stay verifiable until the grace period ends, so that packets can
+	// still time out and be refunded; the client is frozen afterwards.
+	if cs.isHostStateShutdown() && cs.hostStateGracePeriodEnded(ctx, clientStore, cdc) {
+		return exported.Frozen
+	}
 
 	// LatestHeight is required for any meaningful status check. If it is missing,
 	// we cannot locate a consensus state and must treat the client as expired.
@@ -135,6 +143,9 @@ func (cs ClientState) Validate() error {
 	if cs.LatestHeight.RevisionHeight == 0 {
 		return errorsmod.Wrapf(ErrInvalidMithrilHeaderHeight, "mithril client's latest height revision height cannot be zero")
 	}
+	if _, ok := ConsensusStateKeyFormat_name[int32(cs.ConsensusStateKeyFormat)]; !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "unknown consensus_state_key_format %d", cs.ConsensusStateKeyFormat)
+	}
 
 	if cs.CurrentEpoch < 2 {
 		return errorsmod.Wrapf(ErrInvalidHeaderEpoch, "mithril client's current epoch cannot be less than 2")
@@ -160,6 +171,11 @@ func (cs ClientState) Validate() error {
 		return errorsmod.Wrap(ErrInvalidProtocolParamaters, err.Error())
 	}
 
+	// Clients created before genesis anchoring carry no genesis verification key.
+	if len(cs.GenesisVerificationKey) != 0 && len(cs.GenesisVerificationKey) != ed25519.PublicKeySize {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "genesis_verification_key must be %d bytes", ed25519.PublicKeySize)
+	}
+
 	// UpgradePath may be empty, but if it isn't, each key must be non-empty
 	for i, k := range cs.UpgradePath {
 		if strings.TrimSpace(k) == "" {
@@ -197,11 +213,15 @@ func (cs ClientState) ZeroCustomFields() exported.ClientState {
 	// copy over all chain-specified fields
 	// and leave custom fields empty
 	return &ClientState{
-		ChainId:               cs.ChainId,
-		LatestHeight:          cs.LatestHeight,
-		UpgradePath:           cs.UpgradePath,
-		HostStateNftPolicyId:  cs.HostStateNftPolicyId,
-		HostStateNftTokenName: cs.HostStateNftTokenName,
+		ChainId:                 cs.ChainId,
+		LatestHeight:            cs.LatestHeight,
+		CurrentEpoch:            cs.CurrentEpoch,
+		ProtocolParameters:      cs.ProtocolParameters,
+		UpgradePath:             cs.UpgradePath,
+		HostStateNftPolicyId:    cs.HostStateNftPolicyId,
+		HostStateNftTokenName:   cs.HostStateNftTokenName,
+		GenesisVerificationKey:  cs.GenesisVerificationKey,
+		ConsensusStateKeyFormat: cs.ConsensusStateKeyFormat,
 	}
 }
 
@@ -214,6 +234,12 @@ func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientS
 			&ConsensusState{}, consState)
 	}
 
+	if err := cs.verifyInitialCertificateChain(consensusState); err != nil {
+		return err
+	}
+	// The chain back to genesis is only needed once.
+	cs.InitialCertificateChain = nil
+
 	setClientState(clientStore, cdc, &cs)
 	setConsensusState(clientStore, cdc, consensusState, cs.LatestHeight)
 	setConsensusMetadata(ctx, clientStore, cs.LatestHeight)
@@ -224,6 +250,38 @@ func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientS
 	return nil
 }
 
+// verifyInitialCertificateChain checks that the initial stake distribution
+// certificate chains back to a genesis certificate signed by the client's
+// genesis verification key, so client creation does not trust it on first use.
+func (cs ClientState) verifyInitialCertificateChain(consensusState *ConsensusState) error {
+	if consensusState.FirstCertHashLatestEpoch == nil {
+		return errorsmod.Wrap(ErrInvalidCertificate, "initial stake distribution certificate cannot be nil")
+	}
+	initialCertificate, err := FromCertificateProto(consensusState.FirstCertHashLatestEpoch)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidCertificate, "initial stake distribution certificate cannot be parsed: %v", err)
+	}
+
+	verifier := &MithrilCertificateVerifier{
+		CertificateRetriever:   newCertificateChainRetriever(cs.InitialCertificateChain),
+		GenesisVerificationKey: cs.GenesisVerificationKey,
+	}
+	if err := verifier.VerifyCertificateChain(initialCertificate); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCertificate, "initial stake distribution certificate does not chain back to genesis: %v", err)
+	}
+	return nil
+}
+
+// headerHeight returns the height of header under the client's current Cardano
+// revision.
+func (cs ClientState) headerHeight(header *MithrilHeader) Height {
+	var revisionNumber uint64
+	if cs.LatestHeight != nil {
+		revisionNumber = cs.LatestHeight.GetRevisionNumber()
+	}
+	return NewHeight(revisionNumber, header.TransactionSnapshot.BlockNumber)
+}
+
 // GetLatestHeight returns latest block height.
 func (cs ClientState) GetLatestHeight() exported.Height {
 	if cs.LatestHeight == nil {
@@ -255,12 +313,26 @@ func (cs ClientState) VerifyMembership(
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
 	}
+	if err := cs.verifyHostStateNotShutdown(height, consState); err != nil {
+		return err
+	}
 
-	key, err := ibcStateKeyFromPath(path)
+	key, err := ibcStateKeyFromPath(path, cs.ConsensusStateKeyFormat)
 	if err != nil {
 		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
 	}
 
+	transactionProof, err := decodeTransactionMembershipProof(proof)
+	if err != nil {
+		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
+	}
+	if transactionProof != nil {
+		if err := cs.verifyTransactionMembership(consState, key, value, transactionProof); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
+		}
+		return nil
+	}
+
 	if err := VerifyIbcStateMembership(consState.IbcStateRoot, key, value, proof); err != nil {
 		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
 	}
@@ -271,6 +343,50 @@ func (cs ClientState) VerifyMembership(
 // VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
 // If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+// VerifyBatchMembership verifies that every path holds the value at the same
+// index under the root of the consensus state at height, with a single IBC
+// state multi-proof. Transaction membership proofs are not batched.
+func (cs ClientState) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(clienttypes.ErrFailedMembershipVerification, "got %d paths and %d values", len(paths), len(values))
+	}
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
+	}
+	if err := cs.verifyHostStateNotShutdown(height, consState); err != nil {
+		return err
+	}
+
+	items := make([]IbcStateMembershipItem, 0, len(paths))
+	for i, path := range paths {
+		key, err := ibcStateKeyFromPath(path, cs.ConsensusStateKeyFormat)
+		if err != nil {
+			return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
+		}
+		items = append(items, IbcStateMembershipItem{Key: key, Value: values[i]})
+	}
+
+	if err := VerifyIbcStateBatchMembership(consState.IbcStateRoot, items, proof); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
+	}
+	return nil
+}
+
 func (cs ClientState) VerifyNonMembership(
 	ctx sdk.Context,
 	clientStore storetypes.KVStore,
@@ -289,8 +405,11 @@ func (cs ClientState) VerifyNonMembership(
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
 	}
+	if err := cs.verifyHostStateNotShutdown(height, consState); err != nil {
+		return err
+	}
 
-	key, err := ibcStateKeyFromPath(path)
+	key, err := ibcStateKeyFromPath(path, cs.ConsensusStateKeyFormat)
 	if err != nil {
 		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
 	}
@@ -302,7 +421,7 @@ func (cs ClientState) VerifyNonMembership(
 	return nil
 }
 
-func ibcStateKeyFromPath(path exported.Path) ([]byte, error) {
+func ibcStateKeyFromPath(path exported.Path, keyFormat ConsensusStateKeyFormat) ([]byte, error) {
 	mpath, ok := path.(commitmenttypesv2.MerklePath)
 	if !ok {
 		return nil, fmt.Errorf("path is not a MerklePath")
@@ -317,14 +436,17 @@ func ibcStateKeyFromPath(path exported.Path) ([]byte, error) {
 	// Height note (IBC vs Cardano)
 	// - Canonical IBC consensus state keys are formatted as:
 	//     `clients/<client-id>/consensusStates/<revisionNumber>-<revisionHeight>`
-	// - The current Cardano commitment scheme (and Gateway tree) uses only the
+	// - The legacy Cardano commitment scheme (and Gateway tree) uses only the
 	//   `revisionHeight` segment:
 	//     `clients/<client-id>/consensusStates/<revisionHeight>`
 	//
-	// Until Cardano switches to the canonical key format, we bridge the mismatch
-	// here so the light client verifies against the key actually committed under
-	// `ibc_state_root`.
+	// Clients created against a legacy tree bridge the mismatch here so the light
+	// client verifies against the key actually committed under `ibc_state_root`.
+	// Canonical keys are verified as given.
 	key := mpath.KeyPath[len(mpath.KeyPath)-1]
+	if keyFormat == CONSENSUS_STATE_KEY_FORMAT_CANONICAL {
+		return key, nil
+	}
 	keyStr := string(key)
 	if strings.Contains(keyStr, "/consensusStates/") {
 		parts := strings.SplitN(keyStr, "/consensusStates/", 2)
@@ -0,0 +1,147 @@
+package mithril
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializeRequiresChainToGenesis(t *testing.T) {
+	cdc := newTestCodec()
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPublicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	chain := newTestCertificateChain(t, privateKey)
+
+	newInitialStates := func() (*ClientState, *ConsensusState) {
+		clientState := newTestClientState(100, 582, "cardano-test", 24*time.Hour)
+		clientState.GenesisVerificationKey = publicKey
+		clientState.InitialCertificateChain = []*MithrilCertificate{&chain[1], &chain[2]}
+
+		consensusState := newTestConsensusState(0x10)
+		initialCertificate := chain[0]
+		consensusState.FirstCertHashLatestEpoch = &initialCertificate
+		return clientState, consensusState
+	}
+
+	t.Run("chains back to genesis", func(t *testing.T) {
+		ctx, clientStore := newTestClientStore(t, "initialize")
+		clientState, consensusState := newInitialStates()
+		require.NoError(t, clientState.Validate())
+		require.NoError(t, clientState.Initialize(ctx, cdc, clientStore, consensusState))
+
+		stored, found := getClientState(clientStore, cdc)
+		require.True(t, found)
+		require.Empty(t, stored.InitialCertificateChain)
+		require.Equal(t, []byte(publicKey), stored.GenesisVerificationKey)
+		require.Equal(t, chain[0].Hash, getFcInEpoch(clientStore, 582).Hash)
+	})
+
+	testCases := []struct {
+		name   string
+		mutate func(*ClientState, *ConsensusState)
+		want   string
+	}{
+		{
+			name:   "missing genesis verification key",
+			mutate: func(cs *ClientState, _ *ConsensusState) { cs.GenesisVerificationKey = nil },
+			want:   "genesis verification key is not configured",
+		},
+		{
+			name:   "wrong genesis verification key",
+			mutate: func(cs *ClientState, _ *ConsensusState) { cs.GenesisVerificationKey = otherPublicKey },
+			want:   "invalid genesis signature",
+		},
+		{
+			name:   "missing link to genesis",
+			mutate: func(cs *ClientState, _ *ConsensusState) { cs.InitialCertificateChain = cs.InitialCertificateChain[1:] },
+			want:   "hash not found",
+		},
+		{
+			name: "chain without genesis certificate",
+			mutate: func(cs *ClientState, consensusState *ConsensusState) {
+				initialCertificate := certificates[0]
+				consensusState.FirstCertHashLatestEpoch = &initialCertificate
+				cs.InitialCertificateChain = []*MithrilCertificate{&certificates[1], &certificates[2]}
+			},
+			want: "does not chain back to genesis",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, clientStore := newTestClientStore(t, "initialize")
+			clientState, consensusState := newInitialStates()
+			tc.mutate(clientState, consensusState)
+
+			err := clientState.Initialize(ctx, cdc, clientStore, consensusState)
+			require.ErrorIs(t, err, ErrInvalidCertificate)
+			require.ErrorContains(t, err, tc.want)
+
+			_, found := getClientState(clientStore, cdc)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestValidateGenesisVerificationKeyLength(t *testing.T) {
+	clientState := newTestClientState(100, 582, "cardano-test", 24*time.Hour)
+	require.NoError(t, clientState.Validate())
+
+	clientState.GenesisVerificationKey = []byte{0x01}
+	require.ErrorContains(t, clientState.Validate(), "genesis_verification_key must be 32 bytes")
+}
+
+func TestIbcStateKeyFromPathConsensusStateKeyFormats(t *testing.T) {
+	path := commitmenttypesv2.NewMerklePath([]byte("ibc"), []byte("clients/07-tendermint-0/consensusStates/3-120"))
+
+	legacyKey, err := ibcStateKeyFromPath(path, CONSENSUS_STATE_KEY_FORMAT_LEGACY)
+	require.NoError(t, err)
+	require.Equal(t, "clients/07-tendermint-0/consensusStates/120", string(legacyKey))
+
+	canonicalKey, err := ibcStateKeyFromPath(path, CONSENSUS_STATE_KEY_FORMAT_CANONICAL)
+	require.NoError(t, err)
+	require.Equal(t, "clients/07-tendermint-0/consensusStates/3-120", string(canonicalKey))
+
+	clientStatePath := commitmenttypesv2.NewMerklePath([]byte("ibc"), []byte("clients/07-tendermint-0/clientState"))
+	for _, keyFormat := range []ConsensusStateKeyFormat{CONSENSUS_STATE_KEY_FORMAT_LEGACY, CONSENSUS_STATE_KEY_FORMAT_CANONICAL} {
+		key, err := ibcStateKeyFromPath(clientStatePath, keyFormat)
+		require.NoError(t, err)
+		require.Equal(t, "clients/07-tendermint-0/clientState", string(key))
+	}
+}
+
+func TestValidateConsensusStateKeyFormat(t *testing.T) {
+	clientState := newTestClientState(100, 582, "cardano-test", 24*time.Hour)
+	clientState.ConsensusStateKeyFormat = CONSENSUS_STATE_KEY_FORMAT_CANONICAL
+	require.NoError(t, clientState.Validate())
+
+	clientState.ConsensusStateKeyFormat = ConsensusStateKeyFormat(7)
+	require.ErrorContains(t, clientState.Validate(), "unknown consensus_state_key_format")
+}
+
+func TestHeaderHeightUsesClientRevision(t *testing.T) {
+	clientState := newTestClientState(100, 10, "cardano-test", 24*time.Hour)
+	header := newTestMisbehaviourHeader(150, 10, time.Unix(1_700_000_000, 0), 0x10)
+	require.Equal(t, NewHeight(0, 150), clientState.headerHeight(header))
+
+	revisionHeight := NewHeight(3, 100)
+	clientState.LatestHeight = &revisionHeight
+	height := clientState.headerHeight(header)
+	require.Equal(t, NewHeight(3, 150), height)
+	require.Equal(t, "3-150", height.String())
+
+	// A later revision orders after any height of an earlier one.
+	require.True(t, NewHeight(3, 1).GT(NewHeight(2, 1000)))
+	require.True(t, NewHeight(3, 1).LT(NewHeight(3, 2)))
+
+	parsed, err := ParseHeight("3-150")
+	require.NoError(t, err)
+	require.Equal(t, height, parsed)
+	parsed, err = ParseHeight("150")
+	require.NoError(t, err)
+	require.Equal(t, NewHeight(0, 150), parsed)
+}
@@ -27,3 +27,21 @@ func (br *BlockRange) ToMKTreeNode() *MKTreeNode {
 		Hash: buffer.Bytes(),
 	}
 }
+
+// Block is the key of a block in the Merkle map of a block range certified by
+// a CardanoBlocksTransactions snapshot.
+type Block struct {
+	BlockNumber uint64 `json:"block_number"`
+	BlockHash   string `json:"block_hash"`
+}
+
+func (b *Block) ToMKTreeNode() *MKTreeNode {
+	var buffer bytes.Buffer
+	buffer.WriteString(strconv.FormatUint(b.BlockNumber, 10))
+	buffer.WriteString("-")
+	buffer.WriteString(b.BlockHash)
+
+	return &MKTreeNode{
+		Hash: buffer.Bytes(),
+	}
+}
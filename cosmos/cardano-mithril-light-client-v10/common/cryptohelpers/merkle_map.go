@@ -5,9 +5,24 @@ import (
 	"fmt"
 )
 
+// SubProof is the proof of the value of a Merkle map leaf, keyed either by a
+// block range or, inside a block range, by a block.
 type SubProof struct {
 	*BlockRange
 	*MKMapProof
+	Block *Block
+}
+
+// Key returns the Merkle tree node of the sub proof key.
+func (p *SubProof) Key() (*MKTreeNode, error) {
+	switch {
+	case p.BlockRange != nil && p.BlockRange.InnerRange != nil && p.Block == nil:
+		return p.BlockRange.ToMKTreeNode(), nil
+	case p.Block != nil && p.BlockRange == nil:
+		return p.Block.ToMKTreeNode(), nil
+	default:
+		return nil, fmt.Errorf("invalid sub proof format: sub proof must be keyed by a block range or a block")
+	}
 }
 
 type MKMapProof struct {
@@ -26,17 +41,30 @@ func (p *SubProof) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	blockRangeMap, ok := response[0].(map[string]interface{})
+	if len(response) != 2 {
+		return fmt.Errorf("invalid sub proof format")
+	}
+
+	keyMap, ok := response[0].(map[string]interface{})
 	if !ok {
 		return fmt.Errorf("invalid sub proof format: invalid block range format")
 	}
-	blockRangeData, err := json.Marshal(blockRangeMap)
+	keyData, err := json.Marshal(keyMap)
 	if err != nil {
 		return err
 	}
-	blockRange := &BlockRange{}
-	if err := json.Unmarshal(blockRangeData, blockRange); err != nil {
-		return err
+	var blockRange *BlockRange
+	var block *Block
+	if _, isBlock := keyMap["block_hash"]; isBlock {
+		block = &Block{}
+		if err := json.Unmarshal(keyData, block); err != nil {
+			return err
+		}
+	} else {
+		blockRange = &BlockRange{}
+		if err := json.Unmarshal(keyData, blockRange); err != nil {
+			return err
+		}
 	}
 
 	mkMapProofMap, ok := response[1].(map[string]interface{})
@@ -53,6 +81,7 @@ func (p *SubProof) UnmarshalJSON(data []byte) error {
 	}
 
 	p.BlockRange = blockRange
+	p.Block = block
 	p.MKMapProof = mkMapProof
 
 	return nil
@@ -63,8 +92,15 @@ func (proof *MKMapProof) ComputeRoot() *MKTreeNode {
 }
 
 func (proof *MKMapProof) Verify() error {
+	if proof.MasterProof == nil || proof.MasterProof.InnerRoot == nil {
+		return fmt.Errorf("MKMapProof has no master proof")
+	}
+
 	// Verify each sub-proof
 	for _, subProof := range proof.SubProofs {
+		if subProof == nil || subProof.MKMapProof == nil {
+			return fmt.Errorf("MKMapProof has an empty sub proof")
+		}
 		err := subProof.MKMapProof.Verify()
 		if err != nil {
 			return fmt.Errorf("MKMapProof could not verify sub proof: %w", err)
@@ -80,7 +116,11 @@ func (proof *MKMapProof) Verify() error {
 	if len(proof.SubProofs) > 0 {
 		var leaves []*MKTreeNode
 		for _, subProof := range proof.SubProofs {
-			leaf := subProof.BlockRange.ToMKTreeNode().Add(subProof.ComputeRoot())
+			key, err := subProof.Key()
+			if err != nil {
+				return fmt.Errorf("MKMapProof could not verify sub proof: %w", err)
+			}
+			leaf := key.Add(subProof.ComputeRoot())
 			leaves = append(leaves, leaf)
 		}
 
@@ -111,6 +111,25 @@ func (h *Blake2s256Hasher) Hash(data []byte) ([]byte, error) {
 	return hasher.Sum(nil), nil
 }
 
+// ComputeMKTreeRoot returns the root of the Merkle mountain range holding the
+// given leaves in order, bagging peaks right to left as the aggregator does.
+func ComputeMKTreeRoot(leaves []*MKTreeNode) (*MKTreeNode, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("cannot compute MKTree root without leaves")
+	}
+	tree := mmr.NewMMR(0, mmr.NewMemStore(), nil, &Blake2s256Hasher{})
+	for _, leaf := range leaves {
+		if _, err := tree.Push(leaf.Hash); err != nil {
+			return nil, err
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	return &MKTreeNode{Hash: root}, nil
+}
+
 func LeafIndexToPos(index uint64) uint64 {
 	return LeafIndexToMMRSize(index) - uint64(bits.TrailingZeros64(index+1)) - 1
 }
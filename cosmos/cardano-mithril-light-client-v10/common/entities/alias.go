@@ -3,7 +3,7 @@ package entities
 import (
 	"encoding/hex"
 	"fmt"
-	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/crypto"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
 )
 
 type ImmutableFileNumber = uint64
@@ -3,29 +3,41 @@ package entities
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"sort"
 )
 
 type ProtocolMessagePartKey string
 
 const (
-	SnapshotDigest                ProtocolMessagePartKey = "SnapshotDigest"
-	CardanoTransactionsMerkleRoot ProtocolMessagePartKey = "CardanoTransactionsMerkleRoot"
-	NextAggregateVerificationKey  ProtocolMessagePartKey = "NextAggregateVerificationKey"
-	LatestImmutableFileNumber     ProtocolMessagePartKey = "LatestImmutableFileNumber"
+	SnapshotDigest                      ProtocolMessagePartKey = "snapshot_digest"
+	CardanoTransactionsMerkleRoot       ProtocolMessagePartKey = "cardano_transactions_merkle_root"
+	NextAggregateVerificationKey        ProtocolMessagePartKey = "next_aggregate_verification_key"
+	NextProtocolParameters              ProtocolMessagePartKey = "next_protocol_parameters"
+	CurrentEpoch                        ProtocolMessagePartKey = "current_epoch"
+	LatestBlockNumber                   ProtocolMessagePartKey = "latest_block_number"
+	CardanoStakeDistributionEpoch       ProtocolMessagePartKey = "cardano_stake_distribution_epoch"
+	CardanoStakeDistributionMerkleRoot  ProtocolMessagePartKey = "cardano_stake_distribution_merkle_root"
+	LatestImmutableFileNumber           ProtocolMessagePartKey = "latest_immutable_file_number"
+	CardanoBlocksTransactionsMerkleRoot ProtocolMessagePartKey = "cardano_blocks_transactions_merkle_root"
 )
 
+// protocolMessagePartKeyOrder is the order in which the aggregator hashes
+// message parts, following the declaration order of its ordered map keys.
+var protocolMessagePartKeyOrder = []ProtocolMessagePartKey{
+	SnapshotDigest,
+	CardanoTransactionsMerkleRoot,
+	CardanoBlocksTransactionsMerkleRoot,
+	NextAggregateVerificationKey,
+	NextProtocolParameters,
+	CurrentEpoch,
+	LatestBlockNumber,
+	CardanoStakeDistributionEpoch,
+	CardanoStakeDistributionMerkleRoot,
+	LatestImmutableFileNumber,
+}
+
 func (k ProtocolMessagePartKey) String() string {
-	switch k {
-	case SnapshotDigest:
-		return "snapshot_digest"
-	case NextAggregateVerificationKey:
-		return "next_aggregate_verification_key"
-	case CardanoTransactionsMerkleRoot:
-		return "cardano_transactions_merkle_root"
-	case LatestImmutableFileNumber:
-		return "latest_immutable_file_number"
-	}
-	return ""
+	return string(k)
 }
 
 type ProtocolMessagePartValue string
@@ -61,9 +73,28 @@ func (pm *ProtocolMessage) GetMessagePart(
 
 func (pm *ProtocolMessage) ComputeHash() string {
 	hasher := sha256.New()
-	for key, value := range pm.MessageParts {
+	for _, key := range pm.orderedKeys() {
 		hasher.Write([]byte(key.String()))
-		hasher.Write([]byte(value))
+		hasher.Write([]byte(pm.MessageParts[key]))
 	}
 	return hex.EncodeToString(hasher.Sum(nil))
 }
+
+func (pm *ProtocolMessage) orderedKeys() []ProtocolMessagePartKey {
+	keys := make([]ProtocolMessagePartKey, 0, len(pm.MessageParts))
+	known := make(map[ProtocolMessagePartKey]struct{}, len(protocolMessagePartKeyOrder))
+	for _, key := range protocolMessagePartKeyOrder {
+		known[key] = struct{}{}
+		if _, exists := pm.MessageParts[key]; exists {
+			keys = append(keys, key)
+		}
+	}
+	unknown := make([]ProtocolMessagePartKey, 0)
+	for key := range pm.MessageParts {
+		if _, exists := known[key]; !exists {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+	return append(keys, unknown...)
+}
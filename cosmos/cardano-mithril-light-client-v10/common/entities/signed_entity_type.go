@@ -10,6 +10,7 @@ type SignedEntityType struct {
 	CardanoStakeDistribution  *CardanoStakeDistribution
 	CardanoImmutableFilesFull *CardanoImmutableFilesFull
 	CardanoTransactions       *CardanoTransactions
+	CardanoBlocksTransactions *CardanoBlocksTransactions
 }
 
 type MithrilStakeDistribution struct {
@@ -29,6 +30,11 @@ type CardanoTransactions struct {
 	BlockNumber
 }
 
+type CardanoBlocksTransactions struct {
+	Epoch
+	BlockNumber
+}
+
 func (s *SignedEntityType) FeedHash(hasher hash.Hash) {
 	if s.MithrilStakeDistribution != nil {
 		s.MithrilStakeDistribution.FeedHash(hasher)
@@ -46,6 +52,10 @@ func (s *SignedEntityType) FeedHash(hasher hash.Hash) {
 		s.CardanoTransactions.FeedHash(hasher)
 		return
 	}
+	if s.CardanoBlocksTransactions != nil {
+		s.CardanoBlocksTransactions.FeedHash(hasher)
+		return
+	}
 }
 
 func (msd *MithrilStakeDistribution) FeedHash(hasher hash.Hash) {
@@ -78,3 +88,12 @@ func (ct *CardanoTransactions) FeedHash(hasher hash.Hash) {
 	binary.BigEndian.PutUint64(blockNumberBytes, uint64(ct.BlockNumber))
 	hasher.Write(blockNumberBytes)
 }
+
+func (cbt *CardanoBlocksTransactions) FeedHash(hasher hash.Hash) {
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, uint64(cbt.Epoch))
+	hasher.Write(epochBytes)
+	blockNumberBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockNumberBytes, uint64(cbt.BlockNumber))
+	hasher.Write(blockNumberBytes)
+}
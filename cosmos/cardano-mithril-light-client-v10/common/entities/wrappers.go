@@ -7,7 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/cryptohelpers"
-	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/crypto"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
 )
 
 type ed25519Signature = []byte
@@ -30,8 +30,8 @@ func (s *ProtocolGenesisSignature) FromBytes(bytes []byte) (*ProtocolGenesisSign
 		return nil, errors.New("could not deserialize a ProtocolGenesisSignature from bytes hex string: invalid bytes")
 	}
 
-	var key ed25519Signature
-	copy(key[:], bytes)
+	key := make(ed25519Signature, ed25519.SignatureSize)
+	copy(key, bytes)
 
 	s.Key = key
 
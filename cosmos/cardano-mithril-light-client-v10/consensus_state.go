@@ -44,6 +44,13 @@ func (cs ConsensusState) GetTime() time.Time {
 	return time.Unix(int64(cs.GetTimestamp()/uint64(time.Second)), int64(cs.GetTimestamp()%uint64(time.Second)))
 }
 
+// certifiesBlocks reports whether the consensus state was created from a
+// CardanoBlocksTransactions certificate, which records its merkle root in
+// BlocksTransactionsMerkleRoot instead of TransactionsMerkleRoot.
+func (cs ConsensusState) certifiesBlocks() bool {
+	return cs.BlocksTransactionsMerkleRoot != ""
+}
+
 // ValidateBasic defines a basic validation for the mithril consensus state.
 func (cs ConsensusState) ValidateBasic() error {
 	if cs.FirstCertHashLatestEpoch == nil {
@@ -61,6 +68,11 @@ func (cs ConsensusState) ValidateBasic() error {
 	if cmttypes.ValidateHash(cs.IbcStateRoot) != nil {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "ibc_state_root must be a 32-byte hash")
 	}
+	if cs.HostStateShutdown != nil {
+		if err := cs.HostStateShutdown.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+		}
+	}
 
 	return nil
 }
@@ -31,4 +31,5 @@ var (
 	ErrInvalidCardanoTransactionsProofs           = errorsmod.Register(ModuleName, 24, "invalid cardano transactions proofs")
 	ErrInvalidTimestamp                           = errorsmod.Register(ModuleName, 25, "invalid timestamp layout")
 	ErrNotImplemented                             = errorsmod.Register(ModuleName, 26, "not implemented")
+	ErrHostStateShutdown                          = errorsmod.Register(ModuleName, 27, "host state shut down")
 )
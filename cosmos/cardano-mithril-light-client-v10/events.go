@@ -0,0 +1,81 @@
+package mithril
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+const (
+	EventTypeMithrilProtocolParametersRotated = "mithril_protocol_parameters_rotated"
+	EventTypeMithrilHostStateNftPolicyRotated = "mithril_host_state_nft_policy_rotated"
+	EventTypeMithrilHostStateShutdown         = "mithril_host_state_shutdown"
+
+	AttributeKeyClientID                 = "client_id"
+	AttributeKeySubstituteClientID       = "substitute_client_id"
+	AttributeKeyPreviousValue            = "previous_value"
+	AttributeKeyNewValue                 = "new_value"
+	AttributeKeyShutdownHeight           = "shutdown_height"
+	AttributeKeyShutdownInitiatedAtMs    = "shutdown_initiated_at_ms"
+	AttributeKeyShutdownGracePeriodEndMs = "shutdown_grace_period_end_ms"
+)
+
+// emitRecoveryRotationEvents records the recovery-only parameters a
+// governance recovery took from the substitute client.
+func emitRecoveryRotationEvents(ctx sdk.Context, clientID, substituteClientID string, subject, substitute *ClientState) {
+	if subject == nil || substitute == nil {
+		return
+	}
+
+	previousParameters := protocolParametersString(subject.ProtocolParameters)
+	newParameters := protocolParametersString(substitute.ProtocolParameters)
+	if previousParameters != newParameters {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeMithrilProtocolParametersRotated,
+				sdk.NewAttribute(AttributeKeyClientID, clientID),
+				sdk.NewAttribute(AttributeKeySubstituteClientID, substituteClientID),
+				sdk.NewAttribute(AttributeKeyPreviousValue, previousParameters),
+				sdk.NewAttribute(AttributeKeyNewValue, newParameters),
+			),
+		)
+	}
+
+	previousPolicy := hex.EncodeToString(subject.HostStateNftPolicyId)
+	newPolicy := hex.EncodeToString(substitute.HostStateNftPolicyId)
+	if previousPolicy != newPolicy {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeMithrilHostStateNftPolicyRotated,
+				sdk.NewAttribute(AttributeKeyClientID, clientID),
+				sdk.NewAttribute(AttributeKeySubstituteClientID, substituteClientID),
+				sdk.NewAttribute(AttributeKeyPreviousValue, previousPolicy),
+				sdk.NewAttribute(AttributeKeyNewValue, newPolicy),
+			),
+		)
+	}
+}
+
+func protocolParametersString(pm *MithrilProtocolParameters) string {
+	if pm == nil {
+		return ""
+	}
+	return fmt.Sprintf("k=%d,m=%d,phi_f=%d/%d", pm.K, pm.M, pm.PhiF.Numerator, pm.PhiF.Denominator)
+}
+
+// emitHostStateShutdownEvent announces the update that first recorded a
+// shut-down HostState.
+func emitHostStateShutdownEvent(ctx sdk.Context, clientID string, shutdownHeight exported.Height, shutdown *HostStateShutdown) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMithrilHostStateShutdown,
+			sdk.NewAttribute(AttributeKeyClientID, clientID),
+			sdk.NewAttribute(AttributeKeyShutdownHeight, shutdownHeight.String()),
+			sdk.NewAttribute(AttributeKeyShutdownInitiatedAtMs, strconv.FormatUint(shutdown.InitiatedAtMs, 10)),
+			sdk.NewAttribute(AttributeKeyShutdownGracePeriodEndMs, strconv.FormatUint(shutdown.GracePeriodEndMs, 10)),
+		),
+	)
+}
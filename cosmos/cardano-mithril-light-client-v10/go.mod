@@ -2,6 +2,8 @@ module github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithri
 
 go 1.25.13
 
+replace github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core => ../cardano-probabilistic-light-client-core
+
 require (
 	cosmossdk.io/core v0.11.3
 	cosmossdk.io/errors v1.0.2
@@ -9,6 +11,7 @@ require (
 	cosmossdk.io/store v1.1.2
 	github.com/ComposableFi/go-merkle-trees v0.0.0-20220505132313-e976260288cc
 	github.com/blinklabs-io/gouroboros v0.89.1
+	github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core v0.1.4
 	github.com/cometbft/cometbft v0.38.21
 	github.com/cosmos/cosmos-db v1.1.1
 	github.com/cosmos/cosmos-proto v1.0.0-beta.5
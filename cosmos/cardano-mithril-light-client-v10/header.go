@@ -18,6 +18,15 @@ func (h MithrilHeader) ConsensusState() *ConsensusState {
 		FirstCertHashLatestEpoch: h.MithrilStakeDistributionCertificate,
 		LatestCertHashTxSnapshot: h.TransactionSnapshot.CertificateHash,
 		IbcStateRoot:             emptyHash,
+		TransactionsMerkleRoot:   h.TransactionSnapshot.MerkleRoot,
+	}
+
+	if h.certifiesBlocks() {
+		consState.TransactionsMerkleRoot = ""
+		consState.BlocksTransactionsMerkleRoot = h.TransactionSnapshot.MerkleRoot
+		if block, err := h.hostStateBlock(); err == nil {
+			consState.HostStateBlockHash = block.BlockHash
+		}
 	}
 
 	// Best-effort extraction of the Cardano IBC root from the HostState transaction
@@ -29,8 +38,9 @@ func (h MithrilHeader) ConsensusState() *ConsensusState {
 			outputIndex := int(h.HostStateTxOutputIndex)
 			if outputIndex >= 0 && outputIndex < len(outputs) {
 				if datum := outputs[outputIndex].Datum(); datum != nil {
-					if root, err := ExtractIbcStateRootFromHostStateDatum(datum.Cbor(), nil); err == nil {
-						consState.IbcStateRoot = root
+					if hostStateDatum, err := DecodeHostStateDatum(datum.Cbor(), nil); err == nil {
+						consState.IbcStateRoot = hostStateDatum.State.IbcStateRoot
+						consState.HostStateVersion = hostStateDatum.State.Version
 					}
 				}
 			}
@@ -40,6 +50,23 @@ func (h MithrilHeader) ConsensusState() *ConsensusState {
 	return consState
 }
 
+// certifiesBlocks reports whether the transaction snapshot certificate of the
+// header is a CardanoBlocksTransactions certificate.
+func (h MithrilHeader) certifiesBlocks() bool {
+	return h.TransactionSnapshotCertificate != nil && h.TransactionSnapshotCertificate.SignedEntityType.GetCardanoBlocksTransactions() != nil
+}
+
+// hostStateBlock verifies the blocks and transactions proof of the HostState
+// transaction and returns the block holding it.
+func (h MithrilHeader) hostStateBlock() (*CertifiedBlockTransaction, error) {
+	return verifyCertifiedBlockTransaction(
+		h.HostStateTxProof,
+		h.TransactionSnapshot.MerkleRoot,
+		h.TransactionSnapshotCertificate.Hash,
+		h.HostStateTxHash,
+	)
+}
+
 // ClientType defines that the Header is a Mithril header
 func (MithrilHeader) ClientType() string {
 	return ModuleName
@@ -79,10 +106,10 @@ func (h MithrilHeader) ValidateBasic() error {
 	if h.MithrilStakeDistribution.Epoch != h.TransactionSnapshot.Epoch {
 		return errorsmod.Wrap(ErrInvalidMithrilHeader, "mithril stake distribution epoch does not match transaction snapshot epoch")
 	}
-	if !strings.EqualFold(h.MithrilStakeDistribution.CertificateHash, h.MithrilStakeDistributionCertificate.Hash) {
+	if h.MithrilStakeDistribution.CertificateHash != h.MithrilStakeDistributionCertificate.Hash {
 		return errorsmod.Wrap(ErrInvalidMithrilHeader, "mithril stake distribution does not match mithril stake distribution certificate")
 	}
-	if !strings.EqualFold(h.TransactionSnapshot.CertificateHash, h.TransactionSnapshotCertificate.Hash) {
+	if h.TransactionSnapshot.CertificateHash != h.TransactionSnapshotCertificate.Hash {
 		return errorsmod.Wrap(ErrInvalidMithrilHeader, "transaction snapshot does not match transaction snapshot certificate")
 	}
 	if strings.TrimSpace(h.HostStateTxHash) == "" {
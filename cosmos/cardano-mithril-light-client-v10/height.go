@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -27,9 +28,9 @@ func NewHeight(revisionNumber uint64, revisionHeight uint64) Height {
 	}
 }
 
-// GetRevisionNumber always returns 0
+// GetRevisionNumber returns the Cardano revision of the height
 func (h Height) GetRevisionNumber() uint64 {
-	return 0
+	return h.RevisionNumber
 }
 
 // GetMithrilHeight returns the mithril-height of the height
@@ -44,8 +45,13 @@ func (h Height) GetRevisionHeight() uint64 {
 // 1  if a > b
 func (h Height) Compare(other exported.Height) int64 {
 	var a, b big.Int
-	a.SetUint64(h.RevisionHeight)
-	b.SetUint64(other.GetRevisionHeight())
+	if h.RevisionNumber != other.GetRevisionNumber() {
+		a.SetUint64(h.RevisionNumber)
+		b.SetUint64(other.GetRevisionNumber())
+	} else {
+		a.SetUint64(h.RevisionHeight)
+		b.SetUint64(other.GetRevisionHeight())
+	}
 	return int64(a.Cmp(&b))
 }
 
@@ -78,7 +84,7 @@ func (h Height) EQ(other exported.Height) bool {
 
 // String returns a string representation of Height
 func (h Height) String() string {
-	return fmt.Sprintf("%d-%d", 0, h.RevisionHeight)
+	return fmt.Sprintf("%d-%d", h.RevisionNumber, h.RevisionHeight)
 }
 
 // Decrement will return a new height with the MithrilHeight decremented
@@ -87,12 +93,12 @@ func (h Height) Decrement() (decremented exported.Height, success bool) {
 	if h.RevisionHeight == 0 {
 		return Height{}, false
 	}
-	return NewHeight(0, h.RevisionHeight-1), true
+	return NewHeight(h.RevisionNumber, h.RevisionHeight-1), true
 }
 
 // Increment will return a height with an incremented mithril height
 func (h Height) Increment() exported.Height {
-	return NewHeight(0, h.RevisionHeight+1)
+	return NewHeight(h.RevisionNumber, h.RevisionHeight+1)
 }
 
 // IsZero returns true if mithril height is 0
@@ -112,13 +118,23 @@ func MustParseHeight(heightStr string) Height {
 }
 
 // ParseHeight is a utility function that takes a string representation of the height
-// and returns a Height struct
+// and returns a Height struct. Both "<revisionNumber>-<revisionHeight>" and the
+// legacy "<revisionHeight>" (revision 0) forms are accepted.
 func ParseHeight(heightStr string) (Height, error) {
+	var revisionNumber uint64
+	if revisionStr, heightPart, found := strings.Cut(heightStr, "-"); found {
+		var err error
+		revisionNumber, err = strconv.ParseUint(revisionStr, 10, 64)
+		if err != nil {
+			return Height{}, errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "invalid mithril revision number. parse err: %s", err)
+		}
+		heightStr = heightPart
+	}
 	revisionHeight, err := strconv.ParseUint(heightStr, 10, 64)
 	if err != nil {
 		return Height{}, errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "invalid mithril height. parse err: %s", err)
 	}
-	return NewHeight(0, revisionHeight), nil
+	return NewHeight(revisionNumber, revisionHeight), nil
 }
 
 // GetSelfHeight is a utility function that returns self height given context
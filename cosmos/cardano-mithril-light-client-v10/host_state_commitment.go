@@ -22,10 +22,16 @@ import (
 // The returned root is stored in consensus state and used to verify membership/non-membership proofs
 // for Cardano IBC host state (clients, connections, channels, packet state, etc.).
 func (cs ClientState) ExtractIbcStateRootFromHostStateTx(header *MithrilHeader) ([]byte, error) {
-	if len(cs.HostStateNftPolicyId) == 0 || len(cs.HostStateNftTokenName) == 0 {
-		return nil, fmt.Errorf("missing HostState NFT identification in client state")
+	datum, err := cs.ExtractHostStateDatumFromHostStateTx(header)
+	if err != nil {
+		return nil, err
 	}
+	return datum.State.IbcStateRoot, nil
+}
 
+// ExtractHostStateDatumFromHostStateTx decodes the full HostState datum of the
+// header's HostState output, including its shutdown state.
+func (cs ClientState) ExtractHostStateDatumFromHostStateTx(header *MithrilHeader) (*HostStateDatum, error) {
 	if header.HostStateTxHash == "" {
 		return nil, fmt.Errorf("missing HostState transaction hash in header")
 	}
@@ -33,17 +39,27 @@ func (cs ClientState) ExtractIbcStateRootFromHostStateTx(header *MithrilHeader)
 		return nil, fmt.Errorf("missing HostState transaction body CBOR in header")
 	}
 
-	txBody, err := decodeTransactionBody(header.HostStateTxBodyCbor)
+	return cs.extractHostStateDatumFromHostStateOutput(header.HostStateTxHash, header.HostStateTxBodyCbor, header.HostStateTxOutputIndex)
+}
+
+// extractHostStateDatumFromHostStateOutput decodes the HostState datum of the
+// HostState output at outputIndex of the transaction body whose hash is txHash.
+func (cs ClientState) extractHostStateDatumFromHostStateOutput(txHash string, txBodyCbor []byte, hostStateOutputIndex uint32) (*HostStateDatum, error) {
+	if len(cs.HostStateNftPolicyId) == 0 || len(cs.HostStateNftTokenName) == 0 {
+		return nil, fmt.Errorf("missing HostState NFT identification in client state")
+	}
+
+	txBody, err := decodeTransactionBody(txBodyCbor)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode HostState tx body: %w", err)
 	}
 
-	if strings.ToLower(txBody.Hash()) != strings.ToLower(header.HostStateTxHash) {
+	if strings.ToLower(txBody.Hash()) != strings.ToLower(txHash) {
 		return nil, fmt.Errorf("HostState tx body hash mismatch")
 	}
 
 	outputs := txBody.Outputs()
-	outputIndex := int(header.HostStateTxOutputIndex)
+	outputIndex := int(hostStateOutputIndex)
 	if outputIndex < 0 || outputIndex >= len(outputs) {
 		return nil, fmt.Errorf("HostState output index out of range")
 	}
@@ -69,8 +85,8 @@ func (cs ClientState) ExtractIbcStateRootFromHostStateTx(header *MithrilHeader)
 	}
 
 	// The HostState datum is the canonical commitment to Cardano IBC state.
-	// We decode it and extract the 32-byte `ibc_state_root` for proof verification.
-	return ExtractIbcStateRootFromHostStateDatum(datum.Cbor(), cs.HostStateNftPolicyId)
+	// We decode it and check the 32-byte `ibc_state_root` used for proof verification.
+	return DecodeHostStateDatum(datum.Cbor(), cs.HostStateNftPolicyId)
 }
 
 func decodeTransactionBody(data []byte) (ledger.TransactionBody, error) {
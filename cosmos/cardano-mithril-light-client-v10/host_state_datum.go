@@ -9,16 +9,20 @@ import (
 
 // HostStateDatum is the on-chain datum carried by the Cardano HostState UTxO.
 //
-// It is encoded as PlutusData (constructor 0) containing two fields:
+// It is encoded as PlutusData (constructor 0) containing four fields:
 // 1) state: HostState
 // 2) nft_policy: PolicyId (bytes)
+// 3) deployer: VerificationKeyHash (bytes)
+// 4) shutdown: ShutdownState
 //
 // We decode it here so the Cosmos-side Mithril light client can extract the
 // authenticated `ibc_state_root` from a certified HostState transaction output.
 type HostStateDatum struct {
-	_         struct{}  `cbor:",toarray"`
-	State     HostState `cbor:"0"`
-	NftPolicy []byte    `cbor:"1"`
+	_         struct{}      `cbor:",toarray"`
+	State     HostState     `cbor:"0"`
+	NftPolicy []byte        `cbor:"1"`
+	Deployer  []byte        `cbor:"2"`
+	Shutdown  ShutdownState `cbor:"3"`
 }
 
 // HostState is the canonical IBC host state committed on Cardano.
@@ -35,7 +39,63 @@ type HostState struct {
 	LastUpdateTimeMillis uint64
 }
 
-func ExtractIbcStateRootFromHostStateDatum(datumCbor []byte, expectedNftPolicyId []byte) ([]byte, error) {
+const (
+	plutusConstr0Tag = 121
+	plutusConstr1Tag = 122
+)
+
+// ShutdownState is the HostState lifecycle mode: Active (constructor 0) or
+// ShuttingDown (constructor 1) with initiated_at and grace_period_end in
+// POSIX milliseconds. The on-chain validators never move a HostState from
+// ShuttingDown back to Active.
+type ShutdownState struct {
+	ShuttingDown         bool
+	InitiatedAtMillis    uint64
+	GracePeriodEndMillis uint64
+}
+
+func (s ShutdownState) MarshalCBOR() ([]byte, error) {
+	if !s.ShuttingDown {
+		return cbor.Marshal(cbor.Tag{Number: plutusConstr0Tag, Content: []any{}})
+	}
+	return cbor.Marshal(cbor.Tag{
+		Number:  plutusConstr1Tag,
+		Content: []uint64{s.InitiatedAtMillis, s.GracePeriodEndMillis},
+	})
+}
+
+func (s *ShutdownState) UnmarshalCBOR(data []byte) error {
+	var tagged cbor.RawTag
+	if err := cbor.Unmarshal(data, &tagged); err != nil {
+		return fmt.Errorf("invalid HostState shutdown state: %w", err)
+	}
+
+	switch tagged.Number {
+	case plutusConstr0Tag:
+		var fields []cbor.RawMessage
+		if err := cbor.Unmarshal(tagged.Content, &fields); err != nil || len(fields) != 0 {
+			return fmt.Errorf("invalid Active HostState shutdown state")
+		}
+		*s = ShutdownState{}
+	case plutusConstr1Tag:
+		var fields []uint64
+		if err := cbor.Unmarshal(tagged.Content, &fields); err != nil || len(fields) != 2 {
+			return fmt.Errorf("invalid ShuttingDown HostState shutdown state")
+		}
+		*s = ShutdownState{
+			ShuttingDown:         true,
+			InitiatedAtMillis:    fields[0],
+			GracePeriodEndMillis: fields[1],
+		}
+	default:
+		return fmt.Errorf("unknown HostState shutdown constructor tag %d", tagged.Number)
+	}
+	return nil
+}
+
+// DecodeHostStateDatum decodes a HostState datum and checks its nft_policy
+// and `ibc_state_root`.
+func DecodeHostStateDatum(datumCbor []byte, expectedNftPolicyId []byte) (*HostStateDatum, error) {
 	var datum HostStateDatum
 	if err := cbor.Unmarshal(datumCbor, &datum); err != nil {
 		return nil, fmt.Errorf("failed to decode HostState datum: %w", err)
@@ -49,5 +109,13 @@ func ExtractIbcStateRootFromHostStateDatum(datumCbor []byte, expectedNftPolicyId
 		return nil, fmt.Errorf("invalid ibc_state_root length: %d", len(datum.State.IbcStateRoot))
 	}
 
+	return &datum, nil
+}
+
+func ExtractIbcStateRootFromHostStateDatum(datumCbor []byte, expectedNftPolicyId []byte) ([]byte, error) {
+	datum, err := DecodeHostStateDatum(datumCbor, expectedNftPolicyId)
+	if err != nil {
+		return nil, err
+	}
 	return datum.State.IbcStateRoot, nil
 }
@@ -0,0 +1,135 @@
+package mithril
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeHostStateDatumShutdownState(t *testing.T) {
+	policy := bytes.Repeat([]byte{0x24}, 28)
+	encode := func(shutdown any) []byte {
+		datum, err := cbor.Marshal(cbor.Tag{
+			Number: plutusConstr0Tag,
+			Content: []any{
+				cbor.Tag{
+					Number:  plutusConstr0Tag,
+					Content: []any{uint64(3), bytes.Repeat([]byte{0x11}, 32), uint64(0), uint64(0), uint64(0), []uint64{}, uint64(1_000)},
+				},
+				policy,
+				bytes.Repeat([]byte{0x33}, 28),
+				shutdown,
+			},
+		})
+		require.NoError(t, err)
+		return datum
+	}
+
+	datum, err := DecodeHostStateDatum(encode(ShutdownState{}), policy)
+	require.NoError(t, err)
+	require.False(t, datum.Shutdown.ShuttingDown)
+	require.Equal(t, bytes.Repeat([]byte{0x33}, 28), datum.Deployer)
+
+	shutdown := ShutdownState{ShuttingDown: true, InitiatedAtMillis: 1_000, GracePeriodEndMillis: 2_000}
+	datum, err = DecodeHostStateDatum(encode(shutdown), policy)
+	require.NoError(t, err)
+	require.Equal(t, shutdown, datum.Shutdown)
+
+	_, err = DecodeHostStateDatum(encode([]any{}), policy)
+	require.ErrorContains(t, err, "shutdown state")
+	_, err = DecodeHostStateDatum(encode(cbor.Tag{Number: 123, Content: []any{}}), policy)
+	require.ErrorContains(t, err, "unknown HostState shutdown constructor")
+}
+
+func TestHostStateShutdownIsTerminal(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "host-state-shutdown")
+	clientState := newTestClientState(20, 4, "cardano-devnet", 24*time.Hour)
+
+	for _, height := range []Height{NewHeight(0, 10), NewHeight(0, 20)} {
+		setConsensusState(clientStore, cdc, newTestConsensusState(0x11), height)
+		setConsensusMetadata(ctx, clientStore, height)
+	}
+
+	clientState.recordHostStateShutdown(NewHeight(0, 20))
+	clientState.recordHostStateShutdown(NewHeight(0, 30))
+	require.Equal(t, NewHeight(0, 20), *clientState.HostStateShutdownHeight, "a later shutdown must not raise the shutdown height")
+	require.Equal(t, exported.Frozen, clientState.Status(ctx, clientStore, cdc), "a shutdown without a recorded grace period is frozen")
+	err := clientState.VerifyClientMessage(ctx, cdc, clientStore, &MithrilHeader{})
+	require.ErrorIs(t, err, ErrHostStateShutdown)
+
+	err = clientState.VerifyMembership(ctx, clientStore, cdc, NewHeight(0, 20), 0, 0, nil, nil, []byte("value"))
+	require.ErrorIs(t, err, ErrHostStateShutdown)
+	err = clientState.VerifyNonMembership(ctx, clientStore, cdc, NewHeight(0, 20), 0, 0, nil, nil)
+	require.ErrorIs(t, err, ErrHostStateShutdown)
+
+	// Proofs below the shutdown height still reach proof verification.
+	err = clientState.VerifyNonMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, nil, nil)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrHostStateShutdown)
+
+	consensusState := newTestConsensusState(0x11)
+	consensusState.HostStateShutdown = &HostStateShutdown{InitiatedAtMs: 1_000, GracePeriodEndMs: 2_000}
+	require.NoError(t, consensusState.ValidateBasic())
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 10))
+	clientState.HostStateShutdownHeight = nil
+	err = clientState.VerifyNonMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, nil, nil)
+	require.ErrorIs(t, err, ErrHostStateShutdown, "a consensus state recording a shutdown must not verify proofs")
+
+	consensusState.HostStateShutdown.GracePeriodEndMs = 1_000
+	require.ErrorContains(t, consensusState.ValidateBasic(), "grace period end")
+}
+
+func TestHostStateShutdownStatusFollowsGracePeriod(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "host-state-shutdown-grace")
+	clientState := newTestClientState(20, 4, "cardano-devnet", 24*time.Hour)
+	gracePeriodEnd := ctx.BlockTime().Add(time.Hour)
+
+	consensusState := newTestConsensusState(0x11)
+	consensusState.HostStateShutdown = &HostStateShutdown{InitiatedAtMs: 1_000, GracePeriodEndMs: uint64(gracePeriodEnd.UnixMilli())}
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 20))
+	clientState.recordHostStateShutdown(NewHeight(0, 20))
+
+	require.Equal(t, exported.Active, clientState.Status(ctx, clientStore, cdc))
+	require.Equal(t, exported.Active, clientState.Status(ctx.WithBlockTime(gracePeriodEnd.Add(-time.Millisecond)), clientStore, cdc))
+	require.Equal(t, exported.Frozen, clientState.Status(ctx.WithBlockTime(gracePeriodEnd), clientStore, cdc))
+}
+
+func TestCheckSubstituteAndUpdateStateRecoversShutdownClient(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, subjectStore := newTestClientStore(t, "shutdown-subject")
+	_, substituteStore := newTestClientStore(t, "shutdown-substitute")
+
+	subjectState := newTestClientState(10, 4, "cardano-old", 24*time.Hour)
+	subjectState.recordHostStateShutdown(NewHeight(0, 10))
+	setClientState(subjectStore, cdc, subjectState)
+
+	substituteState := newTestClientState(20, 6, "cardano-new", 48*time.Hour)
+	setClientState(substituteStore, cdc, substituteState)
+	setConsensusState(substituteStore, cdc, newTestConsensusState(0x11), substituteState.LatestHeight)
+	setConsensusMetadataWithValues(substituteStore, substituteState.LatestHeight, NewHeight(0, 50), 123456789)
+
+	require.True(t, IsMatchingClientState(*subjectState, *substituteState))
+	require.NoError(t, subjectState.CheckSubstituteAndUpdateState(ctx, cdc, subjectStore, substituteStore, substituteState))
+
+	recoveredClient, found := getClientState(subjectStore, cdc)
+	require.True(t, found)
+	require.Nil(t, recoveredClient.HostStateShutdownHeight)
+}
+
+func TestEmitHostStateShutdownEvent(t *testing.T) {
+	ctx, _ := newTestLightClientModule(t, "host-state-shutdown-event")
+
+	emitHostStateShutdownEvent(ctx, "08-cardano-0", NewHeight(0, 20), &HostStateShutdown{InitiatedAtMs: 1_000, GracePeriodEndMs: 2_000})
+
+	event := findTestEvent(t, ctx.EventManager().Events(), EventTypeMithrilHostStateShutdown)
+	require.Equal(t, "08-cardano-0", testEventAttribute(event, AttributeKeyClientID))
+	require.Equal(t, NewHeight(0, 20).String(), testEventAttribute(event, AttributeKeyShutdownHeight))
+	require.Equal(t, "1000", testEventAttribute(event, AttributeKeyShutdownInitiatedAtMs))
+	require.Equal(t, "2000", testEventAttribute(event, AttributeKeyShutdownGracePeriodEndMs))
+}
@@ -0,0 +1,234 @@
+package mithril
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// ibcStateTreeDepth is the fixed depth of the Cardano IBC state commitment
+// tree: every key sits on a 64-step path selected by its leaf index.
+const ibcStateTreeDepth = 64
+
+// IbcStateMembershipItem is one key/value pair of a batch membership check.
+type IbcStateMembershipItem struct {
+	Key   []byte
+	Value []byte
+}
+
+// ibcStateMultiProof mirrors probabilisticcore.IbcStateMultiProof. Siblings are
+// the subtree hashes the leaves do not determine, level by level from the
+// leaves up and by ascending node position within a level.
+type ibcStateMultiProof struct {
+	Leaves   []ibcStateMultiProofLeaf
+	Siblings [][]byte
+}
+
+type ibcStateMultiProofLeaf struct {
+	Key   []byte
+	Value []byte
+}
+
+type multiProofNode struct {
+	position uint64
+	hash     []byte
+	// leaf is the index of a proof leaf below the node.
+	leaf int
+}
+
+// VerifyIbcStateBatchMembership verifies every `key -> value` item against an
+// authenticated `ibc_state_root` with one multi-proof, which carries the
+// sibling hashes shared by the items' paths once. The proof must prove exactly
+// the keys of a non-empty batch, and committed values are compared with the
+// expected ones as in VerifyIbcStateMembership.
+//
+// Proof encoding: JSON with hex-encoded bytes,
+// `{"leaves":[{"key":..,"value":..}],"siblings":[..]}`. Leaves are ordered by
+// leaf index, an empty leaf value stands for the expected value, and an empty
+// sibling for an empty subtree.
+func VerifyIbcStateBatchMembership(root []byte, items []IbcStateMembershipItem, proofBytes []byte) error {
+	proof, err := decodeJSONIbcStateMultiProof(proofBytes)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return fmt.Errorf("batch membership requires at least one key")
+	}
+	if len(proof.Leaves) != len(items) {
+		return fmt.Errorf("multi-proof proves %d keys, expected %d", len(proof.Leaves), len(items))
+	}
+
+	expected := make(map[string][]byte, len(items))
+	for _, item := range items {
+		if _, found := expected[string(item.Key)]; found {
+			return fmt.Errorf("duplicate key %q in batch", item.Key)
+		}
+		expected[string(item.Key)] = item.Value
+	}
+
+	leaves := make([]ibcStateMultiProofLeaf, 0, len(proof.Leaves))
+	for _, leaf := range proof.Leaves {
+		value, found := expected[string(leaf.Key)]
+		if !found {
+			return fmt.Errorf("multi-proof proves key %q outside the batch", leaf.Key)
+		}
+		delete(expected, string(leaf.Key))
+
+		committedValue := value
+		if len(leaf.Value) > 0 {
+			committedValue = leaf.Value
+			if !bytes.Equal(leaf.Value, value) {
+				if err := verifyCardanoValueMatchesExpected(leaf.Key, value, leaf.Value); err != nil {
+					return err
+				}
+			}
+		}
+		// An empty value hashes to the empty leaf, which would prove absence.
+		if len(committedValue) == 0 {
+			return fmt.Errorf("multi-proof value for key %q must not be empty", leaf.Key)
+		}
+		leaves = append(leaves, ibcStateMultiProofLeaf{Key: leaf.Key, Value: committedValue})
+	}
+
+	computed, err := computeRootFromMultiProof(&ibcStateMultiProof{Leaves: leaves, Siblings: proof.Siblings})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(computed, root) {
+		return fmt.Errorf("proof does not match ibc_state_root")
+	}
+	return nil
+}
+
+// computeRootFromMultiProof folds the leaves of proof up to the root of the
+// IBC state commitment tree. Every sibling hash must be consumed.
+func computeRootFromMultiProof(proof *ibcStateMultiProof) ([]byte, error) {
+	nodes, err := multiProofLeafNodes(proof.Leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings := proof.Siblings
+	root, err := foldMultiProof(nodes, func(multiProofNode, int) ([]byte, error) {
+		if len(siblings) == 0 {
+			return nil, fmt.Errorf("multi-proof is missing sibling hashes")
+		}
+		sibling := siblings[0]
+		siblings = siblings[1:]
+		switch len(sibling) {
+		case 0:
+			return emptyHash, nil
+		case sha256.Size:
+			return sibling, nil
+		default:
+			return nil, fmt.Errorf("invalid multi-proof sibling hash length: %d", len(sibling))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(siblings) != 0 {
+		return nil, fmt.Errorf("multi-proof has %d unused sibling hashes", len(siblings))
+	}
+	return root, nil
+}
+
+func multiProofLeafNodes(leaves []ibcStateMultiProofLeaf) ([]multiProofNode, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("multi-proof must prove at least one key")
+	}
+	nodes := make([]multiProofNode, 0, len(leaves))
+	for i, leaf := range leaves {
+		index := ibcStateLeafIndex(leaf.Key)
+		if i > 0 && index <= nodes[i-1].position {
+			return nil, fmt.Errorf("multi-proof leaves must be ordered by strictly increasing leaf index")
+		}
+		nodes = append(nodes, multiProofNode{position: index, hash: leafHash(leaf.Key, leaf.Value), leaf: i})
+	}
+	return nodes, nil
+}
+
+// foldMultiProof hashes nodes, ordered by position, level by level up to the
+// root. sibling supplies the sibling hash of a node at depth whenever the
+// sibling is not itself one of the nodes.
+func foldMultiProof(nodes []multiProofNode, sibling func(node multiProofNode, depth int) ([]byte, error)) ([]byte, error) {
+	for depth := 0; depth < ibcStateTreeDepth; depth++ {
+		parents := make([]multiProofNode, 0, len(nodes))
+		for i := 0; i < len(nodes); i++ {
+			node := nodes[i]
+			var left, right []byte
+			if node.position&1 == 0 && i+1 < len(nodes) && nodes[i+1].position == node.position|1 {
+				left, right = node.hash, nodes[i+1].hash
+				i++
+			} else {
+				hash, err := sibling(node, depth)
+				if err != nil {
+					return nil, err
+				}
+				if node.position&1 == 0 {
+					left, right = node.hash, hash
+				} else {
+					left, right = hash, node.hash
+				}
+			}
+			parents = append(parents, multiProofNode{
+				position: node.position >> 1,
+				hash:     innerHash(left, right),
+				leaf:     node.leaf,
+			})
+		}
+		nodes = parents
+	}
+	return nodes[0].hash, nil
+}
+
+func ibcStateLeafIndex(key []byte) uint64 {
+	keyHash := sha256.Sum256(key)
+	return binary.BigEndian.Uint64(keyHash[0:8])
+}
+
+// DecodeJSONibcStateMultiProof decodes the JSON encoding of a multi-proof,
+// whose byte fields are hex strings.
+func decodeJSONIbcStateMultiProof(proofBytes []byte) (*ibcStateMultiProof, error) {
+	var jp jsonIbcStateMultiProof
+	if err := json.Unmarshal(proofBytes, &jp); err != nil {
+		return nil, fmt.Errorf("unable to decode multi-proof bytes")
+	}
+	proof := &ibcStateMultiProof{
+		Leaves:   make([]ibcStateMultiProofLeaf, 0, len(jp.Leaves)),
+		Siblings: make([][]byte, 0, len(jp.Siblings)),
+	}
+	for _, leaf := range jp.Leaves {
+		key, err := hex.DecodeString(leaf.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := hex.DecodeString(leaf.Value)
+		if err != nil {
+			return nil, err
+		}
+		proof.Leaves = append(proof.Leaves, ibcStateMultiProofLeaf{Key: key, Value: value})
+	}
+	for _, sibling := range jp.Siblings {
+		hash, err := hex.DecodeString(sibling)
+		if err != nil {
+			return nil, err
+		}
+		proof.Siblings = append(proof.Siblings, hash)
+	}
+	return proof, nil
+}
+
+type jsonIbcStateMultiProof struct {
+	Leaves   []jsonIbcStateMultiProofLeaf `json:"leaves"`
+	Siblings []string                     `json:"siblings"`
+}
+
+type jsonIbcStateMultiProofLeaf struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
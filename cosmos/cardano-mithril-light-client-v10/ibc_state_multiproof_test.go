@@ -0,0 +1,217 @@
+package mithril
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestIbcStateMultiProof commits items, and nothing else, to an IBC state
+// tree and returns its root with a multi-proof for every item.
+func newTestIbcStateMultiProof(t *testing.T, items []IbcStateMembershipItem) ([]byte, *ibcStateMultiProof) {
+	t.Helper()
+
+	proof := &ibcStateMultiProof{}
+	for _, item := range items {
+		proof.Leaves = append(proof.Leaves, ibcStateMultiProofLeaf{Key: item.Key, Value: item.Value})
+	}
+	sort.Slice(proof.Leaves, func(i, j int) bool {
+		return ibcStateLeafIndex(proof.Leaves[i].Key) < ibcStateLeafIndex(proof.Leaves[j].Key)
+	})
+
+	// Every sibling outside the committed paths is an empty subtree.
+	positions := make([]uint64, 0, len(proof.Leaves))
+	for _, leaf := range proof.Leaves {
+		positions = append(positions, ibcStateLeafIndex(leaf.Key))
+	}
+	for depth := 0; depth < ibcStateTreeDepth; depth++ {
+		parents := make([]uint64, 0, len(positions))
+		for i := 0; i < len(positions); i++ {
+			if positions[i]&1 == 0 && i+1 < len(positions) && positions[i+1] == positions[i]|1 {
+				i++
+			} else {
+				proof.Siblings = append(proof.Siblings, []byte{})
+			}
+			parents = append(parents, positions[i]>>1)
+		}
+		positions = parents
+	}
+
+	root, err := computeRootFromMultiProof(proof)
+	require.NoError(t, err)
+	return root, proof
+}
+
+func mustJSONIbcStateMultiProof(t *testing.T, proof *ibcStateMultiProof) []byte {
+	t.Helper()
+
+	jp := jsonIbcStateMultiProof{Leaves: []jsonIbcStateMultiProofLeaf{}, Siblings: []string{}}
+	for _, leaf := range proof.Leaves {
+		jp.Leaves = append(jp.Leaves, jsonIbcStateMultiProofLeaf{Key: hex.EncodeToString(leaf.Key), Value: hex.EncodeToString(leaf.Value)})
+	}
+	for _, sibling := range proof.Siblings {
+		jp.Siblings = append(jp.Siblings, hex.EncodeToString(sibling))
+	}
+	bz, err := json.Marshal(jp)
+	require.NoError(t, err)
+	return bz
+}
+
+func testIbcStateBatchItems(t *testing.T) ([]IbcStateMembershipItem, []IbcStateMembershipItem) {
+	t.Helper()
+
+	commitment := []byte("packet-commitment")
+	committedCommitment, err := cbor.Marshal(commitment)
+	require.NoError(t, err)
+	expected := []IbcStateMembershipItem{
+		{Key: []byte("channelEnds/ports/transfer/channels/channel-0"), Value: []byte("channel")},
+		{Key: []byte("commitments/ports/transfer/channels/channel-0/sequences/1"), Value: commitment},
+		{Key: []byte("acks/ports/transfer/channels/channel-0/sequences/1"), Value: []byte("ack")},
+	}
+	committed := []IbcStateMembershipItem{
+		expected[0],
+		{Key: expected[1].Key, Value: committedCommitment},
+		expected[2],
+	}
+	return expected, committed
+}
+
+func TestVerifyIbcStateBatchMembership(t *testing.T) {
+	expected, committed := testIbcStateBatchItems(t)
+	root, proof := newTestIbcStateMultiProof(t, committed)
+	require.Less(t, len(proof.Siblings), len(committed)*ibcStateTreeDepth)
+
+	require.NoError(t, VerifyIbcStateBatchMembership(root, expected, mustJSONIbcStateMultiProof(t, proof)))
+	reversed := []IbcStateMembershipItem{expected[2], expected[1], expected[0]}
+	require.NoError(t, VerifyIbcStateBatchMembership(root, reversed, mustJSONIbcStateMultiProof(t, proof)))
+
+	cases := []struct {
+		name   string
+		items  []IbcStateMembershipItem
+		mutate func(*ibcStateMultiProof)
+	}{
+		{name: "empty batch", items: nil},
+		{name: "missing item", items: expected[:2]},
+		{name: "extra item", items: append(append([]IbcStateMembershipItem(nil), expected...), IbcStateMembershipItem{Key: []byte("receipts/ports/transfer/channels/channel-0/sequences/1"), Value: []byte{0x01}})},
+		{name: "duplicate item", items: []IbcStateMembershipItem{expected[0], expected[0], expected[2]}},
+		{name: "wrong value", items: []IbcStateMembershipItem{expected[0], {Key: expected[1].Key, Value: []byte("other-commitment")}, expected[2]}},
+		{name: "wrong key", items: []IbcStateMembershipItem{{Key: []byte("channelEnds/ports/transfer/channels/channel-1"), Value: []byte("channel")}, expected[1], expected[2]}},
+		{
+			name:  "tampered sibling",
+			items: expected,
+			mutate: func(proof *ibcStateMultiProof) {
+				proof.Siblings[len(proof.Siblings)-1] = bytes.Repeat([]byte{0xff}, 32)
+			},
+		},
+		{
+			name:  "missing sibling",
+			items: expected,
+			mutate: func(proof *ibcStateMultiProof) {
+				proof.Siblings = proof.Siblings[:len(proof.Siblings)-1]
+			},
+		},
+		{
+			name:  "unused sibling",
+			items: expected,
+			mutate: func(proof *ibcStateMultiProof) {
+				proof.Siblings = append(proof.Siblings, []byte{})
+			},
+		},
+		{
+			name:  "unordered leaves",
+			items: expected,
+			mutate: func(proof *ibcStateMultiProof) {
+				proof.Leaves[0], proof.Leaves[1] = proof.Leaves[1], proof.Leaves[0]
+			},
+		},
+	}
+	for _, tc := range cases {
+		_, proof := newTestIbcStateMultiProof(t, committed)
+		if tc.mutate != nil {
+			tc.mutate(proof)
+		}
+		require.Error(t, VerifyIbcStateBatchMembership(root, tc.items, mustJSONIbcStateMultiProof(t, proof)), tc.name)
+	}
+
+	require.Error(t, VerifyIbcStateBatchMembership(root, expected, []byte("not json")))
+}
+
+func TestVerifyBatchMembership(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "batch-membership")
+	clientState := newTestClientState(10, 4, "cardano-devnet", 24*time.Hour)
+
+	expected, committed := testIbcStateBatchItems(t)
+	root, proof := newTestIbcStateMultiProof(t, committed)
+	proofBytes := mustJSONIbcStateMultiProof(t, proof)
+
+	consensusState := newTestConsensusState(0x11)
+	consensusState.IbcStateRoot = root
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 10))
+	setConsensusMetadata(ctx, clientStore, NewHeight(0, 10))
+
+	paths := make([]exported.Path, 0, len(expected))
+	values := make([][]byte, 0, len(expected))
+	for _, item := range expected {
+		paths = append(paths, commitmenttypesv2.NewMerklePath([]byte("ibc"), item.Key))
+		values = append(values, item.Value)
+	}
+	require.NoError(t, clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, proofBytes, paths, values))
+
+	require.Error(t, clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, proofBytes, paths[:2], values))
+	require.Error(t, clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, proofBytes, paths[:2], values[:2]))
+	require.Error(t, clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, proofBytes, nil, nil))
+
+	err := clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), uint64(ctx.BlockTime().UnixNano()), 0, proofBytes, paths, values)
+	require.ErrorIs(t, err, ErrDelayPeriodNotPassed)
+
+	clientState.recordHostStateShutdown(NewHeight(0, 10))
+	err = clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, proofBytes, paths, values)
+	require.ErrorIs(t, err, ErrHostStateShutdown)
+}
+
+func TestLightClientModuleVerifyBatchMembershipRequiresActiveClient(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, lightClientModule := newTestLightClientModule(t, "batch-membership-module")
+	clientID := "08-cardano-mithril-0"
+	clientStore := lightClientModule.storeProvider.ClientStore(ctx, clientID)
+	clientState := newTestClientState(10, 4, "cardano-devnet", 24*time.Hour)
+
+	expected, committed := testIbcStateBatchItems(t)
+	root, proof := newTestIbcStateMultiProof(t, committed)
+	proofBytes := mustJSONIbcStateMultiProof(t, proof)
+
+	consensusState := newTestConsensusState(0x11)
+	consensusState.IbcStateRoot = root
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 10))
+	setConsensusMetadata(ctx, clientStore, NewHeight(0, 10))
+	setClientState(clientStore, cdc, clientState)
+
+	paths := make([]exported.Path, 0, len(expected))
+	values := make([][]byte, 0, len(expected))
+	for _, item := range expected {
+		paths = append(paths, commitmenttypesv2.NewMerklePath([]byte("ibc"), item.Key))
+		values = append(values, item.Value)
+	}
+	require.NoError(t, lightClientModule.VerifyBatchMembership(ctx, clientID, NewHeight(0, 10), 0, 0, proofBytes, paths, values))
+
+	frozenHeight := FrozenHeight
+	clientState.FrozenHeight = &frozenHeight
+	setClientState(clientStore, cdc, clientState)
+	err := lightClientModule.VerifyBatchMembership(ctx, clientID, NewHeight(0, 10), 0, 0, proofBytes, paths, values)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotActive)
+
+	clientState.FrozenHeight = nil
+	setClientState(clientStore, cdc, clientState)
+	err = lightClientModule.VerifyBatchMembership(ctx.WithBlockTime(ctx.BlockTime().Add(24*time.Hour)), clientID, NewHeight(0, 10), 0, 0, proofBytes, paths, values)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotActive, "an expired client verifies no proofs")
+}
@@ -3,7 +3,6 @@ package mithril
 import (
 	"bytes"
 	"crypto/sha256"
-	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -226,14 +225,12 @@ func VerifyIbcStateNonMembership(root []byte, key []byte, proofBytes []byte) err
 }
 
 func computeRootFromProofPath(key []byte, value []byte, path []*ics23.InnerOp) ([]byte, error) {
-	if len(path) != 64 {
+	if len(path) != ibcStateTreeDepth {
 		return nil, fmt.Errorf("unexpected proof path length: %d", len(path))
 	}
 
 	current := leafHash(key, value)
-
-	keyHash := sha256.Sum256(key)
-	index := binary.BigEndian.Uint64(keyHash[0:8])
+	index := ibcStateLeafIndex(key)
 
 	for depth, op := range path {
 		direction := (index >> uint(depth)) & 1
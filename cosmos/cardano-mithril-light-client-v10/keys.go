@@ -1,6 +1,7 @@
 package mithril
 
 import (
+	"encoding/binary"
 	"fmt"
 )
 
@@ -14,21 +15,19 @@ const (
 	//
 	// Note: this string is independent from the protobuf type URLs for the client
 	// state / header, which remain under `ibc.lightclients.mithril.v1.*`.
-	ModuleName                           = "08-cardano-mithril"
-	KeyFirstCertificateInEpochPrefix     = "fcInEpoch"
-	KeyFirstCertificateMsdInEpochPrefix  = "fcMsdInEpoch"
-	KeyFirstCertificateTsInEpochPrefix   = "fcTsInEpoch"
-	KeyLatestCertificateMsdInEpochPrefix = "LcMsdInEpoch"
-	KeyLatestCertificateTsInEpochPrefix  = "LcTsInEpoch"
-	KeyMSDCertificateHashPrefix          = "MSDCertificateHash"
+	ModuleName                          = "08-cardano-mithril"
+	KeyFirstCertificateInEpochPrefix    = "fcInEpoch"
+	KeyLatestCertificateTsInEpochPrefix = "LcTsInEpoch"
+	KeyMSDCertificateHashPrefix         = "MSDCertificateHash"
+
+	// maxPrunedCertificateEpochsPerUpdate bounds the certificate store entries
+	// deleted by a single update.
+	maxPrunedCertificateEpochsPerUpdate = 8
 )
 
+// FcInEpochKey returns the key of the first certificate of epoch.
 func FcInEpochKey(epoch uint64) []byte {
-	return []byte(FcInEpochPath(epoch))
-}
-
-func FcInEpochPath(epoch uint64) string {
-	return fmt.Sprintf("%s/%v", KeyFirstCertificateInEpochPrefix, epoch)
+	return epochKey(KeyFirstCertificateInEpochPrefix, epoch)
 }
 
 func MSDCertificateHashKey(hash string) []byte {
@@ -39,34 +38,14 @@ func MSDCertificateHashPath(hash string) string {
 	return fmt.Sprintf("%s/%s", KeyMSDCertificateHashPrefix, hash)
 }
 
-func FcMsdInEpochKey(epoch uint64) []byte {
-	return []byte(FcMsdInEpochPath(epoch))
-}
-
-func FcMsdInEpochPath(epoch uint64) string {
-	return fmt.Sprintf("%s/%v", KeyFirstCertificateMsdInEpochPrefix, epoch)
-}
-
-func FcTsInEpochKey(epoch uint64) []byte {
-	return []byte(FcTsInEpochPath(epoch))
-}
-
-func FcTsInEpochPath(epoch uint64) string {
-	return fmt.Sprintf("%s/%v", KeyFirstCertificateTsInEpochPrefix, epoch)
-}
-
-func LcMsdInEpochKey(epoch uint64) []byte {
-	return []byte(LcMsdInEpochPath(epoch))
-}
-
-func LcMsdInEpochPath(epoch uint64) string {
-	return fmt.Sprintf("%s/%v", KeyLatestCertificateMsdInEpochPrefix, epoch)
-}
-
+// LcTsInEpochKey returns the key of the latest transaction snapshot
+// certificate of epoch.
 func LcTsInEpochKey(epoch uint64) []byte {
-	return []byte(LcTsInEpochPath(epoch))
+	return epochKey(KeyLatestCertificateTsInEpochPrefix, epoch)
 }
 
-func LcTsInEpochPath(epoch uint64) string {
-	return fmt.Sprintf("%s/%v", KeyLatestCertificateTsInEpochPrefix, epoch)
+// epochKey returns keyPrefix followed by a separator and the big-endian epoch,
+// so the keys of a prefix iterate in epoch order.
+func epochKey(keyPrefix string, epoch uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(keyPrefix+"/"), epoch)
 }
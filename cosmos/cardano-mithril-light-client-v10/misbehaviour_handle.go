@@ -1,6 +1,8 @@
 package mithril
 
 import (
+	"bytes"
+
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -12,82 +14,129 @@ import (
 
 // CheckForMisbehaviour checks for evidence of misbehaviour in a Header or Misbehaviour type.
 // It assumes the ClientMessage has already been verified by VerifyClientMessage.
-func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
-	// switch msg := msg.(type) {
-	// case *MithrilHeader:
-	// 	mithrilHeader := msg
-	// 	consState := mithrilHeader.ConsensusState()
-
-	// 	// Check if the Client store already has a consensus state for the header's height
-	// 	// If the consensus state exists, and it matches the header then we return early
-	// 	// since header has already been submitted in a previous UpdateClient.
-	// 	if existingConsState, found := GetConsensusState(clientStore, cdc, mithrilHeader.GetHeight()); found {
-	// 		// If this header has already been submitted and the necessary state is already stored
-	// 		// in client store, thus we can return false
-	// 		// Else if a consensus state already exists for this height, but it does not match the provided header,
-	// 		// thus we can return true
-	// 		// The assumption is that MithrilHeader has already been validated.
-	// 		return !reflect.DeepEqual(existingConsState, mithrilHeader.ConsensusState())
-	// 	}
-
-	// 	// Check that consensus state timestamps are monotonic
-	// 	prevCons, prevOk := GetPreviousConsensusState(clientStore, cdc, mithrilHeader.GetHeight())
-	// 	nextCons, nextOk := GetNextConsensusState(clientStore, cdc, mithrilHeader.GetHeight())
-	// 	// if previous consensus state exists, check consensus state time is greater than previous consensus state time
-	// 	// if previous consensus state is not before current consensus state return true
-	// 	if prevOk && !(prevCons.Timestamp < consState.Timestamp) {
-	// 		return true
-	// 	}
-	// 	// if next consensus state exists, check consensus state time is less than next consensus state time
-	// 	// if next consensus state is not after current consensus state return true
-	// 	if nextOk && !(nextCons.Timestamp > consState.Timestamp) {
-	// 		return true
-	// 	}
-	// case *Misbehaviour:
-	// 	// if heights are equal check that this is valid misbehaviour of a fork
-	// 	// otherwise if heights are unequal check that this is valid misbehavior of BFT time violation
-	// 	if msg.MithrilHeader1.GetHeight().EQ(msg.MithrilHeader2.GetHeight()) {
-	// 		// Ensure that Transaction Snapshot Hashes are different
-	// 		if msg.MithrilHeader1.TransactionSnapshot.SnapshotHash != msg.MithrilHeader2.TransactionSnapshot.SnapshotHash {
-	// 			return true
-	// 		}
-
-	// 	} else if !msg.MithrilHeader1.GetTime().After(msg.MithrilHeader2.GetTime()) {
-	// 		// MithrilHeader1 is at greater height than MithrilHeader2, therefore MithrilHeader1 time must be less than or equal to
-	// 		// MithrilHeader2 time in order to be valid misbehaviour (violation of monotonic time).
-	// 		return true
-	// 	}
-	// }
+func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+	switch msg := msg.(type) {
+	case *MithrilHeader:
+		return cs.headerConflictsWithStoredConsensus(clientStore, cdc, msg)
+	case *Misbehaviour:
+		return cs.headersConflict(clientStore, msg.MithrilHeader1, msg.MithrilHeader2) ||
+			cs.headerConflictsWithStoredConsensus(clientStore, cdc, msg.MithrilHeader1) ||
+			cs.headerConflictsWithStoredConsensus(clientStore, cdc, msg.MithrilHeader2)
+	}
 
 	return false
 }
 
 // verifyMisbehaviour determines whether or not two conflicting
-// headers at the same height would have convinced the light client.
+// headers would have convinced the light client.
 // Misbehaviour sets frozen height to {0, 1} since it is only used as a boolean value (zero or non-zero).
 // Called by clientState.VerifyClientMessage, before clientState.CheckForMisbehaviour
-func (cs *ClientState) verifyMisbehaviour(ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, misbehaviour *Misbehaviour) error {
-	// Regardless of the type of misbehaviour, ensure that both mithril headers are valid and would have been accepted by light-client
+func (cs *ClientState) verifyMisbehaviour(_ sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, misbehaviour *Misbehaviour) error {
+	// Both headers must carry certificates the light client would have accepted,
+	// but either may be older than the latest height.
+	if err := cs.verifyHeaderCertificates(clientStore, misbehaviour.MithrilHeader1); err != nil {
+		return errorsmod.Wrap(err, "verifying MithrilHeader1 in Misbehaviour failed")
+	}
 
-	// Check clientStore stored the respective consensus state for each MithrilHeader in misbehaviour or not
-	_, found := GetConsensusState(clientStore, cdc, misbehaviour.MithrilHeader1.GetHeight())
-	if !found {
-		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get consensus state from clientStore for MithrilHeader1 in Misbehaviour at Height: %s", misbehaviour.MithrilHeader1.GetHeight())
+	if err := cs.verifyHeaderCertificates(clientStore, misbehaviour.MithrilHeader2); err != nil {
+		return errorsmod.Wrap(err, "verifying MithrilHeader2 in Misbehaviour failed")
 	}
 
-	_, found = GetConsensusState(clientStore, cdc, misbehaviour.MithrilHeader2.GetHeight())
-	if !found {
-		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get consensus state from clientStore for MithrilHeader2 in Misbehaviour at Height: %s", misbehaviour.MithrilHeader2.GetHeight())
+	if !cs.CheckForMisbehaviour(sdk.Context{}, cdc, clientStore, misbehaviour) {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "mithril headers do not conflict")
 	}
 
-	// Check the validity of the two conflicting headers
-	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.MithrilHeader1); err != nil {
-		return errorsmod.Wrap(err, "verifying MithrilHeader1 in Misbehaviour failed")
+	return nil
+}
+
+// headersConflict reports whether two verified headers cannot both belong to
+// the certified chain. header1 is expected to be at least as high as header2.
+//
+// Only multi-signed data is compared. A verified header's epoch, block number
+// and merkle root match the signed protocol message of its certificate, while
+// the certificate hash and the metadata timestamps are chosen by whoever built
+// the certificate and say nothing about the chain.
+func (cs ClientState) headersConflict(clientStore storetypes.KVStore, header1, header2 *MithrilHeader) bool {
+	if header1 == nil || header2 == nil || header1.TransactionSnapshot == nil || header2.TransactionSnapshot == nil {
+		return false
 	}
+	snapshot1, snapshot2 := header1.TransactionSnapshot, header2.TransactionSnapshot
+	// CardanoTransactions and CardanoBlocksTransactions certificates are signed
+	// independently, so only certificates of the same type are compared.
+	sameEntityType := header1.certifiesBlocks() == header2.certifiesBlocks()
 
-	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.MithrilHeader2); err != nil {
-		return errorsmod.Wrap(err, "verifying MithrilHeader2 in Misbehaviour failed")
+	if snapshot1.BlockNumber == snapshot2.BlockNumber {
+		// Two transaction snapshot certificates of one type for the same block
+		// number must certify the same transactions in the same epoch.
+		// Certificates of either type must commit to the same HostState root.
+		if sameEntityType && (snapshot1.Epoch != snapshot2.Epoch || snapshot1.MerkleRoot != snapshot2.MerkleRoot) {
+			return true
+		}
+		return cs.ibcStateRootsConflict(header1, header2)
 	}
 
-	return nil
+	// Higher block numbers cannot be certified in an earlier epoch.
+	if sameEntityType && snapshot1.BlockNumber > snapshot2.BlockNumber && snapshot1.Epoch < snapshot2.Epoch {
+		return true
+	}
+
+	// Every transaction snapshot certificate of an epoch chains from the same
+	// first stake distribution certificate of that epoch. Once that certificate
+	// is stored, both headers were verified against it. Otherwise each header's
+	// certificate was verified in full, and only its multi-signed message
+	// identifies it.
+	if snapshot1.Epoch == snapshot2.Epoch &&
+		getFcInEpoch(clientStore, snapshot1.Epoch) == (MithrilCertificate{}) &&
+		header1.MithrilStakeDistributionCertificate != nil &&
+		header2.MithrilStakeDistributionCertificate != nil &&
+		header1.MithrilStakeDistributionCertificate.SignedMessage != header2.MithrilStakeDistributionCertificate.SignedMessage {
+		return true
+	}
+
+	return false
+}
+
+// headerConflictsWithStoredConsensus reports whether a verified header
+// contradicts the consensus state stored at its height: a certificate of the
+// same signed entity type must certify the same merkle root, and every header
+// must commit to the same HostState root.
+func (cs ClientState) headerConflictsWithStoredConsensus(clientStore storetypes.KVStore, cdc codec.BinaryCodec, header *MithrilHeader) bool {
+	if header == nil || header.TransactionSnapshot == nil || header.TransactionSnapshotCertificate == nil {
+		return false
+	}
+
+	existing, found := GetConsensusState(clientStore, cdc, cs.headerHeight(header))
+	if !found {
+		return false
+	}
+
+	if existing.certifiesBlocks() == header.certifiesBlocks() {
+		// Consensus states that predate the stored merkle root have none to compare.
+		merkleRoot := existing.TransactionsMerkleRoot
+		if existing.certifiesBlocks() {
+			merkleRoot = existing.BlocksTransactionsMerkleRoot
+		}
+		if merkleRoot != "" && merkleRoot != header.TransactionSnapshot.MerkleRoot {
+			return true
+		}
+	}
+	if root, err := cs.ExtractIbcStateRootFromHostStateTx(header); err == nil && !bytes.Equal(existing.IbcStateRoot, root) {
+		return true
+	}
+	return false
+}
+
+// ibcStateRootsConflict reports whether two headers commit to different
+// ibc_state_root values. Headers whose root cannot be extracted do not
+// conflict.
+func (cs ClientState) ibcStateRootsConflict(header1, header2 *MithrilHeader) bool {
+	root1, err := cs.ExtractIbcStateRootFromHostStateTx(header1)
+	if err != nil {
+		return false
+	}
+	root2, err := cs.ExtractIbcStateRootFromHostStateTx(header2)
+	if err != nil {
+		return false
+	}
+	return !bytes.Equal(root1, root2)
 }
@@ -0,0 +1,188 @@
+package mithril
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMisbehaviourHeader(blockNumber, epoch uint64, sealedAt time.Time, seed byte) *MithrilHeader {
+	return &MithrilHeader{
+		MithrilStakeDistribution: &MithrilStakeDistribution{
+			Epoch:           epoch,
+			CertificateHash: testHashHex(seed),
+		},
+		MithrilStakeDistributionCertificate: &MithrilCertificate{
+			Hash:          testHashHex(seed),
+			Epoch:         epoch,
+			SignedMessage: testHashHex(seed + 3),
+		},
+		TransactionSnapshot: &CardanoTransactionSnapshot{
+			MerkleRoot:      testHashHex(seed + 1),
+			Epoch:           epoch,
+			BlockNumber:     blockNumber,
+			CertificateHash: testHashHex(seed + 2),
+		},
+		TransactionSnapshotCertificate: &MithrilCertificate{
+			Hash:  testHashHex(seed + 2),
+			Epoch: epoch,
+			Metadata: &CertificateMetadata{
+				SealedAt: sealedAt.UTC().Format(time.RFC3339Nano),
+			},
+		},
+	}
+}
+
+func TestCheckForMisbehaviourConflictingHeaders(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "misbehaviour")
+	clientState := newTestClientState(100, 10, "cardano-test", 24*time.Hour)
+	sealedAt := time.Unix(1_700_000_000, 0)
+
+	testCases := []struct {
+		name     string
+		header1  *MithrilHeader
+		header2  *MithrilHeader
+		conflict bool
+	}{
+		{
+			name:     "identical headers",
+			header1:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+			header2:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+			conflict: false,
+		},
+		{
+			name:     "different snapshot certificates for the same block number",
+			header1:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+			header2:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x20),
+			conflict: true,
+		},
+		{
+			name:     "same block number certified in different epochs",
+			header1:  newTestMisbehaviourHeader(100, 11, sealedAt, 0x10),
+			header2:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+			conflict: true,
+		},
+		{
+			name:     "ordered headers in the same epoch",
+			header1:  newTestMisbehaviourHeader(120, 10, sealedAt.Add(time.Minute), 0x10),
+			header2:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+			conflict: false,
+		},
+		{
+			// sealed_at is not multi-signed and does not order headers.
+			name:     "higher block sealed earlier",
+			header1:  newTestMisbehaviourHeader(120, 10, sealedAt.Add(-time.Minute), 0x10),
+			header2:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+			conflict: false,
+		},
+		{
+			name:     "higher block certified in an earlier epoch",
+			header1:  newTestMisbehaviourHeader(120, 9, sealedAt.Add(time.Minute), 0x10),
+			header2:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+			conflict: true,
+		},
+		{
+			name:     "different stake distribution certificates in the same epoch",
+			header1:  newTestMisbehaviourHeader(120, 10, sealedAt.Add(time.Minute), 0x10),
+			header2:  newTestMisbehaviourHeader(100, 10, sealedAt, 0x20),
+			conflict: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			misbehaviour := &Misbehaviour{MithrilHeader1: tc.header1, MithrilHeader2: tc.header2}
+			require.Equal(t, tc.conflict, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, misbehaviour))
+		})
+	}
+}
+
+func TestCheckForMisbehaviourIgnoresUnauthenticatedCertificateHashes(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "misbehaviour")
+	clientState := newTestClientState(100, 10, "cardano-test", 24*time.Hour)
+	sealedAt := time.Unix(1_700_000_000, 0)
+
+	// The same verified stake distribution certificate relabelled with another
+	// hash is not a conflict.
+	header1 := newTestMisbehaviourHeader(120, 10, sealedAt.Add(time.Minute), 0x10)
+	header2 := newTestMisbehaviourHeader(100, 10, sealedAt, 0x10)
+	header2.MithrilStakeDistributionCertificate.Hash = testHashHex(0x30)
+	misbehaviour := &Misbehaviour{MithrilHeader1: header1, MithrilHeader2: header2}
+	require.False(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, misbehaviour))
+
+	// Once the first certificate of the epoch is stored, both headers were
+	// verified against it and the certificates they carry are not compared.
+	setFcInEpoch(clientStore, *header1.MithrilStakeDistributionCertificate, 10)
+	header2 = newTestMisbehaviourHeader(100, 10, sealedAt, 0x20)
+	misbehaviour = &Misbehaviour{MithrilHeader1: header1, MithrilHeader2: header2}
+	require.False(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, misbehaviour))
+
+	// The same transaction snapshot certificate relabelled with another hash
+	// is not a conflict either.
+	header2 = newTestMisbehaviourHeader(120, 10, sealedAt, 0x10)
+	header2.TransactionSnapshot.CertificateHash = testHashHex(0x40)
+	header2.TransactionSnapshotCertificate.Hash = testHashHex(0x40)
+	misbehaviour = &Misbehaviour{MithrilHeader1: header1, MithrilHeader2: header2}
+	require.False(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, misbehaviour))
+}
+
+func TestCheckForMisbehaviourAgainstStoredConsensusStates(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "misbehaviour")
+	clientState := newTestClientState(120, 10, "cardano-test", 24*time.Hour)
+	sealedAt := time.Unix(1_700_000_000, 0)
+
+	for _, stored := range []struct {
+		height   uint64
+		sealedAt time.Time
+		seed     byte
+	}{
+		{height: 100, sealedAt: sealedAt, seed: 0x10},
+		{height: 120, sealedAt: sealedAt.Add(2 * time.Minute), seed: 0x30},
+	} {
+		header := newTestMisbehaviourHeader(stored.height, 10, stored.sealedAt, stored.seed)
+		height := NewHeight(0, stored.height)
+		setConsensusState(clientStore, cdc, header.ConsensusState(), height)
+		SetIterationKey(clientStore, height)
+	}
+
+	// A header matching the stored consensus state does not conflict.
+	require.False(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, newTestMisbehaviourHeader(100, 10, sealedAt, 0x10)))
+	// A snapshot certificate certifying another merkle root at a stored height conflicts.
+	require.True(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, newTestMisbehaviourHeader(100, 10, sealedAt, 0x20)))
+
+	// The certificate hash and sealed_at are not multi-signed, so a header
+	// certifying the stored merkle root under another hash or at another time
+	// does not conflict.
+	relabelled := newTestMisbehaviourHeader(120, 10, sealedAt, 0x30)
+	relabelled.TransactionSnapshot.CertificateHash = testHashHex(0x40)
+	relabelled.TransactionSnapshotCertificate.Hash = testHashHex(0x40)
+	require.False(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, relabelled))
+	require.False(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, newTestMisbehaviourHeader(110, 10, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), 0x20)))
+
+	// Either header of a Misbehaviour may contradict a stored consensus state.
+	misbehaviour := &Misbehaviour{
+		MithrilHeader1: newTestMisbehaviourHeader(130, 10, sealedAt.Add(time.Minute), 0x30),
+		MithrilHeader2: newTestMisbehaviourHeader(100, 10, sealedAt, 0x30),
+	}
+	require.True(t, clientState.CheckForMisbehaviour(ctx, cdc, clientStore, misbehaviour))
+}
+
+func TestVerifyMisbehaviourRequiresVerifiedHeaders(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "misbehaviour")
+	clientState := newTestClientState(100, 10, "cardano-test", 24*time.Hour)
+	sealedAt := time.Unix(1_700_000_000, 0)
+
+	misbehaviour := &Misbehaviour{
+		MithrilHeader1: newTestMisbehaviourHeader(100, 10, sealedAt, 0x10),
+		MithrilHeader2: newTestMisbehaviourHeader(100, 10, sealedAt, 0x20),
+	}
+	err := clientState.VerifyClientMessage(ctx, cdc, clientStore, misbehaviour)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "verifying MithrilHeader1 in Misbehaviour failed")
+	require.NotErrorIs(t, err, clienttypes.ErrInvalidMisbehaviour)
+}
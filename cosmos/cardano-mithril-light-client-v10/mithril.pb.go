@@ -27,6 +27,34 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Consensus State Key Format
+type ConsensusStateKeyFormat int32
+
+const (
+	// key "clients/<client-id>/consensusStates/<revisionHeight>"
+	CONSENSUS_STATE_KEY_FORMAT_LEGACY ConsensusStateKeyFormat = 0
+	// key "clients/<client-id>/consensusStates/<revisionNumber>-<revisionHeight>"
+	CONSENSUS_STATE_KEY_FORMAT_CANONICAL ConsensusStateKeyFormat = 1
+)
+
+var ConsensusStateKeyFormat_name = map[int32]string{
+	0: "CONSENSUS_STATE_KEY_FORMAT_LEGACY",
+	1: "CONSENSUS_STATE_KEY_FORMAT_CANONICAL",
+}
+
+var ConsensusStateKeyFormat_value = map[string]int32{
+	"CONSENSUS_STATE_KEY_FORMAT_LEGACY":    0,
+	"CONSENSUS_STATE_KEY_FORMAT_CANONICAL": 1,
+}
+
+func (x ConsensusStateKeyFormat) String() string {
+	return proto.EnumName(ConsensusStateKeyFormat_name, int32(x))
+}
+
+func (ConsensusStateKeyFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1479b40cd40cb94a, []int{0}
+}
+
 // Protocol Message Part Key
 type ProtocolMessagePartKey int32
 
@@ -43,24 +71,44 @@ const (
 	PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER ProtocolMessagePartKey = 4
 	// key "latest_block_number"
 	PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER ProtocolMessagePartKey = 5
+	// key "next_protocol_parameters"
+	PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS ProtocolMessagePartKey = 6
+	// key "current_epoch"
+	PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH ProtocolMessagePartKey = 7
+	// key "cardano_stake_distribution_epoch"
+	PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH ProtocolMessagePartKey = 8
+	// key "cardano_stake_distribution_merkle_root"
+	PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT ProtocolMessagePartKey = 9
+	// key "cardano_blocks_transactions_merkle_root"
+	PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT ProtocolMessagePartKey = 10
 )
 
 var ProtocolMessagePartKey_name = map[int32]string{
-	0: "PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED",
-	1: "PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST",
-	2: "PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT",
-	3: "PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY",
-	4: "PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER",
-	5: "PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER",
+	0:  "PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED",
+	1:  "PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST",
+	2:  "PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT",
+	3:  "PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY",
+	4:  "PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER",
+	5:  "PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER",
+	6:  "PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS",
+	7:  "PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH",
+	8:  "PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH",
+	9:  "PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT",
+	10: "PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT",
 }
 
 var ProtocolMessagePartKey_value = map[string]int32{
-	"PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED":                      0,
-	"PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST":                  1,
-	"PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT": 2,
-	"PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY":  3,
-	"PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER":     4,
-	"PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER":              5,
+	"PROTOCOL_MESSAGE_PART_KEY_UNSPECIFIED":                             0,
+	"PROTOCOL_MESSAGE_PART_KEY_SNAPSHOT_DIGEST":                         1,
+	"PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT":        2,
+	"PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY":         3,
+	"PROTOCOL_MESSAGE_PART_KEY_LATEST_IMMUTABLE_FILE_NUMBER":            4,
+	"PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER":                     5,
+	"PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS":                6,
+	"PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH":                           7,
+	"PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_EPOCH":        8,
+	"PROTOCOL_MESSAGE_PART_KEY_CARDANO_STAKE_DISTRIBUTION_MERKLE_ROOT":  9,
+	"PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT": 10,
 }
 
 func (x ProtocolMessagePartKey) String() string {
@@ -68,7 +116,7 @@ func (x ProtocolMessagePartKey) String() string {
 }
 
 func (ProtocolMessagePartKey) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{0}
+	return fileDescriptor_1479b40cd40cb94a, []int{1}
 }
 
 // Currently, the height of the certificate corresponds to the immutable file number in Cardano node
@@ -138,6 +186,21 @@ type ClientState struct {
 	// `ibc_state_root` from its inline datum.
 	HostStateNftPolicyId  []byte `protobuf:"bytes,8,opt,name=host_state_nft_policy_id,json=hostStateNftPolicyId,proto3" json:"host_state_nft_policy_id,omitempty"`
 	HostStateNftTokenName []byte `protobuf:"bytes,9,opt,name=host_state_nft_token_name,json=hostStateNftTokenName,proto3" json:"host_state_nft_token_name,omitempty"`
+	// Ed25519 Mithril genesis verification key of the Cardano network. The
+	// client only trusts certificate chains that end in a genesis certificate
+	// signed by this key.
+	GenesisVerificationKey []byte `protobuf:"bytes,10,opt,name=genesis_verification_key,json=genesisVerificationKey,proto3" json:"genesis_verification_key,omitempty"`
+	// Certificates linking the initial stake distribution certificate back to
+	// the genesis certificate, in any order. Only read when the client is
+	// created; it is cleared before the client state is stored.
+	InitialCertificateChain []*MithrilCertificate `protobuf:"bytes,11,rep,name=initial_certificate_chain,json=initialCertificateChain,proto3" json:"initial_certificate_chain,omitempty"`
+	// Format of the consensus state keys committed under the Cardano
+	// `ibc_state_root`. The revision number of latest_height is the Cardano
+	// revision (e.g. a hard-fork counter) assigned to new consensus states.
+	ConsensusStateKeyFormat ConsensusStateKeyFormat `protobuf:"varint,12,opt,name=consensus_state_key_format,json=consensusStateKeyFormat,proto3,enum=ibc.lightclients.mithril.v1.ConsensusStateKeyFormat" json:"consensus_state_key_format,omitempty"`
+	// Lowest height whose consensus state records a shut-down HostState. Once
+	// set, the client is terminal and proofs at or above it are rejected.
+	HostStateShutdownHeight *Height `protobuf:"bytes,13,opt,name=host_state_shutdown_height,json=hostStateShutdownHeight,proto3" json:"host_state_shutdown_height,omitempty"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -190,6 +253,22 @@ type ConsensusState struct {
 	// HostState datum whose creating transaction is proven to be included in the
 	// Mithril-certified transaction snapshot for this height.
 	IbcStateRoot []byte `protobuf:"bytes,4,opt,name=ibc_state_root,json=ibcStateRoot,proto3" json:"ibc_state_root,omitempty"`
+	// Cardano transactions merkle root of the Mithril-certified transaction
+	// snapshot for this height. Transaction proofs against it authenticate any
+	// transaction up to this height; empty for consensus states that predate it.
+	TransactionsMerkleRoot string `protobuf:"bytes,5,opt,name=transactions_merkle_root,json=transactionsMerkleRoot,proto3" json:"transactions_merkle_root,omitempty"`
+	// Cardano blocks and transactions merkle root, set instead of
+	// transactions_merkle_root when the snapshot for this height is a
+	// CardanoBlocksTransactions snapshot.
+	BlocksTransactionsMerkleRoot string `protobuf:"bytes,6,opt,name=blocks_transactions_merkle_root,json=blocksTransactionsMerkleRoot,proto3" json:"blocks_transactions_merkle_root,omitempty"`
+	// Hash of the Cardano block holding the HostState transaction, when the
+	// snapshot for this height certifies blocks.
+	HostStateBlockHash string `protobuf:"bytes,7,opt,name=host_state_block_hash,json=hostStateBlockHash,proto3" json:"host_state_block_hash,omitempty"`
+	// Set when the HostState datum the root was read from is shutting down.
+	HostStateShutdown *HostStateShutdown `protobuf:"bytes,8,opt,name=host_state_shutdown,json=hostStateShutdown,proto3" json:"host_state_shutdown,omitempty"`
+	// Version of the HostState datum the root was read from. Transaction
+	// membership proofs cannot read a HostState output older than this one.
+	HostStateVersion uint64 `protobuf:"varint,9,opt,name=host_state_version,json=hostStateVersion,proto3" json:"host_state_version,omitempty"`
 }
 
 func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
@@ -225,6 +304,94 @@ func (m *ConsensusState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
 
+// HostStateShutdown is the ShuttingDown mode of a HostState datum, in POSIX
+// milliseconds.
+type HostStateShutdown struct {
+	InitiatedAtMs    uint64 `protobuf:"varint,1,opt,name=initiated_at_ms,json=initiatedAtMs,proto3" json:"initiated_at_ms,omitempty"`
+	GracePeriodEndMs uint64 `protobuf:"varint,2,opt,name=grace_period_end_ms,json=gracePeriodEndMs,proto3" json:"grace_period_end_ms,omitempty"`
+}
+
+func (m *HostStateShutdown) Reset()         { *m = HostStateShutdown{} }
+func (m *HostStateShutdown) String() string { return proto.CompactTextString(m) }
+func (*HostStateShutdown) ProtoMessage()    {}
+func (*HostStateShutdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1479b40cd40cb94a, []int{3}
+}
+func (m *HostStateShutdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostStateShutdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostStateShutdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostStateShutdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostStateShutdown.Merge(m, src)
+}
+func (m *HostStateShutdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostStateShutdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostStateShutdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostStateShutdown proto.InternalMessageInfo
+
+// MithrilTransactionMembershipProof proves a packet-level IBC value from the
+// HostState output of a transaction certified by the transaction snapshot of a
+// trusted consensus state. It lets relayers prove state committed between two
+// client updates without another update.
+type MithrilTransactionMembershipProof struct {
+	// Mithril transaction-set proof (Gateway JSON encoding) for tx_hash.
+	TransactionProof []byte `protobuf:"bytes,1,opt,name=transaction_proof,json=transactionProof,proto3" json:"transaction_proof,omitempty"`
+	TxHash           string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxBodyCbor       []byte `protobuf:"bytes,3,opt,name=tx_body_cbor,json=txBodyCbor,proto3" json:"tx_body_cbor,omitempty"`
+	OutputIndex      uint32 `protobuf:"varint,4,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// ICS-23 proof of the value under the `ibc_state_root` of the HostState
+	// output datum.
+	IbcStateProof []byte `protobuf:"bytes,5,opt,name=ibc_state_proof,json=ibcStateProof,proto3" json:"ibc_state_proof,omitempty"`
+}
+
+func (m *MithrilTransactionMembershipProof) Reset()         { *m = MithrilTransactionMembershipProof{} }
+func (m *MithrilTransactionMembershipProof) String() string { return proto.CompactTextString(m) }
+func (*MithrilTransactionMembershipProof) ProtoMessage()    {}
+func (*MithrilTransactionMembershipProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1479b40cd40cb94a, []int{4}
+}
+func (m *MithrilTransactionMembershipProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MithrilTransactionMembershipProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MithrilTransactionMembershipProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MithrilTransactionMembershipProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MithrilTransactionMembershipProof.Merge(m, src)
+}
+func (m *MithrilTransactionMembershipProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MithrilTransactionMembershipProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MithrilTransactionMembershipProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MithrilTransactionMembershipProof proto.InternalMessageInfo
+
 // Misbehavior represents a conflict between two headers.
 type Misbehaviour struct {
 	// ClientID is deprecated
@@ -237,7 +404,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{3}
+	return fileDescriptor_1479b40cd40cb94a, []int{5}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,14 +460,16 @@ type MithrilHeader struct {
 	HostStateTxHash        string `protobuf:"bytes,5,opt,name=host_state_tx_hash,json=hostStateTxHash,proto3" json:"host_state_tx_hash,omitempty"`
 	HostStateTxBodyCbor    []byte `protobuf:"bytes,6,opt,name=host_state_tx_body_cbor,json=hostStateTxBodyCbor,proto3" json:"host_state_tx_body_cbor,omitempty"`
 	HostStateTxOutputIndex uint32 `protobuf:"varint,7,opt,name=host_state_tx_output_index,json=hostStateTxOutputIndex,proto3" json:"host_state_tx_output_index,omitempty"`
-	HostStateTxProof       []byte `protobuf:"bytes,8,opt,name=host_state_tx_proof,json=hostStateTxProof,proto3" json:"host_state_tx_proof,omitempty"`
+	// Gateway JSON proof of host_state_tx_hash. For a CardanoBlocksTransactions
+	// snapshot it also proves the block holding the transaction.
+	HostStateTxProof []byte `protobuf:"bytes,8,opt,name=host_state_tx_proof,json=hostStateTxProof,proto3" json:"host_state_tx_proof,omitempty"`
 }
 
 func (m *MithrilHeader) Reset()         { *m = MithrilHeader{} }
 func (m *MithrilHeader) String() string { return proto.CompactTextString(m) }
 func (*MithrilHeader) ProtoMessage()    {}
 func (*MithrilHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{4}
+	return fileDescriptor_1479b40cd40cb94a, []int{6}
 }
 func (m *MithrilHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +512,7 @@ func (m *MithrilStakeDistribution) Reset()         { *m = MithrilStakeDistributi
 func (m *MithrilStakeDistribution) String() string { return proto.CompactTextString(m) }
 func (*MithrilStakeDistribution) ProtoMessage()    {}
 func (*MithrilStakeDistribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{5}
+	return fileDescriptor_1479b40cd40cb94a, []int{7}
 }
 func (m *MithrilStakeDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +555,7 @@ func (m *CardanoTransactionSnapshot) Reset()         { *m = CardanoTransactionSn
 func (m *CardanoTransactionSnapshot) String() string { return proto.CompactTextString(m) }
 func (*CardanoTransactionSnapshot) ProtoMessage()    {}
 func (*CardanoTransactionSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{6}
+	return fileDescriptor_1479b40cd40cb94a, []int{8}
 }
 func (m *CardanoTransactionSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +602,7 @@ func (m *MithrilCertificate) Reset()         { *m = MithrilCertificate{} }
 func (m *MithrilCertificate) String() string { return proto.CompactTextString(m) }
 func (*MithrilCertificate) ProtoMessage()    {}
 func (*MithrilCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{7}
+	return fileDescriptor_1479b40cd40cb94a, []int{9}
 }
 func (m *MithrilCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +645,7 @@ func (m *CertificateMetadata) Reset()         { *m = CertificateMetadata{} }
 func (m *CertificateMetadata) String() string { return proto.CompactTextString(m) }
 func (*CertificateMetadata) ProtoMessage()    {}
 func (*CertificateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{8}
+	return fileDescriptor_1479b40cd40cb94a, []int{10}
 }
 func (m *CertificateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +684,7 @@ func (m *SignerWithStake) Reset()         { *m = SignerWithStake{} }
 func (m *SignerWithStake) String() string { return proto.CompactTextString(m) }
 func (*SignerWithStake) ProtoMessage()    {}
 func (*SignerWithStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{9}
+	return fileDescriptor_1479b40cd40cb94a, []int{11}
 }
 func (m *SignerWithStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +722,7 @@ func (m *ProtocolMessage) Reset()         { *m = ProtocolMessage{} }
 func (m *ProtocolMessage) String() string { return proto.CompactTextString(m) }
 func (*ProtocolMessage) ProtoMessage()    {}
 func (*ProtocolMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{10}
+	return fileDescriptor_1479b40cd40cb94a, []int{12}
 }
 func (m *ProtocolMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +761,7 @@ func (m *MessagePart) Reset()         { *m = MessagePart{} }
 func (m *MessagePart) String() string { return proto.CompactTextString(m) }
 func (*MessagePart) ProtoMessage()    {}
 func (*MessagePart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{11}
+	return fileDescriptor_1479b40cd40cb94a, []int{13}
 }
 func (m *MessagePart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +804,7 @@ func (m *MithrilProtocolParameters) Reset()         { *m = MithrilProtocolParame
 func (m *MithrilProtocolParameters) String() string { return proto.CompactTextString(m) }
 func (*MithrilProtocolParameters) ProtoMessage()    {}
 func (*MithrilProtocolParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{12}
+	return fileDescriptor_1479b40cd40cb94a, []int{14}
 }
 func (m *MithrilProtocolParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +842,7 @@ func (m *ProtocolGenesisSignature) Reset()         { *m = ProtocolGenesisSignatu
 func (m *ProtocolGenesisSignature) String() string { return proto.CompactTextString(m) }
 func (*ProtocolGenesisSignature) ProtoMessage()    {}
 func (*ProtocolGenesisSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{13}
+	return fileDescriptor_1479b40cd40cb94a, []int{15}
 }
 func (m *ProtocolGenesisSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +880,7 @@ func (m *ProtocolGenesisSignature) GetSignature() []byte {
 
 // An entity type associated with the signature.
 type SignedEntityType struct {
-	// MithrilStakeDistribution(epoch), CardanoStakeDistribution(epoch), CardanoImmutableFilesFull(CardanoDbBeacon), CardanoTransactions(CardanoDbBeacon)
+	// MithrilStakeDistribution(epoch), CardanoStakeDistribution(epoch), CardanoImmutableFilesFull(CardanoDbBeacon), CardanoTransactions(CardanoDbBeacon), CardanoBlocksTransactions(epoch, block_number)
 	//
 	// Types that are valid to be assigned to Entity:
 	//
@@ -719,6 +888,7 @@ type SignedEntityType struct {
 	//	*SignedEntityType_CardanoStakeDistribution
 	//	*SignedEntityType_CardanoImmutableFilesFull
 	//	*SignedEntityType_CardanoTransactions
+	//	*SignedEntityType_CardanoBlocksTransactions
 	Entity isSignedEntityType_Entity `protobuf_oneof:"entity"`
 }
 
@@ -726,7 +896,7 @@ func (m *SignedEntityType) Reset()         { *m = SignedEntityType{} }
 func (m *SignedEntityType) String() string { return proto.CompactTextString(m) }
 func (*SignedEntityType) ProtoMessage()    {}
 func (*SignedEntityType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{14}
+	return fileDescriptor_1479b40cd40cb94a, []int{16}
 }
 func (m *SignedEntityType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,11 +943,15 @@ type SignedEntityType_CardanoImmutableFilesFull struct {
 type SignedEntityType_CardanoTransactions struct {
 	CardanoTransactions *CardanoTransactions `protobuf:"bytes,4,opt,name=cardano_transactions,json=cardanoTransactions,proto3,oneof" json:"cardano_transactions,omitempty"`
 }
+type SignedEntityType_CardanoBlocksTransactions struct {
+	CardanoBlocksTransactions *CardanoBlocksTransactions `protobuf:"bytes,5,opt,name=cardano_blocks_transactions,json=cardanoBlocksTransactions,proto3,oneof" json:"cardano_blocks_transactions,omitempty"`
+}
 
 func (*SignedEntityType_MithrilStakeDistribution) isSignedEntityType_Entity()  {}
 func (*SignedEntityType_CardanoStakeDistribution) isSignedEntityType_Entity()  {}
 func (*SignedEntityType_CardanoImmutableFilesFull) isSignedEntityType_Entity() {}
 func (*SignedEntityType_CardanoTransactions) isSignedEntityType_Entity()       {}
+func (*SignedEntityType_CardanoBlocksTransactions) isSignedEntityType_Entity() {}
 
 func (m *SignedEntityType) GetEntity() isSignedEntityType_Entity {
 	if m != nil {
@@ -814,6 +988,13 @@ func (m *SignedEntityType) GetCardanoTransactions() *CardanoTransactions {
 	return nil
 }
 
+func (m *SignedEntityType) GetCardanoBlocksTransactions() *CardanoBlocksTransactions {
+	if x, ok := m.GetEntity().(*SignedEntityType_CardanoBlocksTransactions); ok {
+		return x.CardanoBlocksTransactions
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SignedEntityType) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -821,6 +1002,7 @@ func (*SignedEntityType) XXX_OneofWrappers() []interface{} {
 		(*SignedEntityType_CardanoStakeDistribution)(nil),
 		(*SignedEntityType_CardanoImmutableFilesFull)(nil),
 		(*SignedEntityType_CardanoTransactions)(nil),
+		(*SignedEntityType_CardanoBlocksTransactions)(nil),
 	}
 }
 
@@ -833,7 +1015,7 @@ func (m *CardanoStakeDistribution) Reset()         { *m = CardanoStakeDistributi
 func (m *CardanoStakeDistribution) String() string { return proto.CompactTextString(m) }
 func (*CardanoStakeDistribution) ProtoMessage()    {}
 func (*CardanoStakeDistribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{15}
+	return fileDescriptor_1479b40cd40cb94a, []int{17}
 }
 func (m *CardanoStakeDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +1060,7 @@ func (m *CardanoImmutableFilesFull) Reset()         { *m = CardanoImmutableFiles
 func (m *CardanoImmutableFilesFull) String() string { return proto.CompactTextString(m) }
 func (*CardanoImmutableFilesFull) ProtoMessage()    {}
 func (*CardanoImmutableFilesFull) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{16}
+	return fileDescriptor_1479b40cd40cb94a, []int{18}
 }
 func (m *CardanoImmutableFilesFull) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1106,7 @@ func (m *CardanoTransactions) Reset()         { *m = CardanoTransactions{} }
 func (m *CardanoTransactions) String() string { return proto.CompactTextString(m) }
 func (*CardanoTransactions) ProtoMessage()    {}
 func (*CardanoTransactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{17}
+	return fileDescriptor_1479b40cd40cb94a, []int{19}
 }
 func (m *CardanoTransactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -967,6 +1149,59 @@ func (m *CardanoTransactions) GetBlockNumber() uint64 {
 	return 0
 }
 
+// Cardano blocks and transactions
+type CardanoBlocksTransactions struct {
+	Epoch       uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *CardanoBlocksTransactions) Reset()         { *m = CardanoBlocksTransactions{} }
+func (m *CardanoBlocksTransactions) String() string { return proto.CompactTextString(m) }
+func (*CardanoBlocksTransactions) ProtoMessage()    {}
+func (*CardanoBlocksTransactions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1479b40cd40cb94a, []int{20}
+}
+func (m *CardanoBlocksTransactions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CardanoBlocksTransactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CardanoBlocksTransactions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CardanoBlocksTransactions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardanoBlocksTransactions.Merge(m, src)
+}
+func (m *CardanoBlocksTransactions) XXX_Size() int {
+	return m.Size()
+}
+func (m *CardanoBlocksTransactions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CardanoBlocksTransactions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CardanoBlocksTransactions proto.InternalMessageInfo
+
+func (m *CardanoBlocksTransactions) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *CardanoBlocksTransactions) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
 // Cardano db beacon
 type CardanoDbBeacon struct {
 	Network             string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -978,7 +1213,7 @@ func (m *CardanoDbBeacon) Reset()         { *m = CardanoDbBeacon{} }
 func (m *CardanoDbBeacon) String() string { return proto.CompactTextString(m) }
 func (*CardanoDbBeacon) ProtoMessage()    {}
 func (*CardanoDbBeacon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{18}
+	return fileDescriptor_1479b40cd40cb94a, []int{21}
 }
 func (m *CardanoDbBeacon) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1039,7 +1274,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1479b40cd40cb94a, []int{19}
+	return fileDescriptor_1479b40cd40cb94a, []int{22}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,10 +1318,13 @@ func (m *Fraction) GetDenominator() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("ibc.lightclients.mithril.v1.ConsensusStateKeyFormat", ConsensusStateKeyFormat_name, ConsensusStateKeyFormat_value)
 	proto.RegisterEnum("ibc.lightclients.mithril.v1.ProtocolMessagePartKey", ProtocolMessagePartKey_name, ProtocolMessagePartKey_value)
 	proto.RegisterType((*Height)(nil), "ibc.lightclients.mithril.v1.Height")
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.mithril.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.mithril.v1.ConsensusState")
+	proto.RegisterType((*HostStateShutdown)(nil), "ibc.lightclients.mithril.v1.HostStateShutdown")
+	proto.RegisterType((*MithrilTransactionMembershipProof)(nil), "ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.mithril.v1.Misbehaviour")
 	proto.RegisterType((*MithrilHeader)(nil), "ibc.lightclients.mithril.v1.MithrilHeader")
 	proto.RegisterType((*MithrilStakeDistribution)(nil), "ibc.lightclients.mithril.v1.MithrilStakeDistribution")
@@ -1102,6 +1340,7 @@ func init() {
 	proto.RegisterType((*CardanoStakeDistribution)(nil), "ibc.lightclients.mithril.v1.CardanoStakeDistribution")
 	proto.RegisterType((*CardanoImmutableFilesFull)(nil), "ibc.lightclients.mithril.v1.CardanoImmutableFilesFull")
 	proto.RegisterType((*CardanoTransactions)(nil), "ibc.lightclients.mithril.v1.CardanoTransactions")
+	proto.RegisterType((*CardanoBlocksTransactions)(nil), "ibc.lightclients.mithril.v1.CardanoBlocksTransactions")
 	proto.RegisterType((*CardanoDbBeacon)(nil), "ibc.lightclients.mithril.v1.CardanoDbBeacon")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.mithril.v1.Fraction")
 }
@@ -1111,134 +1350,164 @@ func init() {
 }
 
 var fileDescriptor_1479b40cd40cb94a = []byte{
-	// 2023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x41, 0x73, 0xdb, 0xc6,
-	0x15, 0x26, 0x29, 0x5a, 0x12, 0x9f, 0x28, 0x89, 0x59, 0x39, 0x2e, 0x24, 0xbb, 0x92, 0x22, 0xd7,
-	0xad, 0x9d, 0x58, 0x92, 0xa5, 0xb4, 0x6e, 0xea, 0x36, 0x99, 0x92, 0x14, 0x25, 0xb1, 0x16, 0x29,
-	0xce, 0x92, 0x76, 0xda, 0xf4, 0xb0, 0x03, 0x02, 0x4b, 0x62, 0x2b, 0x02, 0x8b, 0x01, 0x16, 0x8a,
-	0xd9, 0x99, 0x4e, 0x2f, 0x3d, 0xa4, 0xd3, 0x4b, 0x6f, 0xcd, 0x31, 0x33, 0xbd, 0xf4, 0xde, 0x7f,
-	0xd0, 0x53, 0x8e, 0xe9, 0xa9, 0x9d, 0x1e, 0xd2, 0x8c, 0x3d, 0xd3, 0x53, 0x7f, 0x44, 0x06, 0x8b,
-	0x05, 0x09, 0x4a, 0x24, 0x2d, 0x3b, 0x93, 0x1b, 0xf7, 0x7b, 0xef, 0xdb, 0xb7, 0xef, 0xed, 0x7b,
-	0x6f, 0x1f, 0x08, 0xf7, 0x58, 0xdb, 0xd8, 0xed, 0xb1, 0xae, 0x25, 0x8c, 0x1e, 0xa3, 0x8e, 0xf0,
-	0x77, 0x6d, 0x26, 0x2c, 0x8f, 0xf5, 0x76, 0xcf, 0xf7, 0xe2, 0x9f, 0x3b, 0xae, 0xc7, 0x05, 0x47,
-	0x37, 0x59, 0xdb, 0xd8, 0x49, 0xaa, 0xee, 0xc4, 0xf2, 0xf3, 0xbd, 0xb5, 0xeb, 0x5d, 0xde, 0xe5,
-	0x52, 0x6f, 0x37, 0xfc, 0x15, 0x51, 0xd6, 0xd6, 0xbb, 0x9c, 0x77, 0x7b, 0x74, 0x57, 0xae, 0xda,
-	0x41, 0x67, 0xd7, 0x0c, 0x3c, 0x5d, 0x30, 0xee, 0x44, 0xf2, 0x2d, 0x13, 0x66, 0x8f, 0x69, 0xb8,
-	0x23, 0xfa, 0x01, 0x2c, 0x7b, 0xf4, 0x9c, 0xf9, 0x8c, 0x3b, 0xc4, 0x09, 0xec, 0x36, 0xf5, 0xb4,
-	0xf4, 0x66, 0xfa, 0x6e, 0x16, 0x2f, 0xc5, 0x70, 0x5d, 0xa2, 0x23, 0x8a, 0x96, 0xe4, 0x6a, 0x99,
-	0x51, 0xc5, 0x68, 0xc7, 0x47, 0xf3, 0x9f, 0x7c, 0xb6, 0x91, 0xfa, 0xf4, 0xb3, 0x8d, 0xd4, 0xd6,
-	0xdf, 0xb3, 0xb0, 0x50, 0x96, 0x47, 0x6e, 0x0a, 0x5d, 0x50, 0xb4, 0x0a, 0xf3, 0x86, 0xa5, 0x33,
-	0x87, 0x30, 0x53, 0x1a, 0xc9, 0xe1, 0x39, 0xb9, 0xae, 0x9a, 0xe8, 0x18, 0x16, 0x7b, 0xba, 0xa0,
-	0xbe, 0x48, 0xee, 0xbd, 0xb0, 0x7f, 0x7b, 0x67, 0x8a, 0xef, 0x3b, 0x91, 0x41, 0x9c, 0x8f, 0x98,
-	0xca, 0xa1, 0x63, 0x58, 0xec, 0x78, 0xfc, 0xb7, 0x74, 0x70, 0xca, 0x99, 0x57, 0xd8, 0x29, 0x62,
-	0xaa, 0x9d, 0x6e, 0xc3, 0xa2, 0x11, 0x78, 0x1e, 0x75, 0x04, 0xa1, 0x2e, 0x37, 0x2c, 0x2d, 0x2b,
-	0xfd, 0xcd, 0x2b, 0xb0, 0x12, 0x62, 0xe8, 0x04, 0x96, 0x85, 0x17, 0xf8, 0x82, 0x39, 0x5d, 0xe2,
-	0x52, 0x8f, 0x71, 0x53, 0xbb, 0x26, 0x0d, 0xae, 0xee, 0x44, 0x77, 0xb0, 0x13, 0xdf, 0xc1, 0xce,
-	0x81, 0xba, 0x83, 0xd2, 0xfc, 0xe7, 0x5f, 0x6e, 0xa4, 0x3e, 0xfd, 0xef, 0x46, 0x1a, 0x2f, 0xc5,
-	0xdc, 0x86, 0xa4, 0xa2, 0x2e, 0xac, 0x48, 0x75, 0x83, 0xf7, 0x88, 0xab, 0x7b, 0xba, 0x4d, 0x05,
-	0xf5, 0x7c, 0x6d, 0x56, 0xee, 0xf8, 0x70, 0xaa, 0x0b, 0xb5, 0xe8, 0x67, 0x43, 0xd1, 0x1b, 0x03,
-	0x36, 0x46, 0xee, 0x25, 0x0c, 0xbd, 0x05, 0xf9, 0xc0, 0xed, 0x7a, 0xba, 0x49, 0x89, 0xab, 0x0b,
-	0x4b, 0x9b, 0xdb, 0x9c, 0xb9, 0x9b, 0xc3, 0x0b, 0x0a, 0x6b, 0xe8, 0xc2, 0x42, 0x0f, 0x41, 0xb3,
-	0xb8, 0x2f, 0x88, 0x1f, 0xde, 0x1d, 0x71, 0x3a, 0x82, 0xb8, 0xbc, 0xc7, 0x8c, 0x7e, 0x78, 0x7b,
-	0xf3, 0x9b, 0xe9, 0xbb, 0x79, 0x7c, 0x3d, 0x94, 0xcb, 0xab, 0xad, 0x77, 0x44, 0x43, 0x0a, 0xab,
-	0x26, 0x7a, 0x0f, 0x56, 0x2f, 0xf0, 0x04, 0x3f, 0xa3, 0x0e, 0x71, 0x74, 0x9b, 0x6a, 0x39, 0x49,
-	0x7c, 0x33, 0x49, 0x6c, 0x85, 0xd2, 0xba, 0x6e, 0xd3, 0x47, 0xd9, 0x30, 0x73, 0xb6, 0xfe, 0x94,
-	0x81, 0xa5, 0x32, 0x77, 0x7c, 0xea, 0xf8, 0x81, 0x1f, 0x25, 0xce, 0x2d, 0xc8, 0x09, 0x66, 0x53,
-	0x5f, 0xe8, 0xb6, 0xab, 0xd2, 0x73, 0x08, 0x20, 0x0e, 0xb7, 0x3a, 0xcc, 0xf3, 0x05, 0x31, 0xa8,
-	0x27, 0x88, 0xa5, 0xfb, 0x16, 0x51, 0xb9, 0x14, 0x5d, 0x5b, 0x94, 0x4a, 0xbb, 0x57, 0x89, 0x5e,
-	0x99, 0x7a, 0x82, 0x75, 0x98, 0xa1, 0x0b, 0x8a, 0x35, 0xb9, 0x69, 0x88, 0x1c, 0xeb, 0xbe, 0x75,
-	0x22, 0x77, 0x8c, 0xee, 0xfc, 0x03, 0xb8, 0xa5, 0x0c, 0x0c, 0x2d, 0x8a, 0x67, 0xc4, 0x77, 0x74,
-	0xd7, 0xb7, 0x78, 0x94, 0x71, 0x39, 0xac, 0x45, 0x3a, 0xf1, 0x06, 0xad, 0x67, 0x4d, 0x25, 0x47,
-	0xdf, 0x83, 0x25, 0xd6, 0x36, 0x54, 0x80, 0x3c, 0xce, 0x85, 0xcc, 0xac, 0x3c, 0xce, 0xb3, 0xb6,
-	0x21, 0x1d, 0xc6, 0x9c, 0x0b, 0x15, 0x8d, 0x3f, 0x66, 0x20, 0x5f, 0x63, 0x7e, 0x9b, 0x5a, 0xfa,
-	0x39, 0xe3, 0x81, 0x87, 0x36, 0x20, 0x17, 0x9d, 0x7f, 0x50, 0x45, 0xa5, 0x8c, 0x96, 0xc6, 0xf3,
-	0x11, 0x58, 0x35, 0x91, 0x05, 0x05, 0xe5, 0x18, 0xb1, 0xa8, 0x6e, 0x52, 0x8f, 0xec, 0xa9, 0x10,
-	0xbc, 0x7d, 0x95, 0x10, 0x1c, 0x4b, 0x4e, 0x09, 0x3d, 0xff, 0x72, 0x63, 0x69, 0x04, 0xda, 0xc3,
-	0x4b, 0xf6, 0xc8, 0x7a, 0x8c, 0xa5, 0x7d, 0x55, 0x6d, 0xdf, 0xcc, 0xd2, 0xfe, 0x05, 0x4b, 0xfb,
-	0x2a, 0x16, 0x5f, 0xcd, 0xc2, 0xe2, 0x88, 0x22, 0xf2, 0x61, 0x2d, 0x3e, 0x81, 0x2f, 0xf4, 0x33,
-	0x4a, 0x4c, 0xe6, 0x0b, 0x8f, 0xb5, 0x83, 0xb0, 0xce, 0x64, 0x74, 0x16, 0xf6, 0x7f, 0x74, 0x95,
-	0xb3, 0x34, 0x43, 0xf6, 0x41, 0x82, 0x8c, 0x35, 0x7b, 0x82, 0x04, 0xfd, 0x21, 0x0d, 0xdf, 0x9f,
-	0x6c, 0x55, 0xe6, 0x84, 0xca, 0xa1, 0xd7, 0x4d, 0xbd, 0xdb, 0x93, 0x6c, 0x27, 0x94, 0xd0, 0x6f,
-	0xe0, 0xba, 0xf0, 0x74, 0xc7, 0xd7, 0x0d, 0x69, 0x76, 0x24, 0xfb, 0x16, 0xf6, 0x7f, 0x3c, 0xd5,
-	0x66, 0x59, 0xf7, 0x4c, 0xdd, 0xe1, 0xad, 0x21, 0x3f, 0x4e, 0x4e, 0xbc, 0x22, 0x2e, 0x83, 0xa8,
-	0x0f, 0x9b, 0xe3, 0x6c, 0x8d, 0xf8, 0x9a, 0x7d, 0x3d, 0x5f, 0xd7, 0xc7, 0xd8, 0x4b, 0xba, 0xf9,
-	0x97, 0x34, 0x3c, 0x70, 0xc3, 0x27, 0x86, 0x07, 0x3e, 0xb9, 0x5a, 0xd8, 0x7d, 0x2d, 0xb7, 0x39,
-	0xf3, 0x3a, 0x67, 0xb9, 0x1f, 0x1b, 0xaa, 0xbd, 0x3c, 0xfe, 0x3e, 0x7a, 0x07, 0x50, 0xa2, 0xd1,
-	0x89, 0x67, 0xb2, 0x11, 0xc8, 0xee, 0x9f, 0xc3, 0xcb, 0x83, 0x0e, 0xd7, 0x7a, 0x16, 0x56, 0x3f,
-	0xfa, 0x21, 0x7c, 0x67, 0x54, 0xb9, 0xcd, 0xcd, 0x3e, 0x31, 0xda, 0xdc, 0x93, 0xdd, 0x3d, 0x8f,
-	0x57, 0x12, 0x8c, 0x12, 0x37, 0xfb, 0xe5, 0x36, 0xf7, 0xd0, 0x23, 0x58, 0x1b, 0x65, 0xf1, 0x40,
-	0xb8, 0x81, 0x20, 0xcc, 0x31, 0xe9, 0x33, 0x6d, 0x6e, 0x33, 0x7d, 0x77, 0x11, 0xdf, 0x48, 0x10,
-	0x4f, 0xa5, 0xb8, 0x1a, 0x4a, 0xd1, 0x36, 0xac, 0x8c, 0x72, 0x5d, 0x8f, 0xf3, 0x8e, 0x6a, 0xdd,
-	0x85, 0x04, 0xa9, 0x11, 0xe2, 0xaa, 0xc4, 0xfe, 0x95, 0x01, 0x6d, 0x92, 0xf3, 0xe8, 0x3a, 0x5c,
-	0x8b, 0x3a, 0x6a, 0xd4, 0x82, 0xa3, 0x05, 0xfa, 0x08, 0x90, 0xcf, 0xba, 0x0e, 0xf5, 0x7c, 0xf2,
-	0x31, 0x13, 0x56, 0x74, 0x37, 0x5a, 0x46, 0xde, 0xc0, 0xfd, 0xa9, 0x37, 0xd0, 0x94, 0xb4, 0x0f,
-	0x99, 0xb0, 0xa4, 0x2d, 0x5c, 0x50, 0xfb, 0x0c, 0x10, 0x84, 0x20, 0x2b, 0x83, 0x1a, 0x75, 0x54,
-	0xf9, 0x1b, 0xdd, 0x83, 0x42, 0xe2, 0xae, 0xa3, 0xa0, 0x67, 0xa3, 0xa0, 0x27, 0x70, 0x19, 0xf4,
-	0xef, 0x02, 0x18, 0x1e, 0xd5, 0x05, 0x35, 0x89, 0x2e, 0xe4, 0xcd, 0x64, 0x71, 0x4e, 0x21, 0x45,
-	0x81, 0x28, 0xa0, 0xcb, 0xaf, 0xed, 0x37, 0x7c, 0x6c, 0xdf, 0xb8, 0xf4, 0xd8, 0xaa, 0xc8, 0xfe,
-	0x27, 0x0d, 0x6b, 0x93, 0xcb, 0x0e, 0x6d, 0xc0, 0x82, 0x4d, 0xbd, 0xb3, 0x9e, 0x7a, 0x10, 0xa2,
-	0xf1, 0x08, 0x22, 0x28, 0x7c, 0x0e, 0x86, 0xc1, 0xcf, 0x24, 0x83, 0xff, 0x16, 0xe4, 0xdb, 0x3d,
-	0x6e, 0x9c, 0xc5, 0xb3, 0xdb, 0x8c, 0x14, 0x2e, 0x48, 0x4c, 0x0d, 0x6e, 0x71, 0x0c, 0xb3, 0x2f,
-	0x89, 0xe1, 0xb5, 0xab, 0xc4, 0x70, 0x56, 0x2a, 0x0d, 0x63, 0xa8, 0x9c, 0xfb, 0x5b, 0x16, 0xd0,
-	0xe5, 0x7a, 0x1a, 0x98, 0x4e, 0x27, 0x4c, 0xdf, 0x86, 0xc5, 0x41, 0x39, 0x4b, 0x61, 0x46, 0x0a,
-	0xf3, 0x31, 0x28, 0x8d, 0x0e, 0x9c, 0x9d, 0x49, 0x3a, 0xfb, 0x6b, 0x95, 0x69, 0x26, 0xa1, 0x8e,
-	0x60, 0xa2, 0x4f, 0x44, 0xdf, 0x8d, 0xfb, 0xce, 0xf6, 0xcb, 0x33, 0xcd, 0xac, 0x48, 0x56, 0xab,
-	0xef, 0xc6, 0xa9, 0x96, 0x40, 0xd0, 0x09, 0xcc, 0xdb, 0x54, 0xe8, 0xa6, 0x2e, 0x74, 0x35, 0xc1,
-	0x3d, 0x98, 0xde, 0x42, 0x87, 0x7e, 0xd6, 0x14, 0x0f, 0x0f, 0x76, 0x40, 0x1f, 0x42, 0x61, 0x90,
-	0x5a, 0x36, 0xf5, 0x7d, 0xbd, 0x4b, 0x55, 0x62, 0x4d, 0x2f, 0x89, 0x38, 0xa3, 0x6a, 0x11, 0x07,
-	0x2f, 0xbb, 0xa3, 0x00, 0xba, 0x03, 0x4b, 0x2a, 0x06, 0xf1, 0xb6, 0x73, 0x32, 0x7e, 0x8b, 0x11,
-	0x1a, 0xab, 0xfd, 0x0c, 0xd6, 0xf4, 0x6e, 0xd7, 0xa3, 0xdd, 0xf0, 0x7a, 0xcf, 0xa9, 0x17, 0x9d,
-	0x34, 0xec, 0x93, 0x67, 0xb4, 0x2f, 0x7b, 0x40, 0x0e, 0x6b, 0x03, 0x8d, 0xa7, 0x09, 0x85, 0xc7,
-	0xb4, 0x1f, 0xce, 0xfa, 0x76, 0xd0, 0x13, 0x8c, 0x84, 0x9b, 0xea, 0x22, 0xf0, 0xa2, 0xc1, 0x2d,
-	0x87, 0x97, 0x24, 0xdc, 0x8c, 0x51, 0xf4, 0x0e, 0xbc, 0xd1, 0xa5, 0x0e, 0xf5, 0x99, 0x9f, 0x50,
-	0x05, 0xa9, 0x5a, 0x50, 0x82, 0x81, 0xb2, 0x4a, 0x95, 0x7f, 0x66, 0x60, 0x65, 0x4c, 0xec, 0x90,
-	0x06, 0x73, 0x0e, 0x15, 0x1f, 0x73, 0xef, 0x2c, 0xfe, 0x36, 0x50, 0xcb, 0x30, 0x59, 0x07, 0xb1,
-	0x3c, 0xa7, 0x5e, 0xf8, 0xa9, 0xa1, 0x92, 0x66, 0x10, 0x9d, 0xa7, 0x11, 0x3c, 0x69, 0x7e, 0x9e,
-	0xf9, 0x36, 0xe6, 0x67, 0xe6, 0x30, 0xc1, 0xe2, 0xba, 0x88, 0x8a, 0x6b, 0x61, 0x80, 0x15, 0x05,
-	0xba, 0x09, 0x39, 0x9f, 0xea, 0xbd, 0x61, 0xef, 0xc9, 0xe1, 0xf9, 0x08, 0x28, 0x0a, 0x74, 0x08,
-	0x73, 0xaa, 0xd9, 0x69, 0xb3, 0xaf, 0xd1, 0x29, 0x63, 0xb2, 0x8a, 0xe9, 0x31, 0x2c, 0x5f, 0xd0,
-	0x08, 0xbf, 0xb5, 0x5c, 0xdd, 0x13, 0xfd, 0xc4, 0xb7, 0x96, 0x5c, 0x57, 0xcd, 0xb0, 0xb8, 0xe2,
-	0x1e, 0x2d, 0x8b, 0x4b, 0x2e, 0xd4, 0x4e, 0x1d, 0x58, 0xbe, 0x90, 0x82, 0xa8, 0x06, 0x8b, 0x2a,
-	0xd5, 0xc2, 0x90, 0x0a, 0x5f, 0x4b, 0xcb, 0x03, 0xdf, 0x9d, 0x1e, 0xcd, 0x88, 0xd1, 0xd0, 0x3d,
-	0x81, 0xf3, 0xf6, 0x70, 0x11, 0x9f, 0xf8, 0x1f, 0x69, 0x58, 0x48, 0xe8, 0x20, 0x07, 0x56, 0x2f,
-	0xd6, 0x8b, 0xb4, 0x26, 0xd3, 0x35, 0x3c, 0xff, 0xd2, 0xfe, 0xbb, 0xaf, 0x52, 0x38, 0xe1, 0xa6,
-	0x8f, 0x69, 0x1f, 0xdf, 0x70, 0xc7, 0xe2, 0xe8, 0x7d, 0xb8, 0x39, 0xde, 0xde, 0xb9, 0xde, 0x0b,
-	0xa8, 0x4a, 0x2f, 0x6d, 0x0c, 0xf9, 0x69, 0x28, 0x57, 0x4e, 0xfc, 0x1e, 0x56, 0x27, 0x66, 0x0d,
-	0xca, 0x43, 0xfa, 0x4c, 0x3d, 0x94, 0xe9, 0xb3, 0x70, 0x65, 0xab, 0x78, 0xa7, 0x6d, 0xf4, 0x73,
-	0xb8, 0xe6, 0x5a, 0x8c, 0x74, 0x54, 0x62, 0xde, 0x99, 0xea, 0xd9, 0xa1, 0x17, 0x3d, 0x15, 0xa5,
-	0x6c, 0xf8, 0xd9, 0x88, 0xb3, 0xae, 0xc5, 0x0e, 0xd5, 0x01, 0xde, 0x03, 0x2d, 0xb6, 0x7c, 0x74,
-	0xa1, 0xda, 0xc2, 0x6f, 0xa6, 0x61, 0x49, 0xa6, 0xe5, 0xa3, 0x3f, 0x04, 0xb6, 0xfe, 0x3f, 0x03,
-	0x85, 0x8b, 0x4d, 0x11, 0x05, 0xdf, 0xda, 0x34, 0x7d, 0x9c, 0x9a, 0x32, 0x4f, 0x07, 0xb0, 0x66,
-	0x44, 0x0f, 0xe3, 0x38, 0xb3, 0x99, 0x2b, 0x98, 0x55, 0xef, 0xea, 0x58, 0xb3, 0xc6, 0x04, 0x19,
-	0xea, 0xc3, 0xad, 0xd8, 0x2c, 0xb3, 0xed, 0x40, 0xe8, 0xed, 0x1e, 0x25, 0x1d, 0xd6, 0xa3, 0x3e,
-	0xe9, 0x04, 0xbd, 0xde, 0x95, 0x9a, 0x86, 0x32, 0x5c, 0x8d, 0xf9, 0x87, 0x21, 0xfd, 0x30, 0xe8,
-	0xf5, 0x8e, 0x53, 0x78, 0xd5, 0x98, 0x24, 0x44, 0x14, 0xae, 0xc7, 0xa6, 0x13, 0xd3, 0xaf, 0xaf,
-	0x9e, 0xb2, 0x07, 0xaf, 0x38, 0xba, 0xfb, 0xc7, 0x29, 0xbc, 0x62, 0x5c, 0x86, 0x4b, 0xf3, 0x30,
-	0x1b, 0x3d, 0x94, 0x5b, 0x0f, 0x40, 0x9b, 0x14, 0xa3, 0xf1, 0x53, 0xdd, 0x96, 0x0e, 0xab, 0x13,
-	0x9d, 0x43, 0x07, 0x30, 0xdb, 0xa6, 0xba, 0x31, 0x48, 0x8a, 0xfb, 0x57, 0x39, 0xf1, 0x41, 0xbb,
-	0x24, 0x39, 0x58, 0x71, 0xb7, 0xea, 0xb0, 0x32, 0xc6, 0x99, 0x09, 0x53, 0xe6, 0xc5, 0x41, 0x27,
-	0x73, 0x69, 0xd0, 0xd9, 0x0a, 0x60, 0xf9, 0x82, 0xa9, 0x29, 0x8f, 0xca, 0xf8, 0x71, 0x6a, 0x1f,
-	0xde, 0x1c, 0xcd, 0x85, 0xd1, 0xb9, 0x6a, 0x85, 0x25, 0x63, 0xa1, 0xcc, 0xfe, 0x02, 0xe6, 0xe3,
-	0x12, 0x0d, 0x8b, 0xce, 0x09, 0x6c, 0xea, 0xe9, 0x82, 0xc7, 0xff, 0xa3, 0x0d, 0x01, 0xb4, 0x09,
-	0x0b, 0x26, 0x75, 0xb8, 0xcd, 0x1c, 0x29, 0x57, 0x2e, 0x24, 0xa0, 0xb7, 0xff, 0x97, 0x81, 0x1b,
-	0xe3, 0x3b, 0x19, 0xba, 0x07, 0x77, 0x1a, 0xf8, 0xb4, 0x75, 0x5a, 0x3e, 0x3d, 0x21, 0xb5, 0x4a,
-	0xb3, 0x59, 0x3c, 0xaa, 0x90, 0x46, 0x11, 0xb7, 0xc8, 0xe3, 0xca, 0xaf, 0xc8, 0x93, 0x7a, 0xb3,
-	0x51, 0x29, 0x57, 0x0f, 0xab, 0x95, 0x83, 0x42, 0x0a, 0x6d, 0xc3, 0xbd, 0xc9, 0xaa, 0xcd, 0x7a,
-	0xb1, 0xd1, 0x3c, 0x3e, 0x6d, 0x91, 0x83, 0xea, 0x51, 0xa5, 0xd9, 0x2a, 0xa4, 0xd1, 0x07, 0xf0,
-	0x68, 0xb2, 0x7a, 0xb9, 0x88, 0x0f, 0x8a, 0xf5, 0x53, 0xd2, 0xc2, 0xc5, 0x7a, 0xb3, 0x58, 0x6e,
-	0x55, 0x4f, 0xeb, 0x4d, 0x52, 0xab, 0xe0, 0xc7, 0x27, 0x15, 0x82, 0x4f, 0x4f, 0x5b, 0x85, 0x0c,
-	0x7a, 0x1f, 0x7e, 0x32, 0x99, 0x5f, 0xaf, 0xfc, 0xb2, 0x45, 0x8a, 0x47, 0x47, 0xb8, 0x72, 0x54,
-	0x6c, 0x55, 0xc8, 0xd3, 0x0a, 0xae, 0x1e, 0x56, 0xcb, 0xc5, 0x70, 0x9f, 0x50, 0x5e, 0x98, 0x41,
-	0x8f, 0xe0, 0xe1, 0x64, 0xfa, 0x49, 0xb1, 0x55, 0x69, 0xb6, 0x48, 0xb5, 0x56, 0x7b, 0xd2, 0x2a,
-	0x96, 0x4e, 0x2a, 0xe4, 0xb0, 0x7a, 0x52, 0x21, 0xf5, 0x27, 0xb5, 0x52, 0x05, 0x17, 0xb2, 0x68,
-	0x0f, 0xb6, 0x5f, 0xca, 0x2d, 0x9d, 0x9c, 0x96, 0x1f, 0xc7, 0x94, 0x6b, 0x6b, 0xd9, 0x4f, 0xfe,
-	0xba, 0x9e, 0x2a, 0xfd, 0xee, 0xf3, 0xe7, 0xeb, 0xe9, 0x2f, 0x9e, 0xaf, 0xa7, 0xbf, 0x7a, 0xbe,
-	0x9e, 0xfe, 0xf3, 0x8b, 0xf5, 0xd4, 0x17, 0x2f, 0xd6, 0x53, 0xff, 0x7e, 0xb1, 0x9e, 0xfa, 0xc8,
-	0xe8, 0x32, 0x61, 0x05, 0xed, 0x1d, 0x83, 0xdb, 0xbb, 0xaa, 0xa8, 0xb6, 0x3b, 0x3c, 0x70, 0x4c,
-	0x39, 0x1d, 0x0d, 0x20, 0xd6, 0x36, 0xb6, 0x99, 0x63, 0x04, 0xed, 0xf0, 0xde, 0x76, 0x0d, 0xee,
-	0xdb, 0xdc, 0x1f, 0x08, 0x55, 0xf2, 0x6f, 0xcb, 0x8a, 0xd8, 0x8e, 0x4a, 0x62, 0xfb, 0x7c, 0xef,
-	0xc1, 0x4f, 0x95, 0xa0, 0x3d, 0x2b, 0x5f, 0x96, 0x77, 0xbf, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x57,
-	0x44, 0x9c, 0xd7, 0x06, 0x16, 0x00, 0x00,
+	// 2501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x17, 0x25, 0xea, 0xd7, 0x23, 0x25, 0xd1, 0x23, 0xc7, 0x5e, 0xc9, 0xfe, 0x4a, 0xb2, 0x1c,
+	0x27, 0x72, 0x62, 0x49, 0x96, 0x92, 0xaf, 0x9b, 0xba, 0x4d, 0x10, 0x92, 0x5a, 0x49, 0xac, 0xc4,
+	0x1f, 0x18, 0xae, 0x9d, 0x26, 0x05, 0x3a, 0x5d, 0x2e, 0x87, 0xe4, 0x56, 0xdc, 0x5d, 0x76, 0x77,
+	0x56, 0x11, 0x0b, 0x14, 0xbd, 0xf4, 0x90, 0xde, 0x7a, 0x6b, 0x8e, 0x01, 0x7a, 0xe9, 0xad, 0xff,
+	0x43, 0x4f, 0xb9, 0x14, 0x48, 0x4f, 0x2d, 0x8a, 0x22, 0x0d, 0x1c, 0xa0, 0x7f, 0x47, 0xb1, 0x33,
+	0xb3, 0xcb, 0xa5, 0xf8, 0xc3, 0xb2, 0xda, 0xdc, 0x38, 0xef, 0xe7, 0xbc, 0xcf, 0xbc, 0xf7, 0xe6,
+	0xcd, 0x12, 0x1e, 0x9a, 0x35, 0x63, 0xb7, 0x6d, 0x36, 0x5b, 0xcc, 0x68, 0x9b, 0xd4, 0x66, 0xde,
+	0xae, 0x65, 0xb2, 0x96, 0x6b, 0xb6, 0x77, 0xcf, 0xf7, 0xc2, 0x9f, 0x3b, 0x1d, 0xd7, 0x61, 0x0e,
+	0xba, 0x63, 0xd6, 0x8c, 0x9d, 0xb8, 0xe8, 0x4e, 0xc8, 0x3f, 0xdf, 0x5b, 0xbd, 0xd9, 0x74, 0x9a,
+	0x0e, 0x97, 0xdb, 0x0d, 0x7e, 0x09, 0x95, 0xd5, 0xb5, 0xa6, 0xe3, 0x34, 0xdb, 0x74, 0x97, 0xaf,
+	0x6a, 0x7e, 0x63, 0xb7, 0xee, 0xbb, 0x3a, 0x33, 0x1d, 0x5b, 0xf0, 0x37, 0xeb, 0x30, 0x73, 0x4c,
+	0x03, 0x8b, 0xe8, 0x4d, 0x58, 0x72, 0xe9, 0xb9, 0xe9, 0x99, 0x8e, 0x4d, 0x6c, 0xdf, 0xaa, 0x51,
+	0x57, 0x49, 0x6c, 0x24, 0xb6, 0x92, 0x78, 0x31, 0x24, 0x97, 0x38, 0xb5, 0x4f, 0xb0, 0xc5, 0x75,
+	0x95, 0xc9, 0x7e, 0x41, 0x61, 0xf1, 0xe9, 0xdc, 0x67, 0x5f, 0xac, 0x4f, 0x7c, 0xfe, 0xc5, 0xfa,
+	0xc4, 0xe6, 0x9f, 0x66, 0x21, 0x95, 0xe7, 0x5b, 0xae, 0x32, 0x9d, 0x51, 0xb4, 0x02, 0x73, 0x46,
+	0x4b, 0x37, 0x6d, 0x62, 0xd6, 0xb9, 0x93, 0x79, 0x3c, 0xcb, 0xd7, 0x85, 0x3a, 0x3a, 0x86, 0x85,
+	0xb6, 0xce, 0xa8, 0xc7, 0xe2, 0xb6, 0x53, 0xfb, 0xf7, 0x77, 0xc6, 0xc4, 0xbe, 0x23, 0x1c, 0xe2,
+	0xb4, 0xd0, 0x94, 0x01, 0x1d, 0xc3, 0x42, 0xc3, 0x75, 0x7e, 0x49, 0xa3, 0x5d, 0x4e, 0xbd, 0x82,
+	0x25, 0xa1, 0x29, 0x2d, 0xdd, 0x87, 0x05, 0xc3, 0x77, 0x5d, 0x6a, 0x33, 0x42, 0x3b, 0x8e, 0xd1,
+	0x52, 0x92, 0x3c, 0xde, 0xb4, 0x24, 0xaa, 0x01, 0x0d, 0x9d, 0xc2, 0x12, 0x73, 0x7d, 0x8f, 0x99,
+	0x76, 0x93, 0x74, 0xa8, 0x6b, 0x3a, 0x75, 0x65, 0x9a, 0x3b, 0x5c, 0xd9, 0x11, 0x67, 0xb0, 0x13,
+	0x9e, 0xc1, 0xce, 0x81, 0x3c, 0x83, 0xdc, 0xdc, 0x97, 0x5f, 0xaf, 0x4f, 0x7c, 0xfe, 0xaf, 0xf5,
+	0x04, 0x5e, 0x0c, 0x75, 0x2b, 0x5c, 0x15, 0x35, 0x61, 0x99, 0x8b, 0x1b, 0x4e, 0x9b, 0x74, 0x74,
+	0x57, 0xb7, 0x28, 0xa3, 0xae, 0xa7, 0xcc, 0x70, 0x8b, 0x4f, 0xc6, 0x86, 0x50, 0x14, 0x3f, 0x2b,
+	0x52, 0xbd, 0x12, 0x69, 0x63, 0xd4, 0x19, 0xa0, 0xa1, 0x7b, 0x90, 0xf6, 0x3b, 0x4d, 0x57, 0xaf,
+	0x53, 0xd2, 0xd1, 0x59, 0x4b, 0x99, 0xdd, 0x98, 0xda, 0x9a, 0xc7, 0x29, 0x49, 0xab, 0xe8, 0xac,
+	0x85, 0x9e, 0x80, 0xd2, 0x72, 0x3c, 0x46, 0xbc, 0xe0, 0xec, 0x88, 0xdd, 0x60, 0xa4, 0xe3, 0xb4,
+	0x4d, 0xa3, 0x1b, 0x9c, 0xde, 0xdc, 0x46, 0x62, 0x2b, 0x8d, 0x6f, 0x06, 0x7c, 0x7e, 0xb4, 0xa5,
+	0x06, 0xab, 0x70, 0x66, 0xa1, 0x8e, 0xde, 0x83, 0x95, 0x4b, 0x7a, 0xcc, 0x39, 0xa3, 0x36, 0xb1,
+	0x75, 0x8b, 0x2a, 0xf3, 0x5c, 0xf1, 0xb5, 0xb8, 0xa2, 0x16, 0x70, 0x4b, 0xba, 0x45, 0xd1, 0x7b,
+	0xa0, 0x34, 0xa9, 0x4d, 0x3d, 0xd3, 0x23, 0xe7, 0xd4, 0x35, 0x1b, 0xa6, 0xc1, 0xf1, 0x22, 0x67,
+	0xb4, 0xab, 0x00, 0x57, 0xbc, 0x25, 0xf9, 0xcf, 0x63, 0xec, 0x13, 0xda, 0x45, 0x67, 0xb0, 0x62,
+	0xda, 0x26, 0x33, 0xf5, 0x36, 0x31, 0xa8, 0xcb, 0x04, 0x8f, 0x12, 0x9e, 0x5d, 0x4a, 0x6a, 0x63,
+	0x6a, 0x2b, 0xb5, 0xbf, 0x7b, 0x15, 0xf4, 0xf2, 0x3d, 0x65, 0x7c, 0x5b, 0x5a, 0x8c, 0xd1, 0xf2,
+	0x81, 0x3d, 0xf4, 0x0b, 0x58, 0x35, 0x1c, 0xdb, 0xa3, 0xb6, 0xe7, 0x7b, 0x32, 0xca, 0x33, 0xda,
+	0x25, 0x0d, 0xc7, 0xb5, 0x74, 0xa6, 0xa4, 0x37, 0x12, 0x5b, 0x8b, 0xfb, 0xef, 0x8e, 0xf5, 0x96,
+	0x0f, 0xd5, 0x39, 0x06, 0x27, 0xb4, 0x7b, 0xc8, 0x75, 0xf1, 0x6d, 0x63, 0x38, 0x03, 0xfd, 0x0c,
+	0x56, 0x63, 0x98, 0x7a, 0x2d, 0x9f, 0xd5, 0x9d, 0x4f, 0xa3, 0x0c, 0x5f, 0xb8, 0x7a, 0x86, 0xdf,
+	0x8e, 0x90, 0xaf, 0x4a, 0x23, 0xb2, 0x6a, 0x93, 0x41, 0xd5, 0x6e, 0xfe, 0x25, 0x09, 0x8b, 0xfd,
+	0x9b, 0x43, 0x77, 0x61, 0x9e, 0x99, 0x16, 0xf5, 0x98, 0x6e, 0x75, 0x64, 0x6b, 0xe8, 0x11, 0x90,
+	0x03, 0x77, 0x1b, 0xa6, 0xeb, 0x31, 0x0e, 0x3b, 0x69, 0xe9, 0x5e, 0x8b, 0xc8, 0x3a, 0x16, 0x25,
+	0x23, 0xca, 0xf8, 0x95, 0xb1, 0x57, 0xb8, 0xd1, 0x80, 0x72, 0xac, 0x7b, 0xad, 0x53, 0x6e, 0x51,
+	0xd4, 0xdb, 0x07, 0x70, 0x57, 0x3a, 0xe8, 0x79, 0x64, 0x17, 0xc4, 0xb3, 0xf5, 0x8e, 0xd7, 0x72,
+	0x44, 0xb5, 0xcf, 0x63, 0x45, 0xc8, 0x84, 0x06, 0xb4, 0x8b, 0xaa, 0xe4, 0xa3, 0xd7, 0x61, 0xd1,
+	0xac, 0x19, 0x12, 0x48, 0xd7, 0x71, 0x18, 0xaf, 0xea, 0x34, 0x4e, 0x9b, 0x35, 0x83, 0x07, 0x8c,
+	0x1d, 0x87, 0x05, 0x99, 0xc8, 0x5c, 0xdd, 0xf6, 0x74, 0x23, 0xc8, 0x30, 0x8f, 0x58, 0xd4, 0x3d,
+	0x6b, 0x4b, 0xf9, 0x69, 0xee, 0xe1, 0x56, 0x9c, 0x5f, 0xe4, 0x6c, 0xae, 0xa9, 0xc2, 0x7a, 0xad,
+	0xed, 0x18, 0x67, 0x1e, 0x19, 0x69, 0x60, 0x86, 0x1b, 0xb8, 0x2b, 0xc4, 0xb4, 0xe1, 0x66, 0xf6,
+	0xe0, 0xb5, 0xd8, 0x81, 0x73, 0x51, 0x1e, 0xab, 0x32, 0xcb, 0x95, 0x51, 0x74, 0x8c, 0xb9, 0x80,
+	0x15, 0xc4, 0x88, 0x7e, 0x0a, 0xcb, 0x43, 0x72, 0x84, 0x97, 0x6a, 0x6a, 0x7f, 0x67, 0x7c, 0x72,
+	0x5c, 0x4e, 0x0a, 0x7c, 0x63, 0x20, 0x4f, 0xd0, 0x23, 0x40, 0x31, 0xfb, 0xe7, 0xd4, 0x0d, 0x7a,
+	0x3e, 0x2f, 0xe8, 0x24, 0xce, 0x44, 0xe2, 0xcf, 0x05, 0x5d, 0xe6, 0x53, 0x07, 0x6e, 0x0c, 0xd8,
+	0x46, 0x6f, 0xc0, 0x92, 0x28, 0x2d, 0x46, 0xeb, 0x44, 0x67, 0xc4, 0xf2, 0x64, 0x5e, 0x2d, 0x44,
+	0xe4, 0x2c, 0x2b, 0x7a, 0x68, 0x1b, 0x96, 0x9b, 0xae, 0x6e, 0x50, 0xd9, 0x57, 0x09, 0xb5, 0xeb,
+	0x81, 0xac, 0xb8, 0x75, 0x32, 0x9c, 0x25, 0xda, 0xa6, 0x6a, 0xd7, 0x8b, 0x9e, 0xf4, 0xf8, 0xcf,
+	0x04, 0xdc, 0x93, 0x09, 0x15, 0x83, 0xb6, 0x48, 0x83, 0x3b, 0xcc, 0x6b, 0x99, 0x9d, 0x8a, 0xeb,
+	0x38, 0x0d, 0xf4, 0x36, 0xdc, 0x88, 0x1d, 0x0f, 0xe9, 0x04, 0x44, 0xbe, 0x89, 0x34, 0xce, 0xc4,
+	0x18, 0x42, 0xf8, 0x36, 0xcc, 0xb2, 0x0b, 0x81, 0xfe, 0x24, 0x47, 0x7f, 0x86, 0x5d, 0x70, 0xc4,
+	0x37, 0x20, 0xcd, 0x2e, 0x48, 0xcd, 0xa9, 0x77, 0x89, 0x51, 0x73, 0x5c, 0x9e, 0x7b, 0x69, 0x0c,
+	0xec, 0x22, 0xe7, 0xd4, 0xbb, 0xf9, 0x9a, 0xe3, 0x06, 0x6d, 0xd6, 0xf1, 0x59, 0xc7, 0x67, 0xc4,
+	0xb4, 0xeb, 0xf4, 0x82, 0xe7, 0xda, 0x02, 0x4e, 0x09, 0x5a, 0x21, 0x20, 0x71, 0x34, 0xa2, 0x84,
+	0x14, 0x1b, 0x99, 0xe6, 0x76, 0x16, 0xc2, 0x8c, 0xe4, 0xbb, 0x90, 0xe1, 0xfd, 0x76, 0x12, 0xd2,
+	0x45, 0xd3, 0xab, 0xd1, 0x96, 0x7e, 0x6e, 0x3a, 0xbe, 0x8b, 0xd6, 0x61, 0x5e, 0x1c, 0x68, 0x74,
+	0xa9, 0xe6, 0x26, 0x95, 0x04, 0x9e, 0x13, 0xc4, 0x42, 0x1d, 0xb5, 0x20, 0x23, 0x4f, 0x9a, 0xb4,
+	0xa8, 0x5e, 0xa7, 0x2e, 0xd9, 0x93, 0x55, 0xf9, 0xd6, 0x55, 0xaa, 0xf2, 0x98, 0xeb, 0xe4, 0xd0,
+	0x8b, 0xaf, 0xd7, 0x17, 0xfb, 0x48, 0x7b, 0x78, 0xd1, 0xea, 0x5b, 0x0f, 0xf1, 0xb4, 0xaf, 0x4c,
+	0xfd, 0x0f, 0x3c, 0xed, 0x5f, 0xf2, 0xb4, 0x2f, 0xb1, 0xf8, 0x66, 0x06, 0x16, 0xfa, 0x04, 0x91,
+	0x07, 0xab, 0xe1, 0x0e, 0x3c, 0xa6, 0x9f, 0x51, 0x52, 0x37, 0x3d, 0xe6, 0x9a, 0x35, 0x3f, 0x38,
+	0x4c, 0x8e, 0x4e, 0x6a, 0xff, 0xff, 0xaf, 0xb2, 0x97, 0x6a, 0xa0, 0x7d, 0x10, 0x53, 0xc6, 0x8a,
+	0x35, 0x82, 0x83, 0x7e, 0x93, 0x80, 0x37, 0x46, 0x7b, 0x8d, 0xdf, 0x47, 0xd7, 0xed, 0x86, 0xf7,
+	0x47, 0xf9, 0x8e, 0x09, 0xa1, 0x9f, 0xc3, 0xcd, 0x78, 0x4a, 0xf7, 0x35, 0xc4, 0xd4, 0xfe, 0xf7,
+	0xc6, 0xdf, 0x47, 0xba, 0x5b, 0xd7, 0x6d, 0x27, 0x56, 0x30, 0x61, 0xbf, 0xc4, 0xcb, 0x6c, 0x90,
+	0x88, 0xba, 0xb0, 0x31, 0xcc, 0x57, 0x5f, 0xac, 0xc9, 0xeb, 0xc5, 0xba, 0x36, 0xc4, 0x5f, 0x3c,
+	0xcc, 0xdf, 0x27, 0xe0, 0x71, 0x27, 0x98, 0x38, 0x1d, 0xdf, 0x23, 0x57, 0x83, 0xdd, 0x53, 0xe6,
+	0xaf, 0x37, 0x01, 0x3c, 0x0a, 0x1d, 0x15, 0x5f, 0x8e, 0xbf, 0x87, 0xde, 0xee, 0xeb, 0x8f, 0x61,
+	0xc7, 0x10, 0xb7, 0xc5, 0x52, 0xd4, 0x1f, 0x35, 0xd1, 0x3a, 0xde, 0x85, 0xdb, 0xfd, 0xc2, 0xbd,
+	0x2e, 0x32, 0xc3, 0xab, 0x7f, 0x39, 0xa6, 0x11, 0xb5, 0x93, 0xa7, 0x7d, 0x63, 0x00, 0xbb, 0x20,
+	0x7d, 0xcd, 0x65, 0x96, 0x37, 0x97, 0x5b, 0x31, 0xc5, 0x72, 0xac, 0xcf, 0x6c, 0xf7, 0x5d, 0x0f,
+	0xec, 0x42, 0xf6, 0x1a, 0x31, 0xc9, 0x65, 0x62, 0x4a, 0xf1, 0x76, 0xf3, 0xb7, 0x49, 0x50, 0x46,
+	0x05, 0x8f, 0x6e, 0xc2, 0xb4, 0xb8, 0xe4, 0x45, 0xf7, 0x16, 0x0b, 0xf4, 0x09, 0x20, 0xcf, 0x6c,
+	0xda, 0xd4, 0xf5, 0xc8, 0xa7, 0x26, 0x6b, 0x89, 0xb3, 0x51, 0x26, 0xf9, 0x09, 0x3c, 0x1a, 0x7b,
+	0x02, 0x55, 0xae, 0xf6, 0x91, 0xc9, 0x5a, 0xdc, 0x17, 0xce, 0x48, 0x3b, 0x11, 0x05, 0x21, 0x48,
+	0x72, 0x50, 0xc5, 0x25, 0xcf, 0x7f, 0xa3, 0x87, 0x90, 0x89, 0x8f, 0x7c, 0x9c, 0x9f, 0x14, 0xa0,
+	0xc7, 0xe8, 0x1c, 0xf4, 0xff, 0x03, 0x30, 0x5c, 0x2a, 0xaf, 0x1d, 0x7e, 0x32, 0x49, 0x3c, 0x2f,
+	0x29, 0x59, 0x86, 0x28, 0xa0, 0xc1, 0xe1, 0xfb, 0xbf, 0x9c, 0xbd, 0x6f, 0x0c, 0xcc, 0xde, 0x12,
+	0xd9, 0x7f, 0x24, 0x60, 0x75, 0x74, 0xd9, 0xa1, 0x75, 0x48, 0xc5, 0x47, 0x06, 0xf1, 0x5a, 0x02,
+	0xab, 0x37, 0x20, 0x44, 0xe0, 0x4f, 0xc6, 0xc1, 0xbf, 0x07, 0x69, 0x31, 0x2b, 0xc8, 0xa7, 0xdc,
+	0x14, 0x67, 0xa6, 0x38, 0x4d, 0xbe, 0xe3, 0x42, 0x0c, 0x93, 0x2f, 0xc1, 0x70, 0xfa, 0x2a, 0x18,
+	0x8a, 0x51, 0xa6, 0x87, 0xa1, 0x0c, 0xee, 0x8f, 0x49, 0x40, 0x83, 0xf5, 0x14, 0xb9, 0x4e, 0xc4,
+	0x5c, 0xdf, 0x87, 0x85, 0xa8, 0x9c, 0x63, 0x57, 0x6c, 0x3a, 0x24, 0x72, 0xa7, 0x51, 0xb0, 0x53,
+	0xf1, 0x60, 0x7f, 0x22, 0x33, 0x2d, 0x98, 0x0c, 0x98, 0xc9, 0xba, 0x84, 0x75, 0x3b, 0x61, 0xdf,
+	0xd9, 0x7e, 0x79, 0xa6, 0xd5, 0x55, 0xae, 0xa5, 0x75, 0x3b, 0x61, 0xaa, 0xc5, 0x28, 0xe8, 0x14,
+	0xe6, 0x2c, 0xca, 0xf4, 0xba, 0xce, 0x74, 0xf9, 0xa0, 0x7b, 0x3c, 0xbe, 0x85, 0xf6, 0xe2, 0x2c,
+	0x4a, 0x3d, 0x1c, 0x59, 0x40, 0x1f, 0x41, 0x26, 0x4a, 0x2d, 0x8b, 0x7a, 0x9e, 0xde, 0xa4, 0x32,
+	0xb1, 0xc6, 0x97, 0x44, 0x98, 0x51, 0x45, 0xa1, 0x83, 0x97, 0x3a, 0xfd, 0x04, 0xf4, 0x00, 0x16,
+	0x25, 0x06, 0xa1, 0x59, 0x31, 0x20, 0x2e, 0x08, 0x6a, 0x28, 0xf6, 0x43, 0x58, 0xd5, 0x9b, 0x4d,
+	0x97, 0x36, 0xe5, 0xe8, 0xd6, 0xff, 0xb6, 0x9a, 0xe3, 0x2a, 0x4a, 0x24, 0x71, 0xf9, 0x75, 0xf5,
+	0x26, 0x2c, 0x59, 0x7e, 0x9b, 0x99, 0x24, 0x30, 0xaa, 0x33, 0xdf, 0x15, 0xef, 0xb8, 0x79, 0xbc,
+	0xc8, 0xc9, 0xd5, 0x90, 0x1a, 0x8c, 0x55, 0xe1, 0x03, 0xae, 0x27, 0x0a, 0x5c, 0x34, 0x23, 0x19,
+	0x91, 0xb0, 0x4c, 0x95, 0xbf, 0x4e, 0xc2, 0xf2, 0x10, 0xec, 0x90, 0x02, 0xb3, 0x36, 0x65, 0x9f,
+	0x3a, 0xee, 0x59, 0xf8, 0xa9, 0x40, 0x2e, 0x83, 0x64, 0x8d, 0xb0, 0x0c, 0xa7, 0x50, 0x91, 0x34,
+	0x11, 0x3a, 0x72, 0x08, 0x1d, 0xf5, 0x9c, 0x9e, 0xfa, 0x2e, 0x9e, 0xd3, 0xf1, 0x91, 0x56, 0x16,
+	0x57, 0x2a, 0x36, 0xcf, 0xa2, 0x3b, 0x30, 0xef, 0x51, 0xbd, 0xdd, 0xeb, 0x3d, 0xf3, 0x78, 0x4e,
+	0x10, 0xb2, 0x0c, 0x1d, 0xc2, 0xac, 0x6c, 0x76, 0xca, 0xcc, 0x35, 0x3a, 0x65, 0xa8, 0x2c, 0x31,
+	0x3d, 0x86, 0xa5, 0x4b, 0x12, 0xc1, 0xa7, 0x97, 0x8e, 0xee, 0xb2, 0x6e, 0xec, 0xd3, 0x0b, 0x5f,
+	0x17, 0xea, 0x41, 0x71, 0x85, 0x3d, 0x9a, 0x17, 0x17, 0x5f, 0x48, 0x4b, 0x0d, 0x58, 0xba, 0x94,
+	0x82, 0xa8, 0x08, 0x0b, 0x32, 0xd5, 0x02, 0x48, 0x59, 0x30, 0xbb, 0x07, 0x1b, 0xde, 0x1a, 0x8f,
+	0xa6, 0xd0, 0xa8, 0xe8, 0x2e, 0xc3, 0x69, 0xab, 0xb7, 0x08, 0x77, 0xfc, 0xe7, 0x04, 0xa4, 0x62,
+	0x32, 0xc8, 0x86, 0x95, 0xcb, 0xf5, 0xc2, 0xbd, 0xf1, 0x74, 0x4d, 0xf0, 0x17, 0xf6, 0x3b, 0xaf,
+	0x52, 0x38, 0x81, 0xd1, 0x13, 0xda, 0xc5, 0xb7, 0x3a, 0x43, 0xe9, 0xe8, 0x7d, 0xb8, 0x33, 0xdc,
+	0xdf, 0xb9, 0xde, 0xf6, 0xa9, 0x4c, 0x2f, 0x65, 0x88, 0xf2, 0xf3, 0x80, 0x2f, 0x83, 0xf8, 0x35,
+	0xac, 0x8c, 0xcc, 0x1a, 0x94, 0x86, 0xc4, 0x99, 0xbc, 0x28, 0x13, 0x67, 0xc1, 0xca, 0x92, 0x78,
+	0x27, 0x2c, 0xf4, 0x21, 0x4c, 0x77, 0x5a, 0x26, 0x69, 0xc8, 0xc4, 0x7c, 0x30, 0x36, 0xb2, 0x43,
+	0x57, 0x5c, 0x15, 0xb9, 0x64, 0xf0, 0x15, 0x09, 0x27, 0x3b, 0x2d, 0xf3, 0x50, 0x6e, 0xe0, 0x3d,
+	0x50, 0x42, 0xcf, 0x47, 0x97, 0xaa, 0x2d, 0x78, 0xc6, 0xf7, 0x4a, 0x52, 0xbc, 0x74, 0x7a, 0x84,
+	0xcd, 0x7f, 0x27, 0x21, 0x73, 0xb9, 0x29, 0x22, 0xff, 0x3b, 0x9b, 0xa6, 0x8f, 0x27, 0xc6, 0xcc,
+	0xd3, 0x3e, 0xac, 0x1a, 0xe2, 0x62, 0x1c, 0xe6, 0x76, 0xf2, 0x0a, 0x6e, 0xe5, 0xbd, 0x3a, 0xd4,
+	0xad, 0x31, 0x82, 0x87, 0xba, 0x70, 0x37, 0x74, 0x6b, 0x5a, 0x96, 0xcf, 0xf4, 0x5a, 0x9b, 0x92,
+	0x86, 0xd9, 0xa6, 0x1e, 0x69, 0xf8, 0xed, 0xf6, 0x95, 0x9a, 0x86, 0x74, 0x5c, 0x08, 0xf5, 0x0f,
+	0x03, 0xf5, 0x43, 0xbf, 0xdd, 0x3e, 0x9e, 0xc0, 0x2b, 0xc6, 0x28, 0x26, 0xa2, 0x70, 0x33, 0x74,
+	0x1d, 0xff, 0x68, 0xa0, 0x24, 0xaf, 0x72, 0xef, 0x0c, 0xcc, 0x10, 0xde, 0xf1, 0x04, 0x5e, 0x36,
+	0x06, 0xc9, 0xe8, 0x02, 0xee, 0x84, 0x6e, 0x86, 0x7c, 0xa2, 0x50, 0xa6, 0xaf, 0x1e, 0x60, 0x6e,
+	0xe0, 0xd3, 0x45, 0x2c, 0xc0, 0x41, 0x66, 0x6e, 0x0e, 0x66, 0xc4, 0x15, 0xbd, 0xf9, 0x18, 0x94,
+	0x51, 0xa7, 0x33, 0x7c, 0x9e, 0xdc, 0xd4, 0x61, 0x65, 0x24, 0xac, 0xe8, 0x00, 0x66, 0x6a, 0x54,
+	0x37, 0xa2, 0x74, 0x7c, 0x74, 0x95, 0xdd, 0x1f, 0xd4, 0x72, 0x5c, 0x07, 0x4b, 0xdd, 0xcd, 0x12,
+	0x2c, 0x0f, 0x81, 0x71, 0xc4, 0x7c, 0x7b, 0x79, 0xc4, 0x9a, 0x1c, 0x18, 0xb1, 0x36, 0xb5, 0x68,
+	0xcb, 0x83, 0x58, 0x5c, 0xdf, 0xaa, 0x0f, 0x4b, 0x97, 0x02, 0x18, 0x73, 0x49, 0x0e, 0x1f, 0x0f,
+	0xf7, 0xe1, 0xb5, 0xfe, 0xdc, 0xee, 0x9f, 0x13, 0x97, 0xcd, 0x38, 0xc2, 0xd2, 0xed, 0x8f, 0x60,
+	0x2e, 0x6c, 0x39, 0x41, 0x13, 0xb1, 0x7d, 0x8b, 0xba, 0x3a, 0x73, 0xc2, 0xbf, 0x09, 0x7a, 0x04,
+	0xb4, 0x01, 0xa9, 0x3a, 0xb5, 0x1d, 0xcb, 0xb4, 0x39, 0x5f, 0x86, 0x10, 0x23, 0xbd, 0xd5, 0x81,
+	0xdb, 0x23, 0x3e, 0x7d, 0xa2, 0x07, 0x70, 0x2f, 0x5f, 0x2e, 0x55, 0xd5, 0x52, 0xf5, 0x59, 0x95,
+	0x54, 0xb5, 0xac, 0xa6, 0x92, 0x13, 0xf5, 0x63, 0x72, 0x58, 0xc6, 0xc5, 0xac, 0x46, 0x4e, 0xd5,
+	0xa3, 0x6c, 0xfe, 0xe3, 0xcc, 0x04, 0xda, 0x82, 0xd7, 0xc7, 0x88, 0xe5, 0xb3, 0xa5, 0x72, 0xa9,
+	0x90, 0xcf, 0x9e, 0x66, 0x12, 0xab, 0xc9, 0xcf, 0xfe, 0xb0, 0x36, 0xf1, 0xd6, 0xe7, 0xd3, 0x70,
+	0x6b, 0xf8, 0x5d, 0x80, 0x1e, 0xc2, 0x83, 0x0a, 0x2e, 0x6b, 0xe5, 0x7c, 0xf9, 0x94, 0x14, 0xd5,
+	0x6a, 0x35, 0x7b, 0xa4, 0x92, 0x4a, 0x16, 0x6b, 0xdc, 0xe0, 0xb3, 0x52, 0xb5, 0xa2, 0xe6, 0x0b,
+	0x87, 0x05, 0xf5, 0x20, 0x33, 0x81, 0xb6, 0xe1, 0xe1, 0x68, 0xd1, 0x6a, 0x29, 0x5b, 0xa9, 0x1e,
+	0x97, 0x35, 0x72, 0x50, 0x38, 0x52, 0xab, 0x5a, 0x26, 0x81, 0x3e, 0x80, 0xa7, 0xa3, 0xc5, 0xf3,
+	0x59, 0x7c, 0x90, 0x2d, 0x95, 0x89, 0x86, 0xb3, 0xa5, 0x6a, 0x36, 0xaf, 0x15, 0xca, 0xa5, 0x2a,
+	0x29, 0xaa, 0xf8, 0xe4, 0x54, 0x25, 0xb8, 0x5c, 0xd6, 0x32, 0x93, 0xe8, 0x7d, 0xf8, 0xfe, 0x68,
+	0xfd, 0x92, 0xfa, 0x63, 0x8d, 0x64, 0x8f, 0x8e, 0xb0, 0x7a, 0x14, 0x44, 0xff, 0x5c, 0xc5, 0x85,
+	0xc3, 0x42, 0x3e, 0x1b, 0xd8, 0x09, 0xf8, 0x99, 0x29, 0xf4, 0x14, 0x9e, 0x8c, 0x56, 0x3f, 0xcd,
+	0x6a, 0x6a, 0x55, 0x23, 0x85, 0x62, 0xf1, 0x99, 0x96, 0xcd, 0x9d, 0xaa, 0xe4, 0xb0, 0x70, 0xaa,
+	0x92, 0xd2, 0xb3, 0x62, 0x4e, 0xc5, 0x99, 0x24, 0xda, 0x83, 0xed, 0x97, 0xea, 0xe6, 0x4e, 0xcb,
+	0xf9, 0x93, 0x50, 0x65, 0x1a, 0x3d, 0x81, 0xfd, 0x97, 0xec, 0x36, 0x62, 0x57, 0xb2, 0x38, 0x5b,
+	0x54, 0x35, 0x15, 0x57, 0x33, 0x33, 0xe8, 0x6d, 0x78, 0x73, 0x0c, 0x4a, 0xcf, 0x30, 0x56, 0x4b,
+	0x1a, 0x51, 0x2b, 0xe5, 0xfc, 0x71, 0x66, 0xf6, 0x6a, 0x90, 0x56, 0xb5, 0xec, 0x89, 0x4a, 0x0e,
+	0x0a, 0x55, 0x0d, 0x17, 0x72, 0xcf, 0x38, 0x22, 0x42, 0x7f, 0x0e, 0x1d, 0xc0, 0x87, 0xd7, 0xd2,
+	0x8f, 0x1f, 0xcc, 0x3c, 0x52, 0x21, 0xfb, 0x72, 0x2b, 0x1c, 0x9e, 0xea, 0xe8, 0xf3, 0x05, 0x91,
+	0x9a, 0xb9, 0x5f, 0x7d, 0xf9, 0x62, 0x2d, 0xf1, 0xd5, 0x8b, 0xb5, 0xc4, 0x37, 0x2f, 0xd6, 0x12,
+	0xbf, 0xfb, 0x76, 0x6d, 0xe2, 0xab, 0x6f, 0xd7, 0x26, 0xfe, 0xfe, 0xed, 0xda, 0xc4, 0x27, 0x46,
+	0xd3, 0x64, 0x2d, 0xbf, 0xb6, 0x63, 0x38, 0xd6, 0xae, 0x6c, 0xaa, 0xdb, 0x0d, 0xc7, 0xb7, 0xeb,
+	0x7c, 0x22, 0x8f, 0x48, 0x66, 0xcd, 0xd8, 0x36, 0x6d, 0xc3, 0xaf, 0x05, 0xb5, 0xb5, 0x6b, 0x38,
+	0x9e, 0xe5, 0x78, 0x11, 0x53, 0xb6, 0xbd, 0x6d, 0xde, 0x0b, 0xb7, 0x45, 0x33, 0xdc, 0x3e, 0xdf,
+	0x7b, 0xfc, 0x03, 0xc9, 0xa8, 0xcd, 0xf0, 0x69, 0xe6, 0x9d, 0xff, 0x0c, 0x00, 0x10, 0x0f, 0x9c,
+	0x49, 0x89, 0x1c, 0x00, 0x00,
 }
 
 func (m *Height) Marshal() (dAtA []byte, err error) {
@@ -1294,6 +1563,44 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HostStateShutdownHeight != nil {
+		{
+			size, err := m.HostStateShutdownHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMithril(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ConsensusStateKeyFormat != 0 {
+		i = encodeVarintMithril(dAtA, i, uint64(m.ConsensusStateKeyFormat))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.InitialCertificateChain) > 0 {
+		for iNdEx := len(m.InitialCertificateChain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialCertificateChain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMithril(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.GenesisVerificationKey) > 0 {
+		i -= len(m.GenesisVerificationKey)
+		copy(dAtA[i:], m.GenesisVerificationKey)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.GenesisVerificationKey)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.HostStateNftTokenName) > 0 {
 		i -= len(m.HostStateNftTokenName)
 		copy(dAtA[i:], m.HostStateNftTokenName)
@@ -1329,12 +1636,12 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintMithril(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintMithril(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -1396,6 +1703,44 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HostStateVersion != 0 {
+		i = encodeVarintMithril(dAtA, i, uint64(m.HostStateVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HostStateShutdown != nil {
+		{
+			size, err := m.HostStateShutdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMithril(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.HostStateBlockHash) > 0 {
+		i -= len(m.HostStateBlockHash)
+		copy(dAtA[i:], m.HostStateBlockHash)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.HostStateBlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BlocksTransactionsMerkleRoot) > 0 {
+		i -= len(m.BlocksTransactionsMerkleRoot)
+		copy(dAtA[i:], m.BlocksTransactionsMerkleRoot)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.BlocksTransactionsMerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TransactionsMerkleRoot) > 0 {
+		i -= len(m.TransactionsMerkleRoot)
+		copy(dAtA[i:], m.TransactionsMerkleRoot)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.TransactionsMerkleRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.IbcStateRoot) > 0 {
 		i -= len(m.IbcStateRoot)
 		copy(dAtA[i:], m.IbcStateRoot)
@@ -1430,6 +1775,95 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HostStateShutdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostStateShutdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostStateShutdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GracePeriodEndMs != 0 {
+		i = encodeVarintMithril(dAtA, i, uint64(m.GracePeriodEndMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InitiatedAtMs != 0 {
+		i = encodeVarintMithril(dAtA, i, uint64(m.InitiatedAtMs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MithrilTransactionMembershipProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MithrilTransactionMembershipProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MithrilTransactionMembershipProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcStateProof) > 0 {
+		i -= len(m.IbcStateProof)
+		copy(dAtA[i:], m.IbcStateProof)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.IbcStateProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OutputIndex != 0 {
+		i = encodeVarintMithril(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxBodyCbor) > 0 {
+		i -= len(m.TxBodyCbor)
+		copy(dAtA[i:], m.TxBodyCbor)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.TxBodyCbor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransactionProof) > 0 {
+		i -= len(m.TransactionProof)
+		copy(dAtA[i:], m.TransactionProof)
+		i = encodeVarintMithril(dAtA, i, uint64(len(m.TransactionProof)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2208,6 +2642,27 @@ func (m *SignedEntityType_CardanoTransactions) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SignedEntityType_CardanoBlocksTransactions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedEntityType_CardanoBlocksTransactions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CardanoBlocksTransactions != nil {
+		{
+			size, err := m.CardanoBlocksTransactions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMithril(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *CardanoStakeDistribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2304,7 +2759,7 @@ func (m *CardanoTransactions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CardanoDbBeacon) Marshal() (dAtA []byte, err error) {
+func (m *CardanoBlocksTransactions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2314,7 +2769,40 @@ func (m *CardanoDbBeacon) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CardanoDbBeacon) MarshalTo(dAtA []byte) (int, error) {
+func (m *CardanoBlocksTransactions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CardanoBlocksTransactions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		i = encodeVarintMithril(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintMithril(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CardanoDbBeacon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CardanoDbBeacon) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2444,6 +2932,23 @@ func (m *ClientState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMithril(uint64(l))
 	}
+	l = len(m.GenesisVerificationKey)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	if len(m.InitialCertificateChain) > 0 {
+		for _, e := range m.InitialCertificateChain {
+			l = e.Size()
+			n += 1 + l + sovMithril(uint64(l))
+		}
+	}
+	if m.ConsensusStateKeyFormat != 0 {
+		n += 1 + sovMithril(uint64(m.ConsensusStateKeyFormat))
+	}
+	if m.HostStateShutdownHeight != nil {
+		l = m.HostStateShutdownHeight.Size()
+		n += 1 + l + sovMithril(uint64(l))
+	}
 	return n
 }
 
@@ -2468,6 +2973,68 @@ func (m *ConsensusState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMithril(uint64(l))
 	}
+	l = len(m.TransactionsMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	l = len(m.BlocksTransactionsMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	l = len(m.HostStateBlockHash)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	if m.HostStateShutdown != nil {
+		l = m.HostStateShutdown.Size()
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	if m.HostStateVersion != 0 {
+		n += 1 + sovMithril(uint64(m.HostStateVersion))
+	}
+	return n
+}
+
+func (m *HostStateShutdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiatedAtMs != 0 {
+		n += 1 + sovMithril(uint64(m.InitiatedAtMs))
+	}
+	if m.GracePeriodEndMs != 0 {
+		n += 1 + sovMithril(uint64(m.GracePeriodEndMs))
+	}
+	return n
+}
+
+func (m *MithrilTransactionMembershipProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransactionProof)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	l = len(m.TxBodyCbor)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	if m.OutputIndex != 0 {
+		n += 1 + sovMithril(uint64(m.OutputIndex))
+	}
+	l = len(m.IbcStateProof)
+	if l > 0 {
+		n += 1 + l + sovMithril(uint64(l))
+	}
 	return n
 }
 
@@ -2822,6 +3389,18 @@ func (m *SignedEntityType_CardanoTransactions) Size() (n int) {
 	}
 	return n
 }
+func (m *SignedEntityType_CardanoBlocksTransactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CardanoBlocksTransactions != nil {
+		l = m.CardanoBlocksTransactions.Size()
+		n += 1 + l + sovMithril(uint64(l))
+	}
+	return n
+}
 func (m *CardanoStakeDistribution) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2862,6 +3441,21 @@ func (m *CardanoTransactions) Size() (n int) {
 	return n
 }
 
+func (m *CardanoBlocksTransactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovMithril(uint64(m.Epoch))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovMithril(uint64(m.BlockNumber))
+	}
+	return n
+}
+
 func (m *CardanoDbBeacon) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3238,16 +3832,579 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthMithril
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradePath = append(m.UpgradePath, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftPolicyId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostStateNftPolicyId = append(m.HostStateNftPolicyId[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostStateNftPolicyId == nil {
+				m.HostStateNftPolicyId = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftTokenName", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostStateNftTokenName = append(m.HostStateNftTokenName[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostStateNftTokenName == nil {
+				m.HostStateNftTokenName = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisVerificationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisVerificationKey = append(m.GenesisVerificationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisVerificationKey == nil {
+				m.GenesisVerificationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialCertificateChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialCertificateChain = append(m.InitialCertificateChain, &MithrilCertificate{})
+			if err := m.InitialCertificateChain[len(m.InitialCertificateChain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStateKeyFormat", wireType)
+			}
+			m.ConsensusStateKeyFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusStateKeyFormat |= ConsensusStateKeyFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateShutdownHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HostStateShutdownHeight == nil {
+				m.HostStateShutdownHeight = &Height{}
+			}
+			if err := m.HostStateShutdownHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMithril(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMithril
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstCertHashLatestEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstCertHashLatestEpoch == nil {
+				m.FirstCertHashLatestEpoch = &MithrilCertificate{}
+			}
+			if err := m.FirstCertHashLatestEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCertHashTxSnapshot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestCertHashTxSnapshot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcStateRoot = append(m.IbcStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcStateRoot == nil {
+				m.IbcStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionsMerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionsMerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksTransactionsMerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlocksTransactionsMerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostStateBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateShutdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HostStateShutdown == nil {
+				m.HostStateShutdown = &HostStateShutdown{}
+			}
+			if err := m.HostStateShutdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateVersion", wireType)
+			}
+			m.HostStateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostStateVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMithril(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostStateShutdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMithril
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpgradePath = append(m.UpgradePath, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftPolicyId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var byteLen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostStateShutdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostStateShutdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedAtMs", wireType)
+			}
+			m.InitiatedAtMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMithril
@@ -3257,31 +4414,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.InitiatedAtMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMithril
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMithril
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostStateNftPolicyId = append(m.HostStateNftPolicyId[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostStateNftPolicyId == nil {
-				m.HostStateNftPolicyId = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftTokenName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodEndMs", wireType)
 			}
-			var byteLen int
+			m.GracePeriodEndMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMithril
@@ -3291,26 +4433,11 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.GracePeriodEndMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMithril
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMithril
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostStateNftTokenName = append(m.HostStateNftTokenName[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostStateNftTokenName == nil {
-				m.HostStateNftTokenName = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMithril(dAtA[iNdEx:])
@@ -3332,7 +4459,7 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+func (m *MithrilTransactionMembershipProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3355,17 +4482,17 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MithrilTransactionMembershipProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MithrilTransactionMembershipProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionProof", wireType)
 			}
-			m.Timestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMithril
@@ -3375,16 +4502,31 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionProof = append(m.TransactionProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransactionProof == nil {
+				m.TransactionProof = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstCertHashLatestEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMithril
@@ -3394,33 +4536,29 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMithril
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMithril
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FirstCertHashLatestEpoch == nil {
-				m.FirstCertHashLatestEpoch = &MithrilCertificate{}
-			}
-			if err := m.FirstCertHashLatestEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCertHashTxSnapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBodyCbor", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMithril
@@ -3430,27 +4568,48 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMithril
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMithril
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestCertHashTxSnapshot = string(dAtA[iNdEx:postIndex])
+			m.TxBodyCbor = append(m.TxBodyCbor[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBodyCbor == nil {
+				m.TxBodyCbor = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			}
+			m.OutputIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcStateRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcStateProof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3477,9 +4636,9 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcStateRoot = append(m.IbcStateRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.IbcStateRoot == nil {
-				m.IbcStateRoot = []byte{}
+			m.IbcStateProof = append(m.IbcStateProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcStateProof == nil {
+				m.IbcStateProof = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5719,6 +6878,41 @@ func (m *SignedEntityType) Unmarshal(dAtA []byte) error {
 			}
 			m.Entity = &SignedEntityType_CardanoTransactions{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CardanoBlocksTransactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMithril
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CardanoBlocksTransactions{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Entity = &SignedEntityType_CardanoBlocksTransactions{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMithril(dAtA[iNdEx:])
@@ -5983,6 +7177,94 @@ func (m *CardanoTransactions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CardanoBlocksTransactions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMithril
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CardanoBlocksTransactions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CardanoBlocksTransactions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMithril
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMithril(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMithril
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CardanoDbBeacon) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -71,6 +71,13 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 	cs.CurrentEpoch = substituteClientState.CurrentEpoch
 	cs.ChainId = substituteClientState.ChainId
 	cs.TrustingPeriod = substituteClientState.TrustingPeriod
+	cs.ConsensusStateKeyFormat = substituteClientState.ConsensusStateKeyFormat
+	cs.ProtocolParameters = substituteClientState.ProtocolParameters
+	cs.HostStateNftPolicyId = substituteClientState.HostStateNftPolicyId
+	cs.HostStateShutdownHeight = nil
+	if substituteClientState.isHostStateShutdown() {
+		cs.recordHostStateShutdown(*substituteClientState.HostStateShutdownHeight)
+	}
 
 	setClientState(subjectClientStore, cdc, &cs)
 
@@ -80,6 +87,8 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 // IsMatchingClientState returns true if all recovery-invariant client parameters
 // match between subject and substitute. The substitute is allowed to differ only
 // in fields that naturally advance over time or are rewritten during recovery.
+// Recovery is only reachable through governance, which may also rotate the
+// Mithril protocol parameters and the HostState NFT policy.
 func IsMatchingClientState(subject, substitute ClientState) bool {
 	zeroHeightSubject := ZeroHeight()
 	zeroHeightSubstitute := ZeroHeight()
@@ -89,12 +98,20 @@ func IsMatchingClientState(subject, substitute ClientState) bool {
 	subject.CurrentEpoch = 0
 	subject.TrustingPeriod = 0
 	subject.ChainId = ""
+	subject.ConsensusStateKeyFormat = CONSENSUS_STATE_KEY_FORMAT_LEGACY
+	subject.ProtocolParameters = nil
+	subject.HostStateNftPolicyId = nil
+	subject.HostStateShutdownHeight = nil
 
 	substitute.LatestHeight = &zeroHeightSubstitute
 	substitute.FrozenHeight = &zeroHeightSubstitute
 	substitute.CurrentEpoch = 0
 	substitute.TrustingPeriod = 0
 	substitute.ChainId = ""
+	substitute.ConsensusStateKeyFormat = CONSENSUS_STATE_KEY_FORMAT_LEGACY
+	substitute.ProtocolParameters = nil
+	substitute.HostStateNftPolicyId = nil
+	substitute.HostStateShutdownHeight = nil
 
 	return reflect.DeepEqual(subject, substitute)
 }
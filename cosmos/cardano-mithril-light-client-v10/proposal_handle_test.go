@@ -14,6 +14,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/stretchr/testify/require"
@@ -85,12 +86,58 @@ func TestCheckSubstituteAndUpdateStateRejectsMismatchedRecoveryParameters(t *tes
 	require.ErrorContains(t, err, "subject client state does not match substitute client state")
 }
 
-func TestLightClientModuleVerifyUpgradeAndUpdateStateReturnsUnsupported(t *testing.T) {
-	lightClientModule := LightClientModule{}
+func TestLightClientModuleVerifyUpgradeAndUpdateStateRequiresClient(t *testing.T) {
+	ctx, lightClientModule := newTestLightClientModule(t, "mithril-upgrade-module")
 
-	err := lightClientModule.VerifyUpgradeAndUpdateState(sdk.Context{}, "08-cardano-mithril-0", nil, nil, nil, nil)
-	require.ErrorIs(t, err, clienttypes.ErrInvalidUpgradeClient)
-	require.ErrorContains(t, err, "cannot upgrade mithril client")
+	err := lightClientModule.VerifyUpgradeAndUpdateState(ctx, "08-cardano-mithril-0", nil, nil, nil, nil)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+}
+
+func TestLightClientModuleRecoverClientRotatesGovernanceParameters(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, lightClientModule := newTestLightClientModule(t, "mithril-recover-module")
+	subjectID, substituteID := "08-cardano-mithril-0", "08-cardano-mithril-1"
+	subjectStore := lightClientModule.storeProvider.ClientStore(ctx, subjectID)
+	substituteStore := lightClientModule.storeProvider.ClientStore(ctx, substituteID)
+
+	subjectState := newTestClientState(10, 4, "cardano-test", 24*time.Hour)
+	setClientState(subjectStore, cdc, subjectState)
+
+	substituteState := newTestClientState(20, 6, "cardano-test", 24*time.Hour)
+	substituteState.ProtocolParameters = &MithrilProtocolParameters{K: 2422, M: 20973, PhiF: Fraction{Numerator: 1, Denominator: 5}}
+	substituteState.HostStateNftPolicyId = bytes.Repeat([]byte{0x02}, 28)
+	setClientState(substituteStore, cdc, substituteState)
+	setConsensusState(substituteStore, cdc, newTestConsensusState(0x11), substituteState.LatestHeight)
+	setConsensusMetadataWithValues(substituteStore, substituteState.LatestHeight, NewHeight(0, 50), 123456789)
+
+	require.NoError(t, lightClientModule.RecoverClient(ctx, subjectID, substituteID))
+
+	recovered, found := getClientState(subjectStore, cdc)
+	require.True(t, found)
+	require.Equal(t, substituteState.ProtocolParameters, recovered.ProtocolParameters)
+	require.Equal(t, substituteState.HostStateNftPolicyId, recovered.HostStateNftPolicyId)
+
+	events := ctx.EventManager().Events()
+	parametersEvent := findTestEvent(t, events, EventTypeMithrilProtocolParametersRotated)
+	require.Equal(t, subjectID, testEventAttribute(parametersEvent, AttributeKeyClientID))
+	require.Equal(t, substituteID, testEventAttribute(parametersEvent, AttributeKeySubstituteClientID))
+	require.Equal(t, "k=1,m=1,phi_f=1/1", testEventAttribute(parametersEvent, AttributeKeyPreviousValue))
+	require.Equal(t, "k=2422,m=20973,phi_f=1/5", testEventAttribute(parametersEvent, AttributeKeyNewValue))
+
+	policyEvent := findTestEvent(t, events, EventTypeMithrilHostStateNftPolicyRotated)
+	require.Equal(t, hex.EncodeToString(subjectState.HostStateNftPolicyId), testEventAttribute(policyEvent, AttributeKeyPreviousValue))
+	require.Equal(t, hex.EncodeToString(substituteState.HostStateNftPolicyId), testEventAttribute(policyEvent, AttributeKeyNewValue))
+}
+
+func TestIsMatchingClientStateKeepsGenesisKeyInvariant(t *testing.T) {
+	subject := newTestClientState(10, 4, "cardano-test", 24*time.Hour)
+	substitute := newTestClientState(20, 6, "cardano-test", 24*time.Hour)
+	substitute.ProtocolParameters = &MithrilProtocolParameters{K: 5, M: 7, PhiF: Fraction{Numerator: 1, Denominator: 2}}
+	substitute.HostStateNftPolicyId = bytes.Repeat([]byte{0x02}, 28)
+	require.True(t, IsMatchingClientState(*subject, *substitute))
+
+	substitute.GenesisVerificationKey = bytes.Repeat([]byte{0x03}, 32)
+	require.False(t, IsMatchingClientState(*subject, *substitute))
 }
 
 func TestClientStateGetLatestHeightReturnsCoreHeight(t *testing.T) {
@@ -132,6 +179,47 @@ func newTestClientStore(t *testing.T, keyName string) (sdk.Context, storetypes.K
 	return ctx, stateStore.GetKVStore(key)
 }
 
+func newTestLightClientModule(t *testing.T, keyName string) (sdk.Context, LightClientModule) {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey(keyName)
+
+	stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{
+		ChainID: "mithril-test-0",
+		Height:  100,
+		Time:    time.Unix(1_700_000_000, 0),
+	}, false, log.NewNopLogger())
+
+	storeProvider := clienttypes.NewStoreProvider(runtime.NewKVStoreService(key))
+	return ctx, NewLightClientModule(newTestCodec(), storeProvider)
+}
+
+func findTestEvent(t *testing.T, events sdk.Events, eventType string) sdk.Event {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Type == eventType {
+			return event
+		}
+	}
+	t.Fatalf("event %s not emitted", eventType)
+	return sdk.Event{}
+}
+
+func testEventAttribute(event sdk.Event, key string) string {
+	for _, attribute := range event.Attributes {
+		if attribute.Key == key {
+			return attribute.Value
+		}
+	}
+	return ""
+}
+
 func newTestClientState(latestHeight, currentEpoch uint64, chainID string, trustingPeriod time.Duration) *ClientState {
 	zeroHeight := ZeroHeight()
 
@@ -374,3 +374,36 @@ func getLcTsInEpoch(clientStore storetypes.KVStore, epoch uint64) MithrilCertifi
 	}
 	return MustUnmarshalMithrilCertificate(bz)
 }
+
+// certificateEpochsBefore returns up to limit epochs below epoch that have a
+// certificate stored under keyPrefix, oldest first. Epoch keys are big-endian,
+// so the iteration stops at the first key of epoch.
+func certificateEpochsBefore(clientStore storetypes.KVStore, keyPrefix string, epoch uint64, limit int) []uint64 {
+	iterator := clientStore.Iterator([]byte(keyPrefix+"/"), epochKey(keyPrefix, epoch))
+	defer iterator.Close()
+
+	var epochs []uint64
+	for ; iterator.Valid() && len(epochs) < limit; iterator.Next() {
+		keyEpoch := bytes.TrimPrefix(iterator.Key(), []byte(keyPrefix+"/"))
+		if len(keyEpoch) != 8 {
+			continue
+		}
+		epochs = append(epochs, binary.BigEndian.Uint64(keyEpoch))
+	}
+	return epochs
+}
+
+// pruneCertificatesBeforeEpoch deletes the first and latest certificates of up
+// to limit epochs below epoch, together with the stake distribution
+// certificates indexed by their hash.
+func pruneCertificatesBeforeEpoch(clientStore storetypes.KVStore, epoch uint64, limit int) {
+	for _, fcEpoch := range certificateEpochsBefore(clientStore, KeyFirstCertificateInEpochPrefix, epoch, limit) {
+		if certificate := getFcInEpoch(clientStore, fcEpoch); certificate.Hash != "" {
+			clientStore.Delete(MSDCertificateHashKey(certificate.Hash))
+		}
+		clientStore.Delete(FcInEpochKey(fcEpoch))
+	}
+	for _, lcEpoch := range certificateEpochsBefore(clientStore, KeyLatestCertificateTsInEpochPrefix, epoch, limit) {
+		clientStore.Delete(LcTsInEpochKey(lcEpoch))
+	}
+}
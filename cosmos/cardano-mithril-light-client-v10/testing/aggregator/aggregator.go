@@ -0,0 +1,363 @@
+// Package aggregator simulates a Mithril network offline so the Mithril light
+// client can be exercised end to end with freshly generated, valid
+// cryptography instead of static fixtures.
+//
+// An Aggregator registers signers with stake, certifies their stake
+// distribution every epoch starting from a genesis certificate, records
+// Cardano transactions (including HostState updates) and seals
+// CardanoTransactions snapshots whose headers can be submitted to the client
+// as they are.
+//
+// Signers registered, or protocol parameters changed, during epoch e sign from
+// epoch e+2, as in Mithril: the certificates of epoch e+1 have already
+// committed to the next aggregate verification key when they are signed.
+package aggregator
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	mithril "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10"
+)
+
+const (
+	DefaultNetwork         = "devnet"
+	DefaultProtocolVersion = "0.1.0"
+	DefaultEpoch           = 10
+	DefaultTokenName       = "host_state"
+)
+
+// SignerConfig registers a signer in the genesis stake distribution.
+type SignerConfig struct {
+	PartyId string
+	Stake   uint64
+}
+
+// Config configures a new Aggregator. Zero values take the defaults.
+type Config struct {
+	Network            string
+	Epoch              uint64
+	ProtocolParameters *mithril.MithrilProtocolParameters
+	Signers            []SignerConfig
+	// GenesisTime is the time the genesis certificate is sealed. Every later
+	// certificate is sealed one second after the previous one unless the
+	// clock is advanced further.
+	GenesisTime           time.Time
+	HostStateNftPolicyId  []byte
+	HostStateNftTokenName []byte
+}
+
+// DefaultProtocolParameters returns parameters under which the default signers
+// reach quorum on every message.
+func DefaultProtocolParameters() *mithril.MithrilProtocolParameters {
+	return &mithril.MithrilProtocolParameters{
+		K:    5,
+		M:    100,
+		PhiF: mithril.Fraction{Numerator: 65, Denominator: 100},
+	}
+}
+
+// DefaultSigners returns three signers holding unequal stake.
+func DefaultSigners() []SignerConfig {
+	return []SignerConfig{
+		{PartyId: "pool1signer0", Stake: 1_000},
+		{PartyId: "pool1signer1", Stake: 2_000},
+		{PartyId: "pool1signer2", Stake: 3_000},
+	}
+}
+
+// Aggregator is an in-memory Mithril aggregator and Cardano ledger.
+type Aggregator struct {
+	network    string
+	genesisKey ed25519.PrivateKey
+	policyId   []byte
+	tokenName  []byte
+	now        time.Time
+
+	epoch uint64
+	// current signs the certificates of epoch, next those of epoch+1. The
+	// pending signers and parameters form the distribution of epoch+2.
+	current           *stakeDistribution
+	next              *stakeDistribution
+	pendingSigners    []*Signer
+	pendingParameters *mithril.MithrilProtocolParameters
+
+	genesisCertificate *mithril.MithrilCertificate
+	// stakeDistributionCertificates holds the first certificate of every epoch,
+	// oldest first.
+	stakeDistributionCertificates []*mithril.MithrilCertificate
+
+	transactions      []*Transaction
+	latestHostStateTx *Transaction
+	hostStateVersion  uint64
+	hostStateShutdown mithril.ShutdownState
+}
+
+// New creates an aggregator whose chain starts with a genesis certificate and
+// a stake distribution certificate at the configured epoch.
+func New(config Config) (*Aggregator, error) {
+	if config.Network == "" {
+		config.Network = DefaultNetwork
+	}
+	if config.Epoch == 0 {
+		config.Epoch = DefaultEpoch
+	}
+	if config.ProtocolParameters == nil {
+		config.ProtocolParameters = DefaultProtocolParameters()
+	}
+	if len(config.Signers) == 0 {
+		config.Signers = DefaultSigners()
+	}
+	if config.GenesisTime.IsZero() {
+		config.GenesisTime = time.Unix(1_700_000_000, 0).UTC()
+	}
+	if len(config.HostStateNftPolicyId) == 0 {
+		policyId := sha256.Sum224([]byte("host-state-policy/" + config.Network))
+		config.HostStateNftPolicyId = policyId[:]
+	}
+	if len(config.HostStateNftTokenName) == 0 {
+		config.HostStateNftTokenName = []byte(DefaultTokenName)
+	}
+
+	signers := make([]*Signer, 0, len(config.Signers))
+	for _, signerConfig := range config.Signers {
+		signer, err := newSigner(signerConfig.PartyId, signerConfig.Stake)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	distribution, err := newStakeDistribution(signers, config.ProtocolParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisSeed := sha256.Sum256([]byte("mithril-genesis/" + config.Network))
+	a := &Aggregator{
+		network:           config.Network,
+		genesisKey:        ed25519.NewKeyFromSeed(genesisSeed[:]),
+		policyId:          append([]byte(nil), config.HostStateNftPolicyId...),
+		tokenName:         append([]byte(nil), config.HostStateNftTokenName...),
+		now:               config.GenesisTime,
+		epoch:             config.Epoch,
+		current:           distribution,
+		next:              distribution,
+		pendingSigners:    signers,
+		pendingParameters: config.ProtocolParameters,
+	}
+
+	a.genesisCertificate, err = a.newGenesisCertificate()
+	if err != nil {
+		return nil, err
+	}
+	if err := a.certifyStakeDistribution(a.genesisCertificate.Hash); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Fork returns an aggregator sharing the history of a. Both can then certify
+// different transactions at the same block number, as equivocating signers
+// would.
+func (a *Aggregator) Fork() *Aggregator {
+	fork := *a
+	fork.pendingSigners = append([]*Signer(nil), a.pendingSigners...)
+	fork.stakeDistributionCertificates = append([]*mithril.MithrilCertificate(nil), a.stakeDistributionCertificates...)
+	fork.transactions = append([]*Transaction(nil), a.transactions...)
+	return &fork
+}
+
+// Epoch returns the current Mithril epoch.
+func (a *Aggregator) Epoch() uint64 {
+	return a.epoch
+}
+
+// Now returns the time the latest certificate was sealed at.
+func (a *Aggregator) Now() time.Time {
+	return a.now
+}
+
+// AdvanceTime moves the aggregator clock forward.
+func (a *Aggregator) AdvanceTime(d time.Duration) {
+	a.now = a.now.Add(d)
+}
+
+// GenesisVerificationKey returns the key verifying the genesis certificate.
+func (a *Aggregator) GenesisVerificationKey() ed25519.PublicKey {
+	return a.genesisKey.Public().(ed25519.PublicKey)
+}
+
+// GenesisCertificate returns the certificate every chain ends with.
+func (a *Aggregator) GenesisCertificate() *mithril.MithrilCertificate {
+	return a.genesisCertificate
+}
+
+// StakeDistributionCertificate returns the first certificate of epoch, or nil
+// if the epoch was not certified.
+func (a *Aggregator) StakeDistributionCertificate(epoch uint64) *mithril.MithrilCertificate {
+	for _, certificate := range a.stakeDistributionCertificates {
+		if certificate.Epoch == epoch {
+			return certificate
+		}
+	}
+	return nil
+}
+
+// HostStateNftPolicyId returns the policy id of the HostState NFT.
+func (a *Aggregator) HostStateNftPolicyId() []byte {
+	return a.policyId
+}
+
+// HostStateNftTokenName returns the token name of the HostState NFT.
+func (a *Aggregator) HostStateNftTokenName() []byte {
+	return a.tokenName
+}
+
+// RegisterSigner registers a signer, or changes the stake of a registered one,
+// from epoch+2 on.
+func (a *Aggregator) RegisterSigner(partyId string, stake uint64) error {
+	signer, err := newSigner(partyId, stake)
+	if err != nil {
+		return err
+	}
+	for i, registered := range a.pendingSigners {
+		if registered.PartyId == partyId {
+			a.pendingSigners[i] = signer
+			return nil
+		}
+	}
+	a.pendingSigners = append(a.pendingSigners, signer)
+	return nil
+}
+
+// DeregisterSigner removes a signer from epoch+2 on.
+func (a *Aggregator) DeregisterSigner(partyId string) {
+	signers := make([]*Signer, 0, len(a.pendingSigners))
+	for _, signer := range a.pendingSigners {
+		if signer.PartyId != partyId {
+			signers = append(signers, signer)
+		}
+	}
+	a.pendingSigners = signers
+}
+
+// SetProtocolParameters changes the protocol parameters from epoch+2 on.
+func (a *Aggregator) SetProtocolParameters(parameters *mithril.MithrilProtocolParameters) {
+	a.pendingParameters = parameters
+}
+
+// NextEpoch moves to the next epoch and certifies its stake distribution.
+func (a *Aggregator) NextEpoch() error {
+	distribution, err := newStakeDistribution(a.pendingSigners, a.pendingParameters)
+	if err != nil {
+		return err
+	}
+	previous := a.stakeDistributionCertificates[len(a.stakeDistributionCertificates)-1]
+
+	a.epoch++
+	a.current, a.next = a.next, distribution
+	return a.certifyStakeDistribution(previous.Hash)
+}
+
+func (a *Aggregator) certifyStakeDistribution(previousHash string) error {
+	certificate, err := a.newCertificate(
+		previousHash,
+		&mithril.SignedEntityType{
+			Entity: &mithril.SignedEntityType_MithrilStakeDistribution{
+				MithrilStakeDistribution: &mithril.MithrilStakeDistribution{Epoch: a.epoch},
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	a.stakeDistributionCertificates = append(a.stakeDistributionCertificates, certificate)
+	return nil
+}
+
+func (a *Aggregator) newGenesisCertificate() (*mithril.MithrilCertificate, error) {
+	certificate := a.newUnsignedCertificate("", a.current, a.protocolMessage(nil))
+	certificate.SignedEntityType = &mithril.SignedEntityType{
+		Entity: &mithril.SignedEntityType_MithrilStakeDistribution{
+			MithrilStakeDistribution: &mithril.MithrilStakeDistribution{Epoch: a.epoch},
+		},
+	}
+	certificate.GenesisSignature = hex.EncodeToString(ed25519.Sign(a.genesisKey, []byte(certificate.SignedMessage)))
+	hash, err := certificate.ComputeHash()
+	if err != nil {
+		return nil, fmt.Errorf("could not hash certificate of epoch %d: %w", a.epoch, err)
+	}
+	certificate.Hash = hash
+	return certificate, nil
+}
+
+// newCertificate seals a certificate of the current epoch signed by the current
+// stake distribution.
+func (a *Aggregator) newCertificate(
+	previousHash string, signedEntityType *mithril.SignedEntityType, parts []*mithril.MessagePart,
+) (*mithril.MithrilCertificate, error) {
+	a.now = a.now.Add(time.Second)
+	certificate := a.newUnsignedCertificate(previousHash, a.current, a.protocolMessage(parts))
+	certificate.SignedEntityType = signedEntityType
+
+	multiSignature, err := a.current.sign([]byte(certificate.SignedMessage))
+	if err != nil {
+		return nil, fmt.Errorf("could not sign certificate of epoch %d: %w", a.epoch, err)
+	}
+	certificate.MultiSignature = multiSignature
+	hash, err := certificate.ComputeHash()
+	if err != nil {
+		return nil, fmt.Errorf("could not hash certificate of epoch %d: %w", a.epoch, err)
+	}
+	certificate.Hash = hash
+	return certificate, nil
+}
+
+func (a *Aggregator) newUnsignedCertificate(
+	previousHash string, distribution *stakeDistribution, protocolMessage *mithril.ProtocolMessage,
+) *mithril.MithrilCertificate {
+	sealedAt := a.now.UTC().Format(time.RFC3339Nano)
+	// The protocol message was built from known keys, so it always converts.
+	message, _ := mithril.FromProtocolMessageProto(protocolMessage)
+	return &mithril.MithrilCertificate{
+		PreviousHash: previousHash,
+		Epoch:        a.epoch,
+		Metadata: &mithril.CertificateMetadata{
+			Network:            a.network,
+			ProtocolVersion:    DefaultProtocolVersion,
+			ProtocolParameters: distribution.parameters,
+			InitiatedAt:        sealedAt,
+			SealedAt:           sealedAt,
+			Signers:            distribution.signersWithStake(),
+		},
+		ProtocolMessage:          protocolMessage,
+		SignedMessage:            message.ComputeHash(),
+		AggregateVerificationKey: distribution.avk,
+	}
+}
+
+// protocolMessage returns parts followed by the parts every certificate of the
+// epoch commits to: the signers and parameters of the next epoch.
+func (a *Aggregator) protocolMessage(parts []*mithril.MessagePart) *mithril.ProtocolMessage {
+	nextParameters, _ := mithril.FromProtocolParametersProto(a.next.parameters)
+	return &mithril.ProtocolMessage{
+		MessageParts: append(append([]*mithril.MessagePart(nil), parts...),
+			&mithril.MessagePart{
+				ProtocolMessagePartKey:   mithril.PROTOCOL_MESSAGE_PART_KEY_NEXT_AGGREGATE_VERIFICATION_KEY,
+				ProtocolMessagePartValue: a.next.avk,
+			},
+			&mithril.MessagePart{
+				ProtocolMessagePartKey:   mithril.PROTOCOL_MESSAGE_PART_KEY_NEXT_PROTOCOL_PARAMETERS,
+				ProtocolMessagePartValue: nextParameters.ComputeHash(),
+			},
+			&mithril.MessagePart{
+				ProtocolMessagePartKey:   mithril.PROTOCOL_MESSAGE_PART_KEY_CURRENT_EPOCH,
+				ProtocolMessagePartValue: fmt.Sprintf("%d", a.epoch),
+			},
+		),
+	}
+}
@@ -0,0 +1,635 @@
+package aggregator
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	store "cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	mithril "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10"
+)
+
+const testTrustingPeriod = 24 * time.Hour
+
+func newTestClientStore(t *testing.T) (sdk.Context, storetypes.KVStore) {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey("aggregator")
+
+	stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{
+		ChainID: "mithril-test-0",
+		Height:  100,
+		Time:    time.Unix(1_700_000_000, 0),
+	}, false, log.NewNopLogger())
+
+	return ctx, stateStore.GetKVStore(key)
+}
+
+func newTestLightClientModule(t *testing.T) (sdk.Context, mithril.LightClientModule) {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey("aggregator-module")
+
+	stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{
+		ChainID: "mithril-test-0",
+		Height:  100,
+		Time:    time.Unix(1_700_000_000, 0),
+	}, false, log.NewNopLogger())
+
+	storeProvider := clienttypes.NewStoreProvider(runtime.NewKVStoreService(key))
+	return ctx, mithril.NewLightClientModule(newTestCodec(), storeProvider)
+}
+
+func newTestCodec() codec.BinaryCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	mithril.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func testRoot(seed string) []byte {
+	root := sha256.Sum256([]byte(seed))
+	return root[:]
+}
+
+// testClient is a Mithril client created from an aggregator snapshot.
+type testClient struct {
+	ctx         sdk.Context
+	cdc         codec.BinaryCodec
+	clientStore storetypes.KVStore
+	state       *mithril.ClientState
+}
+
+func newTestClient(t *testing.T, aggregator *Aggregator, snapshot *Snapshot) *testClient {
+	t.Helper()
+
+	ctx, clientStore := newTestClientStore(t)
+	client := &testClient{ctx: ctx, cdc: newTestCodec(), clientStore: clientStore}
+	clientState, consensusState, err := aggregator.CreateClient(snapshot, "cardano-devnet", testTrustingPeriod)
+	require.NoError(t, err)
+	require.NoError(t, clientState.Validate())
+	require.NoError(t, consensusState.ValidateBasic())
+	require.NoError(t, clientState.Initialize(ctx, client.cdc, clientStore, consensusState))
+	clientState.InitialCertificateChain = nil
+	client.state = clientState
+	return client
+}
+
+func (c *testClient) clientState(t *testing.T) *mithril.ClientState {
+	t.Helper()
+
+	return c.state
+}
+
+func (c *testClient) update(t *testing.T, header *mithril.MithrilHeader) error {
+	t.Helper()
+
+	clientState := c.clientState(t)
+	if err := clientState.VerifyClientMessage(c.ctx, c.cdc, c.clientStore, header); err != nil {
+		return err
+	}
+	require.False(t, clientState.CheckForMisbehaviour(c.ctx, c.cdc, c.clientStore, header))
+	clientState.UpdateState(c.ctx, c.cdc, c.clientStore, header)
+	return nil
+}
+
+func sealTestSnapshot(t *testing.T, aggregator *Aggregator, blockNumber uint64, rootSeed string) *Snapshot {
+	t.Helper()
+
+	_, err := aggregator.CommitHostState(blockNumber, testRoot(rootSeed))
+	require.NoError(t, err)
+	snapshot, err := aggregator.SealTransactions(blockNumber)
+	require.NoError(t, err)
+	return snapshot
+}
+
+func testHeader(t *testing.T, snapshot *Snapshot) *mithril.MithrilHeader {
+	t.Helper()
+
+	header, err := snapshot.Header()
+	require.NoError(t, err)
+	require.NoError(t, header.ValidateBasic())
+	return header
+}
+
+func TestUpdateClientWithinEpoch(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	require.NoError(t, aggregator.AddTransactions(5, "aa01", "aa02"))
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	require.NoError(t, aggregator.AddTransactions(30, "aa03"))
+	snapshot := sealTestSnapshot(t, aggregator, 40, "root-40")
+	require.NoError(t, client.update(t, testHeader(t, snapshot)))
+
+	clientState := client.clientState(t)
+	require.Equal(t, uint64(40), clientState.LatestHeight.RevisionHeight)
+	consensusState, found := mithril.GetConsensusState(client.clientStore, client.cdc, clientState.LatestHeight)
+	require.True(t, found)
+	require.Equal(t, testRoot("root-40"), consensusState.IbcStateRoot)
+	require.Equal(t, snapshot.TransactionSnapshot.MerkleRoot, consensusState.TransactionsMerkleRoot)
+
+	// Every transaction of the snapshot can be proven, not only the HostState one.
+	proof, err := snapshot.TransactionProof("aa01", "aa03")
+	require.NoError(t, err)
+	var proofs mithril.CardanoTransactionsProofsMessage
+	require.NoError(t, json.Unmarshal(proof, &proofs))
+	verified, err := proofs.Verify()
+	require.NoError(t, err)
+	require.Equal(t, consensusState.TransactionsMerkleRoot, verified.MerkleRoot)
+
+	_, err = snapshot.TransactionProof("ff00")
+	require.Error(t, err)
+}
+
+func TestUpdateClientWithBlocksTransactionsSnapshot(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	require.NoError(t, aggregator.AddTransactions(30, "bb01"))
+	hostStateTx, err := aggregator.CommitHostState(40, testRoot("root-40"))
+	require.NoError(t, err)
+	snapshot, err := aggregator.SealBlocksTransactions(40)
+	require.NoError(t, err)
+	header := testHeader(t, snapshot)
+
+	// A proof placing the HostState transaction in another block is rejected.
+	forged := *header
+	forged.HostStateTxProof = []byte(strings.ReplaceAll(string(header.HostStateTxProof), hex.EncodeToString([]byte(aggregator.BlockHash(40))), hex.EncodeToString([]byte(aggregator.BlockHash(41)))))
+	require.NotEqual(t, header.HostStateTxProof, forged.HostStateTxProof)
+	require.ErrorIs(t, client.update(t, &forged), mithril.ErrInvalidCardanoTransactionsProofs)
+
+	require.NoError(t, client.update(t, header))
+	consensusState, found := mithril.GetConsensusState(client.clientStore, client.cdc, client.clientState(t).LatestHeight)
+	require.True(t, found)
+	require.Equal(t, testRoot("root-40"), consensusState.IbcStateRoot)
+	require.Empty(t, consensusState.TransactionsMerkleRoot)
+	require.Equal(t, snapshot.TransactionSnapshot.MerkleRoot, consensusState.BlocksTransactionsMerkleRoot)
+	require.Equal(t, aggregator.BlockHash(40), consensusState.HostStateBlockHash)
+
+	proof, err := snapshot.TransactionProof("bb01", hostStateTx.Hash)
+	require.NoError(t, err)
+	var proofs mithril.CardanoBlocksTransactionsProofsMessage
+	require.NoError(t, json.Unmarshal(proof, &proofs))
+	verified, err := proofs.Verify()
+	require.NoError(t, err)
+	require.Equal(t, consensusState.BlocksTransactionsMerkleRoot, verified.MerkleRoot)
+	require.Len(t, verified.CertifiedTransactions, 2)
+	require.Equal(t, uint64(30), verified.CertifiedTransactions[0].BlockNumber)
+	require.Equal(t, aggregator.BlockHash(30), verified.CertifiedTransactions[0].BlockHash)
+
+	// A proof that does not reach the block of a transaction is not a blocks proof.
+	transactionsSnapshot, err := aggregator.SealTransactions(40)
+	require.NoError(t, err)
+	proof, err = transactionsSnapshot.TransactionProof("bb01")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(proof, &proofs))
+	_, err = proofs.Verify()
+	require.ErrorIs(t, err, mithril.ErrInvalidCardanoTransactionsProofs)
+}
+
+func TestUpdateClientRecordsHostStateShutdown(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+	require.NoError(t, client.update(t, testHeader(t, sealTestSnapshot(t, aggregator, 40, "root-40"))))
+	require.Nil(t, client.clientState(t).HostStateShutdownHeight)
+
+	_, err = aggregator.EnterHostStateShutdown(50, 60_000)
+	require.NoError(t, err)
+	snapshot, err := aggregator.SealTransactions(50)
+	require.NoError(t, err)
+	require.NoError(t, client.update(t, testHeader(t, snapshot)))
+
+	clientState := client.clientState(t)
+	require.Equal(t, mithril.NewHeight(0, 50), *clientState.HostStateShutdownHeight)
+	require.Equal(t, exported.Frozen, clientState.Status(client.ctx, client.clientStore, client.cdc))
+	consensusState, found := mithril.GetConsensusState(client.clientStore, client.cdc, clientState.LatestHeight)
+	require.True(t, found)
+	require.Equal(t, testRoot("root-40"), consensusState.IbcStateRoot, "entering shutdown keeps the root")
+	require.Equal(t, &mithril.HostStateShutdown{InitiatedAtMs: 50, GracePeriodEndMs: 60_000}, consensusState.HostStateShutdown)
+
+	err = clientState.VerifyNonMembership(client.ctx, client.clientStore, client.cdc, mithril.NewHeight(0, 50), 0, 0, nil, nil)
+	require.ErrorIs(t, err, mithril.ErrHostStateShutdown)
+	err = clientState.VerifyNonMembership(client.ctx, client.clientStore, client.cdc, mithril.NewHeight(0, 40), 0, 0, nil, nil)
+	require.NotErrorIs(t, err, mithril.ErrHostStateShutdown)
+
+	_, err = aggregator.EnterHostStateShutdown(60, 70_000)
+	require.Error(t, err, "a shutting-down HostState cannot enter shutdown again")
+}
+
+// TestShutdownClientVerifiesTimeoutProofsDuringGracePeriod drives a client
+// through the light client module the way the 02-client keeper does: proofs
+// are only verified while the module reports the client Active.
+func TestShutdownClientVerifiesTimeoutProofsDuringGracePeriod(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	ctx, lightClientModule := newTestLightClientModule(t)
+	const clientID = "08-cardano-0"
+
+	cdc := newTestCodec()
+	clientState, consensusState, err := aggregator.CreateClient(sealTestSnapshot(t, aggregator, 10, "root-10"), "cardano-devnet", testTrustingPeriod)
+	require.NoError(t, err)
+	clientStateBz, err := cdc.Marshal(clientState)
+	require.NoError(t, err)
+	consensusStateBz, err := cdc.Marshal(consensusState)
+	require.NoError(t, err)
+	require.NoError(t, lightClientModule.Initialize(ctx, clientID, clientStateBz, consensusStateBz))
+
+	update := func(header *mithril.MithrilHeader) error {
+		if err := lightClientModule.VerifyClientMessage(ctx, clientID, header); err != nil {
+			return err
+		}
+		require.False(t, lightClientModule.CheckForMisbehaviour(ctx, clientID, header))
+		lightClientModule.UpdateState(ctx, clientID, header)
+		return nil
+	}
+	verifyTimeout := func(ctx sdk.Context, height exported.Height, proof []byte, path exported.Path) error {
+		if status := lightClientModule.Status(ctx, clientID); status != exported.Active {
+			return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+		}
+		return lightClientModule.VerifyNonMembership(ctx, clientID, height, 0, 0, proof, path)
+	}
+
+	// The packet receipt was never written before the HostState shut down.
+	receiptKey := []byte("receipts/ports/transfer/channels/channel-0/sequences/1")
+	root, proof := testIbcStateAbsence(t, receiptKey)
+	path := commitmenttypesv2.NewMerklePath([]byte("ibc"), receiptKey)
+	_, err = aggregator.CommitHostState(40, root)
+	require.NoError(t, err)
+	snapshot, err := aggregator.SealTransactions(40)
+	require.NoError(t, err)
+	require.NoError(t, update(testHeader(t, snapshot)))
+
+	gracePeriodEnd := ctx.BlockTime().Add(time.Hour)
+	_, err = aggregator.EnterHostStateShutdown(50, uint64(gracePeriodEnd.UnixMilli()))
+	require.NoError(t, err)
+	snapshot, err = aggregator.SealTransactions(50)
+	require.NoError(t, err)
+	require.NoError(t, update(testHeader(t, snapshot)))
+
+	require.Equal(t, exported.Active, lightClientModule.Status(ctx, clientID))
+	require.NoError(t, verifyTimeout(ctx, mithril.NewHeight(0, 40), proof, path))
+	require.ErrorIs(t, verifyTimeout(ctx, mithril.NewHeight(0, 50), proof, path), mithril.ErrHostStateShutdown)
+
+	_, err = aggregator.CommitHostState(60, testRoot("root-60"))
+	require.NoError(t, err)
+	snapshot, err = aggregator.SealTransactions(60)
+	require.NoError(t, err)
+	require.ErrorIs(t, update(testHeader(t, snapshot)), mithril.ErrHostStateShutdown, "a shut-down client accepts no updates")
+
+	ctx = ctx.WithBlockTime(gracePeriodEnd)
+	require.Equal(t, exported.Frozen, lightClientModule.Status(ctx, clientID))
+	require.ErrorIs(t, verifyTimeout(ctx, mithril.NewHeight(0, 40), proof, path), clienttypes.ErrClientNotActive)
+}
+
+func TestUpdateClientAcrossEpochs(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	// The new signer and parameters take effect two epochs later.
+	require.NoError(t, aggregator.RegisterSigner("pool1signer3", 4_000))
+	parameters := DefaultProtocolParameters()
+	parameters.M = 120
+	aggregator.SetProtocolParameters(parameters)
+
+	blockNumber := uint64(10)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, aggregator.NextEpoch())
+		blockNumber += 20
+		snapshot := sealTestSnapshot(t, aggregator, blockNumber, "root")
+		require.NoError(t, client.update(t, testHeader(t, snapshot)))
+		require.Equal(t, aggregator.Epoch(), client.clientState(t).CurrentEpoch)
+	}
+
+	signers := aggregator.StakeDistributionCertificate(aggregator.Epoch()).Metadata.Signers
+	require.Len(t, signers, 4)
+	require.Len(t, aggregator.StakeDistributionCertificate(DefaultEpoch+1).Metadata.Signers, 3)
+}
+
+func TestUpdateClientBackfillsSkippedEpochs(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, aggregator.NextEpoch())
+	}
+	header := testHeader(t, sealTestSnapshot(t, aggregator, 90, "root-90"))
+
+	withoutBackfill := *header
+	withoutBackfill.PreviousMithrilStakeDistributionCertificates = nil
+	require.Error(t, client.update(t, &withoutBackfill))
+
+	require.NoError(t, client.update(t, header))
+	require.Equal(t, uint64(DefaultEpoch+3), client.clientState(t).CurrentEpoch)
+}
+
+func TestUpdateClientRejectsForgedCertificates(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	other := sealTestSnapshot(t, aggregator, 20, "root-20")
+	header := testHeader(t, sealTestSnapshot(t, aggregator, 30, "root-30"))
+	header.TransactionSnapshotCertificate.MultiSignature = other.Certificate.MultiSignature
+	require.ErrorIs(t, client.update(t, header), mithril.ErrInvalidCertificate)
+
+	// A network bootstrapped from another genesis key cannot update the client.
+	impostor, err := New(Config{Network: "impostor"})
+	require.NoError(t, err)
+	require.NoError(t, impostor.NextEpoch())
+	require.Error(t, client.update(t, testHeader(t, sealTestSnapshot(t, impostor, 40, "root-40"))))
+}
+
+func TestCreateClientRequiresGenesisChain(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	require.NoError(t, aggregator.NextEpoch())
+	snapshot := sealTestSnapshot(t, aggregator, 10, "root-10")
+
+	clientState, consensusState, err := aggregator.CreateClient(snapshot, "cardano-devnet", testTrustingPeriod)
+	require.NoError(t, err)
+	impostor, err := New(Config{Network: "impostor"})
+	require.NoError(t, err)
+	clientState.GenesisVerificationKey = impostor.GenesisVerificationKey()
+
+	ctx, clientStore := newTestClientStore(t)
+	require.ErrorIs(t, clientState.Initialize(ctx, newTestCodec(), clientStore, consensusState), mithril.ErrInvalidCertificate)
+}
+
+func TestMisbehaviourConflictingSnapshots(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	fork := aggregator.Fork()
+	header1 := testHeader(t, sealTestSnapshot(t, aggregator, 40, "root-40"))
+	header2 := testHeader(t, sealTestSnapshot(t, fork, 40, "forked-root-40"))
+
+	misbehaviour := &mithril.Misbehaviour{MithrilHeader1: header1, MithrilHeader2: header2}
+	clientState := client.clientState(t)
+	require.NoError(t, clientState.VerifyClientMessage(client.ctx, client.cdc, client.clientStore, misbehaviour))
+	require.True(t, clientState.CheckForMisbehaviour(client.ctx, client.cdc, client.clientStore, misbehaviour))
+
+	// Once one branch is accepted, a header from the other branch conflicts
+	// with the stored consensus state.
+	require.NoError(t, client.update(t, header1))
+	clientState = client.clientState(t)
+	require.NoError(t, clientState.VerifyClientMessage(client.ctx, client.cdc, client.clientStore, header2))
+	require.True(t, clientState.CheckForMisbehaviour(client.ctx, client.cdc, client.clientStore, header2))
+}
+
+func TestMisbehaviourIgnoresUnsignedCertificateFields(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+	latest := testHeader(t, sealTestSnapshot(t, aggregator, 20, "root-20"))
+	require.NoError(t, client.update(t, latest))
+	clientState := client.clientState(t)
+
+	// The latest header relabelled with a hash that is not its own is rejected.
+	relabelled := proto.Clone(latest).(*mithril.MithrilHeader)
+	relabelled.TransactionSnapshotCertificate.Hash = latest.MithrilStakeDistributionCertificate.Hash
+	relabelled.TransactionSnapshot.CertificateHash = relabelled.TransactionSnapshotCertificate.Hash
+	require.ErrorIs(t, clientState.VerifyClientMessage(client.ctx, client.cdc, client.clientStore, relabelled), mithril.ErrInvalidCertificate)
+
+	// sealed_at is not multi-signed: anyone can re-seal a certificate and
+	// rehash it. A re-sealed copy of the latest header, or a valid newer
+	// header sealed in the year 2000, is not evidence of misbehaviour.
+	reseal := func(header *mithril.MithrilHeader, sealedAt time.Time) *mithril.MithrilHeader {
+		resealed := proto.Clone(header).(*mithril.MithrilHeader)
+		resealed.TransactionSnapshotCertificate.Metadata.SealedAt = sealedAt.UTC().Format(time.RFC3339Nano)
+		hash, err := resealed.TransactionSnapshotCertificate.ComputeHash()
+		require.NoError(t, err)
+		resealed.HostStateTxProof = []byte(strings.ReplaceAll(string(resealed.HostStateTxProof), resealed.TransactionSnapshotCertificate.Hash, hash))
+		resealed.TransactionSnapshotCertificate.Hash = hash
+		resealed.TransactionSnapshot.CertificateHash = hash
+		return resealed
+	}
+	year2000 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resealedLatest := reseal(latest, year2000)
+	require.NotEqual(t, latest.TransactionSnapshot.CertificateHash, resealedLatest.TransactionSnapshot.CertificateHash)
+	misbehaviour := &mithril.Misbehaviour{MithrilHeader1: latest, MithrilHeader2: resealedLatest}
+	require.ErrorIs(t, clientState.VerifyClientMessage(client.ctx, client.cdc, client.clientStore, misbehaviour), clienttypes.ErrInvalidMisbehaviour)
+	require.False(t, clientState.CheckForMisbehaviour(client.ctx, client.cdc, client.clientStore, resealedLatest))
+
+	newer := reseal(testHeader(t, sealTestSnapshot(t, aggregator, 30, "root-30")), year2000)
+	misbehaviour = &mithril.Misbehaviour{MithrilHeader1: newer, MithrilHeader2: latest}
+	require.ErrorIs(t, clientState.VerifyClientMessage(client.ctx, client.cdc, client.clientStore, misbehaviour), clienttypes.ErrInvalidMisbehaviour)
+	require.NoError(t, client.update(t, newer))
+	require.True(t, client.clientState(t).FrozenHeight.IsZero())
+}
+
+func TestClientExpiresAfterTrustingPeriod(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	ctx := client.ctx.WithBlockTime(aggregator.Now().Add(time.Hour))
+	require.Equal(t, exported.Active, client.clientState(t).Status(ctx, client.clientStore, client.cdc))
+
+	ctx = client.ctx.WithBlockTime(aggregator.Now().Add(testTrustingPeriod))
+	require.Equal(t, exported.Expired, client.clientState(t).Status(ctx, client.clientStore, client.cdc))
+
+	// A fresh snapshot sealed before expiry keeps the client active.
+	aggregator.AdvanceTime(testTrustingPeriod - time.Hour)
+	require.NoError(t, client.update(t, testHeader(t, sealTestSnapshot(t, aggregator, 40, "root-40"))))
+	require.Equal(t, exported.Active, client.clientState(t).Status(ctx, client.clientStore, client.cdc))
+}
+
+// testIbcState returns the ibc_state_root of a state holding only key, and
+// the proof of key under it.
+func testIbcState(t *testing.T, key, value []byte) ([]byte, []byte) {
+	t.Helper()
+
+	keyHash := sha256.Sum256(key)
+	valueHash := sha256.Sum256(value)
+	leaf := sha256.Sum256(append(append([]byte{0x00}, keyHash[:]...), valueHash[:]...))
+	root := leaf[:]
+	index := binary.BigEndian.Uint64(keyHash[0:8])
+	empty := make([]byte, sha256.Size)
+
+	path := make([]*ics23.InnerOp, 0, 64)
+	for depth := 0; depth < 64; depth++ {
+		var node [sha256.Size]byte
+		if (index>>uint(depth))&1 == 0 {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{0x01}, Suffix: empty})
+			node = sha256.Sum256(append(append([]byte{0x01}, root...), empty...))
+		} else {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{0x01}, empty...), Suffix: []byte{}})
+			node = sha256.Sum256(append(append([]byte{0x01}, empty...), root...))
+		}
+		root = node[:]
+	}
+
+	proof, err := (&commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{{
+			Proof: &ics23.CommitmentProof_Exist{Exist: &ics23.ExistenceProof{Key: key, Value: value, Path: path}},
+		}},
+	}).Marshal()
+	require.NoError(t, err)
+	return root, proof
+}
+
+// testIbcStateAbsence returns the root of an empty IBC state tree with a
+// proof, in the Gateway's encoding, that key is absent from it.
+func testIbcStateAbsence(t *testing.T, key []byte) ([]byte, []byte) {
+	t.Helper()
+
+	keyHash := sha256.Sum256(key)
+	index := binary.BigEndian.Uint64(keyHash[0:8])
+	empty := make([]byte, sha256.Size)
+
+	path := make([]*ics23.InnerOp, 0, 64)
+	for depth := 0; depth < 64; depth++ {
+		if (index>>uint(depth))&1 == 0 {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{0x01}, Suffix: empty})
+		} else {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{0x01}, empty...), Suffix: []byte{}})
+		}
+	}
+
+	proof, err := (&commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{{
+			Proof: &ics23.CommitmentProof_Nonexist{Nonexist: &ics23.NonExistenceProof{
+				Key:  key,
+				Left: &ics23.ExistenceProof{Key: key, Value: []byte{}, Path: path},
+			}},
+		}},
+	}).Marshal()
+	require.NoError(t, err)
+	return empty, proof
+}
+
+func testTransactionMembershipProof(t *testing.T, snapshot *Snapshot, tx *Transaction, ibcStateProof []byte) []byte {
+	t.Helper()
+
+	transactionProof, err := snapshot.TransactionProof(tx.Hash)
+	require.NoError(t, err)
+	proof, err := proto.Marshal(&mithril.MithrilTransactionMembershipProof{
+		TransactionProof: transactionProof,
+		TxHash:           tx.Hash,
+		TxBodyCbor:       tx.BodyCbor,
+		OutputIndex:      tx.HostStateOutputIndex,
+		IbcStateProof:    ibcStateProof,
+	})
+	require.NoError(t, err)
+	wrapped, err := proto.Marshal(&gogotypes.Any{
+		TypeUrl: "/" + proto.MessageName(&mithril.MithrilTransactionMembershipProof{}),
+		Value:   proof,
+	})
+	require.NoError(t, err)
+	return wrapped
+}
+
+func TestTransactionMembershipRejectsDeletedCommitments(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	commitment := []byte{0x01}
+	deletedKey := []byte("commitments/ports/transfer/channels/channel-0/sequences/1")
+	liveKey := []byte("commitments/ports/transfer/channels/channel-0/sequences/2")
+	deletedRoot, deletedProof := testIbcState(t, deletedKey, commitment)
+	liveRoot, liveProof := testIbcState(t, liveKey, commitment)
+
+	written, err := aggregator.CommitHostState(10, deletedRoot)
+	require.NoError(t, err)
+	snapshot, err := aggregator.SealTransactions(10)
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, snapshot)
+
+	// The next HostState output deletes the first commitment and writes
+	// another one.
+	latest, err := aggregator.CommitHostState(20, liveRoot)
+	require.NoError(t, err)
+	snapshot, err = aggregator.SealTransactions(20)
+	require.NoError(t, err)
+	require.NoError(t, client.update(t, testHeader(t, snapshot)))
+
+	clientState := client.clientState(t)
+	height := mithril.NewHeight(0, 20)
+	verify := func(tx *Transaction, key, ibcStateProof []byte) error {
+		proof := testTransactionMembershipProof(t, snapshot, tx, ibcStateProof)
+		path := commitmenttypesv2.NewMerklePath([]byte("ibc"), key)
+		return clientState.VerifyMembership(client.ctx, client.clientStore, client.cdc, height, 0, 0, proof, path, commitment)
+	}
+
+	require.NoError(t, verify(latest, liveKey, liveProof))
+	// Both transactions are certified by the snapshot, but the deleted
+	// commitment can only be read from the stale HostState output.
+	err = verify(written, deletedKey, deletedProof)
+	require.ErrorContains(t, err, "reads HostState version 0, older than version 1 of the consensus state")
+}
+
+func TestMisbehaviourIgnoresCertificatesOfDifferentTypes(t *testing.T) {
+	aggregator, err := New(Config{})
+	require.NoError(t, err)
+	client := newTestClient(t, aggregator, sealTestSnapshot(t, aggregator, 10, "root-10"))
+
+	_, err = aggregator.CommitHostState(40, testRoot("root-40"))
+	require.NoError(t, err)
+	transactionsSnapshot, err := aggregator.SealTransactions(40)
+	require.NoError(t, err)
+	blocksSnapshot, err := aggregator.SealBlocksTransactions(40)
+	require.NoError(t, err)
+	header1, header2 := testHeader(t, transactionsSnapshot), testHeader(t, blocksSnapshot)
+	require.NotEqual(t, header1.TransactionSnapshot.CertificateHash, header2.TransactionSnapshot.CertificateHash)
+	require.NotEqual(t, header1.TransactionSnapshot.MerkleRoot, header2.TransactionSnapshot.MerkleRoot)
+
+	// Both certificates describe the same chain, so neither conflicts with the
+	// other or with a consensus state created from the other.
+	clientState := client.clientState(t)
+	for _, misbehaviour := range []*mithril.Misbehaviour{
+		{MithrilHeader1: header1, MithrilHeader2: header2},
+		{MithrilHeader1: header2, MithrilHeader2: header1},
+	} {
+		err := clientState.VerifyClientMessage(client.ctx, client.cdc, client.clientStore, misbehaviour)
+		require.ErrorIs(t, err, clienttypes.ErrInvalidMisbehaviour)
+	}
+
+	require.NoError(t, client.update(t, header1))
+	clientState = client.clientState(t)
+	require.False(t, clientState.CheckForMisbehaviour(client.ctx, client.cdc, client.clientStore, header2))
+	misbehaviour := &mithril.Misbehaviour{MithrilHeader1: header2, MithrilHeader2: header1}
+	require.ErrorIs(t, clientState.VerifyClientMessage(client.ctx, client.cdc, client.clientStore, misbehaviour), clienttypes.ErrInvalidMisbehaviour)
+	require.Equal(t, exported.Active, clientState.Status(client.ctx, client.clientStore, client.cdc))
+
+	// A certificate of the same type at the same block is still compared.
+	forkedHeader := testHeader(t, sealTestSnapshot(t, aggregator.Fork(), 40, "forked-root-40"))
+	require.True(t, clientState.CheckForMisbehaviour(client.ctx, client.cdc, client.clientStore, forkedHeader))
+}
@@ -0,0 +1,144 @@
+package aggregator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/cryptohelpers"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
+)
+
+// The Mithril aggregator serializes its artifacts with serde, which writes
+// byte vectors as arrays of numbers and tuples as arrays. The types below
+// reproduce that layout so the light client decodes them as it decodes the
+// artifacts of a real aggregator.
+
+type byteArray []byte
+
+func (b byteArray) MarshalJSON() ([]byte, error) {
+	numbers := make([]uint16, len(b))
+	for i, v := range b {
+		numbers[i] = uint16(v)
+	}
+	return json.Marshal(numbers)
+}
+
+type merkleTreeCommitmentJSON struct {
+	Root     byteArray `json:"root"`
+	NrLeaves uint64    `json:"nr_leaves"`
+	Hasher   any       `json:"hasher"`
+}
+
+type aggregateVerificationKeyJSON struct {
+	MTCommitment merkleTreeCommitmentJSON `json:"mt_commitment"`
+	TotalStake   uint64                   `json:"total_stake"`
+}
+
+func encodeAggregateVerificationKey(avk *crypto.StmAggrVerificationKey) (string, error) {
+	return encodeJSONHex(aggregateVerificationKeyJSON{
+		MTCommitment: merkleTreeCommitmentJSON{
+			Root:     avk.MTCommitment.Root,
+			NrLeaves: avk.MTCommitment.NrLeaves,
+		},
+		TotalStake: uint64(avk.TotalStake),
+	})
+}
+
+type stmSignatureJSON struct {
+	Sigma       byteArray      `json:"sigma"`
+	Indexes     []crypto.Index `json:"indexes"`
+	SignerIndex crypto.Index   `json:"signer_index"`
+}
+
+type batchPathJSON struct {
+	Values  []byteArray `json:"values"`
+	Indices []uint64    `json:"indices"`
+	Hasher  any         `json:"hasher"`
+}
+
+type multiSignatureJSON struct {
+	Signatures [][2]any      `json:"signatures"`
+	BatchProof batchPathJSON `json:"batch_proof"`
+}
+
+func encodeMultiSignature(signature *crypto.StmAggrSig) (string, error) {
+	encoded := multiSignatureJSON{
+		BatchProof: batchPathJSON{
+			Values:  make([]byteArray, 0, len(signature.BatchProof.Values)),
+			Indices: signature.BatchProof.Indices,
+		},
+	}
+	for _, value := range signature.BatchProof.Values {
+		encoded.BatchProof.Values = append(encoded.BatchProof.Values, value)
+	}
+	for _, sigRegParty := range signature.Signatures {
+		encoded.Signatures = append(encoded.Signatures, [2]any{
+			stmSignatureJSON{
+				Sigma:       sigRegParty.Sig.Sigma.ToBytes(),
+				Indexes:     sigRegParty.Sig.Indexes,
+				SignerIndex: sigRegParty.Sig.SignerIndex,
+			},
+			[2]any{
+				byteArray(sigRegParty.RegParty.VerificationKey.ToBytes()),
+				uint64(sigRegParty.RegParty.Stake),
+			},
+		})
+	}
+	return encodeJSONHex(encoded)
+}
+
+type mkTreeNodeJSON struct {
+	Hash byteArray `json:"hash"`
+}
+
+type mkProofJSON struct {
+	InnerRoot       mkTreeNodeJSON   `json:"inner_root"`
+	InnerLeaves     [][2]any         `json:"inner_leaves"`
+	InnerProofSize  uint64           `json:"inner_proof_size"`
+	InnerProofItems []mkTreeNodeJSON `json:"inner_proof_items"`
+}
+
+type mkMapProofJSON struct {
+	MasterProof mkProofJSON `json:"master_proof"`
+	SubProofs   [][2]any    `json:"sub_proofs,omitempty"`
+}
+
+func newMKProofJSON(proof *cryptohelpers.MKProof) mkProofJSON {
+	encoded := mkProofJSON{
+		InnerRoot:       mkTreeNodeJSON{Hash: proof.InnerRoot.Hash},
+		InnerLeaves:     make([][2]any, 0, len(proof.InnerLeaves)),
+		InnerProofSize:  proof.InnerProofSize,
+		InnerProofItems: make([]mkTreeNodeJSON, 0, len(proof.InnerProofItems)),
+	}
+	for _, leaf := range proof.InnerLeaves {
+		encoded.InnerLeaves = append(encoded.InnerLeaves, [2]any{leaf.MKTreeLeafPosition, mkTreeNodeJSON{Hash: leaf.Hash}})
+	}
+	for _, item := range proof.InnerProofItems {
+		encoded.InnerProofItems = append(encoded.InnerProofItems, mkTreeNodeJSON{Hash: item.Hash})
+	}
+	return encoded
+}
+
+func newMKMapProofJSON(proof *cryptohelpers.MKMapProof) mkMapProofJSON {
+	encoded := mkMapProofJSON{MasterProof: newMKProofJSON(proof.MasterProof)}
+	for _, subProof := range proof.SubProofs {
+		var key any = subProof.BlockRange
+		if subProof.Block != nil {
+			key = subProof.Block
+		}
+		encoded.SubProofs = append(encoded.SubProofs, [2]any{key, newMKMapProofJSON(subProof.MKMapProof)})
+	}
+	return encoded
+}
+
+func encodeMKMapProof(proof *cryptohelpers.MKMapProof) (string, error) {
+	return encodeJSONHex(newMKMapProofJSON(proof))
+}
+
+func encodeJSONHex(value any) (string, error) {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
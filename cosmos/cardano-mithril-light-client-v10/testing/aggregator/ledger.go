@@ -0,0 +1,390 @@
+package aggregator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ComposableFi/go-merkle-trees/mmr"
+	"github.com/fxamacker/cbor/v2"
+	"golang.org/x/crypto/blake2b"
+
+	mithril "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/cryptohelpers"
+)
+
+// BlockRangeLength is the number of blocks grouped under one leaf of the
+// Cardano transactions Merkle map, as in the Mithril aggregator.
+const BlockRangeLength = 15
+
+// plutusConstr0 is the CBOR tag of the first PlutusData constructor.
+const plutusConstr0 = 121
+
+// Transaction is a Cardano transaction known to the aggregator.
+type Transaction struct {
+	Hash        string
+	BlockNumber uint64
+	BlockHash   string
+	// BodyCbor and HostStateOutputIndex are set for HostState transactions.
+	BodyCbor             []byte
+	HostStateOutputIndex uint32
+	HostStateVersion     uint64
+	IbcStateRoot         []byte
+}
+
+// IsHostState reports whether the transaction produced the HostState output.
+func (tx *Transaction) IsHostState() bool {
+	return len(tx.BodyCbor) > 0
+}
+
+// newHostStateTransaction builds a transaction body whose first output carries
+// the HostState NFT and an inline HostState datum committing to ibcStateRoot
+// in the given shutdown state. The transaction spends the previous HostState
+// output, so every root update has a distinct transaction hash.
+func newHostStateTransaction(
+	previousTxHash string, blockNumber, version uint64, ibcStateRoot, policyId, tokenName []byte, shutdown mithril.ShutdownState,
+) (*Transaction, error) {
+	previousTxId, err := hex.DecodeString(previousTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	datum, err := cbor.Marshal(cbor.Tag{
+		Number: plutusConstr0,
+		Content: []any{
+			cbor.Tag{
+				Number: plutusConstr0,
+				Content: []any{
+					version,
+					ibcStateRoot,
+					uint64(0),
+					uint64(0),
+					uint64(0),
+					[]uint64{},
+					blockNumber,
+				},
+			},
+			policyId,
+			make([]byte, 28),
+			shutdown,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// An enterprise address paying to a key hash; the HostState script address
+	// plays no part in root extraction.
+	address := append([]byte{0x60}, make([]byte, 28)...)
+	output := map[uint64]any{
+		0: address,
+		1: []any{
+			uint64(2_000_000),
+			map[cbor.ByteString]map[cbor.ByteString]uint64{
+				cbor.ByteString(policyId): {cbor.ByteString(tokenName): 1},
+			},
+		},
+		2: []any{uint64(1), cbor.Tag{Number: 24, Content: datum}},
+	}
+	body, err := cbor.Marshal(map[uint64]any{
+		0: [][]any{{previousTxId, uint64(0)}},
+		1: []any{output},
+		2: uint64(200_000),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hash := blake2b.Sum256(body)
+	return &Transaction{
+		Hash:                 hex.EncodeToString(hash[:]),
+		BlockNumber:          blockNumber,
+		BodyCbor:             body,
+		HostStateOutputIndex: 0,
+		HostStateVersion:     version,
+		IbcStateRoot:         append([]byte(nil), ibcStateRoot...),
+	}, nil
+}
+
+// transactionSet is the set of transactions certified by a snapshot,
+// committed as a Merkle map from block ranges to the Merkle tree of the
+// transaction hashes in each range. A CardanoBlocksTransactions snapshot
+// commits each block range to a Merkle map from blocks to the Merkle tree of
+// the transaction hashes in each block instead.
+type transactionSet struct {
+	ranges []*blockRangeTransactions
+	blocks bool
+}
+
+type blockRangeTransactions struct {
+	blockRange *cryptohelpers.BlockRange
+	hashes     []string
+	blocks     []*blockTransactions
+}
+
+type blockTransactions struct {
+	block  *cryptohelpers.Block
+	hashes []string
+}
+
+func newTransactionSet(transactions []*Transaction, blocks bool) *transactionSet {
+	set := &transactionSet{blocks: blocks}
+	for _, tx := range transactions {
+		start := tx.BlockNumber - tx.BlockNumber%BlockRangeLength
+		if len(set.ranges) == 0 || set.ranges[len(set.ranges)-1].blockRange.InnerRange.Start != start {
+			set.ranges = append(set.ranges, &blockRangeTransactions{
+				blockRange: &cryptohelpers.BlockRange{
+					InnerRange: &cryptohelpers.Range{Start: start, End: start + BlockRangeLength},
+				},
+			})
+		}
+		current := set.ranges[len(set.ranges)-1]
+		current.hashes = append(current.hashes, tx.Hash)
+		if len(current.blocks) == 0 || current.blocks[len(current.blocks)-1].block.BlockNumber != tx.BlockNumber {
+			current.blocks = append(current.blocks, &blockTransactions{
+				block: &cryptohelpers.Block{BlockNumber: tx.BlockNumber, BlockHash: tx.BlockHash},
+			})
+		}
+		block := current.blocks[len(current.blocks)-1]
+		block.hashes = append(block.hashes, tx.Hash)
+	}
+	return set
+}
+
+func transactionLeaves(hashes []string) [][]byte {
+	leaves := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		leaves = append(leaves, []byte(hash))
+	}
+	return leaves
+}
+
+// blockLeaves returns the leaves of the Merkle map from the blocks of the range
+// to the Merkle tree of their transactions.
+func (r *blockRangeTransactions) blockLeaves() ([][]byte, error) {
+	leaves := make([][]byte, 0, len(r.blocks))
+	for _, b := range r.blocks {
+		subProof, err := buildMKProof(transactionLeaves(b.hashes), nil)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, b.block.ToMKTreeNode().Add(subProof.InnerRoot).Hash)
+	}
+	return leaves, nil
+}
+
+// rangeLeaves returns the leaves of the tree committed to by the block range.
+func (s *transactionSet) rangeLeaves(r *blockRangeTransactions) ([][]byte, error) {
+	if s.blocks {
+		return r.blockLeaves()
+	}
+	return transactionLeaves(r.hashes), nil
+}
+
+// merkleRoot returns the hex encoded root certified as the merkle root of the
+// snapshot.
+func (s *transactionSet) merkleRoot() (string, error) {
+	if len(s.ranges) == 0 {
+		return "", fmt.Errorf("no transaction to certify")
+	}
+	leaves, err := s.masterLeaves()
+	if err != nil {
+		return "", err
+	}
+	root, err := buildMKProof(leaves, nil)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(root.InnerRoot.Hash), nil
+}
+
+func (s *transactionSet) masterLeaves() ([][]byte, error) {
+	leaves := make([][]byte, 0, len(s.ranges))
+	for _, r := range s.ranges {
+		rangeLeaves, err := s.rangeLeaves(r)
+		if err != nil {
+			return nil, err
+		}
+		subProof, err := buildMKProof(rangeLeaves, nil)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, r.blockRange.ToMKTreeNode().Add(subProof.InnerRoot).Hash)
+	}
+	return leaves, nil
+}
+
+// proof returns the Merkle map proof of txHashes, with one sub proof per block
+// range holding any of them.
+func (s *transactionSet) proof(txHashes []string) (*cryptohelpers.MKMapProof, error) {
+	wanted := make(map[string]bool, len(txHashes))
+	for _, hash := range txHashes {
+		wanted[hash] = true
+	}
+
+	masterLeaves, err := s.masterLeaves()
+	if err != nil {
+		return nil, err
+	}
+	var masterIndices []uint64
+	var subProofs []*cryptohelpers.SubProof
+	for i, r := range s.ranges {
+		var rangeProof *cryptohelpers.MKMapProof
+		if s.blocks {
+			rangeProof, err = r.blocksProof(wanted)
+		} else {
+			rangeProof, err = transactionsProof(r.hashes, wanted)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rangeProof == nil {
+			continue
+		}
+		masterIndices = append(masterIndices, uint64(i))
+		subProofs = append(subProofs, &cryptohelpers.SubProof{
+			BlockRange: r.blockRange,
+			MKMapProof: rangeProof,
+		})
+	}
+	for _, hash := range txHashes {
+		if wanted[hash] {
+			return nil, fmt.Errorf("transaction %s is not in the certified set", hash)
+		}
+	}
+
+	masterProof, err := buildMKProof(masterLeaves, masterIndices)
+	if err != nil {
+		return nil, err
+	}
+	return &cryptohelpers.MKMapProof{MasterProof: masterProof, SubProofs: subProofs}, nil
+}
+
+// transactionsProof proves the wanted transactions among hashes and removes
+// them from wanted. It returns nil if hashes holds none of them.
+func transactionsProof(hashes []string, wanted map[string]bool) (*cryptohelpers.MKMapProof, error) {
+	var indices []uint64
+	for j, hash := range hashes {
+		if wanted[hash] {
+			indices = append(indices, uint64(j))
+			delete(wanted, hash)
+		}
+	}
+	if len(indices) == 0 {
+		return nil, nil
+	}
+	proof, err := buildMKProof(transactionLeaves(hashes), indices)
+	if err != nil {
+		return nil, err
+	}
+	return &cryptohelpers.MKMapProof{MasterProof: proof}, nil
+}
+
+// blocksProof proves the blocks of the range holding any wanted transaction,
+// and those transactions within their block.
+func (r *blockRangeTransactions) blocksProof(wanted map[string]bool) (*cryptohelpers.MKMapProof, error) {
+	var indices []uint64
+	var subProofs []*cryptohelpers.SubProof
+	for j, b := range r.blocks {
+		blockProof, err := transactionsProof(b.hashes, wanted)
+		if err != nil {
+			return nil, err
+		}
+		if blockProof == nil {
+			continue
+		}
+		indices = append(indices, uint64(j))
+		subProofs = append(subProofs, &cryptohelpers.SubProof{
+			Block:      b.block,
+			MKMapProof: blockProof,
+		})
+	}
+	if len(indices) == 0 {
+		return nil, nil
+	}
+	leaves, err := r.blockLeaves()
+	if err != nil {
+		return nil, err
+	}
+	masterProof, err := buildMKProof(leaves, indices)
+	if err != nil {
+		return nil, err
+	}
+	return &cryptohelpers.MKMapProof{MasterProof: masterProof, SubProofs: subProofs}, nil
+}
+
+// buildMKProof builds the Merkle mountain range of leaves and proves the
+// leaves at indices. With no indices only the root is filled in.
+func buildMKProof(leaves [][]byte, indices []uint64) (*cryptohelpers.MKProof, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("cannot build a Merkle tree without leaves")
+	}
+	tree := mmr.NewMMR(0, mmr.NewMemStore(), nil, &cryptohelpers.Blake2s256Hasher{})
+	for _, leaf := range leaves {
+		if _, err := tree.Push(leaf); err != nil {
+			return nil, err
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	proof := &cryptohelpers.MKProof{
+		InnerRoot:      &cryptohelpers.MKTreeNode{Hash: root},
+		InnerProofSize: tree.MMRSize(),
+	}
+	if len(indices) == 0 {
+		return proof, nil
+	}
+
+	positions := make([]uint64, 0, len(indices))
+	for _, index := range indices {
+		position := cryptohelpers.LeafIndexToPos(index)
+		positions = append(positions, position)
+		proof.InnerLeaves = append(proof.InnerLeaves, &cryptohelpers.InnerLeaf{
+			MKTreeLeafPosition: position,
+			MKTreeNode:         &cryptohelpers.MKTreeNode{Hash: leaves[index]},
+		})
+	}
+	mmrProof, err := tree.GenProof(positions)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range mmrProof.ProofItems() {
+		proof.InnerProofItems = append(proof.InnerProofItems, &cryptohelpers.MKTreeNode{Hash: item})
+	}
+	return proof, nil
+}
+
+// encodeTransactionsProof returns the Gateway encoding of the proof of
+// txHashes in the snapshot certified by certificateHash.
+func encodeTransactionsProof(set *transactionSet, certificateHash string, blockNumber uint64, txHashes []string) ([]byte, error) {
+	proof, err := set.proof(txHashes)
+	if err != nil {
+		return nil, err
+	}
+	encodedProof, err := encodeMKMapProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	if set.blocks {
+		return json.Marshal(mithril.CardanoBlocksTransactionsProofsMessage{
+			CertificateHash: certificateHash,
+			CertifiedTransactions: []*mithril.CardanoBlocksTransactionsSetProofMessagePart{{
+				TransactionsHashes: txHashes,
+				Proof:              encodedProof,
+			}},
+			NonCertifiedTransactions: []string{},
+			LatestBlockNumber:        blockNumber,
+		})
+	}
+	return json.Marshal(mithril.CardanoTransactionsProofsMessage{
+		CertificateHash: certificateHash,
+		CertifiedTransactions: []*mithril.CardanoTransactionsSetProofMessagePart{{
+			TransactionsHashes: txHashes,
+			Proof:              encodedProof,
+		}},
+		NonCertifiedTransactions: []string{},
+		LatestBlockNumber:        blockNumber,
+	})
+}
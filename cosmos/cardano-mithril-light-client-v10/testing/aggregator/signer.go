@@ -0,0 +1,134 @@
+package aggregator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	mithril "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
+)
+
+// Signer is a Mithril signer registered with the aggregator. Its keys are
+// derived from its party id, so the same party always signs with the same key.
+type Signer struct {
+	PartyId     string
+	Stake       uint64
+	initializer *crypto.StmInitializer
+}
+
+func newSigner(partyId string, stake uint64) (*Signer, error) {
+	seed := sha256.Sum256([]byte("mithril-signer/" + partyId))
+	sk, err := crypto.Gen(seed[:])
+	if err != nil {
+		return nil, err
+	}
+	pk, err := new(crypto.StmVerificationKeyPoP).FromSigningKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		PartyId: partyId,
+		Stake:   stake,
+		initializer: &crypto.StmInitializer{
+			Stake: crypto.Stake(stake),
+			Sk:    sk,
+			Pk:    pk,
+		},
+	}, nil
+}
+
+// stakeDistribution is the closed key registration of the signers that sign
+// the certificates of one epoch, together with the protocol parameters they
+// sign under.
+type stakeDistribution struct {
+	signers    []*Signer
+	parameters *mithril.MithrilProtocolParameters
+	stmParams  *crypto.StmParameters
+	closedReg  *crypto.ClosedKeyReg
+	avk        string
+}
+
+func newStakeDistribution(signers []*Signer, parameters *mithril.MithrilProtocolParameters) (*stakeDistribution, error) {
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("stake distribution has no signers")
+	}
+	ordered := append([]*Signer(nil), signers...)
+	// Registered parties are ordered by stake, then verification key, as the
+	// Mithril key registration does.
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Stake != ordered[j].Stake {
+			return ordered[i].Stake < ordered[j].Stake
+		}
+		return bytes.Compare(ordered[i].initializer.Pk.VK.ToBytes(), ordered[j].initializer.Pk.VK.ToBytes()) < 0
+	})
+
+	stmParams := &crypto.StmParameters{
+		M:    parameters.M,
+		K:    parameters.K,
+		PhiF: float64(parameters.PhiF.Numerator) / float64(parameters.PhiF.Denominator),
+	}
+	parties := make([]crypto.RegParty, 0, len(ordered))
+	var totalStake crypto.Stake
+	for _, signer := range ordered {
+		parties = append(parties, crypto.RegParty{VerificationKey: signer.initializer.Pk.VK, Stake: crypto.Stake(signer.Stake)})
+		totalStake += crypto.Stake(signer.Stake)
+	}
+	merkleTree, err := crypto.Create(parties)
+	if err != nil {
+		return nil, err
+	}
+	closedReg := &crypto.ClosedKeyReg{
+		RegParties: parties,
+		TotalStake: totalStake,
+		MerkleTree: merkleTree,
+	}
+
+	avk, err := encodeAggregateVerificationKey(crypto.FromRegistration(stmParams, closedReg).ComputeAVK())
+	if err != nil {
+		return nil, err
+	}
+	return &stakeDistribution{
+		signers:    ordered,
+		parameters: parameters,
+		stmParams:  stmParams,
+		closedReg:  closedReg,
+		avk:        avk,
+	}, nil
+}
+
+// sign collects the lottery-winning signatures of every signer over message
+// and aggregates them into the JSON hex multi-signature of a certificate.
+func (d *stakeDistribution) sign(message []byte) (string, error) {
+	var signatures []*crypto.StmSig
+	for _, signer := range d.signers {
+		initializer := *signer.initializer
+		initializer.Params = d.stmParams
+		stmSigner, err := initializer.NewSigner(d.closedReg)
+		if err != nil {
+			return "", err
+		}
+		signature, err := stmSigner.Sign(message)
+		if err != nil {
+			return "", err
+		}
+		if signature != nil {
+			signatures = append(signatures, signature)
+		}
+	}
+
+	multiSignature, err := crypto.FromRegistration(d.stmParams, d.closedReg).Aggregate(signatures, message)
+	if err != nil {
+		return "", fmt.Errorf("could not aggregate signatures: %w", err)
+	}
+	return encodeMultiSignature(multiSignature)
+}
+
+func (d *stakeDistribution) signersWithStake() []*mithril.SignerWithStake {
+	signers := make([]*mithril.SignerWithStake, 0, len(d.signers))
+	for _, signer := range d.signers {
+		signers = append(signers, &mithril.SignerWithStake{PartyId: signer.PartyId, Stake: signer.Stake})
+	}
+	return signers
+}
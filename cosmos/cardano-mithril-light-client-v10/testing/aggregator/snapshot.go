@@ -0,0 +1,272 @@
+package aggregator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+
+	mithril "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10"
+)
+
+// Snapshot is a sealed CardanoTransactions or CardanoBlocksTransactions
+// snapshot.
+type Snapshot struct {
+	TransactionSnapshot *mithril.CardanoTransactionSnapshot
+	Certificate         *mithril.MithrilCertificate
+	// StakeDistributionCertificate is the first certificate of the snapshot
+	// epoch, the one Certificate chains from.
+	StakeDistributionCertificate *mithril.MithrilCertificate
+	// HostStateTx is the latest HostState transaction certified by the
+	// snapshot, if any.
+	HostStateTx *Transaction
+
+	transactions *transactionSet
+	// previousStakeDistributionCertificates are the first certificates of the
+	// epochs before the snapshot epoch, oldest first.
+	previousStakeDistributionCertificates []*mithril.MithrilCertificate
+}
+
+// AddTransactions records transactions unrelated to the HostState at
+// blockNumber.
+func (a *Aggregator) AddTransactions(blockNumber uint64, txHashes ...string) error {
+	for _, txHash := range txHashes {
+		if err := a.addTransaction(&Transaction{Hash: strings.ToLower(txHash), BlockNumber: blockNumber}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommitHostState records a HostState transaction at blockNumber committing
+// to ibcStateRoot.
+func (a *Aggregator) CommitHostState(blockNumber uint64, ibcStateRoot []byte) (*Transaction, error) {
+	if len(ibcStateRoot) != sha256.Size {
+		return nil, fmt.Errorf("ibc state root must be %d bytes", sha256.Size)
+	}
+	previousTxHash := hex.EncodeToString(make([]byte, sha256.Size))
+	if a.latestHostStateTx != nil {
+		previousTxHash = a.latestHostStateTx.Hash
+	}
+	tx, err := newHostStateTransaction(previousTxHash, blockNumber, a.hostStateVersion, ibcStateRoot, a.policyId, a.tokenName, a.hostStateShutdown)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.addTransaction(tx); err != nil {
+		return nil, err
+	}
+	a.latestHostStateTx = tx
+	a.hostStateVersion++
+	return tx, nil
+}
+
+// EnterHostStateShutdown records the HostState transaction at blockNumber
+// that moves the HostState into shutdown with the given grace period end,
+// keeping the latest root. Later HostState transactions stay shutting down.
+func (a *Aggregator) EnterHostStateShutdown(blockNumber, gracePeriodEndMillis uint64) (*Transaction, error) {
+	if a.latestHostStateTx == nil {
+		return nil, fmt.Errorf("no HostState transaction to shut down")
+	}
+	if a.hostStateShutdown.ShuttingDown {
+		return nil, fmt.Errorf("HostState is already shutting down")
+	}
+	if gracePeriodEndMillis <= blockNumber {
+		return nil, fmt.Errorf("grace period end %d must be after block %d", gracePeriodEndMillis, blockNumber)
+	}
+	a.hostStateShutdown = mithril.ShutdownState{
+		ShuttingDown:         true,
+		InitiatedAtMillis:    blockNumber,
+		GracePeriodEndMillis: gracePeriodEndMillis,
+	}
+	return a.CommitHostState(blockNumber, a.latestHostStateTx.IbcStateRoot)
+}
+
+func (a *Aggregator) addTransaction(tx *Transaction) error {
+	if len(a.transactions) > 0 && tx.BlockNumber < a.transactions[len(a.transactions)-1].BlockNumber {
+		return fmt.Errorf("block number %d is older than the latest recorded block %d", tx.BlockNumber, a.transactions[len(a.transactions)-1].BlockNumber)
+	}
+	tx.BlockHash = a.BlockHash(tx.BlockNumber)
+	a.transactions = append(a.transactions, tx)
+	return nil
+}
+
+// BlockHash returns the hash of the block at blockNumber. Every block of the
+// network has a fixed hash, whatever transactions it holds.
+func (a *Aggregator) BlockHash(blockNumber uint64) string {
+	hash := blake2b.Sum256([]byte(fmt.Sprintf("mithril-block/%s/%d", a.network, blockNumber)))
+	return hex.EncodeToString(hash[:])
+}
+
+// SealTransactions certifies every transaction recorded up to blockNumber in a
+// CardanoTransactions snapshot of the current epoch.
+func (a *Aggregator) SealTransactions(blockNumber uint64) (*Snapshot, error) {
+	return a.seal(blockNumber, false)
+}
+
+// SealBlocksTransactions certifies every block and transaction recorded up to
+// blockNumber in a CardanoBlocksTransactions snapshot of the current epoch.
+func (a *Aggregator) SealBlocksTransactions(blockNumber uint64) (*Snapshot, error) {
+	return a.seal(blockNumber, true)
+}
+
+func (a *Aggregator) seal(blockNumber uint64, blocks bool) (*Snapshot, error) {
+	var certified []*Transaction
+	var hostStateTx *Transaction
+	for _, tx := range a.transactions {
+		if tx.BlockNumber > blockNumber {
+			break
+		}
+		certified = append(certified, tx)
+		if tx.IsHostState() {
+			hostStateTx = tx
+		}
+	}
+	set := newTransactionSet(certified, blocks)
+	merkleRoot, err := set.merkleRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	signedEntityType := &mithril.SignedEntityType{
+		Entity: &mithril.SignedEntityType_CardanoTransactions{
+			CardanoTransactions: &mithril.CardanoTransactions{Epoch: a.epoch, BlockNumber: blockNumber},
+		},
+	}
+	merkleRootKey := mithril.PROTOCOL_MESSAGE_PART_KEY_CARDANO_TRANSACTIONS_MERKLE_ROOT
+	if blocks {
+		signedEntityType.Entity = &mithril.SignedEntityType_CardanoBlocksTransactions{
+			CardanoBlocksTransactions: &mithril.CardanoBlocksTransactions{Epoch: a.epoch, BlockNumber: blockNumber},
+		}
+		merkleRootKey = mithril.PROTOCOL_MESSAGE_PART_KEY_CARDANO_BLOCKS_TRANSACTIONS_MERKLE_ROOT
+	}
+
+	stakeDistributionCertificate := a.stakeDistributionCertificates[len(a.stakeDistributionCertificates)-1]
+	certificate, err := a.newCertificate(
+		stakeDistributionCertificate.Hash,
+		signedEntityType,
+		[]*mithril.MessagePart{
+			{
+				ProtocolMessagePartKey:   merkleRootKey,
+				ProtocolMessagePartValue: merkleRoot,
+			},
+			{
+				ProtocolMessagePartKey:   mithril.PROTOCOL_MESSAGE_PART_KEY_LATEST_BLOCK_NUMBER,
+				ProtocolMessagePartValue: fmt.Sprintf("%d", blockNumber),
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotHash := sha256.Sum256([]byte(fmt.Sprintf("%s%d%d", merkleRoot, a.epoch, blockNumber)))
+	return &Snapshot{
+		TransactionSnapshot: &mithril.CardanoTransactionSnapshot{
+			MerkleRoot:      merkleRoot,
+			Epoch:           a.epoch,
+			BlockNumber:     blockNumber,
+			Hash:            hex.EncodeToString(snapshotHash[:]),
+			CertificateHash: certificate.Hash,
+			CreatedAt:       certificate.Metadata.SealedAt,
+		},
+		Certificate:                           certificate,
+		StakeDistributionCertificate:          stakeDistributionCertificate,
+		HostStateTx:                           hostStateTx,
+		transactions:                          set,
+		previousStakeDistributionCertificates: append([]*mithril.MithrilCertificate(nil), a.stakeDistributionCertificates[:len(a.stakeDistributionCertificates)-1]...),
+	}, nil
+}
+
+// TransactionProof returns the Gateway encoding of the proof that txHashes are
+// certified by snapshot.
+func (s *Snapshot) TransactionProof(txHashes ...string) ([]byte, error) {
+	return encodeTransactionsProof(s.transactions, s.Certificate.Hash, s.TransactionSnapshot.BlockNumber, txHashes)
+}
+
+// Header returns the header updating a client to snapshot. It carries every
+// earlier stake distribution certificate, so a client that missed epochs can
+// backfill them.
+func (s *Snapshot) Header() (*mithril.MithrilHeader, error) {
+	if s.HostStateTx == nil {
+		return nil, fmt.Errorf("snapshot at block %d certifies no HostState transaction", s.TransactionSnapshot.BlockNumber)
+	}
+	proof, err := s.TransactionProof(s.HostStateTx.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &mithril.MithrilHeader{
+		MithrilStakeDistribution: &mithril.MithrilStakeDistribution{
+			Epoch:             s.StakeDistributionCertificate.Epoch,
+			SignersWithStake:  s.StakeDistributionCertificate.Metadata.Signers,
+			Hash:              hashStakeDistribution(s.StakeDistributionCertificate),
+			CertificateHash:   s.StakeDistributionCertificate.Hash,
+			ProtocolParameter: s.StakeDistributionCertificate.Metadata.ProtocolParameters,
+		},
+		MithrilStakeDistributionCertificate:          s.StakeDistributionCertificate,
+		TransactionSnapshot:                          s.TransactionSnapshot,
+		TransactionSnapshotCertificate:               s.Certificate,
+		PreviousMithrilStakeDistributionCertificates: s.previousStakeDistributionCertificates,
+		HostStateTxHash:                              s.HostStateTx.Hash,
+		HostStateTxBodyCbor:                          s.HostStateTx.BodyCbor,
+		HostStateTxOutputIndex:                       s.HostStateTx.HostStateOutputIndex,
+		HostStateTxProof:                             proof,
+	}, nil
+}
+
+func hashStakeDistribution(certificate *mithril.MithrilCertificate) string {
+	hasher := sha256.New()
+	fmt.Fprintf(hasher, "%d", certificate.Epoch)
+	for _, signer := range certificate.Metadata.Signers {
+		fmt.Fprintf(hasher, "%s%d", signer.PartyId, signer.Stake)
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// CreateClient returns a client state and consensus state anchored at
+// snapshot, ready for ClientState.Initialize. The stake distribution
+// certificate of the snapshot epoch is proven back to genesis through the
+// initial certificate chain.
+func (a *Aggregator) CreateClient(snapshot *Snapshot, chainId string, trustingPeriod time.Duration) (*mithril.ClientState, *mithril.ConsensusState, error) {
+	if snapshot.HostStateTx == nil {
+		return nil, nil, fmt.Errorf("snapshot at block %d certifies no HostState transaction", snapshot.TransactionSnapshot.BlockNumber)
+	}
+	latestHeight := mithril.NewHeight(0, snapshot.TransactionSnapshot.BlockNumber)
+	clientState := mithril.NewClientState(
+		chainId,
+		&latestHeight,
+		snapshot.TransactionSnapshot.Epoch,
+		trustingPeriod,
+		snapshot.StakeDistributionCertificate.Metadata.ProtocolParameters,
+		nil,
+	)
+	clientState.HostStateNftPolicyId = append([]byte(nil), a.policyId...)
+	clientState.HostStateNftTokenName = append([]byte(nil), a.tokenName...)
+	clientState.GenesisVerificationKey = a.GenesisVerificationKey()
+	clientState.InitialCertificateChain = append(
+		[]*mithril.MithrilCertificate{a.genesisCertificate},
+		snapshot.previousStakeDistributionCertificates...,
+	)
+
+	consensusState := &mithril.ConsensusState{
+		Timestamp:                timestamp(snapshot.Certificate),
+		FirstCertHashLatestEpoch: snapshot.StakeDistributionCertificate,
+		LatestCertHashTxSnapshot: snapshot.Certificate.Hash,
+		IbcStateRoot:             append([]byte(nil), snapshot.HostStateTx.IbcStateRoot...),
+		TransactionsMerkleRoot:   snapshot.TransactionSnapshot.MerkleRoot,
+		HostStateVersion:         snapshot.HostStateTx.HostStateVersion,
+	}
+	if snapshot.transactions.blocks {
+		consensusState.TransactionsMerkleRoot = ""
+		consensusState.BlocksTransactionsMerkleRoot = snapshot.TransactionSnapshot.MerkleRoot
+		consensusState.HostStateBlockHash = snapshot.HostStateTx.BlockHash
+	}
+	return clientState, consensusState, nil
+}
+
+func timestamp(certificate *mithril.MithrilCertificate) uint64 {
+	sealedAt, _ := time.Parse(time.RFC3339Nano, certificate.Metadata.SealedAt)
+	return uint64(sealedAt.UnixNano())
+}
@@ -0,0 +1,98 @@
+package mithril
+
+import (
+	"fmt"
+	"strings"
+
+	proto "github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// transactionMembershipProofTypeURL identifies a MithrilTransactionMembershipProof
+// wrapped in a protobuf Any. ICS-23 and Gateway JSON proofs never decode to an
+// Any with this type URL, so the proof kinds cannot be confused. It is spelled
+// out because message names are only registered once package init runs.
+const transactionMembershipProofTypeURL = "/ibc.lightclients.mithril.v1.MithrilTransactionMembershipProof"
+
+// packetStateKeyPrefixes are the IBC keys whose values are written once and
+// afterwards only ever deleted, never overwritten. A packet commitment is
+// deleted on acknowledgement or timeout, and receipts and acknowledgements
+// when packet history is pruned. A value read from a HostState output is
+// therefore the value committed under its key, but the key may have been
+// deleted by any later HostState output, so proofs may not read an output
+// older than the one a consensus state was anchored to.
+var packetStateKeyPrefixes = []string{
+	"commitments/ports/",
+	"acks/ports/",
+	"receipts/ports/",
+}
+
+// decodeTransactionMembershipProof returns the transaction membership proof
+// carried by proofBytes, or nil if proofBytes is another kind of proof.
+func decodeTransactionMembershipProof(proofBytes []byte) (*MithrilTransactionMembershipProof, error) {
+	var wrapped gogotypes.Any
+	if err := proto.Unmarshal(proofBytes, &wrapped); err != nil || wrapped.TypeUrl != transactionMembershipProofTypeURL {
+		return nil, nil
+	}
+
+	var proof MithrilTransactionMembershipProof
+	if err := proto.Unmarshal(wrapped.Value, &proof); err != nil {
+		return nil, fmt.Errorf("malformed transaction membership proof: %w", err)
+	}
+	return &proof, nil
+}
+
+// verifyTransactionMembership verifies `key -> value` under the `ibc_state_root`
+// of a HostState output, where the transaction creating that output is
+// certified by the transaction snapshot of consState.
+func (cs ClientState) verifyTransactionMembership(consState *ConsensusState, key, value []byte, proof *MithrilTransactionMembershipProof) error {
+	if !isPacketStateKey(string(key)) {
+		return fmt.Errorf("transaction membership proofs only support packet state keys, got %s", key)
+	}
+	switch {
+	case consState.BlocksTransactionsMerkleRoot != "":
+		if _, err := verifyCertifiedBlockTransaction(
+			proof.TransactionProof,
+			consState.BlocksTransactionsMerkleRoot,
+			consState.LatestCertHashTxSnapshot,
+			proof.TxHash,
+		); err != nil {
+			return err
+		}
+	case consState.TransactionsMerkleRoot != "":
+		if err := verifyCertifiedTransaction(
+			proof.TransactionProof,
+			consState.TransactionsMerkleRoot,
+			consState.LatestCertHashTxSnapshot,
+			proof.TxHash,
+		); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("consensus state has no certified transactions merkle root")
+	}
+
+	datum, err := cs.extractHostStateDatumFromHostStateOutput(proof.TxHash, proof.TxBodyCbor, proof.OutputIndex)
+	if err != nil {
+		return fmt.Errorf("invalid host state tx body: %w", err)
+	}
+	// A HostState output written after shutdown began is state past the
+	// shutdown, which the client no longer proves.
+	if datum.Shutdown.ShuttingDown {
+		return fmt.Errorf("transaction membership proof reads a shut-down HostState output")
+	}
+	if datum.State.Version < consState.HostStateVersion {
+		return fmt.Errorf("transaction membership proof reads HostState version %d, older than version %d of the consensus state", datum.State.Version, consState.HostStateVersion)
+	}
+
+	return VerifyIbcStateMembership(datum.State.IbcStateRoot, key, value, proof.IbcStateProof)
+}
+
+func isPacketStateKey(key string) bool {
+	for _, prefix := range packetStateKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
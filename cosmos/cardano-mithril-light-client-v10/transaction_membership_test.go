@@ -0,0 +1,136 @@
+package mithril
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	proto "github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/stretchr/testify/require"
+)
+
+const testPacketCommitmentKey = "commitments/ports/transfer/channels/channel-0/sequences/1"
+
+func TestDecodeTransactionMembershipProof(t *testing.T) {
+	require.Equal(t, "/"+proto.MessageName(&MithrilTransactionMembershipProof{}), transactionMembershipProofTypeURL)
+
+	proof := &MithrilTransactionMembershipProof{TxHash: testHashHex(0x01), OutputIndex: 2}
+	decoded, err := decodeTransactionMembershipProof(mustTestTransactionMembershipProof(t, proof))
+	require.NoError(t, err)
+	require.Equal(t, proof.TxHash, decoded.TxHash)
+	require.EqualValues(t, 2, decoded.OutputIndex)
+
+	ics23Proof := mustTestExistenceProof(t, []byte(testPacketCommitmentKey), []byte{0x01}, nil)
+	decoded, err = decodeTransactionMembershipProof(ics23Proof)
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+
+	decoded, err = decodeTransactionMembershipProof(mustJSONExistenceProof(t, []byte(testPacketCommitmentKey), []byte{0x01}, nil))
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+}
+
+func TestVerifyTransactionMembership(t *testing.T) {
+	clientState := newTestClientState(100, 10, "cardano-test", 24*time.Hour)
+	verified, err := testCardanoTransactionsProofsMessage.Verify()
+	require.NoError(t, err)
+	transactionProof, err := json.Marshal(testCardanoTransactionsProofsMessage)
+	require.NoError(t, err)
+
+	newConsensusState := func() *ConsensusState {
+		consensusState := newTestConsensusState(0x10)
+		consensusState.TransactionsMerkleRoot = verified.MerkleRoot
+		consensusState.LatestCertHashTxSnapshot = testCardanoTransactionsProofsMessage.CertificateHash
+		return consensusState
+	}
+	newProof := func() *MithrilTransactionMembershipProof {
+		return &MithrilTransactionMembershipProof{
+			TransactionProof: transactionProof,
+			TxHash:           verified.CertifiedTransactions[0],
+			TxBodyCbor:       []byte{0x80},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		key    string
+		mutate func(*ConsensusState, *MithrilTransactionMembershipProof)
+		want   string
+	}{
+		{
+			name: "non-packet key",
+			key:  "channelEnds/ports/transfer/channels/channel-0",
+			want: "only support packet state keys",
+		},
+		{
+			name:   "consensus state without transactions merkle root",
+			key:    testPacketCommitmentKey,
+			mutate: func(cs *ConsensusState, _ *MithrilTransactionMembershipProof) { cs.TransactionsMerkleRoot = "" },
+			want:   "no certified transactions merkle root",
+		},
+		{
+			name: "proof for another snapshot",
+			key:  testPacketCommitmentKey,
+			mutate: func(cs *ConsensusState, _ *MithrilTransactionMembershipProof) {
+				cs.TransactionsMerkleRoot = testHashHex(0x20)
+			},
+			want: "transaction proof merkle root mismatch",
+		},
+		{
+			name: "proof certified by another certificate",
+			key:  testPacketCommitmentKey,
+			mutate: func(cs *ConsensusState, _ *MithrilTransactionMembershipProof) {
+				cs.LatestCertHashTxSnapshot = testHashHex(0x21)
+			},
+			want: "transaction proof certificate hash mismatch",
+		},
+		{
+			name:   "uncertified transaction",
+			key:    testPacketCommitmentKey,
+			mutate: func(_ *ConsensusState, p *MithrilTransactionMembershipProof) { p.TxHash = testHashHex(0x22) },
+			want:   "host state tx hash not certified",
+		},
+		{
+			name: "certified transaction with an invalid body",
+			key:  testPacketCommitmentKey,
+			want: "invalid host state tx body",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consensusState, proof := newConsensusState(), newProof()
+			if tc.mutate != nil {
+				tc.mutate(consensusState, proof)
+			}
+			err := clientState.verifyTransactionMembership(consensusState, []byte(tc.key), []byte{0x01}, proof)
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
+
+func TestVerifyMembershipAcceptsTransactionMembershipProof(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "transaction-membership")
+	clientState := newTestClientState(100, 10, "cardano-test", 24*time.Hour)
+	consensusState := newTestConsensusState(0x10)
+	setConsensusState(clientStore, cdc, consensusState, clientState.LatestHeight)
+
+	path := commitmenttypesv2.NewMerklePath([]byte("ibc"), []byte(testPacketCommitmentKey))
+	proof := mustTestTransactionMembershipProof(t, &MithrilTransactionMembershipProof{TxHash: testHashHex(0x01)})
+	err := clientState.VerifyMembership(ctx, clientStore, cdc, clientState.LatestHeight, 0, 0, proof, path, []byte{0x01})
+	require.ErrorIs(t, err, clienttypes.ErrFailedMembershipVerification)
+	require.ErrorContains(t, err, "no certified transactions merkle root")
+}
+
+func mustTestTransactionMembershipProof(t *testing.T, proof *MithrilTransactionMembershipProof) []byte {
+	t.Helper()
+
+	value, err := proto.Marshal(proof)
+	require.NoError(t, err)
+	bz, err := proto.Marshal(&gogotypes.Any{TypeUrl: transactionMembershipProofTypeURL, Value: value})
+	require.NoError(t, err)
+	return bz
+}
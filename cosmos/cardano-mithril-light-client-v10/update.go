@@ -1,12 +1,14 @@
 package mithril
 
 import (
-	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-mithril-light-client-v10/common/entities"
+
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -37,9 +39,39 @@ func (cs *ClientState) verifyHeader(
 	_ sdk.Context, clientStore storetypes.KVStore, _ codec.BinaryCodec,
 	header *MithrilHeader,
 ) error {
+	// A shut-down HostState commits to no further IBC state. The client stays
+	// Active for proofs during the grace period but accepts no new headers.
+	if cs.isHostStateShutdown() {
+		return errorsmod.Wrapf(ErrHostStateShutdown, "client was shut down at height %s", cs.HostStateShutdownHeight)
+	}
+	if err := cs.verifyHeaderCertificates(clientStore, header); err != nil {
+		return err
+	}
+
+	// not allow old one
+	if header.TransactionSnapshot.Epoch < cs.CurrentEpoch || header.TransactionSnapshot.BlockNumber < cs.LatestHeight.RevisionHeight {
+		return errorsmod.Wrapf(ErrInvalidCertificate, "Expect newer header: TS.Epoch: %v, cs.Epoch: %v, TS.BlockNumber: %v, cs.LatestHeight.RevisionHeight: %v", header.TransactionSnapshot.Epoch, cs.CurrentEpoch, header.TransactionSnapshot.BlockNumber, cs.LatestHeight.RevisionHeight)
+	}
+
+	return nil
+}
+
+// verifyHeaderCertificates verifies the certificate chain and HostState
+// commitment evidence of a header, without requiring it to be newer than the
+// client's latest height.
+func (cs *ClientState) verifyHeaderCertificates(clientStore storetypes.KVStore, header *MithrilHeader) error {
 	nilCertificate := MithrilCertificate{}
 	expectedPreviousCerForTs := nilCertificate
 
+	// Multi-signatures of every certificate in the header are checked together
+	// once the certificate chain has been walked.
+	msdVerifier := &MithrilCertificateVerifier{
+		CertificateRetriever: &MSDCertificateRetriever{
+			ClientStore: clientStore,
+		},
+		batch: &multiSignatureBatch{},
+	}
+
 	firstCertInEpoch := getFcInEpoch(clientStore, header.MithrilStakeDistribution.Epoch)
 	firstCertInPrevEpoch := getFcInEpoch(clientStore, header.MithrilStakeDistribution.Epoch-1)
 
@@ -56,7 +88,7 @@ func (cs *ClientState) verifyHeader(
 			//
 			// The Gateway supplies a bounded list of prior stake distribution certificates in
 			// `previous_mithril_stake_distribution_certificates` to support this.
-			if err := backfillPreviousStakeDistributionCertificates(clientStore, header.PreviousMithrilStakeDistributionCertificates); err != nil {
+			if err := backfillPreviousStakeDistributionCertificates(clientStore, msdVerifier, header.PreviousMithrilStakeDistributionCertificates); err != nil {
 				return err
 			}
 
@@ -80,17 +112,11 @@ func (cs *ClientState) verifyHeader(
 		return errorsmod.Wrapf(ErrInvalidTimestamp, "%s received: %v, expected: %v", "invalid timestamp layout", header.TransactionSnapshotCertificate.Metadata.SealedAt, time.RFC3339Nano)
 	}
 
-	msdVerifier := &MithrilCertificateVerifier{
-		CertificateRetriever: &MSDCertificateRetriever{
-			ClientStore: clientStore,
-		},
-	}
-
 	// new epoch
 	if firstCertInEpoch == nilCertificate {
 		msdCertificate, parseMSDCertificateError := FromCertificateProto(header.MithrilStakeDistributionCertificate)
 		if parseMSDCertificateError != nil {
-			return errorsmod.Wrapf(ErrInvalidTimestamp, "%s received: %v", "invalid MithrilStakeDistributionCertificate", header.MithrilStakeDistributionCertificate)
+			return errorsmod.Wrapf(parseMSDCertificateError, "invalid MithrilStakeDistributionCertificate received: %v", header.MithrilStakeDistributionCertificate)
 		}
 
 		protocolMultiSignature, err := FromCertificateSignatureProto(header.MithrilStakeDistributionCertificate.SignedEntityType, header.MithrilStakeDistributionCertificate.MultiSignature, "")
@@ -113,7 +139,7 @@ func (cs *ClientState) verifyHeader(
 
 	tsCertificate, parseTsCertificateError := FromCertificateProto(header.TransactionSnapshotCertificate)
 	if parseTsCertificateError != nil {
-		return errorsmod.Wrapf(ErrInvalidTimestamp, "%s received: %v : error: %v", "invalid TransactionSnapshotCertificate", header.TransactionSnapshotCertificate, parseTsCertificateError)
+		return errorsmod.Wrapf(parseTsCertificateError, "invalid TransactionSnapshotCertificate received: %v", header.TransactionSnapshotCertificate)
 	}
 
 	tsMultiSignature, err := FromCertificateSignatureProto(header.TransactionSnapshotCertificate.SignedEntityType, header.TransactionSnapshotCertificate.MultiSignature, "")
@@ -131,39 +157,52 @@ func (cs *ClientState) verifyHeader(
 		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate is invalid: error: %v", verifyTsStandardCertificateError)
 	}
 
+	if err := msdVerifier.batch.verify(); err != nil {
+		return err
+	}
+
 	// compare data TransactionSnapshot and TransactionSnapshotCertificate
 	if header.TransactionSnapshot.CertificateHash != header.TransactionSnapshotCertificate.Hash {
 		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate hash not match: TS.CertHash: %v, TSC.Hash: %v", header.TransactionSnapshot.CertificateHash, header.TransactionSnapshotCertificate.Hash)
 	}
 
+	tsEpoch, tsBlockNumber, merkleRootKey, err := transactionSnapshotEntity(header.TransactionSnapshotCertificate.SignedEntityType)
+	if err != nil {
+		return err
+	}
+
 	tsCertificateProtocolMessage := tsCertificate.ProtocolMessage
-	cardanoTxMerkleRoot, merkleRootExist := tsCertificateProtocolMessage.GetMessagePart("cardano_transactions_merkle_root")
+	cardanoTxMerkleRoot, merkleRootExist := tsCertificateProtocolMessage.GetMessagePart(merkleRootKey)
 
 	if !merkleRootExist || cardanoTxMerkleRoot == "" {
 		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate merkle root not exist")
 	}
 
 	if strings.Compare(header.TransactionSnapshot.MerkleRoot, string(cardanoTxMerkleRoot)) != 0 {
-		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate merkle root not match: TS.MerkleRoot: %v, TSC.cardano_transactions_merkle_root: %v", header.TransactionSnapshot.MerkleRoot, string(cardanoTxMerkleRoot))
+		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate merkle root not match: TS.MerkleRoot: %v, TSC.%s: %v", header.TransactionSnapshot.MerkleRoot, merkleRootKey, string(cardanoTxMerkleRoot))
 	}
 
-	tscCardanoTransactions := header.TransactionSnapshotCertificate.SignedEntityType.GetCardanoTransactions()
-
-	if tscCardanoTransactions == nil {
-		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate CardanoTransactions not found")
-	}
-
-	if header.TransactionSnapshot.Epoch != tscCardanoTransactions.Epoch {
-		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate epoch not match: TS.Epoch: %v, TSC.Epoch: %v", header.TransactionSnapshot.Epoch, tscCardanoTransactions.Epoch)
+	if header.TransactionSnapshot.Epoch != tsEpoch {
+		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate epoch not match: TS.Epoch: %v, TSC.Epoch: %v", header.TransactionSnapshot.Epoch, tsEpoch)
 	}
 
-	if header.TransactionSnapshot.BlockNumber != tscCardanoTransactions.BlockNumber {
-		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate BlockNumber not match: TS.BlockNumber: %v, TSC.BlockNumber: %v", header.TransactionSnapshot.BlockNumber, tscCardanoTransactions.BlockNumber)
+	if header.TransactionSnapshot.BlockNumber != tsBlockNumber {
+		return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate BlockNumber not match: TS.BlockNumber: %v, TSC.BlockNumber: %v", header.TransactionSnapshot.BlockNumber, tsBlockNumber)
 	}
 
-	// not allow old one
-	if header.TransactionSnapshot.Epoch < cs.CurrentEpoch || header.TransactionSnapshot.BlockNumber < cs.LatestHeight.RevisionHeight {
-		return errorsmod.Wrapf(ErrInvalidCertificate, "Expect newer header: TS.Epoch: %v, cs.Epoch: %v, TS.BlockNumber: %v, cs.LatestHeight.RevisionHeight: %v", header.TransactionSnapshot.Epoch, cs.CurrentEpoch, header.TransactionSnapshot.BlockNumber, cs.LatestHeight.RevisionHeight)
+	// The signed entity type is not covered by the multi-signature, so its epoch
+	// and block number must match the signed protocol message.
+	for _, part := range []struct {
+		key   entities.ProtocolMessagePartKey
+		value uint64
+	}{
+		{entities.CurrentEpoch, tsEpoch},
+		{entities.LatestBlockNumber, tsBlockNumber},
+	} {
+		signedValue, ok := tsCertificateProtocolMessage.GetMessagePart(part.key)
+		if !ok || string(signedValue) != strconv.FormatUint(part.value, 10) {
+			return errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate %s not match: signed: %q, TSC: %v", part.key, string(signedValue), part.value)
+		}
 	}
 
 	// Verify that this header carries enough evidence to authenticate the
@@ -184,6 +223,20 @@ func (cs *ClientState) verifyHeader(
 	return nil
 }
 
+// transactionSnapshotEntity returns the epoch and block number certified by a
+// transaction snapshot certificate, and the protocol message part holding the
+// merkle root of the certified transactions. A CardanoBlocksTransactions
+// snapshot also certifies the blocks holding the transactions.
+func transactionSnapshotEntity(signedEntityType *SignedEntityType) (uint64, uint64, entities.ProtocolMessagePartKey, error) {
+	if entity := signedEntityType.GetCardanoTransactions(); entity != nil {
+		return entity.Epoch, entity.BlockNumber, entities.CardanoTransactionsMerkleRoot, nil
+	}
+	if entity := signedEntityType.GetCardanoBlocksTransactions(); entity != nil {
+		return entity.Epoch, entity.BlockNumber, entities.CardanoBlocksTransactionsMerkleRoot, nil
+	}
+	return 0, 0, "", errorsmod.Wrapf(ErrInvalidCertificate, "mithril transaction snapshot certificate CardanoTransactions not found")
+}
+
 // backfillPreviousStakeDistributionCertificates verifies and stores missing Mithril stake distribution
 // certificates provided in a MithrilHeader update.
 //
@@ -192,6 +245,7 @@ func (cs *ClientState) verifyHeader(
 // store yet. The Gateway includes prior certificates so the chain can be verified on-chain.
 func backfillPreviousStakeDistributionCertificates(
 	clientStore storetypes.KVStore,
+	msdVerifier *MithrilCertificateVerifier,
 	certificates []*MithrilCertificate,
 ) error {
 	if len(certificates) == 0 {
@@ -212,12 +266,6 @@ func backfillPreviousStakeDistributionCertificates(
 	})
 
 	nilCertificate := MithrilCertificate{}
-	msdVerifier := &MithrilCertificateVerifier{
-		CertificateRetriever: &MSDCertificateRetriever{
-			ClientStore: clientStore,
-		},
-	}
-
 	for _, cert := range ordered {
 		// If we already stored the first certificate for this epoch, nothing to do.
 		if getFcInEpoch(clientStore, cert.Epoch) != nilCertificate {
@@ -275,47 +323,20 @@ func (cs *ClientState) verifyHostStateCommitmentEvidence(header *MithrilHeader)
 	//
 	// This proves that `HostStateTxHash` is part of the Mithril-certified set of transactions for
 	// `header.TransactionSnapshot.BlockNumber`, and ties that proof to the snapshot certificate.
-	var proofs CardanoTransactionsProofsMessage
-	if err := json.Unmarshal(header.HostStateTxProof, &proofs); err != nil {
-		return errorsmod.Wrapf(ErrInvalidCardanoTransactionsProofs, "malformed host state tx proof: %v", err)
-	}
-
-	verified, err := proofs.Verify()
-	if err != nil {
-		return err
-	}
-
-	if strings.ToLower(verified.MerkleRoot) != strings.ToLower(header.TransactionSnapshot.MerkleRoot) {
-		return errorsmod.Wrapf(
-			ErrInvalidCardanoTransactionsProofs,
-			"transaction proof merkle root mismatch: expected %s, got %s",
-			header.TransactionSnapshot.MerkleRoot,
-			verified.MerkleRoot,
-		)
-	}
-
-	// The proof must be tied to the same snapshot certificate as the header.
-	if proofs.CertificateHash != "" && proofs.CertificateHash != header.TransactionSnapshotCertificate.Hash {
-		return errorsmod.Wrapf(
-			ErrInvalidCardanoTransactionsProofs,
-			"transaction proof certificate hash mismatch: expected %s, got %s",
-			header.TransactionSnapshotCertificate.Hash,
-			proofs.CertificateHash,
-		)
-	}
-
-	found := false
-	for _, txHash := range verified.CertifiedTransactions {
-		if strings.ToLower(txHash) == strings.ToLower(header.HostStateTxHash) {
-			found = true
-			break
+	//
+	// For a CardanoBlocksTransactions snapshot the proof also binds the transaction to the block
+	// holding it.
+	if header.certifiesBlocks() {
+		if _, err := header.hostStateBlock(); err != nil {
+			return err
 		}
-	}
-	if !found {
-		return errorsmod.Wrapf(
-			ErrInvalidCardanoTransactionsProofs,
-			"host state tx hash not certified by transaction proof",
-		)
+	} else if err := verifyCertifiedTransaction(
+		header.HostStateTxProof,
+		header.TransactionSnapshot.MerkleRoot,
+		header.TransactionSnapshotCertificate.Hash,
+		header.HostStateTxHash,
+	); err != nil {
+		return err
 	}
 
 	// Step 2: Use the certified transaction body to derive the authenticated `ibc_state_root`.
@@ -349,17 +370,18 @@ func (cs *ClientState) UpdateState(
 	}
 
 	cs.pruneOldestConsensusState(ctx, cdc, clientStore)
+	cs.pruneCertificates(cdc, clientStore)
 
 	// Retrieve the previous consensus state
 	prevConsensusState, _ := GetConsensusState(clientStore, cdc, cs.LatestHeight)
 
 	// Check for duplicate update
 	if prevConsensusState.LatestCertHashTxSnapshot == header.TransactionSnapshotCertificate.Hash {
-		return []exported.Height{header.GetHeight()} // No-op on duplicate update
+		return []exported.Height{cs.headerHeight(header)} // No-op on duplicate update
 	}
 
 	// Update the latest height and current epoch
-	height := NewHeight(0, header.TransactionSnapshot.BlockNumber)
+	height := cs.headerHeight(header)
 	cs.LatestHeight = &height
 	cs.CurrentEpoch = header.TransactionSnapshot.Epoch
 
@@ -369,7 +391,7 @@ func (cs *ClientState) UpdateState(
 	// which (a) verifies Mithril inclusion and (b) verifies we can extract `ibc_state_root` from
 	// the HostState output datum. So this extraction should only fail if the header is internally
 	// inconsistent (programming error) rather than because it is untrusted user input.
-	ibcStateRoot, err := cs.ExtractIbcStateRootFromHostStateTx(header)
+	hostStateDatum, err := cs.ExtractHostStateDatumFromHostStateTx(header)
 	if err != nil {
 		panic(fmt.Errorf("failed to extract ibc_state_root from verified MithrilHeader: %w", err))
 	}
@@ -378,7 +400,22 @@ func (cs *ClientState) UpdateState(
 		Timestamp:                header.GetTimestamp(),
 		FirstCertHashLatestEpoch: header.MithrilStakeDistributionCertificate,
 		LatestCertHashTxSnapshot: header.TransactionSnapshotCertificate.Hash,
-		IbcStateRoot:             ibcStateRoot,
+		IbcStateRoot:             hostStateDatum.State.IbcStateRoot,
+		TransactionsMerkleRoot:   header.TransactionSnapshot.MerkleRoot,
+		HostStateShutdown:        hostStateShutdownFromDatum(hostStateDatum.Shutdown),
+		HostStateVersion:         hostStateDatum.State.Version,
+	}
+	if newConsensusState.HostStateShutdown != nil {
+		cs.recordHostStateShutdown(height)
+	}
+	if header.certifiesBlocks() {
+		block, err := header.hostStateBlock()
+		if err != nil {
+			panic(fmt.Errorf("failed to prove the HostState block of verified MithrilHeader: %w", err))
+		}
+		newConsensusState.TransactionsMerkleRoot = ""
+		newConsensusState.BlocksTransactionsMerkleRoot = header.TransactionSnapshot.MerkleRoot
+		newConsensusState.HostStateBlockHash = block.BlockHash
 	}
 
 	// Set the latest certificate of transaction snapshot for the epoch
@@ -387,8 +424,8 @@ func (cs *ClientState) UpdateState(
 
 	// Update the client state, consensus state, and associated metadata in the store
 	setClientState(clientStore, cdc, cs)
-	setConsensusState(clientStore, cdc, newConsensusState, header.GetHeight())
-	setConsensusMetadata(ctx, clientStore, header.GetHeight())
+	setConsensusState(clientStore, cdc, newConsensusState, height)
+	setConsensusMetadata(ctx, clientStore, height)
 
 	return []exported.Height{height}
 }
@@ -426,6 +463,32 @@ func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.Binar
 	}
 }
 
+// pruneCertificates deletes the certificates stored for epochs before the epoch
+// preceding the oldest retained consensus state. New headers only chain from
+// the first certificate of their own epoch or of the epoch before it, so
+// later certificates are kept.
+func (cs ClientState) pruneCertificates(cdc codec.BinaryCodec, clientStore storetypes.KVStore) {
+	var oldestEpoch uint64
+	found := false
+	IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		consState, ok := GetConsensusState(clientStore, cdc, height)
+		if ok && consState.FirstCertHashLatestEpoch != nil {
+			oldestEpoch = consState.FirstCertHashLatestEpoch.Epoch
+			found = true
+		}
+		return true
+	})
+	if !found {
+		return
+	}
+
+	retainedEpoch := min(oldestEpoch, cs.CurrentEpoch)
+	if retainedEpoch == 0 {
+		return
+	}
+	pruneCertificatesBeforeEpoch(clientStore, retainedEpoch-1, maxPrunedCertificateEpochsPerUpdate)
+}
+
 // UpdateStateOnMisbehaviour performs appropriate state changes on the client given that misbehaviour has been detected and verified.
 // This method freezes the ClientState and should only be called after misbehaviour is confirmed.
 func (cs ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, _ exported.ClientMessage) {
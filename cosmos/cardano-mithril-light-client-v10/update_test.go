@@ -0,0 +1,88 @@
+package mithril
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneCertificatesKeepsEpochBeforeOldestConsensusState(t *testing.T) {
+	cdc := newTestCodec()
+	_, clientStore := newTestClientStore(t, "prune-certificates")
+	clientState := newTestClientState(200, 10, "cardano-test", 24*time.Hour)
+
+	for epoch := uint64(2); epoch <= 10; epoch++ {
+		seed := byte(epoch * 2)
+		setFcInEpoch(clientStore, MithrilCertificate{Hash: testHashHex(seed), Epoch: epoch}, epoch)
+		setLcTsInEpoch(clientStore, MithrilCertificate{Hash: testHashHex(seed + 1), Epoch: epoch}, epoch)
+	}
+	// The oldest retained consensus state is in epoch 6.
+	for epoch := uint64(6); epoch <= 10; epoch++ {
+		consensusState := newTestConsensusState(byte(epoch * 2))
+		consensusState.FirstCertHashLatestEpoch.Epoch = epoch
+		height := NewHeight(0, epoch*10)
+		setConsensusState(clientStore, cdc, consensusState, height)
+		SetIterationKey(clientStore, height)
+	}
+
+	clientState.pruneCertificates(cdc, clientStore)
+
+	for epoch := uint64(2); epoch <= 10; epoch++ {
+		seed := byte(epoch * 2)
+		fc := getFcInEpoch(clientStore, epoch)
+		lcTs := getLcTsInEpoch(clientStore, epoch)
+		msd := getMSDCertificateWithHash(clientStore, testHashHex(seed))
+		if epoch < 5 {
+			require.Empty(t, fc.Hash, "epoch %d", epoch)
+			require.Empty(t, lcTs.Hash, "epoch %d", epoch)
+			require.Empty(t, msd.Hash, "epoch %d", epoch)
+			continue
+		}
+		require.Equal(t, testHashHex(seed), fc.Hash, "epoch %d", epoch)
+		require.Equal(t, testHashHex(seed+1), lcTs.Hash, "epoch %d", epoch)
+		require.Equal(t, testHashHex(seed), msd.Hash, "epoch %d", epoch)
+	}
+}
+
+func TestPruneCertificatesIsBoundedPerUpdate(t *testing.T) {
+	cdc := newTestCodec()
+	_, clientStore := newTestClientStore(t, "prune-certificates")
+	clientState := newTestClientState(200, 100, "cardano-test", 24*time.Hour)
+
+	for epoch := uint64(2); epoch <= 100; epoch++ {
+		setFcInEpoch(clientStore, MithrilCertificate{Hash: testHashHex(byte(epoch)), Epoch: epoch}, epoch)
+	}
+	consensusState := newTestConsensusState(0x01)
+	consensusState.FirstCertHashLatestEpoch.Epoch = 100
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 200))
+	SetIterationKey(clientStore, NewHeight(0, 200))
+
+	remaining := func() int {
+		return len(certificateEpochsBefore(clientStore, KeyFirstCertificateInEpochPrefix, 99, 1000))
+	}
+	require.Equal(t, 97, remaining())
+
+	clientState.pruneCertificates(cdc, clientStore)
+	require.Equal(t, 97-maxPrunedCertificateEpochsPerUpdate, remaining())
+
+	for remaining() > 0 {
+		clientState.pruneCertificates(cdc, clientStore)
+	}
+	require.Equal(t, testHashHex(99), getFcInEpoch(clientStore, 99).Hash)
+	require.Equal(t, testHashHex(100), getFcInEpoch(clientStore, 100).Hash)
+}
+
+func TestCertificateEpochsBeforeIteratesInEpochOrder(t *testing.T) {
+	_, clientStore := newTestClientStore(t, "certificate-epochs")
+	for _, epoch := range []uint64{100, 9, 1000, 10} {
+		setFcInEpoch(clientStore, MithrilCertificate{Hash: testHashHex(byte(epoch)), Epoch: epoch}, epoch)
+	}
+
+	// Epochs come back oldest first and stop below the given epoch, even where
+	// their decimal forms would sort differently.
+	require.Equal(t, []uint64{9, 10, 100}, certificateEpochsBefore(clientStore, KeyFirstCertificateInEpochPrefix, 1000, 1000))
+	require.Equal(t, []uint64{9, 10}, certificateEpochsBefore(clientStore, KeyFirstCertificateInEpochPrefix, 100, 1000))
+	require.Equal(t, []uint64{9}, certificateEpochsBefore(clientStore, KeyFirstCertificateInEpochPrefix, 1000, 1))
+	require.Empty(t, certificateEpochsBefore(clientStore, KeyLatestCertificateTsInEpochPrefix, 1000, 1000))
+}
@@ -1,6 +1,8 @@
 package mithril
 
 import (
+	"fmt"
+
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,12 +12,101 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-// VerifyUpgradeAndUpdateState returns an error because Mithril client upgrades
-// are not supported yet.
+const (
+	KeyUpgradedClient    = "upgradedClient"
+	KeyUpgradedConsState = "upgradedConsState"
+)
+
+// VerifyUpgradeAndUpdateState verifies that the upgraded client and consensus
+// state are committed under the upgrade path, keyed by the scheduled upgrade
+// height, in the latest authenticated HostState root, then swaps in the
+// chain-chosen parameters of the committed client. The Cardano side cannot
+// certify the Mithril certificates the client will next be anchored at, so the
+// client keeps its height, epoch, consensus states and verified certificate
+// anchors; the committed consensus state is proven but not adopted. The
+// trusting period is kept from the current client.
 func (cs ClientState) VerifyUpgradeAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
 	upgradedClient exported.ClientState, upgradedConsState exported.ConsensusState,
 	proofUpgradeClient, proofUpgradeConsState []byte,
 ) error {
-	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade mithril client")
+	if len(cs.UpgradePath) == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade client, no upgrade path set")
+	}
+
+	upgradedClientState, ok := upgradedClient.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "upgraded client must be mithril client. expected: %T got: %T", &ClientState{}, upgradedClient)
+	}
+	upgradedConsensusState, ok := upgradedConsState.(*ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "upgraded consensus state must be mithril consensus state. expected %T, got: %T", &ConsensusState{}, upgradedConsState)
+	}
+	if cs.LatestHeight == nil || cs.LatestHeight.IsZero() {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "client latest height must be present")
+	}
+	if upgradedClientState.LatestHeight == nil || !upgradedClientState.LatestHeight.GT(cs.LatestHeight) {
+		return errorsmod.Wrapf(
+			clienttypes.ErrInvalidUpgradeClient,
+			"upgraded client height %s must be greater than current client height %s",
+			upgradedClientState.GetLatestHeight(),
+			cs.LatestHeight,
+		)
+	}
+	if err := upgradedConsensusState.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, err.Error())
+	}
+
+	// Proofs must be checked against the latest consensus state so the upgrade
+	// is the one currently scheduled by the Cardano HostState.
+	lastHeight := cs.LatestHeight
+	consState, found := GetConsensusState(clientStore, cdc, lastHeight)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "could not retrieve consensus state for lastHeight")
+	}
+
+	upgradeHeight := upgradedClientState.LatestHeight
+	bz, err := cdc.MarshalInterface(upgradedClientState.ZeroCustomFields())
+	if err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "could not marshal client state: %v", err)
+	}
+	upgradeClientKey := upgradeClientStateKey(cs.UpgradePath, upgradeHeight)
+	if err := VerifyIbcStateMembership(consState.IbcStateRoot, upgradeClientKey, bz, proofUpgradeClient); err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidUpgradeClient, "client state proof failed. Key: %s: %v", upgradeClientKey, err)
+	}
+
+	bz, err = cdc.MarshalInterface(upgradedConsensusState)
+	if err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "could not marshal consensus state: %v", err)
+	}
+	upgradeConsStateKey := upgradeConsensusStateKey(cs.UpgradePath, upgradeHeight)
+	if err := VerifyIbcStateMembership(consState.IbcStateRoot, upgradeConsStateKey, bz, proofUpgradeConsState); err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidUpgradeClient, "consensus state proof failed. Key: %s: %v", upgradeConsStateKey, err)
+	}
+
+	newClientState := cs
+	newClientState.ChainId = upgradedClientState.ChainId
+	newClientState.ProtocolParameters = upgradedClientState.ProtocolParameters
+	newClientState.UpgradePath = append([]string(nil), upgradedClientState.UpgradePath...)
+	newClientState.HostStateNftPolicyId = append([]byte(nil), upgradedClientState.HostStateNftPolicyId...)
+	newClientState.HostStateNftTokenName = append([]byte(nil), upgradedClientState.HostStateNftTokenName...)
+	newClientState.GenesisVerificationKey = append([]byte(nil), upgradedClientState.GenesisVerificationKey...)
+	newClientState.ConsensusStateKeyFormat = upgradedClientState.ConsensusStateKeyFormat
+	if err := newClientState.Validate(); err != nil {
+		return errorsmod.Wrap(err, "updated client state failed basic validation")
+	}
+
+	setClientState(clientStore, cdc, &newClientState)
+	return nil
+}
+
+// upgradeClientStateKey returns the Cardano IBC state key for the upgraded
+// client committed for upgradeHeight. Cardano commits a flat key space, so
+// only the final upgrade path element is used, mirroring ibcStateKeyFromPath.
+func upgradeClientStateKey(upgradePath []string, upgradeHeight exported.Height) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s", upgradePath[len(upgradePath)-1], upgradeHeight.GetRevisionHeight(), KeyUpgradedClient))
+}
+
+func upgradeConsensusStateKey(upgradePath []string, upgradeHeight exported.Height) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s", upgradePath[len(upgradePath)-1], upgradeHeight.GetRevisionHeight(), KeyUpgradedConsState))
 }
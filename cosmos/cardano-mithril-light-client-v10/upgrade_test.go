@@ -0,0 +1,230 @@
+package mithril
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyUpgradeAndUpdateStateRotatesChainParameters(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "mithril-upgrade")
+	cs, upgradedClient, upgradedConsensus := newTestUpgrade()
+	clientProof, consensusProof := initializeTestUpgrade(t, cs, upgradedClient, upgradedConsensus, clientStore)
+
+	// The client is anchored at a verified stake distribution certificate and
+	// transaction snapshot before the upgrade.
+	anchor := *newTestConsensusState(0x10).FirstCertHashLatestEpoch
+	anchor.Epoch = cs.CurrentEpoch
+	setFcInEpoch(clientStore, anchor, cs.CurrentEpoch)
+	setLcTsInEpoch(clientStore, MithrilCertificate{Hash: testHashHex(0x11)}, cs.CurrentEpoch)
+	acceptedConsensus, found := GetConsensusState(clientStore, cdc, cs.LatestHeight)
+	require.True(t, found)
+
+	err := cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, upgradedClient, upgradedConsensus, clientProof, consensusProof)
+	require.NoError(t, err)
+
+	stored, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+	require.Equal(t, "cardano-test-upgraded", stored.ChainId)
+	require.Equal(t, upgradedClient.ProtocolParameters, stored.ProtocolParameters)
+	require.Equal(t, upgradedClient.HostStateNftPolicyId, stored.HostStateNftPolicyId)
+	require.Equal(t, cs.TrustingPeriod, stored.TrustingPeriod)
+	require.True(t, stored.FrozenHeight.IsZero())
+
+	// The verified height, epoch and certificate anchors are kept, and the
+	// unverified certificates of the committed consensus state are not adopted.
+	require.Equal(t, *cs.LatestHeight, *stored.LatestHeight)
+	require.Equal(t, cs.CurrentEpoch, stored.CurrentEpoch)
+	require.Equal(t, anchor.Hash, getFcInEpoch(clientStore, cs.CurrentEpoch).Hash)
+	require.Equal(t, testHashHex(0x11), getLcTsInEpoch(clientStore, cs.CurrentEpoch).Hash)
+	require.Equal(t, MithrilCertificate{}, getFcInEpoch(clientStore, upgradedClient.CurrentEpoch))
+	require.Equal(t, MithrilCertificate{}, getMSDCertificateWithHash(clientStore, upgradedConsensus.FirstCertHashLatestEpoch.Hash))
+	storedConsensus, found := GetConsensusState(clientStore, cdc, cs.LatestHeight)
+	require.True(t, found)
+	require.Equal(t, acceptedConsensus, storedConsensus)
+	_, found = GetConsensusState(clientStore, cdc, upgradedClient.LatestHeight)
+	require.False(t, found)
+}
+
+func TestVerifyUpgradeAndUpdateStateRejectsUncommittedClient(t *testing.T) {
+	cdc := newTestCodec()
+	ctx, clientStore := newTestClientStore(t, "mithril-upgrade-uncommitted")
+	cs, upgradedClient, upgradedConsensus := newTestUpgrade()
+	clientProof, consensusProof := initializeTestUpgrade(t, cs, upgradedClient, upgradedConsensus, clientStore)
+
+	tampered := *upgradedClient
+	tampered.ProtocolParameters = &MithrilProtocolParameters{K: 9, M: 9, PhiF: Fraction{Numerator: 1, Denominator: 2}}
+	err := cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, &tampered, upgradedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "client state proof failed")
+
+	tamperedConsensus := *upgradedConsensus
+	tamperedConsensus.LatestCertHashTxSnapshot = testHashHex(0x77)
+	err = cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, upgradedClient, &tamperedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "consensus state proof failed")
+
+	stale := *upgradedClient
+	staleHeight := NewHeight(0, 10)
+	stale.LatestHeight = &staleHeight
+	err = cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, &stale, upgradedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "must be greater than current client height")
+
+	noPath := *cs
+	noPath.UpgradePath = nil
+	err = noPath.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, upgradedClient, upgradedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "no upgrade path set")
+
+	stored, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+	require.Equal(t, cs.ChainId, stored.ChainId)
+}
+
+func newTestUpgrade() (*ClientState, *ClientState, *ConsensusState) {
+	cs := newTestClientState(10, 10, "cardano-test", 24*time.Hour)
+
+	upgradedClient := newTestClientState(20, 12, "cardano-test-upgraded", 0)
+	upgradedHeight := NewHeight(1, 20)
+	upgradedClient.LatestHeight = &upgradedHeight
+	upgradedClient.ProtocolParameters = &MithrilProtocolParameters{
+		K: 2422,
+		M: 20973,
+		PhiF: Fraction{
+			Numerator:   1,
+			Denominator: 5,
+		},
+	}
+	upgradedClient.HostStateNftPolicyId = bytes.Repeat([]byte{0x02}, 28)
+
+	upgradedConsensus := newTestConsensusState(0x40)
+	upgradedConsensus.FirstCertHashLatestEpoch.Epoch = 12
+	return cs, upgradedClient, upgradedConsensus
+}
+
+// initializeTestUpgrade commits the upgraded client and consensus state in
+// the ibc_state_root of the client's latest consensus state and returns their
+// proofs.
+func initializeTestUpgrade(t *testing.T, cs, upgradedClient *ClientState, upgradedConsensus *ConsensusState, clientStore storetypes.KVStore) ([]byte, []byte) {
+	t.Helper()
+
+	cdc := newTestCodec()
+	clientValue, err := cdc.MarshalInterface(upgradedClient.ZeroCustomFields())
+	require.NoError(t, err)
+	consensusValue, err := cdc.MarshalInterface(upgradedConsensus)
+	require.NoError(t, err)
+	clientKey := upgradeClientStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+	consensusKey := upgradeConsensusStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+	require.Equal(t, "upgradedIBCState/20/upgradedClient", string(clientKey))
+
+	root, paths := buildTestIbcStateTree(map[string][]byte{
+		string(clientKey):    clientValue,
+		string(consensusKey): consensusValue,
+	})
+	consensusState := newTestConsensusState(0x10)
+	consensusState.IbcStateRoot = root
+	setClientState(clientStore, cdc, cs)
+	setConsensusState(clientStore, cdc, consensusState, cs.LatestHeight)
+
+	return mustTestExistenceProof(t, clientKey, clientValue, paths[string(clientKey)]),
+		mustTestExistenceProof(t, consensusKey, consensusValue, paths[string(consensusKey)])
+}
+
+// buildTestIbcStateTree builds the fixed-depth commitment tree used by the
+// Cardano HostState and returns its root with the 64-step path for each key.
+func buildTestIbcStateTree(entries map[string][]byte) ([]byte, map[string][]*ics23.InnerOp) {
+	leaves := make([]testIbcStateLeaf, 0, len(entries))
+	for key, value := range entries {
+		keyHash := sha256.Sum256([]byte(key))
+		leaves = append(leaves, testIbcStateLeaf{
+			index: binary.BigEndian.Uint64(keyHash[0:8]),
+			hash:  testIbcStateLeafHash([]byte(key), value),
+		})
+	}
+
+	paths := make(map[string][]*ics23.InnerOp, len(entries))
+	for key := range entries {
+		keyHash := sha256.Sum256([]byte(key))
+		index := binary.BigEndian.Uint64(keyHash[0:8])
+		path := make([]*ics23.InnerOp, 0, 64)
+		for depth := 0; depth < 64; depth++ {
+			siblings := make([]testIbcStateLeaf, 0)
+			for _, leaf := range leaves {
+				if leaf.index>>uint(depth+1) == index>>uint(depth+1) && (leaf.index>>uint(depth))&1 != (index>>uint(depth))&1 {
+					siblings = append(siblings, leaf)
+				}
+			}
+			sibling := testIbcStateSubtreeHash(depth, siblings)
+			if (index>>uint(depth))&1 == 0 {
+				path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{0x01}, Suffix: sibling})
+			} else {
+				path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{0x01}, sibling...), Suffix: []byte{}})
+			}
+		}
+		paths[key] = path
+	}
+	return testIbcStateSubtreeHash(64, leaves), paths
+}
+
+type testIbcStateLeaf struct {
+	index uint64
+	hash  []byte
+}
+
+func testIbcStateSubtreeHash(level int, leaves []testIbcStateLeaf) []byte {
+	if len(leaves) == 0 {
+		return make([]byte, 32)
+	}
+	if level == 0 {
+		return leaves[0].hash
+	}
+	var left, right []testIbcStateLeaf
+	for _, leaf := range leaves {
+		if (leaf.index>>uint(level-1))&1 == 0 {
+			left = append(left, leaf)
+		} else {
+			right = append(right, leaf)
+		}
+	}
+	leftHash := testIbcStateSubtreeHash(level-1, left)
+	rightHash := testIbcStateSubtreeHash(level-1, right)
+	empty := make([]byte, 32)
+	if bytes.Equal(leftHash, empty) && bytes.Equal(rightHash, empty) {
+		return empty
+	}
+	h := sha256.Sum256(append(append([]byte{0x01}, leftHash...), rightHash...))
+	return h[:]
+}
+
+func testIbcStateLeafHash(key, value []byte) []byte {
+	if len(value) == 0 {
+		return make([]byte, 32)
+	}
+	keyHash := sha256.Sum256(key)
+	valueHash := sha256.Sum256(value)
+	h := sha256.Sum256(append(append([]byte{0x00}, keyHash[:]...), valueHash[:]...))
+	return h[:]
+}
+
+func mustTestExistenceProof(t *testing.T, key, value []byte, path []*ics23.InnerOp) []byte {
+	t.Helper()
+
+	proof := commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{
+			{
+				Proof: &ics23.CommitmentProof_Exist{
+					Exist: &ics23.ExistenceProof{Key: key, Value: value, Path: path},
+				},
+			},
+		},
+	}
+	bz, err := proof.Marshal()
+	require.NoError(t, err)
+	return bz
+}
@@ -2,6 +2,8 @@ package probabilisticcore
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -64,6 +66,19 @@ func DecodeLedgerBlock(blockCbor []byte) (ledger.Block, error) {
 	return nil, fmt.Errorf("%w; raw block fallback failed: %v", err, fallbackErr)
 }
 
+// DecodeLedgerBlockHeader decodes a bare Babbage or Conway block header, which
+// share the same header layout.
+func DecodeLedgerBlockHeader(headerCbor []byte) (ledger.BlockHeader, error) {
+	header, err := ledger.NewBabbageBlockHeaderFromCbor(headerCbor)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := header.Signature.([]byte); !ok {
+		return nil, fmt.Errorf("block header signature missing")
+	}
+	return header, nil
+}
+
 func wrapRawBodyFields(decodedBlock ledger.Block, bodyFields rawBlockBodyFields) ledger.Block {
 	switch block := decodedBlock.(type) {
 	case *ledger.BabbageBlock:
@@ -81,19 +96,12 @@ func wrapRawBodyFields(decodedBlock ledger.Block, bodyFields rawBlockBodyFields)
 	}
 }
 
-func BlockPrevHash(decodedBlock ledger.Block) (string, error) {
-	switch block := decodedBlock.(type) {
-	case *ledger.BabbageBlock:
-		return block.Header.Body.PrevHash.String(), nil
-	case *ledger.ConwayBlock:
-		return block.Header.Body.PrevHash.String(), nil
-	case *rawBabbageBlock:
-		return block.Header.Body.PrevHash.String(), nil
-	case *rawConwayBlock:
-		return block.Header.Body.PrevHash.String(), nil
-	default:
-		return "", fmt.Errorf("unsupported block era %T", decodedBlock)
+func BlockPrevHash(decodedHeader ledger.BlockHeader) (string, error) {
+	header, err := nativeBabbageHeader(decodedHeader)
+	if err != nil {
+		return "", err
 	}
+	return header.Body.PrevHash.String(), nil
 }
 
 func BuildBlockVerificationArtifacts(decodedBlock ledger.Block) (string, string, []byte, error) {
@@ -138,10 +146,31 @@ func BuildBlockVerificationArtifacts(decodedBlock ledger.Block) (string, string,
 }
 
 func VerifyNativeBlock(decodedBlock ledger.Block, epochNonce []byte, slotsPerKesPeriod int) (bool, []byte, error) {
+	isHeaderValid, vrfKeyBytes, err := VerifyNativeBlockHeader(decodedBlock, epochNonce, slotsPerKesPeriod)
+	if err != nil {
+		return false, nil, err
+	}
+
 	header, err := nativeBabbageHeader(decodedBlock)
 	if err != nil {
 		return false, nil, err
 	}
+	isBodyValid, err := verifyNativeBlockBody(decodedBlock, header.Body.BlockBodyHash.String())
+	if err != nil {
+		return false, nil, err
+	}
+
+	return isHeaderValid && isBodyValid, vrfKeyBytes, nil
+}
+
+// VerifyNativeBlockHeader checks the KES signature and VRF proof of a Babbage
+// or Conway header. It does not check the block body hash, so it is only
+// sufficient for blocks whose transactions are never read.
+func VerifyNativeBlockHeader(decodedHeader ledger.BlockHeader, epochNonce []byte, slotsPerKesPeriod int) (bool, []byte, error) {
+	header, err := nativeBabbageHeader(decodedHeader)
+	if err != nil {
+		return false, nil, err
+	}
 
 	isKesValid, err := ledger.VerifyKes(header, uint64(slotsPerKesPeriod))
 	if err != nil {
@@ -169,16 +198,66 @@ func VerifyNativeBlock(decodedBlock ledger.Block, epochNonce []byte, slotsPerKes
 	}
 	isVrfValid := bytes.Equal(output, vrfOutputBytes)
 
-	isBodyValid, err := verifyNativeBlockBody(decodedBlock, header.Body.BlockBodyHash.String())
+	return isKesValid && isVrfValid, vrfKeyBytes, nil
+}
+
+// OperationalCertificate is the operational certificate carried by a Babbage
+// or Conway block header.
+type OperationalCertificate struct {
+	HotVkey        []byte
+	SequenceNumber uint64
+	KesPeriod      uint64
+	Signature      []byte
+}
+
+// Equal reports whether two operational certificates are identical.
+func (c OperationalCertificate) Equal(other OperationalCertificate) bool {
+	return bytes.Equal(c.HotVkey, other.HotVkey) &&
+		c.SequenceNumber == other.SequenceNumber &&
+		c.KesPeriod == other.KesPeriod &&
+		bytes.Equal(c.Signature, other.Signature)
+}
+
+// BlockOperationalCertificate returns the operational certificate of a header.
+func BlockOperationalCertificate(decodedHeader ledger.BlockHeader) (OperationalCertificate, error) {
+	header, err := nativeBabbageHeader(decodedHeader)
 	if err != nil {
-		return false, nil, err
-	}
+		return OperationalCertificate{}, err
+	}
+	opCert := header.Body.OpCert
+	return OperationalCertificate{
+		HotVkey:        append([]byte(nil), opCert.HotVkey...),
+		SequenceNumber: uint64(opCert.SequenceNumber),
+		KesPeriod:      uint64(opCert.KesPeriod),
+		Signature:      append([]byte(nil), opCert.Signature...),
+	}, nil
+}
 
-	return isKesValid && isVrfValid && isBodyValid, vrfKeyBytes, nil
+// VerifyOperationalCertificate checks that the header's operational
+// certificate is signed by the header's issuer cold key. The signed payload is
+// the hot key followed by the big-endian counter and KES period.
+func VerifyOperationalCertificate(decodedHeader ledger.BlockHeader) (bool, error) {
+	opCert, err := BlockOperationalCertificate(decodedHeader)
+	if err != nil {
+		return false, err
+	}
+	if len(opCert.Signature) != ed25519.SignatureSize {
+		return false, fmt.Errorf("operational certificate signature must be %d bytes, got %d", ed25519.SignatureSize, len(opCert.Signature))
+	}
+	issuerVkey := decodedHeader.IssuerVkey()
+	signable := make([]byte, 0, len(opCert.HotVkey)+16)
+	signable = append(signable, opCert.HotVkey...)
+	signable = binary.BigEndian.AppendUint64(signable, opCert.SequenceNumber)
+	signable = binary.BigEndian.AppendUint64(signable, opCert.KesPeriod)
+	return ed25519.Verify(ed25519.PublicKey(issuerVkey[:]), signable, opCert.Signature), nil
 }
 
-func nativeBabbageHeader(decodedBlock ledger.Block) (*ledger.BabbageBlockHeader, error) {
-	switch block := decodedBlock.(type) {
+func nativeBabbageHeader(decoded ledger.BlockHeader) (*ledger.BabbageBlockHeader, error) {
+	switch block := decoded.(type) {
+	case *ledger.BabbageBlockHeader:
+		return block, nil
+	case *ledger.ConwayBlockHeader:
+		return &block.BabbageBlockHeader, nil
 	case *ledger.BabbageBlock:
 		return block.Header, nil
 	case *ledger.ConwayBlock:
@@ -188,7 +267,7 @@ func nativeBabbageHeader(decodedBlock ledger.Block) (*ledger.BabbageBlockHeader,
 	case *rawConwayBlock:
 		return &block.Header.BabbageBlockHeader, nil
 	default:
-		return nil, fmt.Errorf("unsupported block era %T", decodedBlock)
+		return nil, fmt.Errorf("unsupported block era %T", decoded)
 	}
 }
 
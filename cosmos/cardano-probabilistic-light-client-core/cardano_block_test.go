@@ -1,10 +1,13 @@
 package probabilisticcore
 
 import (
+	"bytes"
+	"crypto/ed25519"
 	"encoding/hex"
 	"testing"
 
 	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
 	"golang.org/x/crypto/blake2b"
 )
 
@@ -84,6 +87,92 @@ func TestDecodeBabbageWitnessSetsPreservesRawRedeemerMap(t *testing.T) {
 	}
 }
 
+func TestDecodeLedgerBlockHeaderMatchesFullBlock(t *testing.T) {
+	block := ledger.BabbageBlock{Header: &ledger.BabbageBlockHeader{}}
+	block.Header.Body.BlockNumber = 42
+	block.Header.Body.Slot = 1_234
+	block.Header.Body.PrevHash = ledger.NewBlake2b256(bytes.Repeat([]byte{0x07}, 32))
+	block.Header.Signature = []byte{0x01}
+
+	blockCbor, err := cbor.Encode(block)
+	if err != nil {
+		t.Fatalf("encode block: %v", err)
+	}
+	decodedBlock, err := DecodeLedgerBlock(blockCbor)
+	if err != nil {
+		t.Fatalf("DecodeLedgerBlock: %v", err)
+	}
+	header, err := nativeBabbageHeader(decodedBlock)
+	if err != nil {
+		t.Fatalf("nativeBabbageHeader: %v", err)
+	}
+
+	decodedHeader, err := DecodeLedgerBlockHeader(header.Cbor())
+	if err != nil {
+		t.Fatalf("DecodeLedgerBlockHeader: %v", err)
+	}
+	if got, want := decodedHeader.Hash(), decodedBlock.Hash(); got != want {
+		t.Fatalf("header hash mismatch: got %s want %s", got, want)
+	}
+	if decodedHeader.BlockNumber() != 42 || decodedHeader.SlotNumber() != 1_234 {
+		t.Fatalf("header fields mismatch: height %d slot %d", decodedHeader.BlockNumber(), decodedHeader.SlotNumber())
+	}
+	prevHash, err := BlockPrevHash(decodedHeader)
+	if err != nil {
+		t.Fatalf("BlockPrevHash: %v", err)
+	}
+	if want := hex.EncodeToString(bytes.Repeat([]byte{0x07}, 32)); prevHash != want {
+		t.Fatalf("prev hash mismatch: got %s want %s", prevHash, want)
+	}
+
+	if _, err := DecodeLedgerBlockHeader(blockCbor); err == nil {
+		t.Fatal("expected a full block to be rejected as a header")
+	}
+}
+
+func TestVerifyOperationalCertificate(t *testing.T) {
+	coldPublic, coldPrivate, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate cold key: %v", err)
+	}
+	header := &ledger.BabbageBlockHeader{}
+	copy(header.Body.IssuerVkey[:], coldPublic)
+	header.Body.OpCert.HotVkey = bytes.Repeat([]byte{0x05}, 32)
+	header.Body.OpCert.SequenceNumber = 3
+	header.Body.OpCert.KesPeriod = 400
+
+	signable := append(append([]byte(nil), header.Body.OpCert.HotVkey...), 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0x01, 0x90)
+	header.Body.OpCert.Signature = ed25519.Sign(coldPrivate, signable)
+
+	isValid, err := VerifyOperationalCertificate(header)
+	if err != nil {
+		t.Fatalf("VerifyOperationalCertificate: %v", err)
+	}
+	if !isValid {
+		t.Fatal("expected operational certificate to verify")
+	}
+
+	opCert, err := BlockOperationalCertificate(header)
+	if err != nil {
+		t.Fatalf("BlockOperationalCertificate: %v", err)
+	}
+	if opCert.SequenceNumber != 3 || opCert.KesPeriod != 400 {
+		t.Fatalf("operational certificate mismatch: %+v", opCert)
+	}
+
+	header.Body.OpCert.SequenceNumber = 4
+	isValid, err = VerifyOperationalCertificate(header)
+	if err != nil {
+		t.Fatalf("VerifyOperationalCertificate: %v", err)
+	}
+	if isValid {
+		t.Fatal("expected a changed counter to invalidate the certificate signature")
+	}
+	if reissued, _ := BlockOperationalCertificate(header); reissued.Equal(opCert) {
+		t.Fatal("expected certificates with different counters to differ")
+	}
+}
+
 func rawBodyHash(fields rawBlockBodyFields) [32]byte {
 	transactionBodiesHash := blake2b.Sum256(fields.transactionBodies)
 	transactionWitnessSetsHash := blake2b.Sum256(fields.transactionWitnessSets)
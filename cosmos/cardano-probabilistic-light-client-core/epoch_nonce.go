@@ -0,0 +1,68 @@
+package probabilisticcore
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"golang.org/x/crypto/blake2b"
+)
+
+// NonceLength is the byte length of a non-neutral Praos nonce. An empty nonce
+// is the neutral nonce.
+const NonceLength = 32
+
+// CombineNonces implements the Praos nonce combination a ⭒ b. The neutral
+// nonce is the identity on either side.
+func CombineNonces(a, b []byte) []byte {
+	switch {
+	case len(a) == 0:
+		return append([]byte(nil), b...)
+	case len(b) == 0:
+		return append([]byte(nil), a...)
+	}
+	combined := blake2b.Sum256(append(append([]byte(nil), a...), b...))
+	return combined[:]
+}
+
+// VrfNonceValue returns the nonce contribution of a certified block VRF
+// output, blake2b256(blake2b256("N" || output)).
+func VrfNonceValue(vrfOutput []byte) []byte {
+	tagged := blake2b.Sum256(append([]byte("N"), vrfOutput...))
+	value := blake2b.Sum256(tagged[:])
+	return value[:]
+}
+
+// PrevHashNonce converts a block's previous-hash into the lab nonce the next
+// epoch tick consumes. The genesis previous-hash is the neutral nonce.
+func PrevHashNonce(prevHashHex string) ([]byte, error) {
+	if prevHashHex == "" {
+		return nil, nil
+	}
+	prevHash, err := hex.DecodeString(prevHashHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid previous block hash: %w", err)
+	}
+	if len(prevHash) != NonceLength {
+		return nil, fmt.Errorf("previous block hash must be %d bytes, got %d", NonceLength, len(prevHash))
+	}
+	return prevHash, nil
+}
+
+// BlockVrfOutput returns the certified VRF output carried by a Babbage or
+// Conway block header.
+func BlockVrfOutput(decodedHeader ledger.BlockHeader) ([]byte, error) {
+	header, err := nativeBabbageHeader(decodedHeader)
+	if err != nil {
+		return nil, err
+	}
+	vrfResult, ok := header.Body.VrfResult.([]interface{})
+	if !ok || len(vrfResult) < 2 {
+		return nil, fmt.Errorf("invalid VRF result shape")
+	}
+	vrfOutputBytes, ok := vrfResult[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid VRF output shape")
+	}
+	return append([]byte(nil), vrfOutputBytes...), nil
+}
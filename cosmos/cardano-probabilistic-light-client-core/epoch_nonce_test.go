@@ -0,0 +1,52 @@
+package probabilisticcore
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestCombineNoncesTreatsEmptyAsNeutral(t *testing.T) {
+	a := bytes.Repeat([]byte{0x01}, NonceLength)
+	b := bytes.Repeat([]byte{0x02}, NonceLength)
+
+	if got := CombineNonces(nil, b); !bytes.Equal(got, b) {
+		t.Fatalf("neutral ⭒ b = %x, want %x", got, b)
+	}
+	if got := CombineNonces(a, nil); !bytes.Equal(got, a) {
+		t.Fatalf("a ⭒ neutral = %x, want %x", got, a)
+	}
+	if got := CombineNonces(nil, nil); len(got) != 0 {
+		t.Fatalf("neutral ⭒ neutral = %x, want neutral", got)
+	}
+
+	want := blake2b.Sum256(append(append([]byte(nil), a...), b...))
+	if got := CombineNonces(a, b); !bytes.Equal(got, want[:]) {
+		t.Fatalf("a ⭒ b = %x, want %x", got, want)
+	}
+}
+
+func TestVrfNonceValueHashesTaggedOutputTwice(t *testing.T) {
+	output := bytes.Repeat([]byte{0xab}, 64)
+	tagged := blake2b.Sum256(append([]byte("N"), output...))
+	want := blake2b.Sum256(tagged[:])
+	if got := VrfNonceValue(output); !bytes.Equal(got, want[:]) {
+		t.Fatalf("VrfNonceValue = %x, want %x", got, want)
+	}
+}
+
+func TestPrevHashNonce(t *testing.T) {
+	nonce, err := PrevHashNonce("")
+	if err != nil || len(nonce) != 0 {
+		t.Fatalf("genesis previous hash should be neutral, got %x err %v", nonce, err)
+	}
+	if _, err := PrevHashNonce("abcd"); err == nil {
+		t.Fatalf("expected short previous hash to be rejected")
+	}
+	hash := bytes.Repeat([]byte{0x0f}, NonceLength)
+	nonce, err = PrevHashNonce("0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f")
+	if err != nil || !bytes.Equal(nonce, hash) {
+		t.Fatalf("PrevHashNonce = %x err %v, want %x", nonce, err, hash)
+	}
+}
@@ -6,7 +6,9 @@ require (
 	github.com/blinklabs-io/gouroboros v0.89.1
 	github.com/cosmos/ics23/go v0.11.0
 	github.com/fxamacker/cbor/v2 v2.7.0
+	github.com/supranational/blst v0.3.16-0.20250831170142-f48500c1fdbe
 	golang.org/x/crypto v0.52.0
+	google.golang.org/protobuf v1.33.0
 )
 
 require (
@@ -17,5 +19,4 @@ require (
 	github.com/utxorpc/go-codegen v0.5.1 // indirect
 	github.com/x448/float16 v0.8.4 // indirect
 	golang.org/x/sys v0.45.0 // indirect
-	google.golang.org/protobuf v1.33.0 // indirect
 )
@@ -21,7 +21,61 @@ type HostStateDatum struct {
 	State     HostState `cbor:"0"`
 	NftPolicy []byte    `cbor:"1"`
 	Deployer  []byte    `cbor:"2"`
-	Shutdown  cbor.RawMessage
+	Shutdown  ShutdownState
+}
+
+const (
+	plutusConstr0Tag = 121
+	plutusConstr1Tag = 122
+)
+
+// ShutdownState is the HostState lifecycle mode: Active (constructor 0) or
+// ShuttingDown (constructor 1) with initiated_at and grace_period_end in
+// POSIX milliseconds. Once a HostState is shutting down it never returns to
+// Active.
+type ShutdownState struct {
+	ShuttingDown         bool
+	InitiatedAtMillis    uint64
+	GracePeriodEndMillis uint64
+}
+
+func (s ShutdownState) MarshalCBOR() ([]byte, error) {
+	if !s.ShuttingDown {
+		return cbor.Marshal(cbor.Tag{Number: plutusConstr0Tag, Content: []any{}})
+	}
+	return cbor.Marshal(cbor.Tag{
+		Number:  plutusConstr1Tag,
+		Content: []uint64{s.InitiatedAtMillis, s.GracePeriodEndMillis},
+	})
+}
+
+func (s *ShutdownState) UnmarshalCBOR(data []byte) error {
+	var tagged cbor.RawTag
+	if err := cbor.Unmarshal(data, &tagged); err != nil {
+		return fmt.Errorf("invalid HostState shutdown state: %w", err)
+	}
+
+	switch tagged.Number {
+	case plutusConstr0Tag:
+		var fields []cbor.RawMessage
+		if err := cbor.Unmarshal(tagged.Content, &fields); err != nil || len(fields) != 0 {
+			return fmt.Errorf("invalid Active HostState shutdown state")
+		}
+		*s = ShutdownState{}
+	case plutusConstr1Tag:
+		var fields []uint64
+		if err := cbor.Unmarshal(tagged.Content, &fields); err != nil || len(fields) != 2 {
+			return fmt.Errorf("invalid ShuttingDown HostState shutdown state")
+		}
+		*s = ShutdownState{
+			ShuttingDown:         true,
+			InitiatedAtMillis:    fields[0],
+			GracePeriodEndMillis: fields[1],
+		}
+	default:
+		return fmt.Errorf("unknown HostState shutdown constructor tag %d", tagged.Number)
+	}
+	return nil
 }
 
 // HostState is the canonical IBC host state committed on Cardano.
@@ -38,7 +92,33 @@ type HostState struct {
 	LastUpdateTimeMillis uint64
 }
 
-func ExtractIbcStateRootFromHostStateDatum(datumCbor []byte, expectedNftPolicyId []byte) ([]byte, error) {
+// HostStateOutpoint identifies a transaction output by the hash of the
+// transaction that created it and its output index.
+type HostStateOutpoint struct {
+	TxHash      string
+	OutputIndex uint32
+}
+
+// HostStateOutput is a HostState UTxO decoded from the transaction body that
+// created it, together with the outpoints that transaction spends.
+type HostStateOutput struct {
+	Outpoint HostStateOutpoint
+	Datum    HostStateDatum
+	Spends   []HostStateOutpoint
+}
+
+// SpendsOutpoint reports whether the transaction that created the output
+// spends outpoint.
+func (o *HostStateOutput) SpendsOutpoint(outpoint HostStateOutpoint) bool {
+	for _, spent := range o.Spends {
+		if spent.OutputIndex == outpoint.OutputIndex && strings.EqualFold(spent.TxHash, outpoint.TxHash) {
+			return true
+		}
+	}
+	return false
+}
+
+func DecodeHostStateDatum(datumCbor []byte, expectedNftPolicyId []byte) (*HostStateDatum, error) {
 	var datum HostStateDatum
 	if err := cbor.Unmarshal(datumCbor, &datum); err != nil {
 		return nil, fmt.Errorf("failed to decode HostState datum: %w", err)
@@ -52,6 +132,14 @@ func ExtractIbcStateRootFromHostStateDatum(datumCbor []byte, expectedNftPolicyId
 		return nil, fmt.Errorf("invalid ibc_state_root length: %d", len(datum.State.IbcStateRoot))
 	}
 
+	return &datum, nil
+}
+
+func ExtractIbcStateRootFromHostStateDatum(datumCbor []byte, expectedNftPolicyId []byte) ([]byte, error) {
+	datum, err := DecodeHostStateDatum(datumCbor, expectedNftPolicyId)
+	if err != nil {
+		return nil, err
+	}
 	return datum.State.IbcStateRoot, nil
 }
 
@@ -62,6 +150,28 @@ func ExtractIbcStateRootFromTransactionBody(
 	hostStateNftPolicyId []byte,
 	hostStateNftTokenName []byte,
 ) ([]byte, error) {
+	output, err := ExtractHostStateOutputFromTransactionBody(
+		txBodyCbor,
+		txHash,
+		outputIndex,
+		hostStateNftPolicyId,
+		hostStateNftTokenName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return output.Datum.State.IbcStateRoot, nil
+}
+
+// ExtractHostStateOutputFromTransactionBody decodes the HostState output at
+// outputIndex of the transaction whose body hashes to txHash.
+func ExtractHostStateOutputFromTransactionBody(
+	txBodyCbor []byte,
+	txHash string,
+	outputIndex uint32,
+	hostStateNftPolicyId []byte,
+	hostStateNftTokenName []byte,
+) (*HostStateOutput, error) {
 	if txHash == "" {
 		return nil, fmt.Errorf("missing HostState transaction hash in header")
 	}
@@ -85,21 +195,79 @@ func ExtractIbcStateRootFromTransactionBody(
 
 	out := outputs[idx]
 	if len(hostStateNftPolicyId) > 0 && len(hostStateNftTokenName) > 0 {
-		assets := out.Assets()
-		if assets == nil {
-			return nil, fmt.Errorf("HostState output has no multi-assets")
-		}
-		policy := ledger.NewBlake2b224(hostStateNftPolicyId)
-		if assets.Asset(policy, hostStateNftTokenName) != 1 {
+		if !outputHoldsHostStateNft(out, hostStateNftPolicyId, hostStateNftTokenName) {
 			return nil, fmt.Errorf("HostState output does not contain the expected HostState NFT")
 		}
 	}
+	return decodeHostStateOutput(txBody, outputIndex, hostStateNftPolicyId)
+}
 
-	datum := out.Datum()
+// FindHostStateOutputInTransactionBody decodes the single output of a
+// transaction body that holds the HostState NFT.
+func FindHostStateOutputInTransactionBody(
+	txBodyCbor []byte,
+	hostStateNftPolicyId []byte,
+	hostStateNftTokenName []byte,
+) (*HostStateOutput, error) {
+	if len(hostStateNftPolicyId) == 0 || len(hostStateNftTokenName) == 0 {
+		return nil, fmt.Errorf("HostState NFT policy id and token name are required")
+	}
+	txBody, err := DecodeTransactionBody(txBodyCbor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode HostState tx body: %w", err)
+	}
+
+	found := -1
+	for i, out := range txBody.Outputs() {
+		if !outputHoldsHostStateNft(out, hostStateNftPolicyId, hostStateNftTokenName) {
+			continue
+		}
+		if found >= 0 {
+			return nil, fmt.Errorf("transaction %s has more than one HostState output", txBody.Hash())
+		}
+		found = i
+	}
+	if found < 0 {
+		return nil, fmt.Errorf("transaction %s has no HostState output", txBody.Hash())
+	}
+	return decodeHostStateOutput(txBody, uint32(found), hostStateNftPolicyId)
+}
+
+func outputHoldsHostStateNft(out ledger.TransactionOutput, hostStateNftPolicyId []byte, hostStateNftTokenName []byte) bool {
+	assets := out.Assets()
+	if assets == nil {
+		return false
+	}
+	policy := ledger.NewBlake2b224(hostStateNftPolicyId)
+	return assets.Asset(policy, hostStateNftTokenName) == 1
+}
+
+func decodeHostStateOutput(txBody ledger.TransactionBody, outputIndex uint32, hostStateNftPolicyId []byte) (*HostStateOutput, error) {
+	datum := txBody.Outputs()[outputIndex].Datum()
 	if datum == nil {
 		return nil, fmt.Errorf("HostState output has no inline datum")
 	}
-	return ExtractIbcStateRootFromHostStateDatum(datum.Cbor(), hostStateNftPolicyId)
+	decoded, err := DecodeHostStateDatum(datum.Cbor(), hostStateNftPolicyId)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := txBody.Inputs()
+	spends := make([]HostStateOutpoint, 0, len(inputs))
+	for _, input := range inputs {
+		spends = append(spends, HostStateOutpoint{
+			TxHash:      input.Id().String(),
+			OutputIndex: input.Index(),
+		})
+	}
+	return &HostStateOutput{
+		Outpoint: HostStateOutpoint{
+			TxHash:      strings.ToLower(txBody.Hash()),
+			OutputIndex: outputIndex,
+		},
+		Datum:  *decoded,
+		Spends: spends,
+	}, nil
 }
 
 func DecodeTransactionBody(data []byte) (ledger.TransactionBody, error) {
@@ -2,6 +2,8 @@ package probabilisticcore
 
 import (
 	"bytes"
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/fxamacker/cbor/v2"
@@ -24,7 +26,7 @@ func TestExtractIbcStateRootFromFourFieldHostStateDatum(t *testing.T) {
 		},
 		NftPolicy: nftPolicy,
 		Deployer:  deployer,
-		Shutdown:  cbor.RawMessage{0x80},
+		Shutdown:  ShutdownState{},
 	}
 
 	encoded, err := cbor.Marshal(datum)
@@ -54,7 +56,7 @@ func TestExtractIbcStateRootRejectsUnexpectedNftPolicy(t *testing.T) {
 		},
 		NftPolicy: nftPolicy,
 		Deployer:  bytes.Repeat([]byte{0x17}, 28),
-		Shutdown:  cbor.RawMessage{0x80},
+		Shutdown:  ShutdownState{},
 	})
 	if err != nil {
 		t.Fatalf("marshal datum: %v", err)
@@ -65,3 +67,140 @@ func TestExtractIbcStateRootRejectsUnexpectedNftPolicy(t *testing.T) {
 		t.Fatal("expected unexpected nft_policy error")
 	}
 }
+
+func testHostStateTxBody(t *testing.T, spends []HostStateOutpoint, datum HostStateDatum, nftPolicy, nftTokenName []byte) []byte {
+	t.Helper()
+
+	datumCbor, err := cbor.Marshal(datum)
+	if err != nil {
+		t.Fatalf("marshal datum: %v", err)
+	}
+	inputs := make([]any, 0, len(spends))
+	for _, spent := range spends {
+		txID, err := hex.DecodeString(spent.TxHash)
+		if err != nil {
+			t.Fatalf("decode spent tx hash: %v", err)
+		}
+		inputs = append(inputs, []any{txID, spent.OutputIndex})
+	}
+	address := append([]byte{0x61}, bytes.Repeat([]byte{0x33}, 28)...)
+	changeOutput := map[uint64]any{
+		0: address,
+		1: uint64(5_000_000),
+	}
+	hostStateOutput := map[uint64]any{
+		0: address,
+		1: []any{uint64(2_000_000), map[cbor.ByteString]map[cbor.ByteString]uint64{cbor.ByteString(nftPolicy): {cbor.ByteString(nftTokenName): 1}}},
+		2: []any{uint64(1), cbor.Tag{Number: 24, Content: datumCbor}},
+	}
+	body, err := cbor.Marshal(map[uint64]any{
+		0: inputs,
+		1: []any{changeOutput, hostStateOutput},
+		2: uint64(200_000),
+	})
+	if err != nil {
+		t.Fatalf("marshal tx body: %v", err)
+	}
+	return body
+}
+
+func testHostStateDatum(version uint64, nftPolicy []byte) HostStateDatum {
+	return HostStateDatum{
+		State: HostState{
+			Version:              version,
+			IbcStateRoot:         bytes.Repeat([]byte{byte(version)}, 32),
+			NextClientSequence:   2,
+			NextConnectionSeq:    3,
+			NextChannelSeq:       4,
+			LastUpdateTimeMillis: 7,
+		},
+		NftPolicy: nftPolicy,
+		Deployer:  bytes.Repeat([]byte{0x17}, 28),
+		Shutdown:  ShutdownState{},
+	}
+}
+
+func TestExtractHostStateOutputFromTransactionBody(t *testing.T) {
+	nftPolicy := bytes.Repeat([]byte{0x24}, 28)
+	nftTokenName := []byte("ibc_host_state")
+	previous := HostStateOutpoint{TxHash: strings.Repeat("ab", 32), OutputIndex: 1}
+	body := testHostStateTxBody(t, []HostStateOutpoint{previous}, testHostStateDatum(9, nftPolicy), nftPolicy, nftTokenName)
+
+	txBody, err := DecodeTransactionBody(body)
+	if err != nil {
+		t.Fatalf("decode tx body: %v", err)
+	}
+	output, err := ExtractHostStateOutputFromTransactionBody(body, txBody.Hash(), 1, nftPolicy, nftTokenName)
+	if err != nil {
+		t.Fatalf("extract HostState output: %v", err)
+	}
+	if output.Outpoint.TxHash != txBody.Hash() || output.Outpoint.OutputIndex != 1 {
+		t.Fatalf("unexpected outpoint: %+v", output.Outpoint)
+	}
+	if output.Datum.State.Version != 9 || output.Datum.State.NextChannelSeq != 4 {
+		t.Fatalf("unexpected HostState: %+v", output.Datum.State)
+	}
+	if !output.SpendsOutpoint(previous) {
+		t.Fatalf("expected output to spend %+v, got %+v", previous, output.Spends)
+	}
+	if output.SpendsOutpoint(HostStateOutpoint{TxHash: previous.TxHash, OutputIndex: 0}) {
+		t.Fatalf("output must not spend an unrelated outpoint")
+	}
+
+	found, err := FindHostStateOutputInTransactionBody(body, nftPolicy, nftTokenName)
+	if err != nil {
+		t.Fatalf("find HostState output: %v", err)
+	}
+	if found.Outpoint != output.Outpoint {
+		t.Fatalf("found outpoint %+v, want %+v", found.Outpoint, output.Outpoint)
+	}
+
+	if _, err := ExtractHostStateOutputFromTransactionBody(body, txBody.Hash(), 0, nftPolicy, nftTokenName); err == nil {
+		t.Fatalf("expected an output without the HostState NFT to be rejected")
+	}
+	if _, err := FindHostStateOutputInTransactionBody(body, nftPolicy, []byte("other")); err == nil {
+		t.Fatalf("expected a body without the HostState NFT to be rejected")
+	}
+}
+
+func TestDecodeHostStateDatumShutdownState(t *testing.T) {
+	nftPolicy := bytes.Repeat([]byte{0x24}, 28)
+
+	datum := testHostStateDatum(3, nftPolicy)
+	encoded, err := cbor.Marshal(datum)
+	if err != nil {
+		t.Fatalf("marshal datum: %v", err)
+	}
+	decoded, err := DecodeHostStateDatum(encoded, nftPolicy)
+	if err != nil {
+		t.Fatalf("decode active datum: %v", err)
+	}
+	if decoded.Shutdown.ShuttingDown {
+		t.Fatalf("expected an Active HostState")
+	}
+
+	datum.Shutdown = ShutdownState{ShuttingDown: true, InitiatedAtMillis: 1_000, GracePeriodEndMillis: 2_000}
+	encoded, err = cbor.Marshal(datum)
+	if err != nil {
+		t.Fatalf("marshal datum: %v", err)
+	}
+	decoded, err = DecodeHostStateDatum(encoded, nftPolicy)
+	if err != nil {
+		t.Fatalf("decode shutting down datum: %v", err)
+	}
+	if decoded.Shutdown != datum.Shutdown {
+		t.Fatalf("unexpected shutdown state: got %+v want %+v", decoded.Shutdown, datum.Shutdown)
+	}
+
+	var shutdown ShutdownState
+	if err := cbor.Unmarshal([]byte{0x80}, &shutdown); err == nil {
+		t.Fatalf("expected an untagged shutdown state to be rejected")
+	}
+	unknown, err := cbor.Marshal(cbor.Tag{Number: 123, Content: []any{}})
+	if err != nil {
+		t.Fatalf("marshal unknown constructor: %v", err)
+	}
+	if err := cbor.Unmarshal(unknown, &shutdown); err == nil {
+		t.Fatalf("expected an unknown shutdown constructor to be rejected")
+	}
+}
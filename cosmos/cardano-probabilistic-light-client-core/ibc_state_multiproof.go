@@ -0,0 +1,321 @@
+package probabilisticcore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// IbcStateTreeDepth is the fixed depth of the Cardano IBC state commitment
+// tree: every key sits on a 64-step path selected by its leaf index.
+const IbcStateTreeDepth = 64
+
+// IbcStateMembershipItem is one key/value pair of a batch membership check.
+type IbcStateMembershipItem struct {
+	Key   []byte
+	Value []byte
+}
+
+// IbcStateMultiProof proves several keys of the IBC state commitment tree
+// against one ibc_state_root. A sibling hash shared by several key paths, or
+// derivable from another proven key, is carried once, and empty subtrees are
+// carried as empty entries.
+type IbcStateMultiProof struct {
+	// Leaves are ordered by strictly increasing leaf index. An empty value
+	// stands for the expected value of the key.
+	Leaves []IbcStateMultiProofLeaf
+	// Siblings are the subtree hashes the leaves do not determine, level by
+	// level from the leaves up and by ascending node position within a level.
+	Siblings [][]byte
+}
+
+type IbcStateMultiProofLeaf struct {
+	Key   []byte
+	Value []byte
+}
+
+type multiProofNode struct {
+	position uint64
+	hash     []byte
+	// leaf is the index of a proof leaf below the node.
+	leaf int
+}
+
+// VerifyIbcStateBatchMembershipWithMultiProof verifies every item of a
+// non-empty batch against root. The proof must prove exactly the keys of
+// items. Committed values that differ from the expected ones are compared by
+// verifyCommittedValueMatchesExpected, as in
+// VerifyIbcStateMembershipWithExistenceProof.
+func VerifyIbcStateBatchMembershipWithMultiProof(
+	root []byte,
+	items []IbcStateMembershipItem,
+	proof *IbcStateMultiProof,
+	verifyCommittedValueMatchesExpected func(key []byte, expectedValue []byte, committedValue []byte) error,
+) error {
+	if len(items) == 0 {
+		return fmt.Errorf("batch membership requires at least one key")
+	}
+	if proof == nil {
+		return fmt.Errorf("expected multi-proof")
+	}
+	if len(proof.Leaves) != len(items) {
+		return fmt.Errorf("multi-proof proves %d keys, expected %d", len(proof.Leaves), len(items))
+	}
+
+	expected := make(map[string][]byte, len(items))
+	for _, item := range items {
+		if _, found := expected[string(item.Key)]; found {
+			return fmt.Errorf("duplicate key %q in batch", item.Key)
+		}
+		expected[string(item.Key)] = item.Value
+	}
+
+	leaves := make([]IbcStateMultiProofLeaf, 0, len(proof.Leaves))
+	for _, leaf := range proof.Leaves {
+		value, found := expected[string(leaf.Key)]
+		if !found {
+			return fmt.Errorf("multi-proof proves key %q outside the batch", leaf.Key)
+		}
+		delete(expected, string(leaf.Key))
+
+		committedValue := value
+		if len(leaf.Value) > 0 {
+			committedValue = leaf.Value
+			if !bytes.Equal(leaf.Value, value) {
+				if verifyCommittedValueMatchesExpected == nil {
+					return fmt.Errorf("multi-proof value mismatch for key %q", leaf.Key)
+				}
+				if err := verifyCommittedValueMatchesExpected(leaf.Key, value, leaf.Value); err != nil {
+					return err
+				}
+			}
+		}
+		// An empty value hashes to the empty leaf, which would prove absence.
+		if len(committedValue) == 0 {
+			return fmt.Errorf("multi-proof value for key %q must not be empty", leaf.Key)
+		}
+		leaves = append(leaves, IbcStateMultiProofLeaf{Key: leaf.Key, Value: committedValue})
+	}
+
+	computed, err := ComputeRootFromMultiProof(&IbcStateMultiProof{Leaves: leaves, Siblings: proof.Siblings})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(computed, root) {
+		return fmt.Errorf("proof does not match ibc_state_root")
+	}
+	return nil
+}
+
+// ComputeRootFromMultiProof folds the leaves of proof up to the root of the
+// IBC state commitment tree. Every sibling hash must be consumed.
+func ComputeRootFromMultiProof(proof *IbcStateMultiProof) ([]byte, error) {
+	if proof == nil {
+		return nil, fmt.Errorf("expected multi-proof")
+	}
+	nodes, err := multiProofLeafNodes(proof.Leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings := proof.Siblings
+	root, err := foldMultiProof(nodes, func(multiProofNode, int) ([]byte, error) {
+		if len(siblings) == 0 {
+			return nil, fmt.Errorf("multi-proof is missing sibling hashes")
+		}
+		sibling := siblings[0]
+		siblings = siblings[1:]
+		switch len(sibling) {
+		case 0:
+			return emptyHash, nil
+		case sha256.Size:
+			return sibling, nil
+		default:
+			return nil, fmt.Errorf("invalid multi-proof sibling hash length: %d", len(sibling))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(siblings) != 0 {
+		return nil, fmt.Errorf("multi-proof has %d unused sibling hashes", len(siblings))
+	}
+	return root, nil
+}
+
+// NewIbcStateMultiProof combines existence proofs of distinct keys under one
+// root into a multi-proof, keeping each sibling hash once. It does not verify
+// the proofs; a multi-proof built from proofs under different roots fails
+// verification.
+func NewIbcStateMultiProof(proofs []*ics23.ExistenceProof) (*IbcStateMultiProof, error) {
+	if len(proofs) == 0 {
+		return nil, fmt.Errorf("multi-proof requires at least one existence proof")
+	}
+	sorted := make([]*ics23.ExistenceProof, len(proofs))
+	copy(sorted, proofs)
+	for i, proof := range sorted {
+		if proof == nil {
+			return nil, fmt.Errorf("existence proof %d is nil", i)
+		}
+		if len(proof.Path) != IbcStateTreeDepth {
+			return nil, fmt.Errorf("unexpected proof path length: %d", len(proof.Path))
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return ibcStateLeafIndex(sorted[i].Key) < ibcStateLeafIndex(sorted[j].Key)
+	})
+
+	multiProof := &IbcStateMultiProof{Leaves: make([]IbcStateMultiProofLeaf, 0, len(sorted))}
+	for _, proof := range sorted {
+		multiProof.Leaves = append(multiProof.Leaves, IbcStateMultiProofLeaf{
+			Key:   bytes.Clone(proof.Key),
+			Value: bytes.Clone(proof.Value),
+		})
+	}
+	nodes, err := multiProofLeafNodes(multiProof.Leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = foldMultiProof(nodes, func(node multiProofNode, depth int) ([]byte, error) {
+		direction := node.position & 1
+		left, right, err := childOrderingFromInnerOp(direction, sorted[node.leaf].Path[depth])
+		if err != nil {
+			return nil, err
+		}
+		sibling := left
+		if direction == 0 {
+			sibling = right
+		}
+		if bytes.Equal(sibling, emptyHash) {
+			multiProof.Siblings = append(multiProof.Siblings, []byte{})
+		} else {
+			multiProof.Siblings = append(multiProof.Siblings, bytes.Clone(sibling))
+		}
+		return sibling, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return multiProof, nil
+}
+
+func multiProofLeafNodes(leaves []IbcStateMultiProofLeaf) ([]multiProofNode, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("multi-proof must prove at least one key")
+	}
+	nodes := make([]multiProofNode, 0, len(leaves))
+	for i, leaf := range leaves {
+		index := ibcStateLeafIndex(leaf.Key)
+		if i > 0 && index <= nodes[i-1].position {
+			return nil, fmt.Errorf("multi-proof leaves must be ordered by strictly increasing leaf index")
+		}
+		nodes = append(nodes, multiProofNode{position: index, hash: leafHash(leaf.Key, leaf.Value), leaf: i})
+	}
+	return nodes, nil
+}
+
+// foldMultiProof hashes nodes, ordered by position, level by level up to the
+// root. sibling supplies the sibling hash of a node at depth whenever the
+// sibling is not itself one of the nodes.
+func foldMultiProof(nodes []multiProofNode, sibling func(node multiProofNode, depth int) ([]byte, error)) ([]byte, error) {
+	for depth := 0; depth < IbcStateTreeDepth; depth++ {
+		parents := make([]multiProofNode, 0, len(nodes))
+		for i := 0; i < len(nodes); i++ {
+			node := nodes[i]
+			var left, right []byte
+			if node.position&1 == 0 && i+1 < len(nodes) && nodes[i+1].position == node.position|1 {
+				left, right = node.hash, nodes[i+1].hash
+				i++
+			} else {
+				hash, err := sibling(node, depth)
+				if err != nil {
+					return nil, err
+				}
+				if node.position&1 == 0 {
+					left, right = node.hash, hash
+				} else {
+					left, right = hash, node.hash
+				}
+			}
+			parents = append(parents, multiProofNode{
+				position: node.position >> 1,
+				hash:     innerHash(left, right),
+				leaf:     node.leaf,
+			})
+		}
+		nodes = parents
+	}
+	return nodes[0].hash, nil
+}
+
+func ibcStateLeafIndex(key []byte) uint64 {
+	keyHash := sha256.Sum256(key)
+	return binary.BigEndian.Uint64(keyHash[0:8])
+}
+
+// DecodeJSONIbcStateMultiProof decodes the JSON encoding of a multi-proof,
+// whose byte fields are hex strings.
+func DecodeJSONIbcStateMultiProof(proofBytes []byte) (*IbcStateMultiProof, error) {
+	var jp jsonIbcStateMultiProof
+	if err := json.Unmarshal(proofBytes, &jp); err != nil {
+		return nil, fmt.Errorf("unable to decode multi-proof bytes")
+	}
+	proof := &IbcStateMultiProof{
+		Leaves:   make([]IbcStateMultiProofLeaf, 0, len(jp.Leaves)),
+		Siblings: make([][]byte, 0, len(jp.Siblings)),
+	}
+	for _, leaf := range jp.Leaves {
+		key, err := hex.DecodeString(leaf.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := hex.DecodeString(leaf.Value)
+		if err != nil {
+			return nil, err
+		}
+		proof.Leaves = append(proof.Leaves, IbcStateMultiProofLeaf{Key: key, Value: value})
+	}
+	for _, sibling := range jp.Siblings {
+		hash, err := hex.DecodeString(sibling)
+		if err != nil {
+			return nil, err
+		}
+		proof.Siblings = append(proof.Siblings, hash)
+	}
+	return proof, nil
+}
+
+// EncodeJSONIbcStateMultiProof is the inverse of DecodeJSONIbcStateMultiProof.
+func EncodeJSONIbcStateMultiProof(proof *IbcStateMultiProof) ([]byte, error) {
+	jp := jsonIbcStateMultiProof{
+		Leaves:   make([]jsonIbcStateMultiProofLeaf, 0, len(proof.Leaves)),
+		Siblings: make([]string, 0, len(proof.Siblings)),
+	}
+	for _, leaf := range proof.Leaves {
+		jp.Leaves = append(jp.Leaves, jsonIbcStateMultiProofLeaf{
+			Key:   hex.EncodeToString(leaf.Key),
+			Value: hex.EncodeToString(leaf.Value),
+		})
+	}
+	for _, sibling := range proof.Siblings {
+		jp.Siblings = append(jp.Siblings, hex.EncodeToString(sibling))
+	}
+	return json.Marshal(jp)
+}
+
+type jsonIbcStateMultiProof struct {
+	Leaves   []jsonIbcStateMultiProofLeaf `json:"leaves"`
+	Siblings []string                     `json:"siblings"`
+}
+
+type jsonIbcStateMultiProofLeaf struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
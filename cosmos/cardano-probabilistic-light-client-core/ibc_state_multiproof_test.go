@@ -0,0 +1,339 @@
+package probabilisticcore
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// testIbcStateTree is a sparse IBC state commitment tree over a few keys.
+type testIbcStateTree struct {
+	leaves map[uint64][]byte
+	nodes  map[[2]uint64][]byte
+}
+
+func newTestIbcStateTree(items []IbcStateMembershipItem) *testIbcStateTree {
+	tree := &testIbcStateTree{
+		leaves: make(map[uint64][]byte, len(items)),
+		nodes:  make(map[[2]uint64][]byte),
+	}
+	for _, item := range items {
+		tree.leaves[ibcStateLeafIndex(item.Key)] = leafHash(item.Key, item.Value)
+	}
+	return tree
+}
+
+// nodeHash returns the hash of the subtree at level (0 for leaves) and
+// position.
+func (tree *testIbcStateTree) nodeHash(level int, position uint64) []byte {
+	if level == 0 {
+		if hash, found := tree.leaves[position]; found {
+			return hash
+		}
+		return emptyHash
+	}
+	if hash, found := tree.nodes[[2]uint64{uint64(level), position}]; found {
+		return hash
+	}
+	hash := emptyHash
+	for index := range tree.leaves {
+		if index>>uint(level) == position {
+			hash = innerHash(tree.nodeHash(level-1, position<<1), tree.nodeHash(level-1, position<<1|1))
+			break
+		}
+	}
+	tree.nodes[[2]uint64{uint64(level), position}] = hash
+	return hash
+}
+
+func (tree *testIbcStateTree) root() []byte {
+	return tree.nodeHash(IbcStateTreeDepth, 0)
+}
+
+func (tree *testIbcStateTree) existenceProof(key []byte, value []byte) *ics23.ExistenceProof {
+	index := ibcStateLeafIndex(key)
+	path := make([]*ics23.InnerOp, 0, IbcStateTreeDepth)
+	for depth := 0; depth < IbcStateTreeDepth; depth++ {
+		position := index >> uint(depth)
+		sibling := tree.nodeHash(depth, position^1)
+		if position&1 == 0 {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{0x01}, Suffix: sibling})
+		} else {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{0x01}, sibling...)})
+		}
+	}
+	return &ics23.ExistenceProof{Key: key, Value: value, Path: path}
+}
+
+func testIbcStateItems(n int) []IbcStateMembershipItem {
+	items := make([]IbcStateMembershipItem, 0, n)
+	for i := 0; i < n; i++ {
+		items = append(items, IbcStateMembershipItem{
+			Key:   []byte(fmt.Sprintf("commitments/ports/transfer/channels/channel-0/sequences/%d", i+1)),
+			Value: bytes.Repeat([]byte{byte(i + 1)}, 32),
+		})
+	}
+	return items
+}
+
+func testIbcStateMultiProof(t *testing.T, tree *testIbcStateTree, items []IbcStateMembershipItem) *IbcStateMultiProof {
+	t.Helper()
+	proofs := make([]*ics23.ExistenceProof, 0, len(items))
+	for _, item := range items {
+		proofs = append(proofs, tree.existenceProof(item.Key, item.Value))
+	}
+	proof, err := NewIbcStateMultiProof(proofs)
+	if err != nil {
+		t.Fatalf("multi-proof: %v", err)
+	}
+	return proof
+}
+
+func TestIbcStateMultiProofVerifiesBatch(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 8, 32} {
+		committed := testIbcStateItems(2 * n)
+		tree := newTestIbcStateTree(committed)
+		root := tree.root()
+		items := committed[:n]
+
+		for _, item := range items {
+			if err := VerifyIbcStateMembershipWithExistenceProof(root, item.Key, item.Value, tree.existenceProof(item.Key, item.Value), nil); err != nil {
+				t.Fatalf("n=%d: single proof: %v", n, err)
+			}
+		}
+
+		proof := testIbcStateMultiProof(t, tree, items)
+		if err := VerifyIbcStateBatchMembershipWithMultiProof(root, items, proof, nil); err != nil {
+			t.Fatalf("n=%d: verify: %v", n, err)
+		}
+		if n > 1 && len(proof.Siblings) >= n*IbcStateTreeDepth {
+			t.Fatalf("n=%d: multi-proof carries %d siblings, no fewer than %d single-proof siblings", n, len(proof.Siblings), n*IbcStateTreeDepth)
+		}
+	}
+}
+
+func TestIbcStateMultiProofAcceptsItemsInAnyOrder(t *testing.T) {
+	items := testIbcStateItems(4)
+	tree := newTestIbcStateTree(items)
+	proof := testIbcStateMultiProof(t, tree, items)
+
+	reversed := make([]IbcStateMembershipItem, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		reversed = append(reversed, items[i])
+	}
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(tree.root(), reversed, proof, nil); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+}
+
+func TestIbcStateMultiProofUsesExpectedValueForEmptyLeafValue(t *testing.T) {
+	items := testIbcStateItems(2)
+	tree := newTestIbcStateTree(items)
+	proof := testIbcStateMultiProof(t, tree, items)
+	for i := range proof.Leaves {
+		proof.Leaves[i].Value = nil
+	}
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(tree.root(), items, proof, nil); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+}
+
+func TestIbcStateMultiProofComparesCommittedValues(t *testing.T) {
+	items := testIbcStateItems(2)
+	tree := newTestIbcStateTree(items)
+	proof := testIbcStateMultiProof(t, tree, items)
+
+	expected := []IbcStateMembershipItem{items[0], {Key: items[1].Key, Value: []byte("semantically equal")}}
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(tree.root(), expected, proof, nil); err == nil {
+		t.Fatalf("expected value mismatch without a comparison callback")
+	}
+
+	var compared [][]byte
+	accept := func(key []byte, expectedValue []byte, committedValue []byte) error {
+		compared = append(compared, key)
+		return nil
+	}
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(tree.root(), expected, proof, accept); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if len(compared) != 1 || !bytes.Equal(compared[0], items[1].Key) {
+		t.Fatalf("unexpected comparisons: %q", compared)
+	}
+
+	reject := func([]byte, []byte, []byte) error { return fmt.Errorf("values differ") }
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(tree.root(), expected, proof, reject); err == nil {
+		t.Fatalf("expected rejection from comparison callback")
+	}
+}
+
+func TestIbcStateMultiProofRejectsInvalidBatches(t *testing.T) {
+	committed := testIbcStateItems(8)
+	tree := newTestIbcStateTree(committed)
+	root := tree.root()
+	items := committed[:3]
+
+	cases := []struct {
+		name   string
+		items  func() []IbcStateMembershipItem
+		mutate func(*IbcStateMultiProof)
+	}{
+		{
+			name:  "empty batch",
+			items: func() []IbcStateMembershipItem { return nil },
+		},
+		{
+			name: "wrong value",
+			items: func() []IbcStateMembershipItem {
+				wrong := append([]IbcStateMembershipItem(nil), items...)
+				wrong[1] = IbcStateMembershipItem{Key: items[1].Key, Value: []byte("wrong")}
+				return wrong
+			},
+			mutate: func(proof *IbcStateMultiProof) {
+				for i := range proof.Leaves {
+					proof.Leaves[i].Value = nil
+				}
+			},
+		},
+		{
+			name: "wrong key",
+			items: func() []IbcStateMembershipItem {
+				wrong := append([]IbcStateMembershipItem(nil), items...)
+				wrong[0] = IbcStateMembershipItem{Key: []byte("wrong"), Value: items[0].Value}
+				return wrong
+			},
+		},
+		{
+			name: "extra item",
+			items: func() []IbcStateMembershipItem {
+				return append(append([]IbcStateMembershipItem(nil), items...), IbcStateMembershipItem{Key: []byte("extra"), Value: []byte{0x01}})
+			},
+		},
+		{
+			name:  "missing item",
+			items: func() []IbcStateMembershipItem { return items[:2] },
+		},
+		{
+			name: "duplicate item",
+			items: func() []IbcStateMembershipItem {
+				return []IbcStateMembershipItem{items[0], items[0], items[2]}
+			},
+		},
+		{
+			name:  "empty proof",
+			items: func() []IbcStateMembershipItem { return items },
+			mutate: func(proof *IbcStateMultiProof) {
+				*proof = IbcStateMultiProof{}
+			},
+		},
+		{
+			name:  "tampered sibling",
+			items: func() []IbcStateMembershipItem { return items },
+			mutate: func(proof *IbcStateMultiProof) {
+				for i, sibling := range proof.Siblings {
+					if len(sibling) > 0 {
+						proof.Siblings[i] = bytes.Repeat([]byte{0xff}, 32)
+						return
+					}
+				}
+				t.Fatalf("multi-proof carries no non-empty sibling")
+			},
+		},
+		{
+			name:  "missing sibling",
+			items: func() []IbcStateMembershipItem { return items },
+			mutate: func(proof *IbcStateMultiProof) {
+				proof.Siblings = proof.Siblings[:len(proof.Siblings)-1]
+			},
+		},
+		{
+			name:  "unused sibling",
+			items: func() []IbcStateMembershipItem { return items },
+			mutate: func(proof *IbcStateMultiProof) {
+				proof.Siblings = append(proof.Siblings, []byte{})
+			},
+		},
+		{
+			name:  "malformed sibling",
+			items: func() []IbcStateMembershipItem { return items },
+			mutate: func(proof *IbcStateMultiProof) {
+				proof.Siblings[0] = []byte{0x01}
+			},
+		},
+		{
+			name:  "unordered leaves",
+			items: func() []IbcStateMembershipItem { return items },
+			mutate: func(proof *IbcStateMultiProof) {
+				proof.Leaves[0], proof.Leaves[1] = proof.Leaves[1], proof.Leaves[0]
+			},
+		},
+		{
+			name:  "duplicate leaf",
+			items: func() []IbcStateMembershipItem { return items },
+			mutate: func(proof *IbcStateMultiProof) {
+				proof.Leaves[1] = proof.Leaves[0]
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		proof := testIbcStateMultiProof(t, tree, items)
+		if tc.mutate != nil {
+			tc.mutate(proof)
+		}
+		if err := VerifyIbcStateBatchMembershipWithMultiProof(root, tc.items(), proof, nil); err == nil {
+			t.Fatalf("%s: expected verification failure", tc.name)
+		}
+	}
+
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(root, items, nil, nil); err == nil {
+		t.Fatalf("expected failure for nil proof")
+	}
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(bytes.Repeat([]byte{0x01}, 32), items, testIbcStateMultiProof(t, tree, items), nil); err == nil {
+		t.Fatalf("expected failure against another root")
+	}
+}
+
+func TestNewIbcStateMultiProofRejectsMalformedProofs(t *testing.T) {
+	items := testIbcStateItems(2)
+	tree := newTestIbcStateTree(items)
+
+	if _, err := NewIbcStateMultiProof(nil); err == nil {
+		t.Fatalf("expected failure without proofs")
+	}
+	short := tree.existenceProof(items[0].Key, items[0].Value)
+	short.Path = short.Path[:IbcStateTreeDepth-1]
+	if _, err := NewIbcStateMultiProof([]*ics23.ExistenceProof{short}); err == nil {
+		t.Fatalf("expected failure for short proof path")
+	}
+	proof := tree.existenceProof(items[0].Key, items[0].Value)
+	if _, err := NewIbcStateMultiProof([]*ics23.ExistenceProof{proof, proof}); err == nil {
+		t.Fatalf("expected failure for duplicate keys")
+	}
+}
+
+func TestIbcStateMultiProofJSONRoundTrip(t *testing.T) {
+	items := testIbcStateItems(5)
+	tree := newTestIbcStateTree(items)
+	proof := testIbcStateMultiProof(t, tree, items)
+
+	encoded, err := EncodeJSONIbcStateMultiProof(proof)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	decoded, err := DecodeJSONIbcStateMultiProof(encoded)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if err := VerifyIbcStateBatchMembershipWithMultiProof(tree.root(), items, decoded, nil); err != nil {
+		t.Fatalf("verify decoded proof: %v", err)
+	}
+
+	if _, err := DecodeJSONIbcStateMultiProof([]byte("not json")); err == nil {
+		t.Fatalf("expected failure for malformed JSON")
+	}
+	if _, err := DecodeJSONIbcStateMultiProof([]byte(`{"leaves":[{"key":"zz","value":""}],"siblings":[]}`)); err == nil {
+		t.Fatalf("expected failure for malformed hex")
+	}
+}
@@ -3,7 +3,6 @@ package probabilisticcore
 import (
 	"bytes"
 	"crypto/sha256"
-	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -81,14 +80,12 @@ func VerifyIbcStateNonMembershipWithNonExistenceProof(root []byte, key []byte, n
 }
 
 func ComputeRootFromProofPath(key []byte, value []byte, path []*ics23.InnerOp) ([]byte, error) {
-	if len(path) != 64 {
+	if len(path) != IbcStateTreeDepth {
 		return nil, fmt.Errorf("unexpected proof path length: %d", len(path))
 	}
 
 	current := leafHash(key, value)
-
-	keyHash := sha256.Sum256(key)
-	index := binary.BigEndian.Uint64(keyHash[0:8])
+	index := ibcStateLeafIndex(key)
 
 	for depth, op := range path {
 		direction := (index >> uint(depth)) & 1
@@ -0,0 +1,370 @@
+// Package mithril verifies the Mithril certificates the probabilistic client
+// uses to authenticate Cardano stake distributions.
+//
+// Certificates travel as protobuf-encoded ibc.lightclients.mithril.v1
+// MithrilCertificate messages. They are decoded here with protowire so that
+// the probabilistic adapters neither depend on the Mithril light client module
+// nor register its protobuf types a second time.
+package mithril
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
+)
+
+// ProtocolMessagePartKey mirrors the ibc.lightclients.mithril.v1
+// ProtocolMessagePartKey enum.
+type ProtocolMessagePartKey int32
+
+const (
+	ProtocolMessagePartKeyUnspecified                         ProtocolMessagePartKey = 0
+	ProtocolMessagePartKeySnapshotDigest                      ProtocolMessagePartKey = 1
+	ProtocolMessagePartKeyCardanoTransactionsMerkleRoot       ProtocolMessagePartKey = 2
+	ProtocolMessagePartKeyNextAggregateVerificationKey        ProtocolMessagePartKey = 3
+	ProtocolMessagePartKeyLatestImmutableFileNumber           ProtocolMessagePartKey = 4
+	ProtocolMessagePartKeyLatestBlockNumber                   ProtocolMessagePartKey = 5
+	ProtocolMessagePartKeyNextProtocolParameters              ProtocolMessagePartKey = 6
+	ProtocolMessagePartKeyCurrentEpoch                        ProtocolMessagePartKey = 7
+	ProtocolMessagePartKeyCardanoStakeDistributionEpoch       ProtocolMessagePartKey = 8
+	ProtocolMessagePartKeyCardanoStakeDistributionMerkleRoot  ProtocolMessagePartKey = 9
+	ProtocolMessagePartKeyCardanoBlocksTransactionsMerkleRoot ProtocolMessagePartKey = 10
+)
+
+var protocolMessagePartKeyNames = map[ProtocolMessagePartKey]string{
+	ProtocolMessagePartKeySnapshotDigest:                      "snapshot_digest",
+	ProtocolMessagePartKeyCardanoTransactionsMerkleRoot:       "cardano_transactions_merkle_root",
+	ProtocolMessagePartKeyNextAggregateVerificationKey:        "next_aggregate_verification_key",
+	ProtocolMessagePartKeyLatestImmutableFileNumber:           "latest_immutable_file_number",
+	ProtocolMessagePartKeyLatestBlockNumber:                   "latest_block_number",
+	ProtocolMessagePartKeyNextProtocolParameters:              "next_protocol_parameters",
+	ProtocolMessagePartKeyCurrentEpoch:                        "current_epoch",
+	ProtocolMessagePartKeyCardanoStakeDistributionEpoch:       "cardano_stake_distribution_epoch",
+	ProtocolMessagePartKeyCardanoStakeDistributionMerkleRoot:  "cardano_stake_distribution_merkle_root",
+	ProtocolMessagePartKeyCardanoBlocksTransactionsMerkleRoot: "cardano_blocks_transactions_merkle_root",
+}
+
+// protocolMessagePartKeyOrder is the order in which the aggregator hashes
+// message parts, following the declaration order of its ordered map keys.
+var protocolMessagePartKeyOrder = []ProtocolMessagePartKey{
+	ProtocolMessagePartKeySnapshotDigest,
+	ProtocolMessagePartKeyCardanoTransactionsMerkleRoot,
+	ProtocolMessagePartKeyCardanoBlocksTransactionsMerkleRoot,
+	ProtocolMessagePartKeyNextAggregateVerificationKey,
+	ProtocolMessagePartKeyNextProtocolParameters,
+	ProtocolMessagePartKeyCurrentEpoch,
+	ProtocolMessagePartKeyLatestBlockNumber,
+	ProtocolMessagePartKeyCardanoStakeDistributionEpoch,
+	ProtocolMessagePartKeyCardanoStakeDistributionMerkleRoot,
+	ProtocolMessagePartKeyLatestImmutableFileNumber,
+}
+
+func (k ProtocolMessagePartKey) String() string {
+	if name, ok := protocolMessagePartKeyNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int32(k))
+}
+
+// SignedEntityKind identifies the signed entity type of a certificate. Its
+// values are the field numbers of the SignedEntityType oneof.
+type SignedEntityKind int32
+
+const (
+	MithrilStakeDistribution  SignedEntityKind = 1
+	CardanoStakeDistribution  SignedEntityKind = 2
+	CardanoImmutableFilesFull SignedEntityKind = 3
+	CardanoTransactions       SignedEntityKind = 4
+	CardanoBlocksTransactions SignedEntityKind = 5
+)
+
+// SignedEntityType is the entity a certificate signs. BlockNumber is set for
+// CardanoTransactions and CardanoBlocksTransactions, Network and
+// ImmutableFileNumber for CardanoImmutableFilesFull.
+type SignedEntityType struct {
+	Kind                SignedEntityKind
+	Epoch               uint64
+	BlockNumber         uint64
+	Network             string
+	ImmutableFileNumber uint64
+}
+
+// ProtocolParameters are the Mithril protocol parameters, with phi_f kept as
+// the fraction it is transported as.
+type ProtocolParameters struct {
+	K               uint64
+	M               uint64
+	PhiFNumerator   uint64
+	PhiFDenominator uint64
+}
+
+// Signer is a party that contributed to a certificate.
+type Signer struct {
+	PartyId string
+	Stake   uint64
+}
+
+type CertificateMetadata struct {
+	Network            string
+	ProtocolVersion    string
+	ProtocolParameters *ProtocolParameters
+	InitiatedAt        string
+	SealedAt           string
+	Signers            []Signer
+}
+
+type MessagePart struct {
+	Key   ProtocolMessagePartKey
+	Value string
+}
+
+type ProtocolMessage struct {
+	Parts []MessagePart
+}
+
+// Certificate is a Mithril certificate. AggregateVerificationKey and
+// MultiSignature keep the JSON hex encoding the aggregator publishes, which is
+// also what the certificate hash commits to.
+type Certificate struct {
+	Hash                     string
+	PreviousHash             string
+	Epoch                    uint64
+	SignedEntityType         *SignedEntityType
+	Metadata                 *CertificateMetadata
+	ProtocolMessage          *ProtocolMessage
+	SignedMessage            string
+	AggregateVerificationKey string
+	MultiSignature           string
+	GenesisSignature         string
+}
+
+// Part returns the value of the message part with the given key.
+func (m *ProtocolMessage) Part(key ProtocolMessagePartKey) (string, bool) {
+	for _, part := range m.Parts {
+		if part.Key == key {
+			return part.Value, true
+		}
+	}
+	return "", false
+}
+
+// ComputeHash returns the hex-encoded SHA-256 of the message parts in the
+// aggregator's key order, each as its key name followed by its value.
+func (m *ProtocolMessage) ComputeHash() string {
+	hasher := sha256.New()
+	for _, key := range protocolMessagePartKeyOrder {
+		if value, ok := m.Part(key); ok {
+			hasher.Write([]byte(key.String()))
+			hasher.Write([]byte(value))
+		}
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+func (m *ProtocolMessage) validate() error {
+	seen := make(map[ProtocolMessagePartKey]bool, len(m.Parts))
+	for _, part := range m.Parts {
+		if _, ok := protocolMessagePartKeyNames[part.Key]; !ok {
+			return fmt.Errorf("unsupported protocol message part key %d", int32(part.Key))
+		}
+		if seen[part.Key] {
+			return fmt.Errorf("duplicate protocol message part %s", part.Key)
+		}
+		seen[part.Key] = true
+	}
+	return nil
+}
+
+// PhiF returns phi_f as the float the STM lottery is evaluated with.
+func (p *ProtocolParameters) PhiF() float64 {
+	return float64(p.PhiFNumerator) / float64(p.PhiFDenominator)
+}
+
+// ComputeHash returns the hex-encoded SHA-256 of k, m and phi_f as an
+// unsigned 8.24 fixed-point number, all big-endian.
+func (p *ProtocolParameters) ComputeHash() string {
+	hasher := sha256.New()
+	writeUint64(hasher, p.K)
+	writeUint64(hasher, p.M)
+	phiF := make([]byte, 4)
+	binary.BigEndian.PutUint32(phiF, uint32(p.PhiF()*(1<<24)))
+	hasher.Write(phiF)
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+func (p *ProtocolParameters) validate() error {
+	if p.K == 0 {
+		return fmt.Errorf("number of required signatures should be greater than 0")
+	}
+	if p.M == 0 {
+		return fmt.Errorf("number of lotteries should be greater than 0")
+	}
+	if p.PhiFNumerator == 0 || p.PhiFDenominator == 0 || p.PhiFNumerator > p.PhiFDenominator {
+		return fmt.Errorf("phi_f must be in (0, 1]")
+	}
+	return nil
+}
+
+// ComputeHash returns the hex-encoded SHA-256 of the metadata as the
+// aggregator hashes it, with timestamps as big-endian Unix nanoseconds.
+func (m *CertificateMetadata) ComputeHash() (string, error) {
+	initiatedAt, err := time.Parse(time.RFC3339Nano, m.InitiatedAt)
+	if err != nil {
+		return "", fmt.Errorf("initiated_at: %w", err)
+	}
+	sealedAt, err := time.Parse(time.RFC3339Nano, m.SealedAt)
+	if err != nil {
+		return "", fmt.Errorf("sealed_at: %w", err)
+	}
+
+	hasher := sha256.New()
+	hasher.Write([]byte(m.Network))
+	hasher.Write([]byte(m.ProtocolVersion))
+	hasher.Write([]byte(m.ProtocolParameters.ComputeHash()))
+	writeUint64(hasher, uint64(initiatedAt.UnixNano()))
+	writeUint64(hasher, uint64(sealedAt.UnixNano()))
+	for _, signer := range m.Signers {
+		signerHasher := sha256.New()
+		signerHasher.Write([]byte(signer.PartyId))
+		writeUint64(signerHasher, signer.Stake)
+		hasher.Write([]byte(hex.EncodeToString(signerHasher.Sum(nil))))
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func (s *SignedEntityType) feedHash(hasher hash.Hash) {
+	switch s.Kind {
+	case MithrilStakeDistribution, CardanoStakeDistribution:
+		writeUint64(hasher, s.Epoch)
+	case CardanoImmutableFilesFull:
+		hasher.Write([]byte(s.Network))
+		writeUint64(hasher, s.Epoch)
+		writeUint64(hasher, s.ImmutableFileNumber)
+	case CardanoTransactions, CardanoBlocksTransactions:
+		writeUint64(hasher, s.Epoch)
+		writeUint64(hasher, s.BlockNumber)
+	}
+}
+
+// IsGenesis reports whether the certificate carries a genesis signature.
+func (c *Certificate) IsGenesis() bool {
+	return c.GenesisSignature != ""
+}
+
+// MatchMessage reports whether the signed message is the hash of the
+// certificate's protocol message.
+func (c *Certificate) MatchMessage() bool {
+	return c.ProtocolMessage.ComputeHash() == c.SignedMessage
+}
+
+// ComputeHash recomputes the certificate hash over its previous hash, epoch,
+// metadata, protocol message, signed message, aggregate verification key and
+// signature, as the aggregator does. Certificate hashes are only trusted once
+// they are recomputed here.
+func (c *Certificate) ComputeHash() (string, error) {
+	metadataHash, err := c.Metadata.ComputeHash()
+	if err != nil {
+		return "", err
+	}
+
+	hasher := sha256.New()
+	hasher.Write([]byte(c.PreviousHash))
+	writeUint64(hasher, c.Epoch)
+	hasher.Write([]byte(metadataHash))
+	hasher.Write([]byte(c.ProtocolMessage.ComputeHash()))
+	hasher.Write([]byte(c.SignedMessage))
+	hasher.Write([]byte(c.AggregateVerificationKey))
+	if c.IsGenesis() {
+		signature, err := hex.DecodeString(c.GenesisSignature)
+		if err != nil {
+			return "", fmt.Errorf("genesis_signature: %w", err)
+		}
+		hasher.Write([]byte(hex.EncodeToString(signature)))
+	} else {
+		c.SignedEntityType.feedHash(hasher)
+		hasher.Write([]byte(c.MultiSignature))
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// VerifyMultiSignature checks the STM multi-signature of a standard
+// certificate over its signed message, against the certificate's aggregate
+// verification key and protocol parameters.
+func (c *Certificate) VerifyMultiSignature() error {
+	if c.IsGenesis() || c.MultiSignature == "" {
+		return fmt.Errorf("certificate has no multi-signature")
+	}
+	avk, err := ParseAggregateVerificationKey(c.AggregateVerificationKey)
+	if err != nil {
+		return fmt.Errorf("aggregate_verification_key: %w", err)
+	}
+	signature, err := parseMultiSignature(c.MultiSignature)
+	if err != nil {
+		return fmt.Errorf("multi_signature: %w", err)
+	}
+	return signature.Verify([]byte(c.SignedMessage), avk, &crypto.StmParameters{
+		K:    c.Metadata.ProtocolParameters.K,
+		M:    c.Metadata.ProtocolParameters.M,
+		PhiF: c.Metadata.ProtocolParameters.PhiF(),
+	})
+}
+
+// ParseAggregateVerificationKey decodes a JSON hex STM aggregate verification
+// key.
+func ParseAggregateVerificationKey(jsonHex string) (*crypto.StmAggrVerificationKey, error) {
+	bz, err := hex.DecodeString(jsonHex)
+	if err != nil {
+		return nil, err
+	}
+	avk := &crypto.StmAggrVerificationKey{}
+	if err := json.Unmarshal(bz, avk); err != nil {
+		return nil, err
+	}
+	if avk.MTCommitment == nil || len(avk.MTCommitment.Root) == 0 {
+		return nil, fmt.Errorf("merkle tree commitment must not be empty")
+	}
+	if avk.MTCommitment.Hasher == nil {
+		hasher, err := blake2b.New256(nil)
+		if err != nil {
+			return nil, err
+		}
+		avk.MTCommitment.Hasher = hasher
+	}
+	return avk, nil
+}
+
+func parseMultiSignature(jsonHex string) (*crypto.StmAggrSig, error) {
+	bz, err := hex.DecodeString(jsonHex)
+	if err != nil {
+		return nil, err
+	}
+	signature := &crypto.StmAggrSig{}
+	if err := json.Unmarshal(bz, signature); err != nil {
+		return nil, err
+	}
+	if signature.BatchProof == nil {
+		return nil, fmt.Errorf("batch proof must be present")
+	}
+	if signature.BatchProof.Hasher == nil {
+		hasher, err := blake2b.New256(nil)
+		if err != nil {
+			return nil, err
+		}
+		signature.BatchProof.Hasher = hasher
+	}
+	return signature, nil
+}
+
+func writeUint64(hasher hash.Hash, value uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, value)
+	hasher.Write(bz)
+}
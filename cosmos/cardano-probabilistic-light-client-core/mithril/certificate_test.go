@@ -0,0 +1,243 @@
+package mithril
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
+)
+
+// byteArray encodes bytes as serde does, as an array of numbers.
+type byteArray []byte
+
+func (b byteArray) MarshalJSON() ([]byte, error) {
+	numbers := make([]uint16, len(b))
+	for i, v := range b {
+		numbers[i] = uint16(v)
+	}
+	return json.Marshal(numbers)
+}
+
+func mustJSONHex(t *testing.T, value any) string {
+	t.Helper()
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return hex.EncodeToString(bz)
+}
+
+// newTestSignedCertificate returns a CardanoStakeDistribution certificate
+// signed by a freshly registered set of STM signers.
+func newTestSignedCertificate(t *testing.T) *Certificate {
+	t.Helper()
+
+	parameters := &ProtocolParameters{K: 2, M: 50, PhiFNumerator: 4, PhiFDenominator: 5}
+	stmParams := &crypto.StmParameters{K: parameters.K, M: parameters.M, PhiF: parameters.PhiF()}
+
+	var initializers []*crypto.StmInitializer
+	var parties []crypto.RegParty
+	var totalStake crypto.Stake
+	for i, stake := range []uint64{300, 400, 500} {
+		seed := sha256.Sum256([]byte{byte(i)})
+		sk, err := crypto.Gen(seed[:])
+		if err != nil {
+			t.Fatalf("signing key: %v", err)
+		}
+		pk, err := new(crypto.StmVerificationKeyPoP).FromSigningKey(sk)
+		if err != nil {
+			t.Fatalf("verification key: %v", err)
+		}
+		initializers = append(initializers, &crypto.StmInitializer{Stake: crypto.Stake(stake), Params: stmParams, Sk: sk, Pk: pk})
+		parties = append(parties, crypto.RegParty{VerificationKey: pk.VK, Stake: crypto.Stake(stake)})
+		totalStake += crypto.Stake(stake)
+	}
+	tree, err := crypto.Create(parties)
+	if err != nil {
+		t.Fatalf("key registration: %v", err)
+	}
+	closedReg := &crypto.ClosedKeyReg{RegParties: parties, TotalStake: totalStake, MerkleTree: tree}
+	clerk := crypto.FromRegistration(stmParams, closedReg)
+
+	avk := clerk.ComputeAVK()
+	certificate := &Certificate{
+		PreviousHash: "previous-certificate",
+		Epoch:        101,
+		SignedEntityType: &SignedEntityType{
+			Kind:  CardanoStakeDistribution,
+			Epoch: 100,
+		},
+		Metadata: &CertificateMetadata{
+			Network:            "devnet",
+			ProtocolVersion:    "0.1.0",
+			ProtocolParameters: parameters,
+			InitiatedAt:        "2026-01-02T03:04:05.123456789Z",
+			SealedAt:           "2026-01-02T03:04:06Z",
+			Signers:            []Signer{{PartyId: "pool-a", Stake: 300}, {PartyId: "pool-b", Stake: 400}},
+		},
+		ProtocolMessage: &ProtocolMessage{Parts: []MessagePart{
+			{Key: ProtocolMessagePartKeyCardanoStakeDistributionEpoch, Value: "100"},
+			{Key: ProtocolMessagePartKeyCardanoStakeDistributionMerkleRoot, Value: "00ff"},
+			{Key: ProtocolMessagePartKeyNextAggregateVerificationKey, Value: "next-avk"},
+		}},
+		AggregateVerificationKey: mustJSONHex(t, map[string]any{
+			"mt_commitment": map[string]any{"root": byteArray(avk.MTCommitment.Root), "nr_leaves": avk.MTCommitment.NrLeaves},
+			"total_stake":   uint64(avk.TotalStake),
+		}),
+	}
+	certificate.SignedMessage = certificate.ProtocolMessage.ComputeHash()
+
+	var signatures []*crypto.StmSig
+	for _, initializer := range initializers {
+		signer, err := initializer.NewSigner(closedReg)
+		if err != nil {
+			t.Fatalf("signer: %v", err)
+		}
+		signature, err := signer.Sign([]byte(certificate.SignedMessage))
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		if signature != nil {
+			signatures = append(signatures, signature)
+		}
+	}
+	multiSignature, err := clerk.Aggregate(signatures, []byte(certificate.SignedMessage))
+	if err != nil {
+		t.Fatalf("aggregate: %v", err)
+	}
+
+	values := make([]byteArray, 0, len(multiSignature.BatchProof.Values))
+	for _, value := range multiSignature.BatchProof.Values {
+		values = append(values, value)
+	}
+	var encodedSignatures [][2]any
+	for _, signature := range multiSignature.Signatures {
+		encodedSignatures = append(encodedSignatures, [2]any{
+			map[string]any{
+				"sigma":        byteArray(signature.Sig.Sigma.ToBytes()),
+				"indexes":      signature.Sig.Indexes,
+				"signer_index": signature.Sig.SignerIndex,
+			},
+			[2]any{byteArray(signature.RegParty.VerificationKey.ToBytes()), uint64(signature.RegParty.Stake)},
+		})
+	}
+	certificate.MultiSignature = mustJSONHex(t, map[string]any{
+		"signatures":  encodedSignatures,
+		"batch_proof": map[string]any{"values": values, "indices": multiSignature.BatchProof.Indices, "hasher": nil},
+	})
+
+	certificate.Hash, err = certificate.ComputeHash()
+	if err != nil {
+		t.Fatalf("certificate hash: %v", err)
+	}
+	return certificate
+}
+
+func TestCertificateMarshalRoundTrip(t *testing.T) {
+	certificate := newTestSignedCertificate(t)
+
+	decoded, err := UnmarshalCertificate(certificate.Marshal())
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(certificate, decoded) {
+		t.Fatalf("decoded certificate differs:\n got %+v\nwant %+v", decoded, certificate)
+	}
+
+	for _, kind := range []SignedEntityType{
+		{Kind: MithrilStakeDistribution, Epoch: 7},
+		{Kind: CardanoImmutableFilesFull, Epoch: 7, Network: "devnet", ImmutableFileNumber: 42},
+		{Kind: CardanoTransactions, Epoch: 7, BlockNumber: 40},
+		{Kind: CardanoBlocksTransactions, Epoch: 7, BlockNumber: 40},
+	} {
+		certificate.SignedEntityType = &kind
+		decoded, err := UnmarshalCertificate(certificate.Marshal())
+		if err != nil {
+			t.Fatalf("unmarshal kind %d: %v", kind.Kind, err)
+		}
+		if !reflect.DeepEqual(&kind, decoded.SignedEntityType) {
+			t.Fatalf("kind %d decoded as %+v", kind.Kind, decoded.SignedEntityType)
+		}
+	}
+}
+
+func TestCertificateVerification(t *testing.T) {
+	certificate := newTestSignedCertificate(t)
+	if !certificate.MatchMessage() {
+		t.Fatalf("signed message does not match protocol message")
+	}
+	if err := certificate.VerifyMultiSignature(); err != nil {
+		t.Fatalf("verify multi-signature: %v", err)
+	}
+
+	// A tampered message part no longer matches the signed message, and
+	// changes the certificate hash.
+	tampered := newTestSignedCertificate(t)
+	tampered.ProtocolMessage.Parts[1].Value = "ff00"
+	if tampered.MatchMessage() {
+		t.Fatalf("tampered protocol message matches signed message")
+	}
+	hash, err := tampered.ComputeHash()
+	if err != nil {
+		t.Fatalf("certificate hash: %v", err)
+	}
+	if hash == certificate.Hash {
+		t.Fatalf("tampered protocol message keeps the certificate hash")
+	}
+
+	// Re-signing the message is not possible without the signers' keys.
+	tampered.SignedMessage = tampered.ProtocolMessage.ComputeHash()
+	if err := tampered.VerifyMultiSignature(); err == nil {
+		t.Fatalf("multi-signature verifies over a different message")
+	}
+
+	tampered = newTestSignedCertificate(t)
+	tampered.Metadata.ProtocolParameters.K = 100
+	if err := tampered.VerifyMultiSignature(); err == nil {
+		t.Fatalf("multi-signature verifies under a different quorum")
+	}
+
+	tampered = newTestSignedCertificate(t)
+	tampered.SignedEntityType.Epoch++
+	if hash, _ := tampered.ComputeHash(); hash == certificate.Hash {
+		t.Fatalf("signed entity type is not committed by the certificate hash")
+	}
+}
+
+func TestUnmarshalCertificateRejectsMalformedCertificates(t *testing.T) {
+	cases := map[string]func(*Certificate){
+		"missing metadata": func(c *Certificate) { c.Metadata = nil },
+		"zero quorum":      func(c *Certificate) { c.Metadata.ProtocolParameters.K = 0 },
+		"phi_f above one":  func(c *Certificate) { c.Metadata.ProtocolParameters.PhiFNumerator = 6 },
+		"no signature":     func(c *Certificate) { c.MultiSignature = "" },
+		"two signatures":   func(c *Certificate) { c.GenesisSignature = "00" },
+		"no signed entity": func(c *Certificate) { c.SignedEntityType = nil },
+		"unknown message part": func(c *Certificate) {
+			c.ProtocolMessage.Parts = append(c.ProtocolMessage.Parts, MessagePart{Key: 99, Value: "x"})
+		},
+		"unspecified message part": func(c *Certificate) {
+			c.ProtocolMessage.Parts = append(c.ProtocolMessage.Parts, MessagePart{Value: "x"})
+		},
+		"duplicate message part": func(c *Certificate) {
+			c.ProtocolMessage.Parts = append(c.ProtocolMessage.Parts, c.ProtocolMessage.Parts[0])
+		},
+	}
+	for name, mutate := range cases {
+		certificate := newTestSignedCertificate(t)
+		mutate(certificate)
+		if _, err := UnmarshalCertificate(certificate.Marshal()); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+
+	if _, err := UnmarshalCertificate([]byte{0xff, 0xff}); err == nil {
+		t.Fatalf("expected an error for a malformed protobuf")
+	}
+	if _, err := UnmarshalCertificate([]byte{0x18, 0x01, 0x0a, 0x01}); err == nil {
+		t.Fatalf("expected an error for a truncated field")
+	}
+}
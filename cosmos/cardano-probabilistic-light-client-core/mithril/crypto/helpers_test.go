@@ -6,7 +6,6 @@ import (
 	"math/big"
 	"testing"
 
-	"github.com/stretchr/testify/assert"
 	blst "github.com/supranational/blst/bindings/go"
 )
 
@@ -30,22 +29,30 @@ var (
 
 func TestVkFromP2Affine(t *testing.T) {
 	signingKey, err := Gen(Const32Bytes)
-	assert.NoError(t, err, "signing key generation should be success")
+	if err != nil {
+		t.Fatalf("signing key generation: %v", err)
+	}
 
 	vk, err := new(VerificationKey).FromSigningKey(signingKey)
-	assert.NoError(t, err, "verification key generation from valid signing key should be success")
+	if err != nil {
+		t.Fatalf("verification key generation from valid signing key: %v", err)
+	}
 
 	p2 := vkFromP2Affine(vk)
 	p2Bytes := p2.Serialize()
 	p2Hex := hex.EncodeToString(p2Bytes)
 
 	expectedP2Hex := "0cfd749941a5bea56796745d1fc91668d63f9522374cb6e9c033433e3216dcad48b4fc1ab7000a365f2861565daa6b0819fd041ac58eed8c441c8b3478df6ceeaf89cc02c8119f63891a1368d7ec1d0c7e2abaaae2ac8579b7eece473478dac70f170ab6ff2c30023a686560aea44adbe4d9938f9dd4e761311f23fc91f81b7c6e3037ece5d4428c88a494c65fbd95420e7dbc1ef1502e48bb553bcc411d4c42bc70170821815c0a8f1431421a099a45a74efd2d70623f02011040ec965316eb"
-	assert.Equal(t, expectedP2Hex, p2Hex)
+	if p2Hex != expectedP2Hex {
+		t.Fatalf("p2Hex = %s, want %s", p2Hex, expectedP2Hex)
+	}
 }
 
 func TestSigToP1(t *testing.T) {
 	sk, err := Gen(Const32Bytes)
-	assert.NoError(t, err, "signing key generation should be success")
+	if err != nil {
+		t.Fatalf("signing key generation: %v", err)
+	}
 
 	sig := sk.Sign(Const64Bytes)
 
@@ -54,7 +61,9 @@ func TestSigToP1(t *testing.T) {
 	p1Hex := hex.EncodeToString(p1Bytes)
 
 	expectedP1Hex := "173a1b545fe4fe265609093fcfd494d7ff9a70ce14456978af3673dd6da069b701a670e7c1088693662bf91e71239fff157d28f06f38421f52399bdd6827339e0e0890c730d3c84b829f6c01094f54c299dde91ed1cea751f82bd622a06bb7ce"
-	assert.Equal(t, expectedP1Hex, p1Hex)
+	if p1Hex != expectedP1Hex {
+		t.Fatalf("p1Hex = %s, want %s", p1Hex, expectedP1Hex)
+	}
 }
 
 func TestP2AffineToVk(t *testing.T) {
@@ -70,7 +79,9 @@ func TestP2AffineToVk(t *testing.T) {
 	blstVkHex := hex.EncodeToString(blstVkBytes)
 
 	expectedBlstVkHex := "06b24c5978304dd9e318f9715915f72f7470bda0a4b579b26628b73b72ee4bfddf8f2edb77c35e60f759870590b28c221821aaf152fc655015c50cf486cfd6353e46f379617ec1c5ff943b1ecde42b4656bfb4b61949809b8cc565e3612ad90815301f28d821422849fd0e9da2ede5842a887227102d4848b06916ece2eb5dd003ecc4f57e49afb428e3845f54a6b9e502e1c608fe487ce9e2b1b398e7a16dffe7d26053e7eb1cd768a0e39b051d259ad4558890081dc1ff40e65ef7a62ae828"
-	assert.Equal(t, expectedBlstVkHex, blstVkHex)
+	if blstVkHex != expectedBlstVkHex {
+		t.Fatalf("blstVkHex = %s, want %s", blstVkHex, expectedBlstVkHex)
+	}
 }
 
 func TestP1AffineToSig(t *testing.T) {
@@ -86,7 +97,9 @@ func TestP1AffineToSig(t *testing.T) {
 	blstSigHex := hex.EncodeToString(blstSigBytes)
 
 	expectedBlstSigHex := "0f74191216e7e6b941eadb4f1e4a26e106f1590af3fa70e3fff317a0ea336073ff1e73a4d36a06d49b441f2d04e5a9ee0f43222044278cd6e4e45bc0d786f04535745431ba401e6fc9490383f0b83b86f7272fbb593d82a4fb069e53b45c764f"
-	assert.Equal(t, expectedBlstSigHex, blstSigHex)
+	if blstSigHex != expectedBlstSigHex {
+		t.Fatalf("blstSigHex = %s, want %s", blstSigHex, expectedBlstSigHex)
+	}
 }
 
 func generateIkmByIndex(idx uint64) []byte {
@@ -102,7 +115,9 @@ func generateSigningKeys(t *testing.T, len uint64) []*SigningKey {
 
 		sk, err := Gen(ikm)
 		sks = append(sks, sk)
-		assert.NoError(t, err, "generate signing key should be success")
+		if err != nil {
+			t.Fatalf("generate signing key: %v", err)
+		}
 	}
 	return sks
 }
@@ -114,7 +129,9 @@ func generateVks(t *testing.T, len uint64) ([]*SigningKey, []*VerificationKey) {
 
 	for i := 0; i < int(len); i++ {
 		vk, err := new(VerificationKey).FromSigningKey(sks[i])
-		assert.NoError(t, err, "generate verification key from signing key should be success")
+		if err != nil {
+			t.Fatalf("generate verification key from signing key: %v", err)
+		}
 
 		vks = append(vks, vk)
 	}
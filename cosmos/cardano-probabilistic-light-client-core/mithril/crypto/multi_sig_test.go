@@ -3,20 +3,23 @@ package crypto
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"reflect"
 	"testing"
-
-	"github.com/stretchr/testify/assert"
 )
 
 func TestSig(t *testing.T) {
 	sk := generateSigningKeys(t, 1)[0]
 	vk, err := new(VerificationKey).FromSigningKey(sk)
-	assert.NoError(t, err, "generate verification key from valid signing key should be success")
+	if err != nil {
+		t.Fatalf("generate verification key from valid signing key: %v", err)
+	}
 
 	sig := sk.Sign(Const64Bytes)
 
 	valid := sig.Verify(Const64Bytes, vk)
-	assert.NoError(t, valid, "verify valid signature should be success")
+	if err := valid; err != nil {
+		t.Fatalf("verify valid signature: %v", err)
+	}
 }
 
 func TestInvalidSig(t *testing.T) {
@@ -24,13 +27,17 @@ func TestInvalidSig(t *testing.T) {
 	sk0, sk1 := sks[0], sks[1]
 
 	vk0, err := new(VerificationKey).FromSigningKey(sk0)
-	assert.NoError(t, err, "generate verification key from valid signing key should be success")
+	if err != nil {
+		t.Fatalf("generate verification key from valid signing key: %v", err)
+	}
 
 	fakeSig := sk1.Sign(Const64Bytes)
 
 	valid := fakeSig.Verify(Const64Bytes, vk0)
 
-	assert.Error(t, valid, "verify fake signature should be fail")
+	if valid == nil {
+		t.Fatal("fake signature verified")
+	}
 }
 
 func TestAggregateSig(t *testing.T) {
@@ -39,7 +46,9 @@ func TestAggregateSig(t *testing.T) {
 	_, vks, sigs := generateSignatures(t, len)
 	valid := new(Signature).VerifyAggregate(Const64Bytes, vks, sigs)
 
-	assert.NoError(t, valid, "verify valid aggregate signatures should be success")
+	if err := valid; err != nil {
+		t.Fatalf("verify valid aggregate signatures: %v", err)
+	}
 }
 
 func TestSerializeDeserializeVk(t *testing.T) {
@@ -48,8 +57,12 @@ func TestSerializeDeserializeVk(t *testing.T) {
 	vk0Bytes := vk0.ToBytes()
 
 	vk1, err := new(VerificationKey).FromBytes(vk0Bytes)
-	assert.NoError(t, err, "deserialize verification key from valid bytes should be success")
-	assert.Equal(t, vk0, vk1, "deserialized verification key does not match the original after deserialization from serialized bytes")
+	if err != nil {
+		t.Fatalf("deserialize verification key from valid bytes: %v", err)
+	}
+	if !reflect.DeepEqual(vk0, vk1) {
+		t.Fatal("deserialized verification key does not match the original after deserialization from serialized bytes")
+	}
 }
 
 func TestSerializeDeserializeSk(t *testing.T) {
@@ -57,8 +70,12 @@ func TestSerializeDeserializeSk(t *testing.T) {
 	sk0Bytes := sk0.ToBytes()
 
 	sk1, err := new(SigningKey).FromBytes(sk0Bytes)
-	assert.NoError(t, err, "deserialize signing key from valid bytes should be success")
-	assert.Equal(t, sk0, sk1, "deserialized signing key does not match the original after deserialization from serialized bytes")
+	if err != nil {
+		t.Fatalf("deserialize signing key from valid bytes: %v", err)
+	}
+	if !reflect.DeepEqual(sk0, sk1) {
+		t.Fatal("deserialized signing key does not match the original after deserialization from serialized bytes")
+	}
 }
 
 func TestBatchVerify(t *testing.T) {
@@ -72,7 +89,9 @@ func TestBatchVerify(t *testing.T) {
 	for i := 0; i < numBatches; i++ {
 		msg := make([]byte, 64)
 		_, err := rand.Read(msg)
-		assert.NoError(t, err, "generate random message should be success")
+		if err != nil {
+			t.Fatalf("generate random message: %v", err)
+		}
 
 		mvks := []*VerificationKey{}
 		sigs := []*Signature{}
@@ -80,39 +99,59 @@ func TestBatchVerify(t *testing.T) {
 		for j := 0; j < numSigs; j++ {
 			ikm := make([]byte, 32)
 			_, err := rand.Read(ikm)
-			assert.NoError(t, err, "generate random ikm should be success")
+			if err != nil {
+				t.Fatalf("generate random ikm: %v", err)
+			}
 			sk, err := Gen(ikm)
-			assert.NoError(t, err, "generate signing key should be success")
+			if err != nil {
+				t.Fatalf("generate signing key: %v", err)
+			}
 			vk, err := new(VerificationKey).FromSigningKey(sk)
-			assert.NoError(t, err, "derive verification key from valid signing key should be success")
+			if err != nil {
+				t.Fatalf("derive verification key from valid signing key: %v", err)
+			}
 			sig := sk.Sign(msg)
 			sigs = append(sigs, sig)
 			mvks = append(mvks, vk)
 		}
-		assert.NoError(t, new(Signature).VerifyAggregate(msg, mvks, sigs))
+		if err := new(Signature).VerifyAggregate(msg, mvks, sigs); err != nil {
+			t.Fatal(err)
+		}
 		aggVk, aggSig, err := new(Signature).Aggregate(mvks, sigs)
-		assert.NoError(t, err, "verification keys aggregation and signtures aggregation should be success")
+		if err != nil {
+			t.Fatalf("verification keys aggregation and signatures aggregation: %v", err)
+		}
 		batchMsgs = append(batchMsgs, msg)
 		batchVk = append(batchVk, aggVk)
 		batchSig = append(batchSig, aggSig)
 	}
-	assert.NoError(t, new(Signature).BatchVerifyAggregates(batchMsgs, batchVk, batchSig), "batch verification should be success")
+	if err := new(Signature).BatchVerifyAggregates(batchMsgs, batchVk, batchSig); err != nil {
+		t.Fatalf("batch verification: %v", err)
+	}
 
 	// If we have an invalid signature, the batch verification will fail
 	sk := generateSigningKeys(t, 1)[0]
 	fakeSig := sk.Sign(Const64Bytes)
 	batchSig[0] = fakeSig
 
-	assert.Error(t, new(Signature).BatchVerifyAggregates(batchMsgs, batchVk, batchSig), "batch verification with fake sig should be fail")
+	if new(Signature).BatchVerifyAggregates(batchMsgs, batchVk, batchSig) == nil {
+		t.Fatal("batch with a fake signature verified")
+	}
 }
 
 func TestEval(t *testing.T) {
 	sk, err := Gen(Const32Bytes)
-	assert.NoError(t, err)
+	if err != nil {
+		t.Fatal(err)
+	}
 	sig := sk.Sign(Const64Bytes)
 	ev, err := sig.Eval(Const64Bytes, 0)
 	evHex := hex.EncodeToString(ev)
-	assert.NoError(t, err)
+	if err != nil {
+		t.Fatal(err)
+	}
 	expectedEvHex := "6357c6598357712cfe46f9cca981a2aeb627557b8f448d090ed884edae9e9fda36c571f3c3697549a8b0bca514b623c9903e6f64e7d4720c9f2da7e767634c02"
-	assert.Equal(t, expectedEvHex, evHex)
+	if evHex != expectedEvHex {
+		t.Fatalf("evHex = %s, want %s", evHex, expectedEvHex)
+	}
 }
@@ -512,6 +512,8 @@ func (clerk *StmClerk) Aggregate(sigs []*StmSig, msg []byte) (*StmAggrSig, error
 	if err != nil {
 		return nil, err
 	}
+	// The batch proof is built over signer indices in ascending order.
+	sort.Slice(uniqueSigs, func(i, j int) bool { return uniqueSigs[i].Sig.SignerIndex < uniqueSigs[j].Sig.SignerIndex })
 
 	var mtIndexList []uint64
 	for _, sigReg := range uniqueSigs {
@@ -0,0 +1,115 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestVerificationKeyToBytes(t *testing.T) {
+	sk, err := Gen(Const32Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vk, err := new(VerificationKey).FromSigningKey(sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vkBytes := vk.ToBytes()
+	vkHex := hex.EncodeToString(vkBytes)
+	expectedVkHex := "acfd749941a5bea56796745d1fc91668d63f9522374cb6e9c033433e3216dcad48b4fc1ab7000a365f2861565daa6b0819fd041ac58eed8c441c8b3478df6ceeaf89cc02c8119f63891a1368d7ec1d0c7e2abaaae2ac8579b7eece473478dac7"
+	if vkHex != expectedVkHex {
+		t.Fatalf("vkHex = %s, want %s", vkHex, expectedVkHex)
+	}
+}
+
+func TestMerkleTreeBatchPathOverPaddedLeaf(t *testing.T) {
+	_, vks := generateVks(t, 3)
+	leaves := make([]MTLeaf, 0, len(vks))
+	for i, vk := range vks {
+		leaves = append(leaves, MTLeaf{VerificationKey: vk, Stake: Stake(i + 1)})
+	}
+	tree, err := Create(leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last leaf of a three leaf tree has no sibling, so its path runs
+	// through a padded node.
+	path, err := tree.GetBatchedPath([]uint64{0, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.ToCommitmentBatchCompat().Check([]MTLeaf{leaves[0], leaves[2]}, path); err != nil {
+		t.Fatal(err)
+	}
+
+	// Sibling leaves share their parent on the way up.
+	path, err = tree.GetBatchedPath([]uint64{0, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.ToCommitmentBatchCompat().Check(leaves, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAggregateOrdersSignaturesBySignerIndex(t *testing.T) {
+	params := &StmParameters{K: 4, M: 50, PhiF: 0.8}
+	var initializers []*StmInitializer
+	var parties []RegParty
+	var totalStake Stake
+	for i := 0; i < 6; i++ {
+		seed := sha256.Sum256([]byte{byte(i)})
+		sk, err := Gen(seed[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		pk, err := new(StmVerificationKeyPoP).FromSigningKey(sk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		initializers = append(initializers, &StmInitializer{Stake: 100, Params: params, Sk: sk, Pk: pk})
+		parties = append(parties, RegParty{VerificationKey: pk.VK, Stake: 100})
+		totalStake += 100
+	}
+	tree, err := Create(parties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedReg := &ClosedKeyReg{RegParties: parties, TotalStake: totalStake, MerkleTree: tree}
+	clerk := FromRegistration(params, closedReg)
+
+	msg := []byte("message")
+	var signatures []*StmSig
+	for _, initializer := range initializers {
+		signer, err := initializer.NewSigner(closedReg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature, err := signer.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signature != nil {
+			signatures = append(signatures, signature)
+		}
+	}
+
+	// Deduplication walks a map, so aggregation must not depend on the order
+	// in which it returns the signatures.
+	for i := 0; i < 20; i++ {
+		aggregate, err := clerk.Aggregate(signatures, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := 1; j < len(aggregate.Signatures); j++ {
+			if aggregate.Signatures[j-1].Sig.SignerIndex >= aggregate.Signatures[j].Sig.SignerIndex {
+				t.Fatalf("signature %d has signer index %d after %d", j, aggregate.Signatures[j].Sig.SignerIndex, aggregate.Signatures[j-1].Sig.SignerIndex)
+			}
+		}
+		if err := aggregate.Verify(msg, clerk.ComputeAVK(), params); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,401 @@
+package mithril
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the ibc.lightclients.mithril.v1 messages decoded here.
+const (
+	certificateHashField                     = 1
+	certificatePreviousHashField             = 2
+	certificateEpochField                    = 3
+	certificateSignedEntityTypeField         = 4
+	certificateMetadataField                 = 5
+	certificateProtocolMessageField          = 6
+	certificateSignedMessageField            = 7
+	certificateAggregateVerificationKeyField = 8
+	certificateMultiSignatureField           = 9
+	certificateGenesisSignatureField         = 10
+
+	metadataNetworkField            = 1
+	metadataProtocolVersionField    = 2
+	metadataProtocolParametersField = 3
+	metadataInitiatedAtField        = 4
+	metadataSealedAtField           = 5
+	metadataSignersField            = 6
+
+	protocolParametersKField    = 1
+	protocolParametersMField    = 2
+	protocolParametersPhiFField = 3
+	fractionNumeratorField      = 1
+	fractionDenominatorField    = 2
+
+	signerPartyIdField = 1
+	signerStakeField   = 2
+
+	protocolMessagePartsField = 1
+	messagePartKeyField       = 1
+	messagePartValueField     = 2
+
+	// Every signed entity type carries its epoch as field 1. Transactions
+	// types carry their block number as field 2, and CardanoImmutableFilesFull
+	// wraps a CardanoDbBeacon as field 1.
+	signedEntityEpochField       = 1
+	signedEntityBlockNumberField = 2
+	dbBeaconField                = 1
+	dbBeaconNetworkField         = 1
+	dbBeaconEpochField           = 2
+	dbBeaconImmutableFileField   = 3
+)
+
+// UnmarshalCertificate decodes a protobuf-encoded MithrilCertificate and
+// checks that it carries everything certificate verification reads.
+func UnmarshalCertificate(bz []byte) (*Certificate, error) {
+	certificate := &Certificate{}
+	err := decodeFields(bz, func(f field) error {
+		switch f.num {
+		case certificateHashField:
+			return f.string(&certificate.Hash)
+		case certificatePreviousHashField:
+			return f.string(&certificate.PreviousHash)
+		case certificateEpochField:
+			return f.uint64(&certificate.Epoch)
+		case certificateSignedEntityTypeField:
+			var err error
+			certificate.SignedEntityType, err = decodeSignedEntityType(f)
+			return err
+		case certificateMetadataField:
+			var err error
+			certificate.Metadata, err = decodeMetadata(f)
+			return err
+		case certificateProtocolMessageField:
+			var err error
+			certificate.ProtocolMessage, err = decodeProtocolMessage(f)
+			return err
+		case certificateSignedMessageField:
+			return f.string(&certificate.SignedMessage)
+		case certificateAggregateVerificationKeyField:
+			return f.string(&certificate.AggregateVerificationKey)
+		case certificateMultiSignatureField:
+			return f.string(&certificate.MultiSignature)
+		case certificateGenesisSignatureField:
+			return f.string(&certificate.GenesisSignature)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if certificate.Metadata == nil || certificate.Metadata.ProtocolParameters == nil {
+		return nil, fmt.Errorf("certificate metadata and protocol parameters must be present")
+	}
+	if err := certificate.Metadata.ProtocolParameters.validate(); err != nil {
+		return nil, err
+	}
+	if certificate.ProtocolMessage == nil {
+		certificate.ProtocolMessage = &ProtocolMessage{}
+	}
+	if err := certificate.ProtocolMessage.validate(); err != nil {
+		return nil, err
+	}
+	switch {
+	case certificate.MultiSignature != "" && certificate.GenesisSignature != "":
+		return nil, fmt.Errorf("certificate has both a multi-signature and a genesis signature")
+	case certificate.MultiSignature != "":
+		if certificate.SignedEntityType == nil {
+			return nil, fmt.Errorf("signed entity type must be present")
+		}
+	case certificate.GenesisSignature == "":
+		return nil, fmt.Errorf("certificate has neither a multi-signature nor a genesis signature")
+	}
+	return certificate, nil
+}
+
+// Marshal encodes the certificate as a protobuf MithrilCertificate.
+func (c *Certificate) Marshal() []byte {
+	var bz []byte
+	bz = appendString(bz, certificateHashField, c.Hash)
+	bz = appendString(bz, certificatePreviousHashField, c.PreviousHash)
+	bz = appendUint64(bz, certificateEpochField, c.Epoch)
+	if c.SignedEntityType != nil {
+		bz = appendMessage(bz, certificateSignedEntityTypeField, c.SignedEntityType.marshal())
+	}
+	if c.Metadata != nil {
+		bz = appendMessage(bz, certificateMetadataField, c.Metadata.marshal())
+	}
+	if c.ProtocolMessage != nil {
+		bz = appendMessage(bz, certificateProtocolMessageField, c.ProtocolMessage.marshal())
+	}
+	bz = appendString(bz, certificateSignedMessageField, c.SignedMessage)
+	bz = appendString(bz, certificateAggregateVerificationKeyField, c.AggregateVerificationKey)
+	bz = appendString(bz, certificateMultiSignatureField, c.MultiSignature)
+	bz = appendString(bz, certificateGenesisSignatureField, c.GenesisSignature)
+	return bz
+}
+
+func decodeSignedEntityType(f field) (*SignedEntityType, error) {
+	set := &SignedEntityType{}
+	err := f.message(func(entity field) error {
+		if entity.num < protowire.Number(MithrilStakeDistribution) || entity.num > protowire.Number(CardanoBlocksTransactions) {
+			return nil
+		}
+		set.Kind = SignedEntityKind(entity.num)
+		return entity.message(func(inner field) error {
+			if set.Kind == CardanoImmutableFilesFull {
+				if inner.num != dbBeaconField {
+					return nil
+				}
+				return inner.message(func(beacon field) error {
+					switch beacon.num {
+					case dbBeaconNetworkField:
+						return beacon.string(&set.Network)
+					case dbBeaconEpochField:
+						return beacon.uint64(&set.Epoch)
+					case dbBeaconImmutableFileField:
+						return beacon.uint64(&set.ImmutableFileNumber)
+					}
+					return nil
+				})
+			}
+			switch inner.num {
+			case signedEntityEpochField:
+				return inner.uint64(&set.Epoch)
+			case signedEntityBlockNumberField:
+				if set.Kind == CardanoTransactions || set.Kind == CardanoBlocksTransactions {
+					return inner.uint64(&set.BlockNumber)
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if set.Kind == 0 {
+		return nil, fmt.Errorf("signed entity type must be set")
+	}
+	return set, nil
+}
+
+func (s *SignedEntityType) marshal() []byte {
+	var inner []byte
+	switch s.Kind {
+	case CardanoImmutableFilesFull:
+		var beacon []byte
+		beacon = appendString(beacon, dbBeaconNetworkField, s.Network)
+		beacon = appendUint64(beacon, dbBeaconEpochField, s.Epoch)
+		beacon = appendUint64(beacon, dbBeaconImmutableFileField, s.ImmutableFileNumber)
+		inner = appendMessage(inner, dbBeaconField, beacon)
+	case CardanoTransactions, CardanoBlocksTransactions:
+		inner = appendUint64(inner, signedEntityEpochField, s.Epoch)
+		inner = appendUint64(inner, signedEntityBlockNumberField, s.BlockNumber)
+	default:
+		inner = appendUint64(inner, signedEntityEpochField, s.Epoch)
+	}
+	return appendMessage(nil, protowire.Number(s.Kind), inner)
+}
+
+func decodeMetadata(f field) (*CertificateMetadata, error) {
+	metadata := &CertificateMetadata{}
+	err := f.message(func(f field) error {
+		switch f.num {
+		case metadataNetworkField:
+			return f.string(&metadata.Network)
+		case metadataProtocolVersionField:
+			return f.string(&metadata.ProtocolVersion)
+		case metadataProtocolParametersField:
+			parameters := &ProtocolParameters{}
+			metadata.ProtocolParameters = parameters
+			return f.message(func(f field) error {
+				switch f.num {
+				case protocolParametersKField:
+					return f.uint64(&parameters.K)
+				case protocolParametersMField:
+					return f.uint64(&parameters.M)
+				case protocolParametersPhiFField:
+					return f.message(func(f field) error {
+						switch f.num {
+						case fractionNumeratorField:
+							return f.uint64(&parameters.PhiFNumerator)
+						case fractionDenominatorField:
+							return f.uint64(&parameters.PhiFDenominator)
+						}
+						return nil
+					})
+				}
+				return nil
+			})
+		case metadataInitiatedAtField:
+			return f.string(&metadata.InitiatedAt)
+		case metadataSealedAtField:
+			return f.string(&metadata.SealedAt)
+		case metadataSignersField:
+			var signer Signer
+			err := f.message(func(f field) error {
+				switch f.num {
+				case signerPartyIdField:
+					return f.string(&signer.PartyId)
+				case signerStakeField:
+					return f.uint64(&signer.Stake)
+				}
+				return nil
+			})
+			metadata.Signers = append(metadata.Signers, signer)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func (m *CertificateMetadata) marshal() []byte {
+	var bz []byte
+	bz = appendString(bz, metadataNetworkField, m.Network)
+	bz = appendString(bz, metadataProtocolVersionField, m.ProtocolVersion)
+	if m.ProtocolParameters != nil {
+		var fraction []byte
+		fraction = appendUint64(fraction, fractionNumeratorField, m.ProtocolParameters.PhiFNumerator)
+		fraction = appendUint64(fraction, fractionDenominatorField, m.ProtocolParameters.PhiFDenominator)
+		var parameters []byte
+		parameters = appendUint64(parameters, protocolParametersKField, m.ProtocolParameters.K)
+		parameters = appendUint64(parameters, protocolParametersMField, m.ProtocolParameters.M)
+		parameters = appendMessage(parameters, protocolParametersPhiFField, fraction)
+		bz = appendMessage(bz, metadataProtocolParametersField, parameters)
+	}
+	bz = appendString(bz, metadataInitiatedAtField, m.InitiatedAt)
+	bz = appendString(bz, metadataSealedAtField, m.SealedAt)
+	for _, signer := range m.Signers {
+		var signerBz []byte
+		signerBz = appendString(signerBz, signerPartyIdField, signer.PartyId)
+		signerBz = appendUint64(signerBz, signerStakeField, signer.Stake)
+		bz = appendMessage(bz, metadataSignersField, signerBz)
+	}
+	return bz
+}
+
+func decodeProtocolMessage(f field) (*ProtocolMessage, error) {
+	message := &ProtocolMessage{}
+	err := f.message(func(f field) error {
+		if f.num != protocolMessagePartsField {
+			return nil
+		}
+		var part MessagePart
+		err := f.message(func(f field) error {
+			switch f.num {
+			case messagePartKeyField:
+				var key uint64
+				if err := f.uint64(&key); err != nil {
+					return err
+				}
+				part.Key = ProtocolMessagePartKey(key)
+			case messagePartValueField:
+				return f.string(&part.Value)
+			}
+			return nil
+		})
+		message.Parts = append(message.Parts, part)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func (m *ProtocolMessage) marshal() []byte {
+	var bz []byte
+	for _, part := range m.Parts {
+		var partBz []byte
+		partBz = appendUint64(partBz, messagePartKeyField, uint64(part.Key))
+		partBz = appendString(partBz, messagePartValueField, part.Value)
+		bz = appendMessage(bz, protocolMessagePartsField, partBz)
+	}
+	return bz
+}
+
+// field is one decoded protobuf field. Unknown fields are skipped, so only
+// the fields read through its accessors are checked for their wire type.
+type field struct {
+	num    protowire.Number
+	typ    protowire.Type
+	bytes  []byte
+	varint uint64
+}
+
+func (f field) string(out *string) error {
+	if f.typ != protowire.BytesType {
+		return fmt.Errorf("field %d: expected a length-delimited value", f.num)
+	}
+	*out = string(f.bytes)
+	return nil
+}
+
+func (f field) uint64(out *uint64) error {
+	if f.typ != protowire.VarintType {
+		return fmt.Errorf("field %d: expected a varint", f.num)
+	}
+	*out = f.varint
+	return nil
+}
+
+func (f field) message(visit func(field) error) error {
+	if f.typ != protowire.BytesType {
+		return fmt.Errorf("field %d: expected a length-delimited value", f.num)
+	}
+	return decodeFields(f.bytes, visit)
+}
+
+func decodeFields(bz []byte, visit func(field) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		f := field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.varint, n = protowire.ConsumeVarint(bz)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(bz)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+		if err := visit(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendString(bz []byte, num protowire.Number, value string) []byte {
+	if value == "" {
+		return bz
+	}
+	bz = protowire.AppendTag(bz, num, protowire.BytesType)
+	return protowire.AppendString(bz, value)
+}
+
+func appendUint64(bz []byte, num protowire.Number, value uint64) []byte {
+	if value == 0 {
+		return bz
+	}
+	bz = protowire.AppendTag(bz, num, protowire.VarintType)
+	return protowire.AppendVarint(bz, value)
+}
+
+func appendMessage(bz []byte, num protowire.Number, value []byte) []byte {
+	bz = protowire.AppendTag(bz, num, protowire.BytesType)
+	return protowire.AppendBytes(bz, value)
+}
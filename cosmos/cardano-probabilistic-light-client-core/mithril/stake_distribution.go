@@ -0,0 +1,61 @@
+package mithril
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"golang.org/x/crypto/blake2s"
+)
+
+// CardanoStakeDistributionMerkleRoot returns the hex-encoded root signed in the
+// cardano_stake_distribution_merkle_root message part. As in the aggregator,
+// each leaf is the string "{pool_id}{stake}", leaves are ordered by pool id,
+// and the root is that of a Merkle mountain range merging with Blake2s-256.
+func CardanoStakeDistributionMerkleRoot(stakes map[string]uint64) (string, error) {
+	if len(stakes) == 0 {
+		return "", fmt.Errorf("cannot compute stake distribution merkle root without pools")
+	}
+	poolIDs := make([]string, 0, len(stakes))
+	for poolID := range stakes {
+		poolIDs = append(poolIDs, poolID)
+	}
+	sort.Strings(poolIDs)
+
+	leaves := make([][]byte, 0, len(poolIDs))
+	for _, poolID := range poolIDs {
+		leaves = append(leaves, []byte(poolID+strconv.FormatUint(stakes[poolID], 10)))
+	}
+	return hex.EncodeToString(merkleMountainRangeRoot(leaves)), nil
+}
+
+// merkleMountainRangeRoot pushes leaves, unhashed, into a Merkle mountain range
+// and bags its peaks right to left.
+func merkleMountainRangeRoot(leaves [][]byte) []byte {
+	type peak struct {
+		height int
+		hash   []byte
+	}
+	var peaks []peak
+	for _, leaf := range leaves {
+		current := peak{hash: leaf}
+		for len(peaks) > 0 && peaks[len(peaks)-1].height == current.height {
+			left := peaks[len(peaks)-1]
+			peaks = peaks[:len(peaks)-1]
+			current = peak{height: current.height + 1, hash: mergeMountainRangeNodes(left.hash, current.hash)}
+		}
+		peaks = append(peaks, current)
+	}
+
+	root := peaks[len(peaks)-1].hash
+	for i := len(peaks) - 2; i >= 0; i-- {
+		root = mergeMountainRangeNodes(root, peaks[i].hash)
+	}
+	return root
+}
+
+func mergeMountainRangeNodes(left, right []byte) []byte {
+	hash := blake2s.Sum256(append(append([]byte(nil), left...), right...))
+	return hash[:]
+}
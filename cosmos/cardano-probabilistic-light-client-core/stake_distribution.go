@@ -0,0 +1,156 @@
+package probabilisticcore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// StakeDistributionHashLength is the byte length of stake distribution leaf,
+// node and root hashes.
+const StakeDistributionHashLength = blake2b.Size256
+
+const (
+	stakeDistributionLeafPrefix  = 0x00
+	stakeDistributionInnerPrefix = 0x01
+)
+
+// StakeDistributionLeafHash commits one pool's stake distribution entry as
+// blake2b256(0x00 || len(pool_id) || pool_id || stake || vrf_key_hash ||
+// first_registration_slot), with the pool id lower-cased and the length and
+// integers big-endian.
+func StakeDistributionLeafHash(poolID string, stake uint64, vrfKeyHash []byte, firstRegistrationSlot uint64) []byte {
+	normalizedPoolID := strings.ToLower(poolID)
+	preimage := make([]byte, 0, 1+4+len(normalizedPoolID)+8+len(vrfKeyHash)+8)
+	preimage = append(preimage, stakeDistributionLeafPrefix)
+	preimage = binary.BigEndian.AppendUint32(preimage, uint32(len(normalizedPoolID)))
+	preimage = append(preimage, normalizedPoolID...)
+	preimage = binary.BigEndian.AppendUint64(preimage, stake)
+	preimage = append(preimage, vrfKeyHash...)
+	preimage = binary.BigEndian.AppendUint64(preimage, firstRegistrationSlot)
+	hash := blake2b.Sum256(preimage)
+	return hash[:]
+}
+
+// StakeDistributionRoot returns the Merkle root over leaf hashes that are
+// already ordered by lower-cased pool id. The tree splits n leaves at the
+// largest power of two below n, as in RFC 6962.
+func StakeDistributionRoot(leafHashes [][]byte) ([]byte, error) {
+	if len(leafHashes) == 0 {
+		return nil, fmt.Errorf("stake distribution must not be empty")
+	}
+	if len(leafHashes) == 1 {
+		return bytes.Clone(leafHashes[0]), nil
+	}
+	split := stakeDistributionSplit(uint64(len(leafHashes)))
+	left, err := StakeDistributionRoot(leafHashes[:split])
+	if err != nil {
+		return nil, err
+	}
+	right, err := StakeDistributionRoot(leafHashes[split:])
+	if err != nil {
+		return nil, err
+	}
+	return stakeDistributionInnerHash(left, right), nil
+}
+
+// StakeDistributionProof returns the sibling hashes, from the leaf up to the
+// root, proving the leaf at index.
+func StakeDistributionProof(leafHashes [][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(leafHashes) {
+		return nil, fmt.Errorf("stake distribution leaf index %d out of range for %d leaves", index, len(leafHashes))
+	}
+	if len(leafHashes) == 1 {
+		return nil, nil
+	}
+	split := int(stakeDistributionSplit(uint64(len(leafHashes))))
+	if index < split {
+		siblings, err := StakeDistributionProof(leafHashes[:split], index)
+		if err != nil {
+			return nil, err
+		}
+		right, err := StakeDistributionRoot(leafHashes[split:])
+		if err != nil {
+			return nil, err
+		}
+		return append(siblings, right), nil
+	}
+	siblings, err := StakeDistributionProof(leafHashes[split:], index-split)
+	if err != nil {
+		return nil, err
+	}
+	left, err := StakeDistributionRoot(leafHashes[:split])
+	if err != nil {
+		return nil, err
+	}
+	return append(siblings, left), nil
+}
+
+// VerifyStakeDistributionProof checks that leafHash is the leaf at index of a
+// stake distribution tree with total leaves and the given root.
+func VerifyStakeDistributionProof(root, leafHash []byte, index, total uint64, siblings [][]byte) error {
+	if total == 0 {
+		return fmt.Errorf("stake distribution must not be empty")
+	}
+	if index >= total {
+		return fmt.Errorf("stake distribution leaf index %d out of range for %d leaves", index, total)
+	}
+	for i, sibling := range siblings {
+		if len(sibling) != StakeDistributionHashLength {
+			return fmt.Errorf("stake distribution sibling %d must be %d bytes, got %d", i, StakeDistributionHashLength, len(sibling))
+		}
+	}
+	computed, err := stakeDistributionRootFromProof(leafHash, index, total, siblings)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(computed, root) {
+		return fmt.Errorf("stake distribution proof does not match root")
+	}
+	return nil
+}
+
+func stakeDistributionRootFromProof(leafHash []byte, index, total uint64, siblings [][]byte) ([]byte, error) {
+	if total == 1 {
+		if len(siblings) != 0 {
+			return nil, fmt.Errorf("stake distribution proof has %d unused siblings", len(siblings))
+		}
+		return leafHash, nil
+	}
+	if len(siblings) == 0 {
+		return nil, fmt.Errorf("stake distribution proof is too short")
+	}
+	top := siblings[len(siblings)-1]
+	split := stakeDistributionSplit(total)
+	if index < split {
+		left, err := stakeDistributionRootFromProof(leafHash, index, split, siblings[:len(siblings)-1])
+		if err != nil {
+			return nil, err
+		}
+		return stakeDistributionInnerHash(left, top), nil
+	}
+	right, err := stakeDistributionRootFromProof(leafHash, index-split, total-split, siblings[:len(siblings)-1])
+	if err != nil {
+		return nil, err
+	}
+	return stakeDistributionInnerHash(top, right), nil
+}
+
+// stakeDistributionSplit returns the largest power of two strictly below n,
+// for n > 1.
+func stakeDistributionSplit(n uint64) uint64 {
+	return uint64(1) << (bits.Len64(n-1) - 1)
+}
+
+func stakeDistributionInnerHash(left, right []byte) []byte {
+	preimage := make([]byte, 0, 1+len(left)+len(right))
+	preimage = append(preimage, stakeDistributionInnerPrefix)
+	preimage = append(preimage, left...)
+	preimage = append(preimage, right...)
+	hash := blake2b.Sum256(preimage)
+	return hash[:]
+}
@@ -0,0 +1,89 @@
+package probabilisticcore
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func testStakeDistributionLeaves(n int) [][]byte {
+	leaves := make([][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		leaves = append(leaves, StakeDistributionLeafHash(
+			fmt.Sprintf("pool%03d", i),
+			uint64(1_000+i),
+			bytes.Repeat([]byte{byte(i)}, 32),
+			uint64(i+1),
+		))
+	}
+	return leaves
+}
+
+func TestStakeDistributionLeafHashIgnoresPoolIDCase(t *testing.T) {
+	vrfKeyHash := bytes.Repeat([]byte{0x01}, 32)
+	lower := StakeDistributionLeafHash("pool1abc", 10, vrfKeyHash, 5)
+	upper := StakeDistributionLeafHash("POOL1ABC", 10, vrfKeyHash, 5)
+	if !bytes.Equal(lower, upper) {
+		t.Fatalf("leaf hash depends on pool id case: %x != %x", lower, upper)
+	}
+	if bytes.Equal(lower, StakeDistributionLeafHash("pool1abc", 11, vrfKeyHash, 5)) {
+		t.Fatalf("leaf hash does not commit to stake")
+	}
+	if bytes.Equal(lower, StakeDistributionLeafHash("pool1abc", 10, vrfKeyHash, 6)) {
+		t.Fatalf("leaf hash does not commit to first registration slot")
+	}
+}
+
+func TestStakeDistributionProofsVerifyForEveryLeaf(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 8, 13} {
+		leaves := testStakeDistributionLeaves(n)
+		root, err := StakeDistributionRoot(leaves)
+		if err != nil {
+			t.Fatalf("n=%d: root: %v", n, err)
+		}
+		for i := range leaves {
+			siblings, err := StakeDistributionProof(leaves, i)
+			if err != nil {
+				t.Fatalf("n=%d i=%d: proof: %v", n, i, err)
+			}
+			if err := VerifyStakeDistributionProof(root, leaves[i], uint64(i), uint64(n), siblings); err != nil {
+				t.Fatalf("n=%d i=%d: verify: %v", n, i, err)
+			}
+		}
+	}
+}
+
+func TestVerifyStakeDistributionProofRejectsTampering(t *testing.T) {
+	leaves := testStakeDistributionLeaves(5)
+	root, err := StakeDistributionRoot(leaves)
+	if err != nil {
+		t.Fatalf("root: %v", err)
+	}
+	siblings, err := StakeDistributionProof(leaves, 3)
+	if err != nil {
+		t.Fatalf("proof: %v", err)
+	}
+
+	if err := VerifyStakeDistributionProof(root, leaves[2], 3, 5, siblings); err == nil {
+		t.Fatalf("expected a different leaf to be rejected")
+	}
+	if err := VerifyStakeDistributionProof(root, leaves[3], 2, 5, siblings); err == nil {
+		t.Fatalf("expected a wrong index to be rejected")
+	}
+	lastSiblings, err := StakeDistributionProof(leaves, 4)
+	if err != nil {
+		t.Fatalf("proof: %v", err)
+	}
+	if err := VerifyStakeDistributionProof(root, leaves[4], 4, 6, lastSiblings); err == nil {
+		t.Fatalf("expected a wrong leaf count to be rejected")
+	}
+	if err := VerifyStakeDistributionProof(root, leaves[3], 3, 5, siblings[:len(siblings)-1]); err == nil {
+		t.Fatalf("expected a truncated proof to be rejected")
+	}
+	if err := VerifyStakeDistributionProof(root, leaves[3], 5, 5, siblings); err == nil {
+		t.Fatalf("expected an out-of-range index to be rejected")
+	}
+	if _, err := StakeDistributionRoot(nil); err == nil {
+		t.Fatalf("expected an empty stake distribution to be rejected")
+	}
+}
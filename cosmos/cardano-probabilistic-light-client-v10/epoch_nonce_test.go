@@ -0,0 +1,108 @@
+package probabilistic
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvolveEpochNonceDerivesNextEpochNonce(t *testing.T) {
+	cs, contexts, header := newTestEpochNonceRollover()
+	bridge, anchor := header.bridgeBlocks[0], header.anchorBlock
+
+	evolving := probabilisticcore.CombineNonces(cs.EpochNonceEvolution.EvolvingNonce, probabilisticcore.VrfNonceValue(bridge.vrfOutput))
+	derivedNonce := probabilisticcore.CombineNonces(evolving, cs.EpochNonceEvolution.LastEpochBlockNonce)
+	contexts[1].EpochNonce = derivedNonce
+
+	evolution, err := cs.evolveEpochNonce(7, header, contexts)
+	require.NoError(t, err)
+
+	bridgeLab, err := hex.DecodeString(bridge.prevHash)
+	require.NoError(t, err)
+	anchorLab, err := hex.DecodeString(anchor.prevHash)
+	require.NoError(t, err)
+	evolving = probabilisticcore.CombineNonces(evolving, probabilisticcore.VrfNonceValue(anchor.vrfOutput))
+	require.Equal(t, evolving, evolution.EvolvingNonce)
+	require.Equal(t, evolving, evolution.CandidateNonce)
+	require.Equal(t, bridgeLab, evolution.LastEpochBlockNonce)
+	require.Equal(t, anchorLab, evolution.LabNonce)
+	require.Equal(t, uint64(100), evolution.RandomnessStabilityWindowSlots)
+}
+
+func TestEvolveEpochNonceRejectsUnderivedNonce(t *testing.T) {
+	cs, contexts, header := newTestEpochNonceRollover()
+	contexts[1].EpochNonce = bytes.Repeat([]byte{0x99}, 32)
+
+	_, err := cs.evolveEpochNonce(7, header, contexts)
+	require.ErrorIs(t, err, ErrInvalidEpochNonce)
+	require.ErrorContains(t, err, "epoch 8 nonce")
+
+	cs.EpochNonceEvolution = nil
+	evolution, err := cs.evolveEpochNonce(7, header, contexts)
+	require.NoError(t, err)
+	require.Nil(t, evolution)
+}
+
+func TestEvolveEpochNonceFreezesCandidateInStabilityWindow(t *testing.T) {
+	cs, contexts, header := newTestEpochNonceRollover()
+	header.bridgeBlocks[0].slot = 950
+	contexts[1].EpochNonce = probabilisticcore.CombineNonces(
+		cs.EpochNonceEvolution.CandidateNonce,
+		cs.EpochNonceEvolution.LastEpochBlockNonce,
+	)
+
+	_, err := cs.evolveEpochNonce(7, header, contexts)
+	require.NoError(t, err)
+}
+
+func TestClientStateValidateRejectsInvalidEpochNonceEvolution(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	cs.EpochNonceEvolution = &EpochNonceEvolution{RandomnessStabilityWindowSlots: 100}
+	require.NoError(t, cs.Validate())
+
+	cs.EpochNonceEvolution.LabNonce = []byte{0x01}
+	require.ErrorIs(t, cs.Validate(), ErrInvalidEpochNonce)
+
+	cs.EpochNonceEvolution = &EpochNonceEvolution{}
+	require.ErrorIs(t, cs.Validate(), ErrInvalidEpochNonce)
+}
+
+// newTestEpochNonceRollover returns a client authenticating epoch nonces with
+// a header whose bridge block closes epoch 7 and whose anchor opens epoch 8.
+func newTestEpochNonceRollover() (*ClientState, []*EpochContext, *authenticatedProbabilisticHeader) {
+	cs := newProbabilisticTestClientState()
+	cs.EpochNonceEvolution = &EpochNonceEvolution{
+		RandomnessStabilityWindowSlots: 100,
+		EvolvingNonce:                  bytes.Repeat([]byte{0x21}, 32),
+		CandidateNonce:                 bytes.Repeat([]byte{0x22}, 32),
+		LabNonce:                       bytes.Repeat([]byte{0x23}, 32),
+		LastEpochBlockNonce:            bytes.Repeat([]byte{0x24}, 32),
+	}
+	contexts := []*EpochContext{
+		{Epoch: 7, EpochStartSlot: 0, EpochEndSlotExclusive: 1_000},
+		{Epoch: 8, EpochStartSlot: 1_000, EpochEndSlotExclusive: 2_000},
+	}
+	bridgeHash := hex.EncodeToString(bytes.Repeat([]byte{0x32}, 32))
+	header := &authenticatedProbabilisticHeader{
+		bridgeBlocks: []*authenticatedProbabilisticBlock{{
+			height:    11,
+			slot:      500,
+			hash:      bridgeHash,
+			prevHash:  hex.EncodeToString(bytes.Repeat([]byte{0x31}, 32)),
+			epoch:     7,
+			vrfOutput: bytes.Repeat([]byte{0x41}, 64),
+		}},
+		anchorBlock: &authenticatedProbabilisticBlock{
+			height:    12,
+			slot:      1_010,
+			hash:      hex.EncodeToString(bytes.Repeat([]byte{0x33}, 32)),
+			prevHash:  bridgeHash,
+			epoch:     8,
+			vrfOutput: bytes.Repeat([]byte{0x42}, 64),
+		},
+	}
+	return cs, contexts, header
+}
@@ -0,0 +1,143 @@
+package probabilistic
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func cloneEraHistory(eras []*EraSummary) []*EraSummary {
+	if len(eras) == 0 {
+		return nil
+	}
+	cloned := make([]*EraSummary, 0, len(eras))
+	for _, era := range eras {
+		if era == nil {
+			continue
+		}
+		cloned = append(cloned, &EraSummary{
+			StartSlot:       era.StartSlot,
+			StartTimeUnixNs: era.StartTimeUnixNs,
+			SlotLengthNs:    era.SlotLengthNs,
+		})
+	}
+	return cloned
+}
+
+// eraHistory returns the validated era history of the client. A client
+// without era_history has a single era starting at slot 0.
+func (cs ClientState) eraHistory() ([]*EraSummary, error) {
+	if len(cs.EraHistory) == 0 {
+		if cs.SystemStartUnixNs == 0 {
+			return nil, errorsmod.Wrap(ErrInvalidTimestamp, "system_start_unix_ns must be greater than zero")
+		}
+		if cs.SlotLengthNs == 0 {
+			return nil, errorsmod.Wrap(ErrInvalidTimestamp, "slot_length_ns must be greater than zero")
+		}
+		return []*EraSummary{{StartSlot: 0, StartTimeUnixNs: cs.SystemStartUnixNs, SlotLengthNs: cs.SlotLengthNs}}, nil
+	}
+	if err := validateEraHistory(cs.EraHistory); err != nil {
+		return nil, err
+	}
+	return cs.EraHistory, nil
+}
+
+// validateEraHistory requires the eras to start at slot 0, to be ordered by
+// start slot, and to each begin exactly when the previous era ends.
+func validateEraHistory(eras []*EraSummary) error {
+	for i, era := range eras {
+		if era == nil {
+			return errorsmod.Wrapf(ErrInvalidTimestamp, "era history entry %d cannot be nil", i)
+		}
+		if era.SlotLengthNs == 0 {
+			return errorsmod.Wrapf(ErrInvalidTimestamp, "era history entry %d slot_length_ns must be greater than zero", i)
+		}
+		if i == 0 {
+			if era.StartSlot != 0 {
+				return errorsmod.Wrapf(ErrInvalidTimestamp, "era history must start at slot 0, got %d", era.StartSlot)
+			}
+			if era.StartTimeUnixNs == 0 {
+				return errorsmod.Wrap(ErrInvalidTimestamp, "era history start_time_unix_ns must be greater than zero")
+			}
+			continue
+		}
+		previous := eras[i-1]
+		if era.StartSlot <= previous.StartSlot {
+			return errorsmod.Wrapf(ErrInvalidTimestamp, "era history entry %d start slot %d must be greater than %d", i, era.StartSlot, previous.StartSlot)
+		}
+		previousEnd, err := eraSlotTime(previous, era.StartSlot)
+		if err != nil {
+			return err
+		}
+		if era.StartTimeUnixNs != previousEnd {
+			return errorsmod.Wrapf(
+				ErrInvalidTimestamp,
+				"era history entry %d start time %d does not match the end of the previous era %d",
+				i,
+				era.StartTimeUnixNs,
+				previousEnd,
+			)
+		}
+	}
+	return nil
+}
+
+// eraForSlot returns the last era starting at or before slot.
+func eraForSlot(eras []*EraSummary, slot uint64) *EraSummary {
+	var found *EraSummary
+	for _, era := range eras {
+		if era.StartSlot > slot {
+			break
+		}
+		found = era
+	}
+	return found
+}
+
+func eraSlotTime(era *EraSummary, slot uint64) (uint64, error) {
+	elapsed := slot - era.StartSlot
+	if elapsed > (math.MaxUint64-era.StartTimeUnixNs)/era.SlotLengthNs {
+		return 0, errorsmod.Wrapf(ErrInvalidTimestamp, "slot-derived timestamp overflows uint64 for slot %d", slot)
+	}
+	return era.StartTimeUnixNs + elapsed*era.SlotLengthNs, nil
+}
+
+// firstSlotAtOrAfter returns the first slot that starts at or after timeUnixNs.
+func firstSlotAtOrAfter(eras []*EraSummary, timeUnixNs uint64) (uint64, error) {
+	era := eras[0]
+	if timeUnixNs <= era.StartTimeUnixNs {
+		return era.StartSlot, nil
+	}
+	for _, candidate := range eras[1:] {
+		if candidate.StartTimeUnixNs > timeUnixNs {
+			break
+		}
+		era = candidate
+	}
+
+	delta := timeUnixNs - era.StartTimeUnixNs
+	if delta > math.MaxUint64-(era.SlotLengthNs-1) {
+		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "slot for time overflows uint64")
+	}
+	slots := (delta + era.SlotLengthNs - 1) / era.SlotLengthNs
+	if slots > math.MaxUint64-era.StartSlot {
+		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "slot for time overflows uint64")
+	}
+	return era.StartSlot + slots, nil
+}
+
+// eraHistoryExtends reports whether next keeps every era of previous and only
+// appends later ones.
+func eraHistoryExtends(previous, next []*EraSummary) bool {
+	if len(next) < len(previous) {
+		return false
+	}
+	for i, era := range previous {
+		if era.StartSlot != next[i].StartSlot ||
+			era.StartTimeUnixNs != next[i].StartTimeUnixNs ||
+			era.SlotLengthNs != next[i].SlotLengthNs {
+			return false
+		}
+	}
+	return true
+}
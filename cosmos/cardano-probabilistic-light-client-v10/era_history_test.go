@@ -0,0 +1,116 @@
+package probabilistic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMainnetSystemStartUnixNs = 1_506_203_091_000_000_000
+	testMainnetShelleyStartSlot  = 4_492_800
+	testMainnetShelleyStartNs    = 1_596_059_091_000_000_000
+)
+
+func newTestMainnetEraHistory() []*EraSummary {
+	return []*EraSummary{
+		{StartSlot: 0, StartTimeUnixNs: testMainnetSystemStartUnixNs, SlotLengthNs: 20_000_000_000},
+		{StartSlot: testMainnetShelleyStartSlot, StartTimeUnixNs: testMainnetShelleyStartNs, SlotLengthNs: 1_000_000_000},
+	}
+}
+
+func TestDeriveTimestampFromSlotUsesEraHistory(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	cs.SystemStartUnixNs = 0
+	cs.SlotLengthNs = 0
+	cs.EraHistory = newTestMainnetEraHistory()
+	require.NoError(t, cs.Validate())
+
+	timestamp, err := cs.DeriveTimestampFromSlot(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(testMainnetSystemStartUnixNs+20_000_000_000), timestamp)
+
+	timestamp, err = cs.DeriveTimestampFromSlot(testMainnetShelleyStartSlot)
+	require.NoError(t, err)
+	require.Equal(t, uint64(testMainnetShelleyStartNs), timestamp)
+
+	// Shelley slot numbers map to unix seconds with a fixed offset on mainnet.
+	timestamp, err = cs.DeriveTimestampFromSlot(100_000_000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100_000_000+1_591_566_291)*1_000_000_000, timestamp)
+
+	cutoffSlot, err := cs.poolRegistrationCutoffSlotExclusive()
+	require.NoError(t, err)
+	require.Equal(t, poolRegistrationCutoffUnixNs/1_000_000_000-1_591_566_291, cutoffSlot)
+}
+
+func TestDeriveTimestampFromSlotFallsBackToSingleEra(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+
+	timestamp, err := cs.DeriveTimestampFromSlot(10)
+	require.NoError(t, err)
+	require.Equal(t, cs.SystemStartUnixNs+10*cs.SlotLengthNs, timestamp)
+
+	cs.SlotLengthNs = 0
+	_, err = cs.DeriveTimestampFromSlot(10)
+	require.ErrorIs(t, err, ErrInvalidTimestamp)
+	require.ErrorContains(t, err, "slot_length_ns must be greater than zero")
+}
+
+func TestValidateEraHistory(t *testing.T) {
+	require.NoError(t, validateEraHistory(newTestMainnetEraHistory()))
+
+	testCases := []struct {
+		name   string
+		mutate func([]*EraSummary)
+		want   string
+	}{
+		{
+			name:   "first era after slot 0",
+			mutate: func(eras []*EraSummary) { eras[0].StartSlot = 1 },
+			want:   "era history must start at slot 0",
+		},
+		{
+			name:   "zero slot length",
+			mutate: func(eras []*EraSummary) { eras[1].SlotLengthNs = 0 },
+			want:   "slot_length_ns must be greater than zero",
+		},
+		{
+			name: "non-increasing start slot",
+			mutate: func(eras []*EraSummary) {
+				eras[1].StartSlot = 0
+				eras[1].StartTimeUnixNs = testMainnetSystemStartUnixNs
+			},
+			want: "start slot 0 must be greater than 0",
+		},
+		{
+			name:   "discontinuous start time",
+			mutate: func(eras []*EraSummary) { eras[1].StartTimeUnixNs++ },
+			want:   "does not match the end of the previous era",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eras := newTestMainnetEraHistory()
+			tc.mutate(eras)
+			err := validateEraHistory(eras)
+			require.ErrorIs(t, err, ErrInvalidTimestamp)
+			require.ErrorContains(t, err, tc.want)
+
+			cs := newProbabilisticTestClientState()
+			cs.EraHistory = eras
+			require.ErrorIs(t, cs.Validate(), ErrInvalidTimestamp)
+		})
+	}
+}
+
+func TestEraHistoryExtends(t *testing.T) {
+	eras := newTestMainnetEraHistory()
+	require.True(t, eraHistoryExtends(eras[:1], eras))
+	require.True(t, eraHistoryExtends(eras, cloneEraHistory(eras)))
+	require.False(t, eraHistoryExtends(eras, eras[:1]))
+
+	changed := cloneEraHistory(eras)
+	changed[1].SlotLengthNs = 2_000_000_000
+	require.False(t, eraHistoryExtends(eras, changed))
+}
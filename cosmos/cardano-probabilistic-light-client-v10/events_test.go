@@ -37,6 +37,35 @@ func TestEmitProbabilisticHeaderAcceptedEvent(t *testing.T) {
 	require.Equal(t, "10000", eventAttributeValue(t, event, AttributeKeyUniqueStakeBps))
 }
 
+func TestEmitProbabilisticHeaderAcceptedEventReportsClientAcceptancePolicy(t *testing.T) {
+	ctx, _ := newProbabilisticTestClientStore(t, "probabilistic-events-policy")
+	header := newVerifiedTestHeader(t)
+	clientState := newProbabilisticTestClientState()
+	consensusState := newProbabilisticTestConsensusState(header.AnchorBlock.Hash)
+
+	emitProbabilisticHeaderAcceptedEvent(ctx, "08-cardano-probabilistic-0", header, clientState, consensusState, clientState.CurrentEpoch)
+	event := findEventByType(t, ctx.EventManager().Events(), EventTypeProbabilisticHeaderAccepted)
+	require.Equal(t, "24", eventAttributeValue(t, event, AttributeKeyThresholdDepth))
+	require.Equal(t, "5", eventAttributeValue(t, event, AttributeKeyThresholdUniquePools))
+	require.Equal(t, "511", eventAttributeValue(t, event, AttributeKeyThresholdUniqueStakeBps))
+	require.Equal(t, "0", eventAttributeValue(t, event, AttributeKeyThresholdChainDensityBps))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	clientState.AcceptancePolicy = &AcceptancePolicy{
+		ThresholdDepth:          100,
+		ThresholdUniquePools:    20,
+		ThresholdUniqueStakeBps: 2_500,
+		DepthWeightBps:          DefaultDepthWeightBps,
+		PoolsWeightBps:          DefaultPoolsWeightBps,
+		StakeWeightBps:          DefaultStakeWeightBps,
+	}
+	emitProbabilisticHeaderAcceptedEvent(ctx, "08-cardano-probabilistic-0", header, clientState, consensusState, clientState.CurrentEpoch)
+	event = findEventByType(t, ctx.EventManager().Events(), EventTypeProbabilisticHeaderAccepted)
+	require.Equal(t, "100", eventAttributeValue(t, event, AttributeKeyThresholdDepth))
+	require.Equal(t, "20", eventAttributeValue(t, event, AttributeKeyThresholdUniquePools))
+	require.Equal(t, "2500", eventAttributeValue(t, event, AttributeKeyThresholdUniqueStakeBps))
+}
+
 func TestLightClientModuleVerifyClientMessageEmitsRejectedEvent(t *testing.T) {
 	ctx, clientStore, module, clientID := newProbabilisticTestModule(t, "probabilistic-events-rejected")
 	cdc := newProbabilisticTestCodec()
@@ -71,6 +100,21 @@ func TestLightClientModuleUpdateStateOnMisbehaviourEmitsFrozenEvent(t *testing.T
 	require.Equal(t, "misbehaviour", eventAttributeValue(t, event, AttributeKeyReason))
 }
 
+func TestEmitProbabilisticHostStateShutdownEvent(t *testing.T) {
+	ctx, _, _, clientID := newProbabilisticTestModule(t, "probabilistic-events-shutdown")
+
+	emitProbabilisticHostStateShutdownEvent(ctx, clientID, NewHeight(0, 20), &HostStateShutdown{
+		InitiatedAtMs:    1_000,
+		GracePeriodEndMs: 2_000,
+	})
+
+	event := findEventByType(t, ctx.EventManager().Events(), EventTypeProbabilisticHostStateShutdown)
+	require.Equal(t, clientID, eventAttributeValue(t, event, AttributeKeyClientID))
+	require.Equal(t, NewHeight(0, 20).String(), eventAttributeValue(t, event, AttributeKeyShutdownHeight))
+	require.Equal(t, "1000", eventAttributeValue(t, event, AttributeKeyShutdownInitiatedAtMs))
+	require.Equal(t, "2000", eventAttributeValue(t, event, AttributeKeyShutdownGracePeriodEndMs))
+}
+
 func newProbabilisticTestModule(t *testing.T, keyName string) (sdk.Context, storetypes.KVStore, LightClientModule, string) {
 	t.Helper()
 
@@ -0,0 +1,203 @@
+package probabilistic
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// ClientStoreProvider returns the store of a client. It is satisfied by the
+// ibc-go client store provider and client keeper.
+type ClientStoreProvider interface {
+	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	cdc           codec.BinaryCodec
+	storeProvider ClientStoreProvider
+}
+
+func NewQueryServer(cdc codec.BinaryCodec, storeProvider ClientStoreProvider) QueryServer {
+	return queryServer{cdc: cdc, storeProvider: storeProvider}
+}
+
+func (q queryServer) SecurityMetrics(goCtx context.Context, req *QuerySecurityMetricsRequest) (*QuerySecurityMetricsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	clientStore, _, err := q.clientState(sdk.UnwrapSDKContext(goCtx), req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	scoreBz := clientStore.Get(ProbabilisticScoreKey(req.RevisionHeight))
+	poolsBz := clientStore.Get(UniquePoolsKey(req.RevisionHeight))
+	stakeBz := clientStore.Get(UniqueStakeKey(req.RevisionHeight))
+	if len(scoreBz) != 8 || len(poolsBz) != 8 || len(stakeBz) != 8 {
+		return nil, status.Errorf(codes.NotFound, "no security metrics stored for client %s at height %d", req.ClientId, req.RevisionHeight)
+	}
+	chainDensityBps := uint64(0)
+	if densityBz := clientStore.Get(ChainDensityKey(req.RevisionHeight)); len(densityBz) == 8 {
+		chainDensityBps = binary.BigEndian.Uint64(densityBz)
+	}
+	return &QuerySecurityMetricsResponse{
+		SecurityScoreBps:  binary.BigEndian.Uint64(scoreBz),
+		UniquePoolsCount:  binary.BigEndian.Uint64(poolsBz),
+		UniqueStakeBps:    binary.BigEndian.Uint64(stakeBz),
+		AcceptedBlockHash: string(clientStore.Get(AcceptedBlockHashKey(req.RevisionHeight))),
+		ChainDensityBps:   chainDensityBps,
+	}, nil
+}
+
+func (q queryServer) EpochContexts(goCtx context.Context, req *QueryEpochContextsRequest) (*QueryEpochContextsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	_, clientState, err := q.clientState(sdk.UnwrapSDKContext(goCtx), req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	contexts, err := clientState.normalizedEpochContexts()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	summaries := make([]*EpochContextSummary, 0, len(contexts))
+	for _, epochContext := range contexts {
+		summaries = append(summaries, &EpochContextSummary{
+			EpochContext: epochContext,
+			TotalStake:   epochContext.TotalStake,
+			PoolCount:    epochContext.PoolCount,
+		})
+	}
+	return &QueryEpochContextsResponse{EpochContexts: summaries, CurrentEpoch: clientState.CurrentEpoch}, nil
+}
+
+func (q queryServer) CheckpointCursor(goCtx context.Context, req *QueryCheckpointCursorRequest) (*QueryCheckpointCursorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	_, clientState, err := q.clientState(sdk.UnwrapSDKContext(goCtx), req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryCheckpointCursorResponse{
+		LatestCheckpointHeight:    clientState.LatestCheckpointHeight,
+		LatestCheckpointBlockHash: clientState.LatestCheckpointBlockHash,
+		LatestCheckpointEpoch:     clientState.LatestCheckpointEpoch,
+		LatestHeight:              clientState.LatestHeight,
+	}, nil
+}
+
+// VerifyHeader runs the trusted-state header checks on a cached context, so
+// nothing it touches is written back. A rejected header is reported in the
+// response rather than as a query error.
+func (q queryServer) VerifyHeader(goCtx context.Context, req *QueryVerifyHeaderRequest) (*QueryVerifyHeaderResponse, error) {
+	if req == nil || req.Header == nil {
+		return nil, status.Error(codes.InvalidArgument, "header must be provided")
+	}
+	cacheCtx, _ := sdk.UnwrapSDKContext(goCtx).CacheContext()
+	clientStore, clientState, err := q.clientState(cacheCtx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := clientState.verifyHeaderAgainstTrustedState(clientStore, q.cdc, req.Header); err != nil {
+		return &QueryVerifyHeaderResponse{RejectionReason: err.Error()}, nil
+	}
+	metrics, err := clientState.headerAnchorMetrics(req.Header)
+	if err != nil {
+		return &QueryVerifyHeaderResponse{RejectionReason: err.Error()}, nil
+	}
+	metrics.Accepted = true
+	return metrics, nil
+}
+
+// VerifyMembershipBatch checks a multi-proof without the connection delay
+// period, which only applies to packet handling. A client that is not Active
+// is a query error; a rejected proof is reported in the response.
+func (q queryServer) VerifyMembershipBatch(goCtx context.Context, req *QueryVerifyMembershipBatchRequest) (*QueryVerifyMembershipBatchResponse, error) {
+	if req == nil || len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "items must be provided")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientStore, clientState, err := q.clientState(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	if clientStatus := clientState.Status(ctx, clientStore, q.cdc); clientStatus != exported.Active {
+		return nil, status.Errorf(codes.FailedPrecondition, "probabilistic client %s is %s", req.ClientId, clientStatus)
+	}
+
+	items := make([]probabilisticcore.IbcStateMembershipItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, probabilisticcore.IbcStateMembershipItem{
+			Key:   []byte(normalizeConsensusKeyForCardano(string(item.Key))),
+			Value: item.Value,
+		})
+	}
+	// The height is resolved as 02-client passes it to VerifyMembership,
+	// revision number included.
+	height := clienttypes.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	if err := clientState.verifyBatchMembershipAtHeight(clientStore, q.cdc, height, items, req.Proof); err != nil {
+		return &QueryVerifyMembershipBatchResponse{RejectionReason: err.Error()}, nil
+	}
+	return &QueryVerifyMembershipBatchResponse{Verified: true}, nil
+}
+
+func (q queryServer) clientState(ctx sdk.Context, clientID string) (storetypes.KVStore, *ClientState, error) {
+	if strings.TrimSpace(clientID) == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "client id must not be empty")
+	}
+	clientStore := q.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, q.cdc)
+	if !found {
+		return nil, nil, status.Errorf(codes.NotFound, "probabilistic client %s not found", clientID)
+	}
+	return clientStore, clientState, nil
+}
+
+// headerAnchorMetrics recomputes the security metrics of a verified header's
+// anchor block.
+func (cs *ClientState) headerAnchorMetrics(header *ProbabilisticHeader) (*QueryVerifyHeaderResponse, error) {
+	currentEpochContexts, err := cs.normalizedEpochContexts()
+	if err != nil {
+		return nil, err
+	}
+	epochContexts, err := mergeHeaderEpochContexts(currentEpochContexts, header)
+	if err != nil {
+		return nil, err
+	}
+	authenticatedHeader, err := cs.authenticateHeaderBlocksWithContexts(header, epochContexts)
+	if err != nil {
+		return nil, err
+	}
+	anchorEpochContext := epochContextByEpoch(epochContexts, authenticatedHeader.anchorBlock.epoch)
+	qualifiedUniquePools, qualifiedUniqueStakeBps, securityScoreBps, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, anchorEpochContext)
+	if err != nil {
+		return nil, err
+	}
+	chainDensityBps, err := cs.computeChainDensityBps(authenticatedHeader)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryVerifyHeaderResponse{
+		SecurityScoreBps: securityScoreBps,
+		UniquePoolsCount: qualifiedUniquePools,
+		UniqueStakeBps:   qualifiedUniqueStakeBps,
+		DescendantDepth:  uint64(len(authenticatedHeader.descendantBlocks)),
+		ChainDensityBps:  chainDensityBps,
+	}, nil
+}
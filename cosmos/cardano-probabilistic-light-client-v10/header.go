@@ -22,6 +22,7 @@ func (h ProbabilisticHeader) ConsensusState() *ConsensusState {
 		UniquePoolsCount:  0,
 		UniqueStakeBps:    0,
 		SecurityScoreBps:  0,
+		ChainDensityBps:   0,
 	}
 }
 
@@ -60,6 +61,9 @@ func (h ProbabilisticHeader) ValidateBasic() error {
 	if len(h.AnchorBlock.BlockCbor) == 0 {
 		return errorsmod.Wrap(ErrInvalidAcceptedBlock, "anchor block_cbor cannot be empty")
 	}
+	if len(h.AnchorBlock.HeaderCbor) != 0 {
+		return errorsmod.Wrap(ErrInvalidAcceptedBlock, "anchor block must not carry header_cbor")
+	}
 	if h.TrustedHeight.RevisionHeight >= h.AnchorBlock.Height.RevisionHeight {
 		return errorsmod.Wrapf(
 			ErrInvalidHeaderHeight,
@@ -69,32 +73,88 @@ func (h ProbabilisticHeader) ValidateBasic() error {
 		)
 	}
 	if h.IsCheckpoint {
-		if h.HostStateTxHash != "" || h.HostStateTxOutputIndex != 0 {
+		if h.HostStateTxHash != "" || h.HostStateTxOutputIndex != 0 || len(h.HostStateLineageTxBodies) != 0 {
 			return errorsmod.Wrap(ErrInvalidHostStateCommitment, "checkpoint header must not contain HostState transaction fields")
 		}
 	} else if h.HostStateTxHash == "" {
 		return errorsmod.Wrap(ErrInvalidHostStateCommitment, "root-bearing header must contain a HostState transaction hash")
 	}
+	for i, txBody := range h.HostStateLineageTxBodies {
+		if len(txBody) == 0 {
+			return errorsmod.Wrapf(ErrInvalidHostStateLineage, "lineage tx %d cannot be empty", i)
+		}
+	}
+	for i, segment := range h.EpochBridgeSegments {
+		if segment == nil || segment.EpochContext == nil {
+			return errorsmod.Wrapf(ErrInvalidHeader, "epoch bridge segment %d must carry an epoch context", i)
+		}
+		if err := validateEpochContext(segment.EpochContext); err != nil {
+			return err
+		}
+		if i > 0 && segment.EpochContext.Epoch <= h.EpochBridgeSegments[i-1].EpochContext.Epoch {
+			return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch bridge segment %d epoch must increase", i)
+		}
+		if len(segment.BridgeBlocks) == 0 {
+			return errorsmod.Wrapf(ErrInvalidAcceptedBlock, "epoch bridge segment %d must contain bridge blocks", i)
+		}
+		for _, block := range segment.BridgeBlocks {
+			if block == nil {
+				return errorsmod.Wrap(ErrInvalidAcceptedBlock, "bridge block cannot be nil")
+			}
+			if err := validateBlockWitness(block, "bridge"); err != nil {
+				return err
+			}
+		}
+	}
+	if err := validateStakeDistributionProofs(h.StakeDistributionProofs); err != nil {
+		return err
+	}
 	if h.NewEpochContext != nil {
 		if err := validateEpochContext(h.NewEpochContext); err != nil {
 			return err
 		}
+		if n := len(h.EpochBridgeSegments); n > 0 {
+			last := h.EpochBridgeSegments[n-1].EpochContext
+			if h.NewEpochContext.Epoch < last.Epoch ||
+				(h.NewEpochContext.Epoch == last.Epoch && !epochContextsEqual(h.NewEpochContext, last)) {
+				return errorsmod.Wrapf(
+					ErrInvalidCurrentEpoch,
+					"new_epoch_context epoch %d conflicts with final epoch bridge segment epoch %d",
+					h.NewEpochContext.Epoch,
+					last.Epoch,
+				)
+			}
+		}
 	}
 	for _, block := range h.BridgeBlocks {
 		if block == nil {
 			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "bridge block cannot be nil")
 		}
-		if len(block.BlockCbor) == 0 {
-			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "bridge block_cbor cannot be empty")
+		if err := validateBlockWitness(block, "bridge"); err != nil {
+			return err
 		}
 	}
 	for _, block := range h.DescendantBlocks {
 		if block == nil {
 			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "descendant block cannot be nil")
 		}
-		if len(block.BlockCbor) == 0 {
-			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "descendant block_cbor cannot be empty")
+		if err := validateBlockWitness(block, "descendant"); err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// validateBlockWitness requires a bridge or descendant block to carry exactly
+// one of block_cbor and header_cbor.
+func validateBlockWitness(block *ProbabilisticBlock, label string) error {
+	hasBlock := len(block.BlockCbor) != 0
+	hasHeader := len(block.HeaderCbor) != 0
+	if !hasBlock && !hasHeader {
+		return errorsmod.Wrapf(ErrInvalidAcceptedBlock, "%s block must carry block_cbor or header_cbor", label)
+	}
+	if hasBlock && hasHeader {
+		return errorsmod.Wrapf(ErrInvalidAcceptedBlock, "%s block must not carry both block_cbor and header_cbor", label)
+	}
+	return nil
+}
@@ -1,5 +1,11 @@
 package probabilistic
 
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
 const (
 	DefaultThresholdDepth          = 24
 	DefaultThresholdUniquePools    = 5
@@ -7,4 +13,115 @@ const (
 	DefaultDepthWeightBps          = 2000
 	DefaultPoolsWeightBps          = 2000
 	DefaultStakeWeightBps          = 6000
+
+	// DefaultThresholdChainDensityBps leaves the chain-density check off, so
+	// clients without an acceptance policy keep accepting the headers they
+	// accepted before it existed. A policy opts in with a threshold such as
+	// 5000, half the block rate of a fully participating chain, which a
+	// private fork minted by less than half of the stake cannot sustain.
+	DefaultThresholdChainDensityBps   = 0
+	DefaultActiveSlotCoeffNumerator   = 1
+	DefaultActiveSlotCoeffDenominator = 20
 )
+
+func DefaultAcceptancePolicy() AcceptancePolicy {
+	return AcceptancePolicy{
+		ThresholdDepth:             DefaultThresholdDepth,
+		ThresholdUniquePools:       DefaultThresholdUniquePools,
+		ThresholdUniqueStakeBps:    DefaultThresholdUniqueStakeBps,
+		DepthWeightBps:             DefaultDepthWeightBps,
+		PoolsWeightBps:             DefaultPoolsWeightBps,
+		StakeWeightBps:             DefaultStakeWeightBps,
+		ThresholdChainDensityBps:   DefaultThresholdChainDensityBps,
+		ActiveSlotCoeffNumerator:   DefaultActiveSlotCoeffNumerator,
+		ActiveSlotCoeffDenominator: DefaultActiveSlotCoeffDenominator,
+	}
+}
+
+func (p AcceptancePolicy) Validate() error {
+	if p.ThresholdDepth == 0 {
+		return errorsmod.Wrap(ErrInvalidAcceptancePolicy, "threshold_depth must be greater than zero")
+	}
+	if p.ThresholdUniquePools == 0 {
+		return errorsmod.Wrap(ErrInvalidAcceptancePolicy, "threshold_unique_pools must be greater than zero")
+	}
+	if p.ThresholdUniqueStakeBps == 0 || p.ThresholdUniqueStakeBps > 10_000 {
+		return errorsmod.Wrapf(
+			ErrInvalidAcceptancePolicy,
+			"threshold_unique_stake_bps must be in (0, 10000], got %d",
+			p.ThresholdUniqueStakeBps,
+		)
+	}
+	for _, weight := range []uint64{p.DepthWeightBps, p.PoolsWeightBps, p.StakeWeightBps} {
+		if weight > 10_000 {
+			return errorsmod.Wrapf(ErrInvalidAcceptancePolicy, "score weight %d exceeds 10000 bps", weight)
+		}
+	}
+	if total := p.DepthWeightBps + p.PoolsWeightBps + p.StakeWeightBps; total != 10_000 {
+		return errorsmod.Wrapf(ErrInvalidAcceptancePolicy, "score weights must sum to 10000 bps, got %d", total)
+	}
+	if p.ThresholdChainDensityBps > 10_000 {
+		return errorsmod.Wrapf(
+			ErrInvalidAcceptancePolicy,
+			"threshold_chain_density_bps must not exceed 10000, got %d",
+			p.ThresholdChainDensityBps,
+		)
+	}
+	if p.ActiveSlotCoeffNumerator != 0 || p.ActiveSlotCoeffDenominator != 0 {
+		if p.ActiveSlotCoeffNumerator == 0 || p.ActiveSlotCoeffNumerator > p.ActiveSlotCoeffDenominator {
+			return errorsmod.Wrapf(
+				ErrInvalidAcceptancePolicy,
+				"active slot coefficient must be in (0, 1], got %d/%d",
+				p.ActiveSlotCoeffNumerator,
+				p.ActiveSlotCoeffDenominator,
+			)
+		}
+	}
+	return nil
+}
+
+// activeSlotCoeff returns the policy's active slot coefficient, or the mainnet
+// coefficient when unset.
+func (p AcceptancePolicy) activeSlotCoeff() (numerator, denominator uint64) {
+	if p.ActiveSlotCoeffNumerator == 0 && p.ActiveSlotCoeffDenominator == 0 {
+		return DefaultActiveSlotCoeffNumerator, DefaultActiveSlotCoeffDenominator
+	}
+	return p.ActiveSlotCoeffNumerator, p.ActiveSlotCoeffDenominator
+}
+
+// chainDensityBps returns the number of blocks over a slot span relative to
+// the f * span blocks expected from the active slot coefficient f, in basis
+// points capped at 10000.
+func (p AcceptancePolicy) chainDensityBps(blocks, slotSpan uint64) uint64 {
+	if blocks == 0 || slotSpan == 0 {
+		return 0
+	}
+	numerator, denominator := p.activeSlotCoeff()
+	observed := new(big.Int).SetUint64(blocks)
+	observed.Mul(observed, big.NewInt(10_000))
+	observed.Mul(observed, new(big.Int).SetUint64(denominator))
+	expected := new(big.Int).SetUint64(slotSpan)
+	expected.Mul(expected, new(big.Int).SetUint64(numerator))
+	density := observed.Quo(observed, expected)
+	if !density.IsUint64() {
+		return 10_000
+	}
+	return min(density.Uint64(), 10_000)
+}
+
+// effectiveAcceptancePolicy returns the stored policy, or the defaults for
+// clients created before the policy was carried in client state.
+func (cs *ClientState) effectiveAcceptancePolicy() AcceptancePolicy {
+	if cs == nil || cs.AcceptancePolicy == nil {
+		return DefaultAcceptancePolicy()
+	}
+	return *cs.AcceptancePolicy
+}
+
+func cloneAcceptancePolicy(policy *AcceptancePolicy) *AcceptancePolicy {
+	if policy == nil {
+		return nil
+	}
+	cloned := *policy
+	return &cloned
+}
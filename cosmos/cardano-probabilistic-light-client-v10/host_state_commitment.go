@@ -16,6 +16,22 @@ func (cs ClientState) ExtractIbcStateRootFromHostStateTx(header *ProbabilisticHe
 	)
 }
 
+// ExtractHostStateOutputFromHostStateTx decodes the HostState output of the
+// header's HostState transaction recovered from the anchor block.
+func (cs ClientState) ExtractHostStateOutputFromHostStateTx(header *ProbabilisticHeader) (*probabilisticcore.HostStateOutput, error) {
+	txBodyCbor, err := extractHostStateTxBodyCborFromAnchorBlock(header)
+	if err != nil {
+		return nil, err
+	}
+	return probabilisticcore.ExtractHostStateOutputFromTransactionBody(
+		txBodyCbor,
+		header.HostStateTxHash,
+		header.HostStateTxOutputIndex,
+		cs.HostStateNftPolicyId,
+		cs.HostStateNftTokenName,
+	)
+}
+
 func extractIbcStateRootFromTransactionBody(
 	txBodyCbor []byte,
 	txHash string,
@@ -0,0 +1,101 @@
+package probabilistic
+
+import (
+	"strings"
+	"testing"
+
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+	"github.com/stretchr/testify/require"
+)
+
+func testHostStateOutput(txHashByte string, version uint64, spends ...probabilisticcore.HostStateOutpoint) *probabilisticcore.HostStateOutput {
+	return &probabilisticcore.HostStateOutput{
+		Outpoint: probabilisticcore.HostStateOutpoint{TxHash: strings.Repeat(txHashByte, 64), OutputIndex: 0},
+		Datum: probabilisticcore.HostStateDatum{
+			State: probabilisticcore.HostState{
+				Version:            version,
+				NextClientSequence: 2,
+				NextConnectionSeq:  3,
+				NextChannelSeq:     4,
+			},
+		},
+		Spends: spends,
+	}
+}
+
+func TestLinkHostStateLineage(t *testing.T) {
+	baselineOutput := testHostStateOutput("a", 10)
+	baseline := hostStateLineageFromOutput(baselineOutput)
+
+	lineage, conflict, err := linkHostStateLineage(nil, []*probabilisticcore.HostStateOutput{baselineOutput})
+	require.NoError(t, err)
+	require.False(t, conflict)
+	require.Equal(t, baseline, lineage)
+
+	lineage, conflict, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{baselineOutput})
+	require.NoError(t, err)
+	require.False(t, conflict)
+	require.Equal(t, uint64(10), lineage.Version)
+
+	direct := testHostStateOutput("b", 11, baselineOutput.Outpoint)
+	lineage, conflict, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{direct})
+	require.NoError(t, err)
+	require.False(t, conflict)
+	require.Equal(t, strings.Repeat("b", 64), lineage.TxHash)
+
+	intermediate := testHostStateOutput("c", 12, direct.Outpoint)
+	anchor := testHostStateOutput("d", 13, intermediate.Outpoint)
+	lineage, conflict, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{direct, intermediate, anchor})
+	require.NoError(t, err)
+	require.False(t, conflict)
+	require.Equal(t, uint64(13), lineage.Version)
+
+	_, _, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{intermediate, anchor})
+	require.ErrorIs(t, err, ErrInvalidHostStateLineage)
+	require.ErrorContains(t, err, "starts at version 12, need 11")
+
+	_, _, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{direct, anchor})
+	require.ErrorIs(t, err, ErrInvalidHostStateLineage)
+
+	_, _, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{direct, baselineOutput})
+	require.ErrorIs(t, err, ErrInvalidHostStateLineage)
+}
+
+func TestLinkHostStateLineageReportsForks(t *testing.T) {
+	baselineOutput := testHostStateOutput("a", 10)
+	baseline := hostStateLineageFromOutput(baselineOutput)
+	otherOutpoint := probabilisticcore.HostStateOutpoint{TxHash: strings.Repeat("e", 64), OutputIndex: 0}
+
+	_, conflict, err := linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{testHostStateOutput("b", 11, otherOutpoint)})
+	require.NoError(t, err)
+	require.True(t, conflict, "a successor version that does not spend the trusted output")
+
+	_, conflict, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{testHostStateOutput("b", 10, otherOutpoint)})
+	require.NoError(t, err)
+	require.True(t, conflict, "a later output that does not advance the version")
+
+	regressed := testHostStateOutput("b", 11, baselineOutput.Outpoint)
+	regressed.Datum.State.NextChannelSeq = 3
+	_, conflict, err = linkHostStateLineage(baseline, []*probabilisticcore.HostStateOutput{regressed})
+	require.NoError(t, err)
+	require.True(t, conflict, "a counter that decreases")
+}
+
+func TestHostStateLineageValidation(t *testing.T) {
+	consensusState := newProbabilisticTestConsensusState("trusted-10")
+	consensusState.HostStateLineage = &HostStateLineage{TxHash: strings.Repeat("a", 64)}
+	require.NoError(t, consensusState.ValidateBasic())
+
+	consensusState.HostStateLineage.TxHash = "abc"
+	require.ErrorContains(t, consensusState.ValidateBasic(), "HostState tx hash")
+
+	header := newVerifiedTestHeader(t)
+	header.HostStateLineageTxBodies = [][]byte{{}}
+	require.ErrorIs(t, header.ValidateBasic(), ErrInvalidHostStateLineage)
+
+	header.HostStateLineageTxBodies = [][]byte{{0x80}}
+	header.IsCheckpoint = true
+	header.HostStateTxHash = ""
+	header.HostStateTxOutputIndex = 0
+	require.ErrorIs(t, header.ValidateBasic(), ErrInvalidHostStateCommitment)
+}
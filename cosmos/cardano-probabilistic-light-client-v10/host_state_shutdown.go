@@ -0,0 +1,75 @@
+package probabilistic
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+)
+
+// hostStateShutdownFromDatum returns the recorded form of a HostState shutdown
+// state, or nil when the HostState is active.
+func hostStateShutdownFromDatum(shutdown probabilisticcore.ShutdownState) *HostStateShutdown {
+	if !shutdown.ShuttingDown {
+		return nil
+	}
+	return &HostStateShutdown{
+		InitiatedAtMs:    shutdown.InitiatedAtMillis,
+		GracePeriodEndMs: shutdown.GracePeriodEndMillis,
+	}
+}
+
+func (s *HostStateShutdown) ValidateBasic() error {
+	if s.GracePeriodEndMs <= s.InitiatedAtMs {
+		return errorsmod.Wrapf(
+			ErrHostStateShutdown,
+			"grace period end %d must be after shutdown initiation %d",
+			s.GracePeriodEndMs,
+			s.InitiatedAtMs,
+		)
+	}
+	return nil
+}
+
+func (cs ClientState) isHostStateShutdown() bool {
+	return cs.HostStateShutdownHeight != nil && !cs.HostStateShutdownHeight.IsZero()
+}
+
+// hostStateGracePeriodEnded reports whether the block time has reached the
+// grace period end recorded at the client's shutdown height. A client whose
+// shutdown consensus state is no longer stored is past its grace period.
+func (cs ClientState) hostStateGracePeriodEnded(ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec) bool {
+	consState, found := GetConsensusState(clientStore, cdc, cs.HostStateShutdownHeight)
+	if !found || consState.HostStateShutdown == nil {
+		return true
+	}
+	now := ctx.BlockTime().UnixMilli()
+	return now < 0 || uint64(now) >= consState.HostStateShutdown.GracePeriodEndMs
+}
+
+// recordHostStateShutdown lowers the client's shutdown height to height.
+func (cs *ClientState) recordHostStateShutdown(height *Height) {
+	if !cs.isHostStateShutdown() || height.LT(cs.HostStateShutdownHeight) {
+		cs.HostStateShutdownHeight = NewHeight(height.RevisionNumber, height.RevisionHeight)
+	}
+}
+
+// verifyHostStateNotShutdown rejects proofs against a consensus state at or
+// above the client's shutdown height, or one that itself records a shutdown.
+func (cs ClientState) verifyHostStateNotShutdown(height exported.Height, consState *ConsensusState) error {
+	if cs.isHostStateShutdown() && height.GTE(cs.HostStateShutdownHeight) {
+		return errorsmod.Wrapf(
+			ErrHostStateShutdown,
+			"proof height %s is at or after HostState shutdown height %s",
+			height,
+			cs.HostStateShutdownHeight,
+		)
+	}
+	if consState.HostStateShutdown != nil {
+		return errorsmod.Wrapf(ErrHostStateShutdown, "consensus state at height %s records a HostState shutdown", height)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package probabilistic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+)
+
+func TestHostStateShutdownFromDatum(t *testing.T) {
+	require.Nil(t, hostStateShutdownFromDatum(probabilisticcore.ShutdownState{}))
+
+	shutdown := hostStateShutdownFromDatum(probabilisticcore.ShutdownState{
+		ShuttingDown:         true,
+		InitiatedAtMillis:    1_000,
+		GracePeriodEndMillis: 2_000,
+	})
+	require.Equal(t, &HostStateShutdown{InitiatedAtMs: 1_000, GracePeriodEndMs: 2_000}, shutdown)
+
+	consensusState := newProbabilisticTestConsensusState("shutdown-block-hash")
+	consensusState.HostStateShutdown = shutdown
+	require.NoError(t, consensusState.ValidateBasic())
+
+	consensusState.HostStateShutdown.GracePeriodEndMs = 1_000
+	require.ErrorContains(t, consensusState.ValidateBasic(), "grace period end")
+}
+
+func TestHostStateShutdownIsTerminal(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-host-state-shutdown")
+	clientState := newProbabilisticTestClientState()
+	clientState.LatestHeight = NewHeight(0, 20)
+
+	for _, height := range []*Height{NewHeight(0, 10), NewHeight(0, 20)} {
+		consensusState := newProbabilisticTestConsensusState("root-block-hash")
+		consensusState.Timestamp = uint64(ctx.BlockTime().UnixNano())
+		setConsensusState(clientStore, cdc, consensusState, height)
+		setConsensusMetadata(ctx, clientStore, height)
+	}
+	require.Equal(t, exported.Active, clientState.Status(ctx, clientStore, cdc))
+
+	clientState.recordHostStateShutdown(NewHeight(0, 20))
+	require.Equal(t, exported.Frozen, clientState.Status(ctx, clientStore, cdc), "a shutdown without a recorded grace period is frozen")
+	err := clientState.VerifyClientMessage(ctx, cdc, clientStore, &ProbabilisticHeader{})
+	require.ErrorIs(t, err, ErrHostStateShutdown)
+
+	clientState.recordHostStateShutdown(NewHeight(0, 30))
+	require.Equal(t, NewHeight(0, 20), clientState.HostStateShutdownHeight, "a later shutdown must not raise the shutdown height")
+
+	err = clientState.VerifyMembership(ctx, clientStore, cdc, NewHeight(0, 20), 0, 0, nil, nil, []byte("value"))
+	require.ErrorIs(t, err, ErrHostStateShutdown)
+	err = clientState.VerifyNonMembership(ctx, clientStore, cdc, NewHeight(0, 20), 0, 0, nil, nil)
+	require.ErrorIs(t, err, ErrHostStateShutdown)
+
+	// Proofs below the shutdown height still reach proof verification.
+	err = clientState.VerifyNonMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, nil, nil)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrHostStateShutdown)
+
+	consensusState, found := GetConsensusState(clientStore, cdc, NewHeight(0, 10))
+	require.True(t, found)
+	consensusState.HostStateShutdown = &HostStateShutdown{InitiatedAtMs: 1_000, GracePeriodEndMs: 2_000}
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 10))
+	clientState.HostStateShutdownHeight = nil
+	err = clientState.VerifyNonMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, nil, nil)
+	require.ErrorIs(t, err, ErrHostStateShutdown, "a consensus state recording a shutdown must not verify proofs")
+}
+
+func TestHostStateShutdownStatusFollowsGracePeriod(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-host-state-shutdown-grace")
+	clientState := newProbabilisticTestClientState()
+	clientState.LatestHeight = NewHeight(0, 20)
+	gracePeriodEnd := ctx.BlockTime().Add(time.Hour)
+
+	consensusState := newProbabilisticTestConsensusState("shutdown-block-hash")
+	consensusState.Timestamp = uint64(ctx.BlockTime().UnixNano())
+	consensusState.HostStateShutdown = &HostStateShutdown{InitiatedAtMs: 1_000, GracePeriodEndMs: uint64(gracePeriodEnd.UnixMilli())}
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 20))
+	clientState.recordHostStateShutdown(NewHeight(0, 20))
+
+	require.Equal(t, exported.Active, clientState.Status(ctx, clientStore, cdc))
+	require.Equal(t, exported.Active, clientState.Status(ctx.WithBlockTime(gracePeriodEnd.Add(-time.Millisecond)), clientStore, cdc))
+	require.Equal(t, exported.Frozen, clientState.Status(ctx.WithBlockTime(gracePeriodEnd), clientStore, cdc))
+}
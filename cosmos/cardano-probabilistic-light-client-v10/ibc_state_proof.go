@@ -48,6 +48,21 @@ func VerifyIbcStateMembership(root []byte, key []byte, value []byte, proofBytes
 	return probabilisticcore.VerifyIbcStateMembershipWithExistenceProof(root, key, value, exist, verifyCardanoValueMatchesExpected)
 }
 
+// VerifyIbcStateBatchMembership verifies every `key -> value` item against an
+// authenticated `ibc_state_root` with one multi-proof, which carries the
+// sibling hashes shared by the items' paths once.
+//
+// Proof encoding: the JSON encoding of probabilisticcore.IbcStateMultiProof.
+// Committed values are compared with the expected ones as in
+// VerifyIbcStateMembership.
+func VerifyIbcStateBatchMembership(root []byte, items []probabilisticcore.IbcStateMembershipItem, proofBytes []byte) error {
+	proof, err := probabilisticcore.DecodeJSONIbcStateMultiProof(proofBytes)
+	if err != nil {
+		return err
+	}
+	return probabilisticcore.VerifyIbcStateBatchMembershipWithMultiProof(root, items, proof, verifyCardanoValueMatchesExpected)
+}
+
 func verifyCardanoValueMatchesExpected(key []byte, expectedValue []byte, committedValue []byte) error {
 	keyStr := string(key)
 
@@ -132,11 +147,14 @@ func verifyCardanoValueMatchesExpected(key []byte, expectedValue []byte, committ
 	case strings.HasPrefix(keyStr, "commitments/ports/"),
 		strings.HasPrefix(keyStr, "acks/ports/"),
 		strings.HasPrefix(keyStr, "receipts/ports/"),
-		strings.HasPrefix(keyStr, "nextSequenceRecv/ports/"):
+		strings.HasPrefix(keyStr, "nextSequenceRecv/ports/"),
+		strings.HasSuffix(keyStr, "/"+KeyUpgradedClient),
+		strings.HasSuffix(keyStr, "/"+KeyUpgradedConsState):
 		// Packet commitments / acknowledgements / receipts are stored on Cosmos chains
 		// as raw bytes (not protobuf-encoded). Cardano commits to the CBOR-serialised
 		// Plutus `ByteArray` for these values, so we need to unwrap the committed
-		// CBOR bytestring and compare the underlying bytes.
+		// CBOR bytestring and compare the underlying bytes. Upgraded client and
+		// consensus states are committed the same way, wrapping their `Any` bytes.
 		var committedBytes []byte
 		if err := cbor.Unmarshal(committedValue, &committedBytes); err != nil {
 			return fmt.Errorf("failed to decode committed packet bytes CBOR: %w", err)
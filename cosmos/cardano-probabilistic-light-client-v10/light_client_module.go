@@ -83,6 +83,7 @@ func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientM
 		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
 	}
 	previousEpoch := clientState.CurrentEpoch
+	wasShutdown := clientState.isHostStateShutdown()
 	updatedHeights := clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
 
 	header, ok := clientMsg.(*ProbabilisticHeader)
@@ -96,6 +97,9 @@ func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientM
 	}
 
 	emitProbabilisticHeaderAcceptedEvent(ctx, clientID, header, clientState, consensusState, previousEpoch)
+	if !wasShutdown && consensusState.HostStateShutdown != nil {
+		emitProbabilisticHostStateShutdownEvent(ctx, clientID, updatedHeights[0], consensusState.HostStateShutdown)
+	}
 	return updatedHeights
 }
 
@@ -108,6 +112,21 @@ func (l LightClientModule) VerifyMembership(ctx sdk.Context, clientID string, he
 	return clientState.VerifyMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
 }
 
+// VerifyBatchMembership verifies several paths of one height with a single
+// multi-proof. It is not part of the ibc-go LightClientModule interface, so
+// it checks the client status that 02-client checks before VerifyMembership.
+func (l LightClientModule) VerifyBatchMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+	if status := clientState.Status(ctx, clientStore, l.cdc); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot verify membership using client (%s) with status %s", clientID, status)
+	}
+	return clientState.VerifyBatchMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+}
+
 func (l LightClientModule) VerifyNonMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path) error {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
 	clientState, found := getClientState(clientStore, l.cdc)
@@ -173,5 +192,18 @@ func (l LightClientModule) VerifyUpgradeAndUpdateState(
 	upgradeClientProof []byte,
 	upgradeConsensusStateProof []byte,
 ) error {
-	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade probabilistic-scored client")
+	var newClientState ClientState
+	if err := l.cdc.Unmarshal(newClient, &newClientState); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, err.Error())
+	}
+	var newConsensusState ConsensusState
+	if err := l.cdc.Unmarshal(newConsState, &newConsensusState); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+	}
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+	return clientState.VerifyUpgradeAndUpdateState(ctx, l.cdc, clientStore, &newClientState, &newConsensusState, upgradeClientProof, upgradeConsensusStateProof)
 }
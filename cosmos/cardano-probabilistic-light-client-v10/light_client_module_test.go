@@ -0,0 +1,118 @@
+package probabilistic
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+)
+
+// TestLightClientModuleVerifiesTimeoutProofsDuringShutdownGracePeriod checks
+// proofs the way the 02-client keeper does: only while the module reports
+// the client Active.
+func TestLightClientModuleVerifiesTimeoutProofsDuringShutdownGracePeriod(t *testing.T) {
+	ctx, clientStore, module, clientID := newProbabilisticTestModule(t, "probabilistic-shutdown-grace-period")
+	cdc := newProbabilisticTestCodec()
+
+	// The packet receipt was never written before the HostState shut down.
+	receiptKey := []byte("receipts/ports/transfer/channels/channel-0/sequences/1")
+	root, proof := newTestIbcStateAbsenceProof(t, receiptKey)
+	path := commitmenttypesv2.NewMerklePath([]byte("ibc"), receiptKey)
+
+	gracePeriodEnd := ctx.BlockTime().Add(time.Hour)
+	for _, height := range []*Height{NewHeight(0, 10), NewHeight(0, 20)} {
+		consensusState := newProbabilisticTestConsensusState("root-block-hash")
+		consensusState.Timestamp = uint64(ctx.BlockTime().UnixNano())
+		consensusState.IbcStateRoot = root
+		if height.RevisionHeight == 20 {
+			consensusState.HostStateShutdown = &HostStateShutdown{InitiatedAtMs: 1_000, GracePeriodEndMs: uint64(gracePeriodEnd.UnixMilli())}
+		}
+		setConsensusState(clientStore, cdc, consensusState, height)
+		setConsensusMetadata(ctx, clientStore, height)
+	}
+	clientState := newProbabilisticTestClientState()
+	clientState.LatestHeight = NewHeight(0, 20)
+	clientState.recordHostStateShutdown(NewHeight(0, 20))
+	setClientState(clientStore, cdc, clientState)
+
+	verifyTimeout := func(ctx sdk.Context, height exported.Height) error {
+		if status := module.Status(ctx, clientID); status != exported.Active {
+			return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+		}
+		return module.VerifyNonMembership(ctx, clientID, height, 0, 0, proof, path)
+	}
+
+	require.Equal(t, exported.Active, module.Status(ctx, clientID))
+	require.NoError(t, verifyTimeout(ctx, NewHeight(0, 10)))
+	require.ErrorIs(t, verifyTimeout(ctx, NewHeight(0, 20)), ErrHostStateShutdown)
+	require.ErrorIs(t, module.VerifyClientMessage(ctx, clientID, newVerifiedTestHeader(t)), ErrHostStateShutdown)
+
+	ctx = ctx.WithBlockTime(gracePeriodEnd)
+	require.Equal(t, exported.Frozen, module.Status(ctx, clientID))
+	require.ErrorIs(t, verifyTimeout(ctx, NewHeight(0, 10)), clienttypes.ErrClientNotActive)
+}
+
+func TestLightClientModuleVerifyBatchMembershipRequiresActiveClient(t *testing.T) {
+	ctx, clientStore, module, clientID := newProbabilisticTestModule(t, "probabilistic-batch-membership-module")
+	cdc := newProbabilisticTestCodec()
+
+	item := probabilisticcore.IbcStateMembershipItem{Key: []byte("channelEnds/ports/transfer/channels/channel-0"), Value: []byte("channel")}
+	root, proof := newTestIbcStateMultiProof(t, []probabilisticcore.IbcStateMembershipItem{item})
+	consensusState := newProbabilisticTestConsensusState("batch-block-hash")
+	consensusState.Timestamp = uint64(ctx.BlockTime().UnixNano())
+	consensusState.IbcStateRoot = root
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 10))
+	setConsensusMetadata(ctx, clientStore, NewHeight(0, 10))
+	clientState := newProbabilisticTestClientState()
+	setClientState(clientStore, cdc, clientState)
+
+	paths := []exported.Path{commitmenttypesv2.NewMerklePath([]byte("ibc"), item.Key)}
+	values := [][]byte{item.Value}
+	require.NoError(t, module.VerifyBatchMembership(ctx, clientID, NewHeight(0, 10), 0, 0, proof, paths, values))
+
+	clientState.FrozenHeight = FrozenHeight
+	setClientState(clientStore, cdc, clientState)
+	err := module.VerifyBatchMembership(ctx, clientID, NewHeight(0, 10), 0, 0, proof, paths, values)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotActive)
+}
+
+// newTestIbcStateAbsenceProof returns the root of an empty IBC state tree with
+// a proof, in the Gateway's encoding, that key is absent from it.
+func newTestIbcStateAbsenceProof(t *testing.T, key []byte) ([]byte, []byte) {
+	t.Helper()
+
+	keyHash := sha256.Sum256(key)
+	index := binary.BigEndian.Uint64(keyHash[0:8])
+	empty := make([]byte, sha256.Size)
+
+	path := make([]*ics23.InnerOp, 0, probabilisticcore.IbcStateTreeDepth)
+	for depth := 0; depth < probabilisticcore.IbcStateTreeDepth; depth++ {
+		if (index>>uint(depth))&1 == 0 {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{0x01}, Suffix: empty})
+		} else {
+			path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{0x01}, empty...), Suffix: []byte{}})
+		}
+	}
+
+	proof, err := (&commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{{
+			Proof: &ics23.CommitmentProof_Nonexist{Nonexist: &ics23.NonExistenceProof{
+				Key:  key,
+				Left: &ics23.ExistenceProof{Key: key, Value: []byte{}, Path: path},
+			}},
+		}},
+	}).Marshal()
+	require.NoError(t, err)
+	return empty, proof
+}
@@ -1,10 +1,12 @@
 package probabilistic
 
 import (
+	"fmt"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,14 +18,19 @@ func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCode
 	switch msg := msg.(type) {
 	case *ProbabilisticHeader:
 		return headerConflictsWithStoredConsensus(clientStore, cdc, msg) ||
-			cs.headerEpochContextConflictsWithStored(msg)
+			cs.headerEpochContextConflictsWithStored(msg) ||
+			cs.headerHostStateLineageConflictsWithStored(clientStore, cdc, msg)
 	case *Misbehaviour:
 		return headersConflict(msg.ProbabilisticHeader1, msg.ProbabilisticHeader2) ||
 			headersEpochContextConflict(msg.ProbabilisticHeader1, msg.ProbabilisticHeader2) ||
 			headerConflictsWithStoredConsensus(clientStore, cdc, msg.ProbabilisticHeader1) ||
 			headerConflictsWithStoredConsensus(clientStore, cdc, msg.ProbabilisticHeader2) ||
 			cs.headerEpochContextConflictsWithStored(msg.ProbabilisticHeader1) ||
-			cs.headerEpochContextConflictsWithStored(msg.ProbabilisticHeader2)
+			cs.headerEpochContextConflictsWithStored(msg.ProbabilisticHeader2) ||
+			cs.headerHostStateLineageConflictsWithStored(clientStore, cdc, msg.ProbabilisticHeader1) ||
+			cs.headerHostStateLineageConflictsWithStored(clientStore, cdc, msg.ProbabilisticHeader2)
+	case *SlotLeaderEquivocation:
+		return slotLeaderEquivocationConflict(msg) == nil
 	}
 
 	return false
@@ -41,12 +48,82 @@ func (cs *ClientState) verifyMisbehaviour(_ sdk.Context, clientStore storetypes.
 		!headerConflictsWithStoredConsensus(clientStore, cdc, misbehaviour.ProbabilisticHeader1) &&
 		!headerConflictsWithStoredConsensus(clientStore, cdc, misbehaviour.ProbabilisticHeader2) &&
 		!cs.headerEpochContextConflictsWithStored(misbehaviour.ProbabilisticHeader1) &&
-		!cs.headerEpochContextConflictsWithStored(misbehaviour.ProbabilisticHeader2) {
+		!cs.headerEpochContextConflictsWithStored(misbehaviour.ProbabilisticHeader2) &&
+		!cs.headerHostStateLineageConflictsWithStored(clientStore, cdc, misbehaviour.ProbabilisticHeader1) &&
+		!cs.headerHostStateLineageConflictsWithStored(clientStore, cdc, misbehaviour.ProbabilisticHeader2) {
 		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "probabilistic headers do not conflict")
 	}
 	return nil
 }
 
+// verifySlotLeaderEquivocation authenticates both blocks against the stored
+// epoch contexts, checks that their operational certificates are signed by
+// the issuer, and requires them to be an equivocation.
+func (cs *ClientState) verifySlotLeaderEquivocation(equivocation *SlotLeaderEquivocation) error {
+	epochContexts, err := cs.normalizedEpochContexts()
+	if err != nil {
+		return err
+	}
+	for i, block := range []*ProbabilisticBlock{equivocation.Block1, equivocation.Block2} {
+		label := fmt.Sprintf("equivocation block %d", i+1)
+		if _, err := cs.authenticateProbabilisticBlock(block, label, epochContexts, equivocation.StakeDistributionProofs); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+		}
+		decodedHeader, err := decodeProbabilisticBlockWitness(block, label)
+		if err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+		}
+		isValid, err := probabilisticcore.VerifyOperationalCertificate(decodedHeader)
+		if err != nil {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidMisbehaviour, "%s operational certificate: %v", label, err)
+		}
+		if !isValid {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidMisbehaviour, "%s operational certificate is not signed by its issuer", label)
+		}
+	}
+	return slotLeaderEquivocationConflict(equivocation)
+}
+
+// slotLeaderEquivocationConflict returns nil when two distinct blocks from
+// the same issuer share a slot, or carry different operational certificates
+// with the same counter.
+func slotLeaderEquivocationConflict(equivocation *SlotLeaderEquivocation) error {
+	if equivocation == nil || equivocation.Block1 == nil || equivocation.Block2 == nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "equivocation blocks must be present")
+	}
+	header1, err := decodeProbabilisticBlockWitness(equivocation.Block1, "equivocation block 1")
+	if err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+	}
+	header2, err := decodeProbabilisticBlockWitness(equivocation.Block2, "equivocation block 2")
+	if err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+	}
+
+	if strings.EqualFold(header1.Hash(), header2.Hash()) {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "equivocation blocks are the same block")
+	}
+	if header1.IssuerVkey() != header2.IssuerVkey() {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "equivocation blocks have different issuers")
+	}
+	if header1.SlotNumber() == header2.SlotNumber() {
+		return nil
+	}
+
+	opCert1, err := probabilisticcore.BlockOperationalCertificate(header1)
+	if err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+	}
+	opCert2, err := probabilisticcore.BlockOperationalCertificate(header2)
+	if err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+	}
+	if opCert1.SequenceNumber == opCert2.SequenceNumber && !opCert1.Equal(opCert2) {
+		return nil
+	}
+	return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "equivocation blocks neither share a slot nor reuse an operational certificate counter")
+}
+
 func headersConflict(header1, header2 *ProbabilisticHeader) bool {
 	if header1 == nil || header2 == nil {
 		return false
@@ -69,17 +146,22 @@ func headersConflict(header1, header2 *ProbabilisticHeader) bool {
 }
 
 func headersEpochContextConflict(header1, header2 *ProbabilisticHeader) bool {
-	if header1 == nil || header2 == nil || header1.NewEpochContext == nil || header2.NewEpochContext == nil {
+	if header1 == nil || header2 == nil {
 		return false
 	}
-	if header1.NewEpochContext.Epoch != header2.NewEpochContext.Epoch {
-		return false
+	header2Contexts := headerEpochContexts(header2)
+	for _, context1 := range headerEpochContexts(header1) {
+		context2 := epochContextByEpoch(header2Contexts, context1.Epoch)
+		if context2 != nil && !epochContextsEqual(context1, context2) {
+			return true
+		}
 	}
-	return !epochContextsEqual(header1.NewEpochContext, header2.NewEpochContext)
+	return false
 }
 
 func (cs ClientState) headerEpochContextConflictsWithStored(header *ProbabilisticHeader) bool {
-	if header == nil || header.NewEpochContext == nil {
+	headerContexts := headerEpochContexts(header)
+	if len(headerContexts) == 0 {
 		return false
 	}
 
@@ -87,8 +169,13 @@ func (cs ClientState) headerEpochContextConflictsWithStored(header *Probabilisti
 	if err != nil {
 		return false
 	}
-	stored := epochContextByEpoch(contexts, header.NewEpochContext.Epoch)
-	return stored != nil && !epochContextsEqual(stored, header.NewEpochContext)
+	for _, headerContext := range headerContexts {
+		stored := epochContextByEpoch(contexts, headerContext.Epoch)
+		if stored != nil && !epochContextsEqual(stored, headerContext) {
+			return true
+		}
+	}
+	return false
 }
 
 func collectHeaderBlocksByHeight(header *ProbabilisticHeader) map[uint64]string {
@@ -104,6 +191,14 @@ func collectHeaderBlocksByHeight(header *ProbabilisticHeader) map[uint64]string
 		blocksByHeight[block.Height.RevisionHeight] = block.Hash
 	}
 
+	for _, segment := range header.EpochBridgeSegments {
+		if segment == nil {
+			continue
+		}
+		for _, block := range segment.BridgeBlocks {
+			appendBlock(block)
+		}
+	}
 	for _, block := range header.BridgeBlocks {
 		appendBlock(block)
 	}
@@ -0,0 +1,248 @@
+package probabilistic
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril"
+)
+
+func (t MithrilStakeDistributionTrust) Validate() error {
+	if strings.TrimSpace(t.CertificateHash) == "" {
+		return errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "certificate_hash must not be empty")
+	}
+	if _, err := canonicalMithrilAvk(t.AggregateVerificationKey); err != nil {
+		return errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "aggregate_verification_key: %v", err)
+	}
+	if t.NextAggregateVerificationKey != "" {
+		if _, err := canonicalMithrilAvk(t.NextAggregateVerificationKey); err != nil {
+			return errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "next_aggregate_verification_key: %v", err)
+		}
+	}
+	if t.K == 0 || t.M == 0 {
+		return errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "k and m must be greater than zero")
+	}
+	if t.PhiFNumerator == 0 || t.PhiFDenominator == 0 || t.PhiFNumerator > t.PhiFDenominator {
+		return errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "phi_f must be in (0, 1]")
+	}
+	return nil
+}
+
+// authenticateEpochStakeDistributions walks the header's Mithril certificates
+// from the client's trusted certificate and requires every epoch context the
+// header introduces to match the stake distribution Merkle root of a
+// CardanoStakeDistribution certificate for the same epoch. It returns the
+// trust advanced to the last certificate, or nil when the client trusts epoch
+// contexts from new_epoch_context.
+//
+// Mithril certifies pool ids and stakes only; vrf_key_hash and
+// first_registration_slot remain relayer-supplied.
+func (cs *ClientState) authenticateEpochStakeDistributions(
+	header *ProbabilisticHeader,
+	trustedContexts []*EpochContext,
+) (*MithrilStakeDistributionTrust, error) {
+	if cs.MithrilStakeDistributionTrust == nil {
+		if len(header.MithrilCertificates) != 0 {
+			return nil, errorsmod.Wrap(ErrInvalidHeader, "mithril_certificates require a client with mithril_stake_distribution_trust")
+		}
+		return nil, nil
+	}
+
+	trust := cloneMithrilStakeDistributionTrust(cs.MithrilStakeDistributionTrust)
+	stakeDistributionRoots := make(map[uint64]string)
+	for i, bz := range header.MithrilCertificates {
+		certificate, err := mithril.UnmarshalCertificate(bz)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "certificate %d: %v", i, err)
+		}
+		next, err := verifyMithrilCertificateExtendsTrust(trust, certificate)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "certificate %d", i)
+		}
+		if certificate.SignedEntityType.Kind == mithril.CardanoStakeDistribution {
+			root, err := cardanoStakeDistributionRoot(certificate)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "certificate %d", i)
+			}
+			stakeDistributionRoots[certificate.SignedEntityType.Epoch] = root
+		}
+		trust = next
+	}
+
+	for _, epochContext := range headerEpochContexts(header) {
+		if epochContextByEpoch(trustedContexts, epochContext.Epoch) != nil {
+			continue
+		}
+		if len(epochContext.StakeDistribution) == 0 {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidStakeDistributionCertificate,
+				"epoch %d context must carry its full stake distribution to be checked against Mithril",
+				epochContext.Epoch,
+			)
+		}
+		certifiedRoot, ok := stakeDistributionRoots[epochContext.Epoch]
+		if !ok {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidStakeDistributionCertificate,
+				"epoch %d context is not committed by a CardanoStakeDistribution certificate",
+				epochContext.Epoch,
+			)
+		}
+		stakes := make(map[string]uint64, len(epochContext.StakeDistribution))
+		for _, entry := range epochContext.StakeDistribution {
+			stakes[entry.PoolId] = entry.Stake
+		}
+		root, err := mithril.CardanoStakeDistributionMerkleRoot(stakes)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "epoch %d stake distribution: %v", epochContext.Epoch, err)
+		}
+		if !strings.EqualFold(root, certifiedRoot) {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidStakeDistributionCertificate,
+				"epoch %d stake distribution root %s does not match certified root %s",
+				epochContext.Epoch,
+				root,
+				certifiedRoot,
+			)
+		}
+	}
+	return trust, nil
+}
+
+// verifyMithrilCertificateExtendsTrust checks that a standard certificate
+// chains from the trusted certificate and is signed by the trusted aggregate
+// verification key of its epoch under the trusted protocol parameters, and
+// returns the trust it establishes.
+//
+// As in Mithril, every certificate of an epoch links to the first (master)
+// certificate of its epoch, and the master certificate of an epoch links to
+// the master certificate of the previous epoch. The trust therefore always
+// holds the master certificate of its epoch: a certificate of the trusted
+// epoch is checked against it and leaves it in place, while a certificate of
+// the next epoch becomes the new master.
+func verifyMithrilCertificateExtendsTrust(
+	trust *MithrilStakeDistributionTrust,
+	certificate *mithril.Certificate,
+) (*MithrilStakeDistributionTrust, error) {
+	if certificate.IsGenesis() {
+		return nil, errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "genesis certificates cannot extend mithril trust")
+	}
+	if certificate.PreviousHash != trust.CertificateHash {
+		return nil, errorsmod.Wrapf(
+			ErrInvalidStakeDistributionCertificate,
+			"certificate previous hash %s does not chain from trusted master certificate %s",
+			certificate.PreviousHash,
+			trust.CertificateHash,
+		)
+	}
+	hash, err := certificate.ComputeHash()
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "certificate hash: %v", err)
+	}
+	if hash != certificate.Hash {
+		return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "certificate hash %s does not match computed hash %s", certificate.Hash, hash)
+	}
+
+	params := certificate.Metadata.ProtocolParameters
+	if params.K != trust.K || params.M != trust.M ||
+		params.PhiFNumerator != trust.PhiFNumerator || params.PhiFDenominator != trust.PhiFDenominator {
+		return nil, errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "protocol parameters differ from trusted parameters")
+	}
+
+	var signingAvk string
+	switch certificate.Epoch {
+	case trust.Epoch:
+		signingAvk = trust.AggregateVerificationKey
+	case trust.Epoch + 1:
+		signingAvk = trust.NextAggregateVerificationKey
+	default:
+		return nil, errorsmod.Wrapf(
+			ErrInvalidStakeDistributionCertificate,
+			"certificate epoch %d does not extend trusted epoch %d",
+			certificate.Epoch,
+			trust.Epoch,
+		)
+	}
+	if err := requireSameMithrilAvk(certificate.AggregateVerificationKey, signingAvk); err != nil {
+		return nil, err
+	}
+
+	if !certificate.MatchMessage() {
+		return nil, errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "signed message does not match protocol message")
+	}
+	if err := certificate.VerifyMultiSignature(); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "multi-signature: %v", err)
+	}
+
+	if certificate.Epoch == trust.Epoch {
+		return cloneMithrilStakeDistributionTrust(trust), nil
+	}
+	nextAvk, _ := certificate.ProtocolMessage.Part(mithril.ProtocolMessagePartKeyNextAggregateVerificationKey)
+	if nextAvk == "" {
+		return nil, errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "certificate does not commit a next aggregate verification key")
+	}
+	return &MithrilStakeDistributionTrust{
+		CertificateHash:              hash,
+		Epoch:                        certificate.Epoch,
+		AggregateVerificationKey:     certificate.AggregateVerificationKey,
+		NextAggregateVerificationKey: nextAvk,
+		K:                            trust.K,
+		M:                            trust.M,
+		PhiFNumerator:                trust.PhiFNumerator,
+		PhiFDenominator:              trust.PhiFDenominator,
+	}, nil
+}
+
+func cardanoStakeDistributionRoot(certificate *mithril.Certificate) (string, error) {
+	epoch := certificate.SignedEntityType.Epoch
+	signedEpoch, _ := certificate.ProtocolMessage.Part(mithril.ProtocolMessagePartKeyCardanoStakeDistributionEpoch)
+	if signedEpoch != strconv.FormatUint(epoch, 10) {
+		return "", errorsmod.Wrapf(
+			ErrInvalidStakeDistributionCertificate,
+			"signed stake distribution epoch %q does not match signed entity epoch %d",
+			signedEpoch,
+			epoch,
+		)
+	}
+	root, _ := certificate.ProtocolMessage.Part(mithril.ProtocolMessagePartKeyCardanoStakeDistributionMerkleRoot)
+	if root == "" {
+		return "", errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "certificate does not commit a stake distribution merkle root")
+	}
+	return root, nil
+}
+
+func requireSameMithrilAvk(got, want string) error {
+	if want == "" {
+		return errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "no trusted aggregate verification key for certificate epoch")
+	}
+	gotKey, err := canonicalMithrilAvk(got)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "certificate aggregate verification key: %v", err)
+	}
+	wantKey, err := canonicalMithrilAvk(want)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidStakeDistributionCertificate, "trusted aggregate verification key: %v", err)
+	}
+	if gotKey != wantKey {
+		return errorsmod.Wrap(ErrInvalidStakeDistributionCertificate, "certificate aggregate verification key does not match trusted key")
+	}
+	return nil
+}
+
+// canonicalMithrilAvk re-encodes a hex JSON aggregate verification key so keys
+// that differ only in JSON layout compare equal.
+func canonicalMithrilAvk(avk string) (string, error) {
+	key, err := mithril.ParseAggregateVerificationKey(avk)
+	if err != nil {
+		return "", err
+	}
+	key.MTCommitment.Hasher = nil
+	canonical, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return string(canonical), nil
+}
@@ -0,0 +1,396 @@
+package probabilistic
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril"
+	"github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core/mithril/crypto"
+)
+
+func TestMithrilStakeDistributionTrustValidate(t *testing.T) {
+	trust := newTestMithrilStakeDistributionTrust(t)
+	require.NoError(t, trust.Validate())
+
+	invalid := *trust
+	invalid.CertificateHash = ""
+	require.ErrorIs(t, invalid.Validate(), ErrInvalidStakeDistributionCertificate)
+
+	invalid = *trust
+	invalid.AggregateVerificationKey = "not-hex"
+	require.ErrorIs(t, invalid.Validate(), ErrInvalidStakeDistributionCertificate)
+
+	invalid = *trust
+	invalid.PhiFNumerator = 3
+	invalid.PhiFDenominator = 2
+	require.ErrorIs(t, invalid.Validate(), ErrInvalidStakeDistributionCertificate)
+
+	cs := newProbabilisticTestClientState()
+	cs.MithrilStakeDistributionTrust = &invalid
+	require.ErrorIs(t, cs.Validate(), ErrInvalidStakeDistributionCertificate)
+}
+
+func TestAuthenticateEpochStakeDistributionsRequiresTrust(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	header := &ProbabilisticHeader{NewEpochContext: newTestNextEpochContext()}
+
+	trust, err := cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.NoError(t, err)
+	require.Nil(t, trust)
+
+	header.MithrilCertificates = [][]byte{{0x01}}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorIs(t, err, ErrInvalidHeader)
+}
+
+func TestAuthenticateEpochStakeDistributionsRejectsUncertifiedContext(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	cs.MithrilStakeDistributionTrust = newTestMithrilStakeDistributionTrust(t)
+
+	known := &ProbabilisticHeader{NewEpochContext: mustCurrentTestEpochContext(t, cs)}
+	trust, err := cs.authenticateEpochStakeDistributions(known, mustTestEpochContexts(t, cs))
+	require.NoError(t, err)
+	require.Equal(t, cs.MithrilStakeDistributionTrust, trust)
+
+	header := &ProbabilisticHeader{NewEpochContext: newTestNextEpochContext()}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorIs(t, err, ErrInvalidStakeDistributionCertificate)
+	require.ErrorContains(t, err, "epoch 8 context is not committed")
+}
+
+func TestAuthenticateEpochStakeDistributionsRequiresFullStakeDistribution(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	cs.MithrilStakeDistributionTrust = newTestMithrilStakeDistributionTrust(t)
+
+	header := &ProbabilisticHeader{NewEpochContext: mustCompactTestEpochContext(t, newTestNextEpochContext())}
+	_, err := cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorIs(t, err, ErrInvalidStakeDistributionCertificate)
+	require.ErrorContains(t, err, "must carry its full stake distribution")
+}
+
+func TestAuthenticateEpochStakeDistributionsRejectsCertificateOutsideTrust(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	cs.MithrilStakeDistributionTrust = newTestMithrilStakeDistributionTrust(t)
+	header := &ProbabilisticHeader{NewEpochContext: newTestNextEpochContext()}
+
+	header.MithrilCertificates = [][]byte{{0xff, 0xff}}
+	_, err := cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorIs(t, err, ErrInvalidStakeDistributionCertificate)
+
+	certificate := newTestMithrilCertificate(cs.MithrilStakeDistributionTrust)
+	certificate.Epoch = cs.MithrilStakeDistributionTrust.Epoch + 2
+	header.MithrilCertificates = [][]byte{mustMarshalTestMithrilCertificate(t, certificate)}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorContains(t, err, "does not extend trusted epoch")
+
+	certificate = newTestMithrilCertificate(cs.MithrilStakeDistributionTrust)
+	certificate.Metadata.ProtocolParameters.K++
+	header.MithrilCertificates = [][]byte{mustMarshalTestMithrilCertificate(t, certificate)}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorContains(t, err, "protocol parameters differ")
+
+	certificate = newTestMithrilCertificate(cs.MithrilStakeDistributionTrust)
+	certificate.AggregateVerificationKey = mustTestMithrilAvk(t, 0x09)
+	header.MithrilCertificates = [][]byte{mustMarshalTestMithrilCertificate(t, certificate)}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorContains(t, err, "does not match trusted key")
+
+	certificate = newTestMithrilCertificate(cs.MithrilStakeDistributionTrust)
+	certificate.PreviousHash = "other-certificate"
+	header.MithrilCertificates = [][]byte{mustMarshalTestMithrilCertificate(t, certificate)}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorContains(t, err, "does not chain from trusted master certificate")
+
+	// The certificate hash is recomputed rather than taken from the relayer.
+	certificate = newTestMithrilCertificate(cs.MithrilStakeDistributionTrust)
+	bz := mustMarshalTestMithrilCertificate(t, certificate)
+	certificate.Hash = "relayer-chosen-hash"
+	header.MithrilCertificates = [][]byte{certificate.Marshal()}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorContains(t, err, "does not match computed hash")
+
+	// The signed message must be the hash of the protocol message.
+	header.MithrilCertificates = [][]byte{bz}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorContains(t, err, "signed message does not match protocol message")
+}
+
+func TestAuthenticateEpochStakeDistributionsFollowsMasterCertificates(t *testing.T) {
+	parameters := &mithril.ProtocolParameters{K: 2, M: 50, PhiFNumerator: 4, PhiFDenominator: 5}
+	current := newTestMithrilSigners(t, parameters, 0x10)
+	next := newTestMithrilSigners(t, parameters, 0x20)
+	following := newTestMithrilSigners(t, parameters, 0x30)
+
+	// Epoch 100 has a master certificate and a transactions certificate
+	// linked to it. The master certificate of epoch 101 links to the master
+	// certificate of epoch 100, and the stake distribution certificate of
+	// epoch 101 links to the master certificate of epoch 101.
+	stakeDistribution := newTestNextEpochContext()
+	stakes := make(map[string]uint64, len(stakeDistribution.StakeDistribution))
+	for _, entry := range stakeDistribution.StakeDistribution {
+		stakes[entry.PoolId] = entry.Stake
+	}
+	root, err := mithril.CardanoStakeDistributionMerkleRoot(stakes)
+	require.NoError(t, err)
+	master100 := current.sign(t, "previous-master", 100, &mithril.SignedEntityType{Kind: mithril.MithrilStakeDistribution, Epoch: 100}, next.avk)
+	transactions100 := current.sign(t, master100.Hash, 100, &mithril.SignedEntityType{Kind: mithril.CardanoTransactions, Epoch: 100, BlockNumber: 40}, next.avk,
+		mithril.MessagePart{Key: mithril.ProtocolMessagePartKeyCardanoTransactionsMerkleRoot, Value: "00ff"},
+		mithril.MessagePart{Key: mithril.ProtocolMessagePartKeyLatestBlockNumber, Value: "40"},
+	)
+	master101 := next.sign(t, master100.Hash, 101, &mithril.SignedEntityType{Kind: mithril.MithrilStakeDistribution, Epoch: 101}, following.avk)
+	stakeDistribution101 := next.sign(t, master101.Hash, 101, &mithril.SignedEntityType{Kind: mithril.CardanoStakeDistribution, Epoch: stakeDistribution.Epoch}, following.avk,
+		mithril.MessagePart{Key: mithril.ProtocolMessagePartKeyCardanoStakeDistributionEpoch, Value: strconv.FormatUint(stakeDistribution.Epoch, 10)},
+		mithril.MessagePart{Key: mithril.ProtocolMessagePartKeyCardanoStakeDistributionMerkleRoot, Value: root},
+	)
+
+	cs := newProbabilisticTestClientState()
+	cs.MithrilStakeDistributionTrust = &MithrilStakeDistributionTrust{
+		CertificateHash:              master100.Hash,
+		Epoch:                        100,
+		AggregateVerificationKey:     current.avk,
+		NextAggregateVerificationKey: next.avk,
+		K:                            parameters.K,
+		M:                            parameters.M,
+		PhiFNumerator:                parameters.PhiFNumerator,
+		PhiFDenominator:              parameters.PhiFDenominator,
+	}
+	header := &ProbabilisticHeader{
+		NewEpochContext: stakeDistribution,
+		MithrilCertificates: [][]byte{
+			transactions100.Marshal(),
+			master101.Marshal(),
+			stakeDistribution101.Marshal(),
+		},
+	}
+	trust, err := cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.NoError(t, err)
+	require.Equal(t, master101.Hash, trust.CertificateHash)
+	require.EqualValues(t, 101, trust.Epoch)
+	require.Equal(t, next.avk, trust.AggregateVerificationKey)
+	require.Equal(t, following.avk, trust.NextAggregateVerificationKey)
+
+	// The master certificate of the next epoch must not link to another
+	// certificate of the trusted epoch.
+	relinked := next.sign(t, transactions100.Hash, 101, &mithril.SignedEntityType{Kind: mithril.MithrilStakeDistribution, Epoch: 101}, following.avk)
+	header.MithrilCertificates = [][]byte{transactions100.Marshal(), relinked.Marshal()}
+	_, err = cs.authenticateEpochStakeDistributions(header, mustTestEpochContexts(t, cs))
+	require.ErrorContains(t, err, "does not chain from trusted master certificate")
+}
+
+func newTestMithrilStakeDistributionTrust(t *testing.T) *MithrilStakeDistributionTrust {
+	t.Helper()
+
+	return &MithrilStakeDistributionTrust{
+		CertificateHash:              "trusted-certificate",
+		Epoch:                        100,
+		AggregateVerificationKey:     mustTestMithrilAvk(t, 0x01),
+		NextAggregateVerificationKey: mustTestMithrilAvk(t, 0x02),
+		K:                            5,
+		M:                            100,
+		PhiFNumerator:                65,
+		PhiFDenominator:              100,
+	}
+}
+
+func newTestMithrilCertificate(trust *MithrilStakeDistributionTrust) *mithril.Certificate {
+	return &mithril.Certificate{
+		PreviousHash: trust.CertificateHash,
+		Epoch:        trust.Epoch + 1,
+		SignedEntityType: &mithril.SignedEntityType{
+			Kind:  mithril.CardanoStakeDistribution,
+			Epoch: 8,
+		},
+		Metadata: &mithril.CertificateMetadata{
+			ProtocolParameters: &mithril.ProtocolParameters{
+				K:               trust.K,
+				M:               trust.M,
+				PhiFNumerator:   trust.PhiFNumerator,
+				PhiFDenominator: trust.PhiFDenominator,
+			},
+			InitiatedAt: "2026-01-02T03:04:05Z",
+			SealedAt:    "2026-01-02T03:04:06Z",
+		},
+		ProtocolMessage:          &mithril.ProtocolMessage{},
+		AggregateVerificationKey: trust.NextAggregateVerificationKey,
+		MultiSignature:           "00",
+	}
+}
+
+func newTestNextEpochContext() *EpochContext {
+	return &EpochContext{
+		Epoch: 8,
+		StakeDistribution: []*StakeDistributionEntry{{
+			PoolId:     "pool-a",
+			Stake:      10_000,
+			VrfKeyHash: bytes.Repeat([]byte{0x02}, 32),
+		}},
+		EpochNonce:            bytes.Repeat([]byte{0x04}, 32),
+		SlotsPerKesPeriod:     129600,
+		EpochStartSlot:        1_000_000,
+		EpochEndSlotExclusive: 2_000_000,
+	}
+}
+
+func mustTestMithrilAvk(t *testing.T, seed byte) string {
+	t.Helper()
+
+	bz, err := json.Marshal(&crypto.StmAggrVerificationKey{
+		MTCommitment: &crypto.MerkleTreeCommitmentBatchCompat{
+			Root:     bytes.Repeat([]byte{seed}, 32),
+			NrLeaves: 3,
+		},
+		TotalStake: 30_000,
+	})
+	require.NoError(t, err)
+	return hex.EncodeToString(bz)
+}
+
+// mustMarshalTestMithrilCertificate seals certificate with its computed hash
+// and encodes it as a header carries it.
+func mustMarshalTestMithrilCertificate(t *testing.T, certificate *mithril.Certificate) []byte {
+	t.Helper()
+
+	hash, err := certificate.ComputeHash()
+	require.NoError(t, err)
+	certificate.Hash = hash
+	return certificate.Marshal()
+}
+
+// testMithrilSigners is a registered STM stake distribution that signs the
+// Mithril certificates of one epoch.
+type testMithrilSigners struct {
+	parameters *mithril.ProtocolParameters
+	closedReg  *crypto.ClosedKeyReg
+	signers    []*crypto.StmInitializer
+	clerk      *crypto.StmClerk
+	avk        string
+}
+
+func newTestMithrilSigners(t *testing.T, parameters *mithril.ProtocolParameters, seed byte) *testMithrilSigners {
+	t.Helper()
+
+	stmParams := &crypto.StmParameters{K: parameters.K, M: parameters.M, PhiF: parameters.PhiF()}
+	var initializers []*crypto.StmInitializer
+	var parties []crypto.RegParty
+	var totalStake crypto.Stake
+	for i, stake := range []uint64{300, 400, 500} {
+		ikm := sha256.Sum256([]byte{seed, byte(i)})
+		sk, err := crypto.Gen(ikm[:])
+		require.NoError(t, err)
+		pk, err := new(crypto.StmVerificationKeyPoP).FromSigningKey(sk)
+		require.NoError(t, err)
+		initializers = append(initializers, &crypto.StmInitializer{Stake: crypto.Stake(stake), Params: stmParams, Sk: sk, Pk: pk})
+		parties = append(parties, crypto.RegParty{VerificationKey: pk.VK, Stake: crypto.Stake(stake)})
+		totalStake += crypto.Stake(stake)
+	}
+	tree, err := crypto.Create(parties)
+	require.NoError(t, err)
+	closedReg := &crypto.ClosedKeyReg{RegParties: parties, TotalStake: totalStake, MerkleTree: tree}
+	clerk := crypto.FromRegistration(stmParams, closedReg)
+
+	avk := clerk.ComputeAVK()
+	return &testMithrilSigners{
+		parameters: parameters,
+		closedReg:  closedReg,
+		signers:    initializers,
+		clerk:      clerk,
+		avk: mustTestJSONHex(t, map[string]any{
+			"mt_commitment": map[string]any{"root": testByteArray(avk.MTCommitment.Root), "nr_leaves": avk.MTCommitment.NrLeaves},
+			"total_stake":   uint64(avk.TotalStake),
+		}),
+	}
+}
+
+// sign seals a certificate of epoch linked to previousHash, committing
+// nextAvk and parts, with a multi-signature of the distribution.
+func (d *testMithrilSigners) sign(
+	t *testing.T,
+	previousHash string,
+	epoch uint64,
+	signedEntityType *mithril.SignedEntityType,
+	nextAvk string,
+	parts ...mithril.MessagePart,
+) *mithril.Certificate {
+	t.Helper()
+
+	certificate := &mithril.Certificate{
+		PreviousHash:     previousHash,
+		Epoch:            epoch,
+		SignedEntityType: signedEntityType,
+		Metadata: &mithril.CertificateMetadata{
+			Network:            "devnet",
+			ProtocolVersion:    "0.1.0",
+			ProtocolParameters: d.parameters,
+			InitiatedAt:        "2026-01-02T03:04:05Z",
+			SealedAt:           "2026-01-02T03:04:06Z",
+		},
+		ProtocolMessage: &mithril.ProtocolMessage{Parts: append(parts, mithril.MessagePart{
+			Key:   mithril.ProtocolMessagePartKeyNextAggregateVerificationKey,
+			Value: nextAvk,
+		})},
+		AggregateVerificationKey: d.avk,
+	}
+	certificate.SignedMessage = certificate.ProtocolMessage.ComputeHash()
+
+	var signatures []*crypto.StmSig
+	for _, initializer := range d.signers {
+		signer, err := initializer.NewSigner(d.closedReg)
+		require.NoError(t, err)
+		signature, err := signer.Sign([]byte(certificate.SignedMessage))
+		require.NoError(t, err)
+		if signature != nil {
+			signatures = append(signatures, signature)
+		}
+	}
+	multiSignature, err := d.clerk.Aggregate(signatures, []byte(certificate.SignedMessage))
+	require.NoError(t, err)
+
+	values := make([]testByteArray, 0, len(multiSignature.BatchProof.Values))
+	for _, value := range multiSignature.BatchProof.Values {
+		values = append(values, value)
+	}
+	var encodedSignatures [][2]any
+	for _, signature := range multiSignature.Signatures {
+		encodedSignatures = append(encodedSignatures, [2]any{
+			map[string]any{
+				"sigma":        testByteArray(signature.Sig.Sigma.ToBytes()),
+				"indexes":      signature.Sig.Indexes,
+				"signer_index": signature.Sig.SignerIndex,
+			},
+			[2]any{testByteArray(signature.RegParty.VerificationKey.ToBytes()), uint64(signature.RegParty.Stake)},
+		})
+	}
+	certificate.MultiSignature = mustTestJSONHex(t, map[string]any{
+		"signatures":  encodedSignatures,
+		"batch_proof": map[string]any{"values": values, "indices": multiSignature.BatchProof.Indices, "hasher": nil},
+	})
+
+	certificate.Hash, err = certificate.ComputeHash()
+	require.NoError(t, err)
+	return certificate
+}
+
+// testByteArray encodes bytes as serde does, as an array of numbers.
+type testByteArray []byte
+
+func (b testByteArray) MarshalJSON() ([]byte, error) {
+	numbers := make([]uint16, len(b))
+	for i, v := range b {
+		numbers[i] = uint16(v)
+	}
+	return json.Marshal(numbers)
+}
+
+func mustTestJSONHex(t *testing.T, value any) string {
+	t.Helper()
+
+	bz, err := json.Marshal(value)
+	require.NoError(t, err)
+	return hex.EncodeToString(bz)
+}
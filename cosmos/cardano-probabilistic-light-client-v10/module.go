@@ -5,6 +5,7 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	"cosmossdk.io/core/appmodule"
 
@@ -17,6 +18,7 @@ import (
 var (
 	_ module.AppModuleBasic = (*AppModuleBasic)(nil)
 	_ appmodule.AppModule   = (*AppModule)(nil)
+	_ appmodule.HasServices = (*AppModule)(nil)
 )
 
 type AppModuleBasic struct{}
@@ -46,3 +48,8 @@ type AppModule struct {
 func NewAppModule(lightClientModule LightClientModule) AppModule {
 	return AppModule{lightClientModule: lightClientModule}
 }
+
+func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	RegisterQueryServer(registrar, NewQueryServer(am.lightClientModule.cdc, am.lightClientModule.storeProvider))
+	return nil
+}
@@ -106,12 +106,19 @@ func (m *StakeDistributionEntry) XXX_DiscardUnknown() {
 var xxx_messageInfo_StakeDistributionEntry proto.InternalMessageInfo
 
 type EpochContext struct {
-	Epoch                 uint64                    `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Full stake distribution. Only carried by headers and client creation to
+	// introduce an epoch; the client stores the commitment below instead.
 	StakeDistribution     []*StakeDistributionEntry `protobuf:"bytes,2,rep,name=stake_distribution,json=stakeDistribution,proto3" json:"stake_distribution,omitempty"`
 	EpochNonce            []byte                    `protobuf:"bytes,3,opt,name=epoch_nonce,json=epochNonce,proto3" json:"epoch_nonce,omitempty"`
 	SlotsPerKesPeriod     uint64                    `protobuf:"varint,4,opt,name=slots_per_kes_period,json=slotsPerKesPeriod,proto3" json:"slots_per_kes_period,omitempty"`
 	EpochStartSlot        uint64                    `protobuf:"varint,5,opt,name=epoch_start_slot,json=epochStartSlot,proto3" json:"epoch_start_slot,omitempty"`
 	EpochEndSlotExclusive uint64                    `protobuf:"varint,6,opt,name=epoch_end_slot_exclusive,json=epochEndSlotExclusive,proto3" json:"epoch_end_slot_exclusive,omitempty"`
+	// Merkle root over the stake distribution entries ordered by lower-cased
+	// pool id, as computed by probabilisticcore.StakeDistributionRoot.
+	StakeDistributionRoot []byte `protobuf:"bytes,7,opt,name=stake_distribution_root,json=stakeDistributionRoot,proto3" json:"stake_distribution_root,omitempty"`
+	TotalStake            uint64 `protobuf:"varint,8,opt,name=total_stake,json=totalStake,proto3" json:"total_stake,omitempty"`
+	PoolCount             uint64 `protobuf:"varint,9,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
 }
 
 func (m *EpochContext) Reset()         { *m = EpochContext{} }
@@ -147,16 +154,254 @@ func (m *EpochContext) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EpochContext proto.InternalMessageInfo
 
+// StakeDistributionProof proves that entry is leaf index of the stake
+// distribution committed by the epoch context for epoch.
+type StakeDistributionProof struct {
+	Epoch uint64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Entry *StakeDistributionEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Index uint64                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Sibling hashes from the leaf up to the root.
+	Siblings [][]byte `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (m *StakeDistributionProof) Reset()         { *m = StakeDistributionProof{} }
+func (m *StakeDistributionProof) String() string { return proto.CompactTextString(m) }
+func (*StakeDistributionProof) ProtoMessage()    {}
+func (*StakeDistributionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{3}
+}
+func (m *StakeDistributionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeDistributionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeDistributionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeDistributionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeDistributionProof.Merge(m, src)
+}
+func (m *StakeDistributionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeDistributionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeDistributionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeDistributionProof proto.InternalMessageInfo
+
+// AcceptancePolicy configures the thresholds and score weights a header must
+// satisfy before its anchor block is accepted.
+type AcceptancePolicy struct {
+	ThresholdDepth          uint64 `protobuf:"varint,1,opt,name=threshold_depth,json=thresholdDepth,proto3" json:"threshold_depth,omitempty"`
+	ThresholdUniquePools    uint64 `protobuf:"varint,2,opt,name=threshold_unique_pools,json=thresholdUniquePools,proto3" json:"threshold_unique_pools,omitempty"`
+	ThresholdUniqueStakeBps uint64 `protobuf:"varint,3,opt,name=threshold_unique_stake_bps,json=thresholdUniqueStakeBps,proto3" json:"threshold_unique_stake_bps,omitempty"`
+	DepthWeightBps          uint64 `protobuf:"varint,4,opt,name=depth_weight_bps,json=depthWeightBps,proto3" json:"depth_weight_bps,omitempty"`
+	PoolsWeightBps          uint64 `protobuf:"varint,5,opt,name=pools_weight_bps,json=poolsWeightBps,proto3" json:"pools_weight_bps,omitempty"`
+	StakeWeightBps          uint64 `protobuf:"varint,6,opt,name=stake_weight_bps,json=stakeWeightBps,proto3" json:"stake_weight_bps,omitempty"`
+	// Minimum density of the descendant window, in basis points of the block
+	// rate the active slot coefficient predicts over the slots it spans. Zero
+	// disables the check.
+	ThresholdChainDensityBps uint64 `protobuf:"varint,7,opt,name=threshold_chain_density_bps,json=thresholdChainDensityBps,proto3" json:"threshold_chain_density_bps,omitempty"`
+	// Active slot coefficient f = numerator / denominator. When both are unset,
+	// the mainnet coefficient 1/20 applies.
+	ActiveSlotCoeffNumerator   uint64 `protobuf:"varint,8,opt,name=active_slot_coeff_numerator,json=activeSlotCoeffNumerator,proto3" json:"active_slot_coeff_numerator,omitempty"`
+	ActiveSlotCoeffDenominator uint64 `protobuf:"varint,9,opt,name=active_slot_coeff_denominator,json=activeSlotCoeffDenominator,proto3" json:"active_slot_coeff_denominator,omitempty"`
+}
+
+func (m *AcceptancePolicy) Reset()         { *m = AcceptancePolicy{} }
+func (m *AcceptancePolicy) String() string { return proto.CompactTextString(m) }
+func (*AcceptancePolicy) ProtoMessage()    {}
+func (*AcceptancePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{4}
+}
+func (m *AcceptancePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptancePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptancePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptancePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptancePolicy.Merge(m, src)
+}
+func (m *AcceptancePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptancePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptancePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptancePolicy proto.InternalMessageInfo
+
+// EpochNonceEvolution carries the Praos nonce accumulators as of the latest
+// authenticated checkpoint block. Empty nonces are the neutral nonce.
+type EpochNonceEvolution struct {
+	// Slots before the end of an epoch after which block VRF outputs no longer
+	// contribute to the candidate nonce (4k/f since Conway).
+	RandomnessStabilityWindowSlots uint64 `protobuf:"varint,1,opt,name=randomness_stability_window_slots,json=randomnessStabilityWindowSlots,proto3" json:"randomness_stability_window_slots,omitempty"`
+	EvolvingNonce                  []byte `protobuf:"bytes,2,opt,name=evolving_nonce,json=evolvingNonce,proto3" json:"evolving_nonce,omitempty"`
+	CandidateNonce                 []byte `protobuf:"bytes,3,opt,name=candidate_nonce,json=candidateNonce,proto3" json:"candidate_nonce,omitempty"`
+	// Previous-hash of the latest authenticated block.
+	LabNonce []byte `protobuf:"bytes,4,opt,name=lab_nonce,json=labNonce,proto3" json:"lab_nonce,omitempty"`
+	// Lab nonce captured at the last epoch boundary.
+	LastEpochBlockNonce []byte `protobuf:"bytes,5,opt,name=last_epoch_block_nonce,json=lastEpochBlockNonce,proto3" json:"last_epoch_block_nonce,omitempty"`
+}
+
+func (m *EpochNonceEvolution) Reset()         { *m = EpochNonceEvolution{} }
+func (m *EpochNonceEvolution) String() string { return proto.CompactTextString(m) }
+func (*EpochNonceEvolution) ProtoMessage()    {}
+func (*EpochNonceEvolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{5}
+}
+func (m *EpochNonceEvolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochNonceEvolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochNonceEvolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochNonceEvolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochNonceEvolution.Merge(m, src)
+}
+func (m *EpochNonceEvolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochNonceEvolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochNonceEvolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochNonceEvolution proto.InternalMessageInfo
+
+// MithrilStakeDistributionTrust is the master (first) Mithril certificate of
+// the latest epoch the client has verified. Certificates of that epoch link to
+// it, and so does the master certificate of the next epoch. When it is set,
+// every new epoch context must be committed by a Mithril
+// CardanoStakeDistribution certificate chaining from it.
+type MithrilStakeDistributionTrust struct {
+	CertificateHash string `protobuf:"bytes,1,opt,name=certificate_hash,json=certificateHash,proto3" json:"certificate_hash,omitempty"`
+	Epoch           uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Hex-encoded JSON aggregate verification key that signed the certificate.
+	AggregateVerificationKey string `protobuf:"bytes,3,opt,name=aggregate_verification_key,json=aggregateVerificationKey,proto3" json:"aggregate_verification_key,omitempty"`
+	// The next_aggregate_verification_key committed by the certificate.
+	NextAggregateVerificationKey string `protobuf:"bytes,4,opt,name=next_aggregate_verification_key,json=nextAggregateVerificationKey,proto3" json:"next_aggregate_verification_key,omitempty"`
+	// Mithril protocol parameters certificates must be signed under.
+	K               uint64 `protobuf:"varint,5,opt,name=k,proto3" json:"k,omitempty"`
+	M               uint64 `protobuf:"varint,6,opt,name=m,proto3" json:"m,omitempty"`
+	PhiFNumerator   uint64 `protobuf:"varint,7,opt,name=phi_f_numerator,json=phiFNumerator,proto3" json:"phi_f_numerator,omitempty"`
+	PhiFDenominator uint64 `protobuf:"varint,8,opt,name=phi_f_denominator,json=phiFDenominator,proto3" json:"phi_f_denominator,omitempty"`
+}
+
+func (m *MithrilStakeDistributionTrust) Reset()         { *m = MithrilStakeDistributionTrust{} }
+func (m *MithrilStakeDistributionTrust) String() string { return proto.CompactTextString(m) }
+func (*MithrilStakeDistributionTrust) ProtoMessage()    {}
+func (*MithrilStakeDistributionTrust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{6}
+}
+func (m *MithrilStakeDistributionTrust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MithrilStakeDistributionTrust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MithrilStakeDistributionTrust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MithrilStakeDistributionTrust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MithrilStakeDistributionTrust.Merge(m, src)
+}
+func (m *MithrilStakeDistributionTrust) XXX_Size() int {
+	return m.Size()
+}
+func (m *MithrilStakeDistributionTrust) XXX_DiscardUnknown() {
+	xxx_messageInfo_MithrilStakeDistributionTrust.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MithrilStakeDistributionTrust proto.InternalMessageInfo
+
+// EraSummary is one segment of the chain's era history: from start_slot on,
+// every slot lasts slot_length_ns, and start_slot begins at
+// start_time_unix_ns.
+type EraSummary struct {
+	StartSlot       uint64 `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	StartTimeUnixNs uint64 `protobuf:"varint,2,opt,name=start_time_unix_ns,json=startTimeUnixNs,proto3" json:"start_time_unix_ns,omitempty"`
+	SlotLengthNs    uint64 `protobuf:"varint,3,opt,name=slot_length_ns,json=slotLengthNs,proto3" json:"slot_length_ns,omitempty"`
+}
+
+func (m *EraSummary) Reset()         { *m = EraSummary{} }
+func (m *EraSummary) String() string { return proto.CompactTextString(m) }
+func (*EraSummary) ProtoMessage()    {}
+func (*EraSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{7}
+}
+func (m *EraSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EraSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EraSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EraSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EraSummary.Merge(m, src)
+}
+func (m *EraSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EraSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EraSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EraSummary proto.InternalMessageInfo
+
 type ClientState struct {
-	ChainId                      string                    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	LatestHeight                 *Height                   `protobuf:"bytes,2,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
-	FrozenHeight                 *Height                   `protobuf:"bytes,3,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
-	CurrentEpoch                 uint64                    `protobuf:"varint,4,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
-	TrustingPeriod               time.Duration             `protobuf:"bytes,5,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period"`
-	UpgradePath                  []string                  `protobuf:"bytes,7,rep,name=upgrade_path,json=upgradePath,proto3" json:"upgrade_path,omitempty"`
-	HostStateNftPolicyId         []byte                    `protobuf:"bytes,8,opt,name=host_state_nft_policy_id,json=hostStateNftPolicyId,proto3" json:"host_state_nft_policy_id,omitempty"`
-	HostStateNftTokenName        []byte                    `protobuf:"bytes,9,opt,name=host_state_nft_token_name,json=hostStateNftTokenName,proto3" json:"host_state_nft_token_name,omitempty"`
-	EpochStakeDistribution       []*StakeDistributionEntry `protobuf:"bytes,10,rep,name=epoch_stake_distribution,json=epochStakeDistribution,proto3" json:"epoch_stake_distribution,omitempty"`
+	ChainId               string        `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	LatestHeight          *Height       `protobuf:"bytes,2,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
+	FrozenHeight          *Height       `protobuf:"bytes,3,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	CurrentEpoch          uint64        `protobuf:"varint,4,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	TrustingPeriod        time.Duration `protobuf:"bytes,5,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period"`
+	UpgradePath           []string      `protobuf:"bytes,7,rep,name=upgrade_path,json=upgradePath,proto3" json:"upgrade_path,omitempty"`
+	HostStateNftPolicyId  []byte        `protobuf:"bytes,8,opt,name=host_state_nft_policy_id,json=hostStateNftPolicyId,proto3" json:"host_state_nft_policy_id,omitempty"`
+	HostStateNftTokenName []byte        `protobuf:"bytes,9,opt,name=host_state_nft_token_name,json=hostStateNftTokenName,proto3" json:"host_state_nft_token_name,omitempty"`
+	// Deprecated: no longer populated. The current epoch's stake distribution
+	// is committed by its entry in epoch_contexts.
+	EpochStakeDistribution       []*StakeDistributionEntry `protobuf:"bytes,10,rep,name=epoch_stake_distribution,json=epochStakeDistribution,proto3" json:"epoch_stake_distribution,omitempty"` // Deprecated: Do not use.
 	EpochNonce                   []byte                    `protobuf:"bytes,11,opt,name=epoch_nonce,json=epochNonce,proto3" json:"epoch_nonce,omitempty"`
 	SlotsPerKesPeriod            uint64                    `protobuf:"varint,12,opt,name=slots_per_kes_period,json=slotsPerKesPeriod,proto3" json:"slots_per_kes_period,omitempty"`
 	CurrentEpochStartSlot        uint64                    `protobuf:"varint,13,opt,name=current_epoch_start_slot,json=currentEpochStartSlot,proto3" json:"current_epoch_start_slot,omitempty"`
@@ -169,13 +414,31 @@ type ClientState struct {
 	LatestCheckpointHeight    *Height `protobuf:"bytes,19,opt,name=latest_checkpoint_height,json=latestCheckpointHeight,proto3" json:"latest_checkpoint_height,omitempty"`
 	LatestCheckpointBlockHash string  `protobuf:"bytes,20,opt,name=latest_checkpoint_block_hash,json=latestCheckpointBlockHash,proto3" json:"latest_checkpoint_block_hash,omitempty"`
 	LatestCheckpointEpoch     uint64  `protobuf:"varint,21,opt,name=latest_checkpoint_epoch,json=latestCheckpointEpoch,proto3" json:"latest_checkpoint_epoch,omitempty"`
+	// When unset, the default acceptance policy applies.
+	AcceptancePolicy *AcceptancePolicy `protobuf:"bytes,22,opt,name=acceptance_policy,json=acceptancePolicy,proto3" json:"acceptance_policy,omitempty"`
+	// When set, the nonce of every epoch entered by the checkpoint cursor must
+	// equal the nonce derived from the authenticated blocks of the previous
+	// epoch. When unset, epoch nonces are trusted from new_epoch_context.
+	EpochNonceEvolution *EpochNonceEvolution `protobuf:"bytes,23,opt,name=epoch_nonce_evolution,json=epochNonceEvolution,proto3" json:"epoch_nonce_evolution,omitempty"`
+	// When set, new epoch contexts are only accepted with Mithril certificates
+	// committing to their stake distribution.
+	MithrilStakeDistributionTrust *MithrilStakeDistributionTrust `protobuf:"bytes,24,opt,name=mithril_stake_distribution_trust,json=mithrilStakeDistributionTrust,proto3" json:"mithril_stake_distribution_trust,omitempty"`
+	// Maximum number of expired consensus states pruned by one update. When
+	// zero, the default limit applies.
+	MaxPrunedConsensusStatesPerUpdate uint64 `protobuf:"varint,25,opt,name=max_pruned_consensus_states_per_update,json=maxPrunedConsensusStatesPerUpdate,proto3" json:"max_pruned_consensus_states_per_update,omitempty"`
+	// Era history used for slot-to-time conversion, ordered by start slot. When
+	// empty, system_start_unix_ns and slot_length_ns describe a single era.
+	EraHistory []*EraSummary `protobuf:"bytes,26,rep,name=era_history,json=eraHistory,proto3" json:"era_history,omitempty"`
+	// Lowest height whose consensus state records a shut-down HostState. Once
+	// set, the client is terminal and proofs at or above it are rejected.
+	HostStateShutdownHeight *Height `protobuf:"bytes,27,opt,name=host_state_shutdown_height,json=hostStateShutdownHeight,proto3" json:"host_state_shutdown_height,omitempty"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
 func (m *ClientState) String() string { return proto.CompactTextString(m) }
 func (*ClientState) ProtoMessage()    {}
 func (*ClientState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dbfbf493c1ef770, []int{3}
+	return fileDescriptor_4dbfbf493c1ef770, []int{8}
 }
 func (m *ClientState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,13 +475,18 @@ type ConsensusState struct {
 	UniquePoolsCount  uint64 `protobuf:"varint,5,opt,name=unique_pools_count,json=uniquePoolsCount,proto3" json:"unique_pools_count,omitempty"`
 	UniqueStakeBps    uint64 `protobuf:"varint,6,opt,name=unique_stake_bps,json=uniqueStakeBps,proto3" json:"unique_stake_bps,omitempty"`
 	SecurityScoreBps  uint64 `protobuf:"varint,7,opt,name=security_score_bps,json=securityScoreBps,proto3" json:"security_score_bps,omitempty"`
+	ChainDensityBps   uint64 `protobuf:"varint,8,opt,name=chain_density_bps,json=chainDensityBps,proto3" json:"chain_density_bps,omitempty"`
+	// Unset for consensus states accepted before HostState lineage was tracked.
+	HostStateLineage *HostStateLineage `protobuf:"bytes,9,opt,name=host_state_lineage,json=hostStateLineage,proto3" json:"host_state_lineage,omitempty"`
+	// Set when the HostState datum the root was read from is shutting down.
+	HostStateShutdown *HostStateShutdown `protobuf:"bytes,10,opt,name=host_state_shutdown,json=hostStateShutdown,proto3" json:"host_state_shutdown,omitempty"`
 }
 
 func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
 func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
 func (*ConsensusState) ProtoMessage()    {}
 func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dbfbf493c1ef770, []int{4}
+	return fileDescriptor_4dbfbf493c1ef770, []int{9}
 }
 func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,6 +515,91 @@ func (m *ConsensusState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
 
+// HostStateShutdown is the ShuttingDown mode of a HostState datum, in POSIX
+// milliseconds.
+type HostStateShutdown struct {
+	InitiatedAtMs    uint64 `protobuf:"varint,1,opt,name=initiated_at_ms,json=initiatedAtMs,proto3" json:"initiated_at_ms,omitempty"`
+	GracePeriodEndMs uint64 `protobuf:"varint,2,opt,name=grace_period_end_ms,json=gracePeriodEndMs,proto3" json:"grace_period_end_ms,omitempty"`
+}
+
+func (m *HostStateShutdown) Reset()         { *m = HostStateShutdown{} }
+func (m *HostStateShutdown) String() string { return proto.CompactTextString(m) }
+func (*HostStateShutdown) ProtoMessage()    {}
+func (*HostStateShutdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{10}
+}
+func (m *HostStateShutdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostStateShutdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostStateShutdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostStateShutdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostStateShutdown.Merge(m, src)
+}
+func (m *HostStateShutdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostStateShutdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostStateShutdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostStateShutdown proto.InternalMessageInfo
+
+// HostStateLineage identifies the HostState UTxO an accepted root was read
+// from, together with the counters of its datum. The HostState UTxO of a
+// later root must descend from it through HostState transactions.
+type HostStateLineage struct {
+	TxHash                 string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	OutputIndex            uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Version                uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	NextClientSequence     uint64 `protobuf:"varint,4,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty"`
+	NextConnectionSequence uint64 `protobuf:"varint,5,opt,name=next_connection_sequence,json=nextConnectionSequence,proto3" json:"next_connection_sequence,omitempty"`
+	NextChannelSequence    uint64 `protobuf:"varint,6,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
+}
+
+func (m *HostStateLineage) Reset()         { *m = HostStateLineage{} }
+func (m *HostStateLineage) String() string { return proto.CompactTextString(m) }
+func (*HostStateLineage) ProtoMessage()    {}
+func (*HostStateLineage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{11}
+}
+func (m *HostStateLineage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostStateLineage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostStateLineage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostStateLineage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostStateLineage.Merge(m, src)
+}
+func (m *HostStateLineage) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostStateLineage) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostStateLineage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostStateLineage proto.InternalMessageInfo
+
 type Misbehaviour struct {
 	ClientId             string               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Deprecated: Do not use.
 	ProbabilisticHeader1 *ProbabilisticHeader `protobuf:"bytes,2,opt,name=probabilistic_header_1,json=probabilisticHeader1,proto3" json:"probabilistic_header_1,omitempty"`
@@ -257,7 +610,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dbfbf493c1ef770, []int{5}
+	return fileDescriptor_4dbfbf493c1ef770, []int{12}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,20 +639,68 @@ func (m *Misbehaviour) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
 
+// SlotLeaderEquivocation is evidence that one stake pool issued two different
+// blocks for the same slot, or two blocks whose operational certificates
+// reuse a counter. Each block may be relayed header-only.
+type SlotLeaderEquivocation struct {
+	Block1 *ProbabilisticBlock `protobuf:"bytes,1,opt,name=block_1,json=block1,proto3" json:"block_1,omitempty"`
+	Block2 *ProbabilisticBlock `protobuf:"bytes,2,opt,name=block_2,json=block2,proto3" json:"block_2,omitempty"`
+	// Inclusion proofs for the issuer in the epoch of each block.
+	StakeDistributionProofs []*StakeDistributionProof `protobuf:"bytes,3,rep,name=stake_distribution_proofs,json=stakeDistributionProofs,proto3" json:"stake_distribution_proofs,omitempty"`
+}
+
+func (m *SlotLeaderEquivocation) Reset()         { *m = SlotLeaderEquivocation{} }
+func (m *SlotLeaderEquivocation) String() string { return proto.CompactTextString(m) }
+func (*SlotLeaderEquivocation) ProtoMessage()    {}
+func (*SlotLeaderEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{13}
+}
+func (m *SlotLeaderEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotLeaderEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotLeaderEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlotLeaderEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotLeaderEquivocation.Merge(m, src)
+}
+func (m *SlotLeaderEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotLeaderEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotLeaderEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotLeaderEquivocation proto.InternalMessageInfo
+
 type ProbabilisticBlock struct {
 	Height    *Height `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
 	Slot      uint64  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
 	Hash      string  `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
 	Epoch     uint64  `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Timestamp uint64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	BlockCbor []byte  `protobuf:"bytes,9,opt,name=block_cbor,json=blockCbor,proto3" json:"block_cbor,omitempty"`
+	// Full block CBOR. Required for the anchor block, whose HostState
+	// transaction is read from the block body.
+	BlockCbor []byte `protobuf:"bytes,9,opt,name=block_cbor,json=blockCbor,proto3" json:"block_cbor,omitempty"`
+	// Header-only CBOR, accepted in place of block_cbor for bridge and
+	// descendant blocks. The body hash of such blocks is not checked.
+	HeaderCbor []byte `protobuf:"bytes,10,opt,name=header_cbor,json=headerCbor,proto3" json:"header_cbor,omitempty"`
 }
 
 func (m *ProbabilisticBlock) Reset()         { *m = ProbabilisticBlock{} }
 func (m *ProbabilisticBlock) String() string { return proto.CompactTextString(m) }
 func (*ProbabilisticBlock) ProtoMessage()    {}
 func (*ProbabilisticBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dbfbf493c1ef770, []int{6}
+	return fileDescriptor_4dbfbf493c1ef770, []int{14}
 }
 func (m *ProbabilisticBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,6 +729,46 @@ func (m *ProbabilisticBlock) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProbabilisticBlock proto.InternalMessageInfo
 
+// EpochBridgeSegment is one step of a multi-epoch catch-up: the bridge blocks
+// leading from the previous step into epoch_context.epoch.
+type EpochBridgeSegment struct {
+	EpochContext *EpochContext         `protobuf:"bytes,1,opt,name=epoch_context,json=epochContext,proto3" json:"epoch_context,omitempty"`
+	BridgeBlocks []*ProbabilisticBlock `protobuf:"bytes,2,rep,name=bridge_blocks,json=bridgeBlocks,proto3" json:"bridge_blocks,omitempty"`
+}
+
+func (m *EpochBridgeSegment) Reset()         { *m = EpochBridgeSegment{} }
+func (m *EpochBridgeSegment) String() string { return proto.CompactTextString(m) }
+func (*EpochBridgeSegment) ProtoMessage()    {}
+func (*EpochBridgeSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dbfbf493c1ef770, []int{15}
+}
+func (m *EpochBridgeSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochBridgeSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochBridgeSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochBridgeSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochBridgeSegment.Merge(m, src)
+}
+func (m *EpochBridgeSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochBridgeSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochBridgeSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochBridgeSegment proto.InternalMessageInfo
+
 type ProbabilisticHeader struct {
 	TrustedHeight          *Height               `protobuf:"bytes,1,opt,name=trusted_height,json=trustedHeight,proto3" json:"trusted_height,omitempty"`
 	AnchorBlock            *ProbabilisticBlock   `protobuf:"bytes,2,opt,name=anchor_block,json=anchorBlock,proto3" json:"anchor_block,omitempty"`
@@ -339,13 +780,26 @@ type ProbabilisticHeader struct {
 	// Checkpoints authenticate Cardano chain progression without creating an
 	// IBC consensus state or renewing the trusting period.
 	IsCheckpoint bool `protobuf:"varint,12,opt,name=is_checkpoint,json=isCheckpoint,proto3" json:"is_checkpoint,omitempty"`
+	// Ordered intermediate epoch steps between trusted_height and
+	// bridge_blocks, used when the anchor is more than one epoch ahead.
+	EpochBridgeSegments []*EpochBridgeSegment `protobuf:"bytes,13,rep,name=epoch_bridge_segments,json=epochBridgeSegments,proto3" json:"epoch_bridge_segments,omitempty"`
+	// Protobuf-encoded ibc.lightclients.mithril.v1.MithrilCertificate messages,
+	// oldest first, extending the client's Mithril trust to the
+	// CardanoStakeDistribution certificates for the header's new epoch contexts.
+	MithrilCertificates [][]byte `protobuf:"bytes,14,rep,name=mithril_certificates,json=mithrilCertificates,proto3" json:"mithril_certificates,omitempty"`
+	// Inclusion proofs for the slot leaders of the header's bridge, anchor and
+	// descendant blocks, one per epoch and pool.
+	StakeDistributionProofs []*StakeDistributionProof `protobuf:"bytes,15,rep,name=stake_distribution_proofs,json=stakeDistributionProofs,proto3" json:"stake_distribution_proofs,omitempty"`
+	// HostState transaction bodies, oldest first, between the HostState UTxO
+	// of the trusted consensus state and host_state_tx_hash.
+	HostStateLineageTxBodies [][]byte `protobuf:"bytes,16,rep,name=host_state_lineage_tx_bodies,json=hostStateLineageTxBodies,proto3" json:"host_state_lineage_tx_bodies,omitempty"`
 }
 
 func (m *ProbabilisticHeader) Reset()         { *m = ProbabilisticHeader{} }
 func (m *ProbabilisticHeader) String() string { return proto.CompactTextString(m) }
 func (*ProbabilisticHeader) ProtoMessage()    {}
 func (*ProbabilisticHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dbfbf493c1ef770, []int{7}
+	return fileDescriptor_4dbfbf493c1ef770, []int{16}
 }
 func (m *ProbabilisticHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,10 +832,19 @@ func init() {
 	proto.RegisterType((*Height)(nil), "ibc.lightclients.probabilistic.v1.Height")
 	proto.RegisterType((*StakeDistributionEntry)(nil), "ibc.lightclients.probabilistic.v1.StakeDistributionEntry")
 	proto.RegisterType((*EpochContext)(nil), "ibc.lightclients.probabilistic.v1.EpochContext")
+	proto.RegisterType((*StakeDistributionProof)(nil), "ibc.lightclients.probabilistic.v1.StakeDistributionProof")
+	proto.RegisterType((*AcceptancePolicy)(nil), "ibc.lightclients.probabilistic.v1.AcceptancePolicy")
+	proto.RegisterType((*EpochNonceEvolution)(nil), "ibc.lightclients.probabilistic.v1.EpochNonceEvolution")
+	proto.RegisterType((*MithrilStakeDistributionTrust)(nil), "ibc.lightclients.probabilistic.v1.MithrilStakeDistributionTrust")
+	proto.RegisterType((*EraSummary)(nil), "ibc.lightclients.probabilistic.v1.EraSummary")
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.probabilistic.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.probabilistic.v1.ConsensusState")
+	proto.RegisterType((*HostStateShutdown)(nil), "ibc.lightclients.probabilistic.v1.HostStateShutdown")
+	proto.RegisterType((*HostStateLineage)(nil), "ibc.lightclients.probabilistic.v1.HostStateLineage")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.probabilistic.v1.Misbehaviour")
+	proto.RegisterType((*SlotLeaderEquivocation)(nil), "ibc.lightclients.probabilistic.v1.SlotLeaderEquivocation")
 	proto.RegisterType((*ProbabilisticBlock)(nil), "ibc.lightclients.probabilistic.v1.ProbabilisticBlock")
+	proto.RegisterType((*EpochBridgeSegment)(nil), "ibc.lightclients.probabilistic.v1.EpochBridgeSegment")
 	proto.RegisterType((*ProbabilisticHeader)(nil), "ibc.lightclients.probabilistic.v1.ProbabilisticHeader")
 }
 
@@ -390,97 +853,170 @@ func init() {
 }
 
 var fileDescriptor_4dbfbf493c1ef770 = []byte{
-	// 1437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0x1b, 0xc5,
-	0x16, 0xb6, 0x6c, 0x59, 0x96, 0xda, 0x92, 0x2d, 0x77, 0x1c, 0x67, 0x9c, 0xca, 0xb5, 0x1d, 0xe7,
-	0xde, 0xba, 0xa6, 0xc0, 0x12, 0x36, 0x95, 0x04, 0xc2, 0x82, 0xc2, 0x8e, 0xa9, 0x38, 0x09, 0xc2,
-	0x35, 0x0e, 0x14, 0x15, 0x16, 0xc3, 0x4c, 0x4f, 0x4b, 0xd3, 0x65, 0xa9, 0x7b, 0xe8, 0xee, 0x51,
-	0x64, 0x1e, 0x80, 0x82, 0x1d, 0x4b, 0x96, 0xec, 0x78, 0x04, 0x1e, 0x80, 0x05, 0x61, 0x97, 0x25,
-	0xab, 0x40, 0x39, 0x3b, 0x1e, 0x82, 0xa2, 0xfa, 0x67, 0xa4, 0x19, 0x47, 0x01, 0x3b, 0x61, 0x63,
-	0x6b, 0xce, 0x5f, 0xf7, 0x39, 0xe7, 0xfb, 0xce, 0x69, 0x70, 0x9d, 0x04, 0xa8, 0xd9, 0x25, 0x9d,
-	0x48, 0xa2, 0x2e, 0xc1, 0x54, 0x8a, 0x66, 0xcc, 0x59, 0xe0, 0x07, 0xa4, 0x4b, 0x84, 0x24, 0xa8,
-	0xd9, 0xdf, 0xca, 0x0b, 0x1a, 0x31, 0x67, 0x92, 0xc1, 0xab, 0x24, 0x40, 0x8d, 0xac, 0x5b, 0x23,
-	0x6f, 0xd5, 0xdf, 0xba, 0xbc, 0xd8, 0x61, 0x1d, 0xa6, 0xad, 0x9b, 0xea, 0x97, 0x71, 0xbc, 0xbc,
-	0xd2, 0x61, 0xac, 0xd3, 0xc5, 0x4d, 0xfd, 0x15, 0x24, 0xed, 0x66, 0x98, 0x70, 0x5f, 0x12, 0x46,
-	0x8d, 0x7e, 0xfd, 0x73, 0x50, 0xba, 0x83, 0x55, 0x5c, 0xf8, 0x7f, 0x30, 0xcf, 0x71, 0x9f, 0x08,
-	0xc2, 0xa8, 0x47, 0x93, 0x5e, 0x80, 0xb9, 0x53, 0x58, 0x2b, 0x6c, 0x14, 0xdd, 0xb9, 0x54, 0xdc,
-	0xd2, 0xd2, 0x9c, 0x61, 0xa4, 0x7d, 0x9d, 0xc9, 0xbc, 0xa1, 0x89, 0x78, 0xab, 0xf8, 0xf5, 0xf7,
-	0xab, 0x13, 0xeb, 0x3f, 0x14, 0xc0, 0xd2, 0xa1, 0xf4, 0x8f, 0xf0, 0x6d, 0x22, 0x24, 0x27, 0x41,
-	0xa2, 0x4e, 0xdf, 0xa3, 0x92, 0x1f, 0xc3, 0x4b, 0x60, 0x26, 0x66, 0xac, 0xeb, 0x91, 0x50, 0x1f,
-	0x55, 0x71, 0x4b, 0xea, 0x73, 0x3f, 0x84, 0x8b, 0x60, 0x5a, 0x28, 0x17, 0x1b, 0xd8, 0x7c, 0xc0,
-	0x35, 0x50, 0xed, 0xf3, 0xb6, 0x77, 0x84, 0x8f, 0xbd, 0xc8, 0x17, 0x91, 0x33, 0xb5, 0x56, 0xd8,
-	0xa8, 0xba, 0xa0, 0xcf, 0xdb, 0xf7, 0xf0, 0xf1, 0x1d, 0x5f, 0x44, 0xf0, 0x06, 0xb8, 0xd4, 0x26,
-	0x5c, 0x48, 0x8f, 0xe3, 0x8e, 0x3a, 0x4d, 0x67, 0xea, 0x89, 0x2e, 0x93, 0x4e, 0x51, 0x47, 0xba,
-	0xa8, 0xd5, 0x6e, 0x46, 0x7b, 0xd8, 0x65, 0xe9, 0x4d, 0x7f, 0x99, 0x04, 0xd5, 0xbd, 0x98, 0xa1,
-	0x68, 0x97, 0x51, 0x89, 0x07, 0x52, 0x5d, 0x03, 0xab, 0x6f, 0x5b, 0x08, 0xf3, 0x01, 0x23, 0x00,
-	0xf5, 0x7d, 0xbc, 0x30, 0x93, 0x90, 0x33, 0xb9, 0x36, 0xb5, 0x31, 0xbb, 0xfd, 0x4e, 0xe3, 0x1f,
-	0x1b, 0xd5, 0x18, 0x5f, 0x0c, 0x77, 0x41, 0x9c, 0x96, 0xc3, 0x55, 0x30, 0xab, 0x8f, 0xf4, 0x28,
-	0xa3, 0x08, 0xa7, 0xf9, 0x6a, 0x51, 0x4b, 0x49, 0x60, 0x13, 0x2c, 0xaa, 0xe4, 0x84, 0x17, 0x63,
-	0xee, 0x1d, 0x61, 0xfd, 0x9f, 0xb0, 0xd0, 0x26, 0xbb, 0xa0, 0x75, 0x07, 0x98, 0xdf, 0xc3, 0xea,
-	0x2f, 0x61, 0x21, 0xdc, 0x00, 0x75, 0x13, 0x51, 0x48, 0x9f, 0x4b, 0x53, 0x99, 0x69, 0xd3, 0x3c,
-	0x2d, 0x3f, 0x54, 0x62, 0x55, 0x12, 0x78, 0x13, 0x38, 0xc6, 0x12, 0xd3, 0x50, 0xdb, 0x79, 0x78,
-	0x80, 0xba, 0x89, 0x20, 0x7d, 0xec, 0x94, 0x4c, 0x2d, 0xb5, 0x7e, 0x8f, 0x86, 0xca, 0x7e, 0x2f,
-	0x55, 0xda, 0x5a, 0xfe, 0x54, 0x01, 0xb3, 0xbb, 0xba, 0x02, 0x87, 0xd2, 0x97, 0x18, 0x2e, 0x83,
-	0x32, 0x8a, 0x7c, 0x42, 0x47, 0xbd, 0x9e, 0xd1, 0xdf, 0xfb, 0x21, 0x6c, 0x81, 0x5a, 0xd7, 0x97,
-	0x58, 0xc8, 0x2c, 0x9a, 0x66, 0xb7, 0x5f, 0x3b, 0x43, 0x29, 0x0d, 0xd0, 0xdc, 0xaa, 0xf1, 0xb7,
-	0x40, 0x6e, 0x81, 0x5a, 0x9b, 0xb3, 0x2f, 0xf1, 0x10, 0x9d, 0x53, 0xe7, 0x8e, 0x67, 0xfc, 0x6d,
-	0xbc, 0x6b, 0xa0, 0x86, 0x12, 0xce, 0x31, 0x95, 0x9e, 0x41, 0x83, 0xa9, 0x6e, 0xd5, 0x0a, 0x35,
-	0x62, 0xe0, 0x7d, 0x30, 0x2f, 0x79, 0x22, 0x24, 0xa1, 0x9d, 0xb4, 0x09, 0xd3, 0xfa, 0xd8, 0xe5,
-	0x86, 0x61, 0x60, 0x23, 0x65, 0x60, 0xe3, 0xb6, 0x65, 0xe0, 0x4e, 0xf9, 0xf1, 0xd3, 0xd5, 0x89,
-	0xef, 0x7e, 0x5b, 0x2d, 0xb8, 0x73, 0xa9, 0xaf, 0x6d, 0xd3, 0x55, 0x50, 0x4d, 0xe2, 0x0e, 0xf7,
-	0x43, 0xec, 0xc5, 0xbe, 0x8c, 0x9c, 0x99, 0xb5, 0xa9, 0x8d, 0x8a, 0x3b, 0x6b, 0x65, 0x07, 0xbe,
-	0x54, 0x50, 0x77, 0x22, 0x26, 0xa4, 0x6a, 0xa4, 0xc4, 0x1e, 0x6d, 0x4b, 0x2f, 0x66, 0x5d, 0x82,
-	0x8e, 0x55, 0x81, 0xcb, 0x1a, 0x28, 0x8b, 0x4a, 0xaf, 0xab, 0xdf, 0x6a, 0xcb, 0x03, 0xad, 0xdc,
-	0x0f, 0xe1, 0xdb, 0x60, 0xf9, 0x94, 0x9f, 0x64, 0x47, 0x98, 0x7a, 0xd4, 0xef, 0x61, 0xa7, 0xa2,
-	0x1d, 0x2f, 0x66, 0x1d, 0x1f, 0x28, 0x6d, 0xcb, 0xef, 0x61, 0x28, 0x52, 0x44, 0x8c, 0x41, 0x3f,
-	0x78, 0x55, 0xf4, 0x2f, 0xa5, 0xf0, 0xfb, 0x7b, 0x0a, 0xcc, 0x9e, 0x99, 0x02, 0xd5, 0x17, 0x51,
-	0xe0, 0x26, 0x70, 0x72, 0xed, 0xcc, 0x52, 0xa1, 0x66, 0x80, 0x9d, 0xed, 0xec, 0x88, 0x11, 0x1f,
-	0x80, 0xb5, 0xbc, 0xe3, 0x18, 0x66, 0xcc, 0xe9, 0x00, 0x57, 0xb2, 0x01, 0x4e, 0x13, 0x44, 0xdf,
-	0xf8, 0x58, 0x48, 0xdc, 0xb3, 0x27, 0x27, 0x94, 0x0c, 0x3c, 0x2a, 0x9c, 0x79, 0x7b, 0x63, 0xad,
-	0xd3, 0xc7, 0x7e, 0x4c, 0xc9, 0xa0, 0x25, 0xe0, 0x7f, 0xc1, 0x9c, 0x3e, 0xa6, 0x8b, 0x69, 0x47,
-	0x46, 0xca, 0xb4, 0x6e, 0x10, 0xa8, 0xa4, 0xf7, 0xb5, 0xb0, 0x25, 0xe0, 0x27, 0xc0, 0x50, 0xd8,
-	0x43, 0x66, 0x7a, 0x09, 0x67, 0x41, 0x37, 0xa5, 0x79, 0x86, 0xa6, 0x64, 0xa7, 0x9e, 0x5b, 0xc3,
-	0x99, 0x2f, 0x01, 0x11, 0x70, 0x2c, 0x3d, 0x51, 0x84, 0xd1, 0x51, 0xcc, 0x08, 0x1d, 0x32, 0xf5,
-	0xc2, 0x79, 0x99, 0xb5, 0x64, 0x42, 0xed, 0x0e, 0x23, 0x59, 0x8e, 0xbd, 0x07, 0xae, 0x3c, 0x7f,
-	0x48, 0xd0, 0x65, 0xe8, 0xc8, 0x8c, 0xfa, 0x45, 0x3d, 0x32, 0x96, 0x4f, 0x7b, 0xef, 0x28, 0x8b,
-	0x74, 0xf2, 0x3f, 0x1f, 0xc0, 0xd0, 0xf5, 0xa2, 0x69, 0xea, 0x69, 0x5f, 0x9d, 0xb3, 0x99, 0x56,
-	0x77, 0x8b, 0xe5, 0x52, 0x7d, 0xc6, 0xad, 0x47, 0x38, 0xe1, 0xfa, 0xc6, 0x5e, 0xec, 0x73, 0xbf,
-	0x27, 0xd6, 0x7f, 0x9c, 0x04, 0x73, 0xbb, 0x8c, 0x0a, 0x4c, 0x45, 0x22, 0xcc, 0x20, 0xbb, 0x02,
-	0x2a, 0x92, 0xf4, 0xb0, 0x90, 0x7e, 0x2f, 0xb6, 0x7b, 0x61, 0x24, 0x50, 0xad, 0x22, 0x01, 0xb2,
-	0xe4, 0xe2, 0x8c, 0x99, 0x61, 0x56, 0x75, 0xab, 0x24, 0x40, 0xda, 0xdf, 0x65, 0x4c, 0xc2, 0x06,
-	0xb8, 0xe0, 0x23, 0x84, 0x63, 0x89, 0xc3, 0x6c, 0x92, 0x53, 0x3a, 0xc9, 0x85, 0x54, 0x35, 0x4a,
-	0xee, 0x7f, 0x60, 0x6e, 0x68, 0x9f, 0x1d, 0x41, 0xb5, 0x54, 0x6a, 0x66, 0xd0, 0x1b, 0x00, 0x26,
-	0x94, 0x7c, 0x91, 0x60, 0x4f, 0xad, 0x51, 0xe1, 0x21, 0x96, 0xd0, 0x74, 0xbc, 0xd7, 0x8d, 0xe6,
-	0x40, 0x29, 0x76, 0x95, 0x5c, 0xad, 0x02, 0x6b, 0x6d, 0xf8, 0x1c, 0xc4, 0xc2, 0x0e, 0xf6, 0x39,
-	0x23, 0xd7, 0x64, 0xdc, 0x89, 0x85, 0x8a, 0x2b, 0x30, 0x4a, 0x38, 0x91, 0xc7, 0x9e, 0x40, 0x8c,
-	0x1b, 0xdb, 0x19, 0x13, 0x37, 0xd5, 0x1c, 0x2a, 0xc5, 0x4e, 0x2c, 0xec, 0xfc, 0xff, 0x79, 0x12,
-	0x54, 0x3f, 0x24, 0x22, 0xc0, 0x91, 0xdf, 0x27, 0x2c, 0xe1, 0x70, 0x15, 0x54, 0x0c, 0x38, 0x86,
-	0x1b, 0x60, 0x67, 0xd2, 0x29, 0xb8, 0x65, 0x23, 0xdc, 0x0f, 0xe1, 0x57, 0x05, 0xb0, 0x94, 0x83,
-	0x8d, 0x17, 0x61, 0x3f, 0xc4, 0xdc, 0xdb, 0xb2, 0x0b, 0xe1, 0xc6, 0x19, 0x60, 0x76, 0x90, 0x15,
-	0xdc, 0xd1, 0xfe, 0x3b, 0xce, 0xc9, 0xd3, 0xd5, 0xc5, 0x31, 0x8a, 0x2d, 0x77, 0x31, 0x1e, 0x23,
-	0x7d, 0xf1, 0x45, 0xb6, 0xed, 0x26, 0xf9, 0xb7, 0x2f, 0xb2, 0x3d, 0xf6, 0x22, 0xdb, 0xb6, 0x92,
-	0x7f, 0x14, 0x00, 0xcc, 0x39, 0x69, 0x5c, 0xc0, 0xf7, 0x41, 0xc9, 0x92, 0xb0, 0x70, 0x5e, 0x12,
-	0x5a, 0x47, 0x08, 0x41, 0x51, 0x4f, 0x3d, 0xf3, 0xc8, 0xd2, 0xbf, 0x95, 0x2c, 0x83, 0x45, 0xfd,
-	0x7b, 0xf4, 0x0c, 0x9a, 0xce, 0x3e, 0x83, 0x72, 0x44, 0x28, 0x9d, 0x26, 0xc2, 0x7f, 0x00, 0x30,
-	0xc8, 0x46, 0x01, 0xe3, 0x76, 0xaf, 0x54, 0xb4, 0x64, 0x37, 0x60, 0x7c, 0x48, 0xbb, 0x62, 0x7d,
-	0xfa, 0x6e, 0xb1, 0x3c, 0x53, 0x2f, 0xdf, 0x2d, 0x96, 0xcb, 0xf5, 0xca, 0xfa, 0x9f, 0x45, 0x70,
-	0x61, 0x4c, 0x85, 0xe0, 0x01, 0x30, 0x2b, 0x12, 0x87, 0xde, 0xcb, 0x66, 0x5d, 0xb3, 0x01, 0xec,
-	0xc4, 0xf9, 0x14, 0x54, 0x7d, 0x8a, 0x22, 0xc6, 0x0d, 0x03, 0x2d, 0xc6, 0xae, 0x9f, 0xb7, 0xb5,
-	0xba, 0x19, 0xee, 0xac, 0x09, 0x65, 0x3a, 0x13, 0x80, 0x85, 0x10, 0x0b, 0x84, 0x69, 0xe8, 0xa7,
-	0x43, 0x4c, 0x38, 0x53, 0x7a, 0x16, 0xbf, 0x64, 0xf8, 0xfa, 0x28, 0x9e, 0x16, 0x08, 0xf8, 0x3a,
-	0x80, 0x99, 0x2d, 0x2e, 0x07, 0x66, 0x80, 0x14, 0x75, 0xd3, 0xe6, 0x87, 0xeb, 0xfb, 0xc1, 0x40,
-	0x8f, 0x8f, 0x5b, 0xe0, 0x72, 0xde, 0x98, 0x25, 0x32, 0x4e, 0xa4, 0x47, 0x68, 0x88, 0x07, 0xba,
-	0x75, 0x35, 0x77, 0x29, 0xe3, 0xf4, 0x91, 0x56, 0xef, 0x2b, 0x2d, 0x7c, 0x08, 0x6a, 0x01, 0x27,
-	0x61, 0x07, 0xa7, 0x89, 0x80, 0x57, 0x49, 0xa4, 0x6a, 0x62, 0xd9, 0x24, 0x3e, 0x03, 0x0b, 0x14,
-	0x3f, 0xf2, 0x72, 0x5b, 0x4b, 0x6f, 0xf8, 0x97, 0x58, 0x5a, 0xf3, 0x14, 0x3f, 0xca, 0xbd, 0xdd,
-	0xaf, 0x81, 0x1a, 0x11, 0x99, 0x65, 0xa0, 0x1f, 0x04, 0x65, 0xb7, 0x4a, 0xc4, 0x68, 0x05, 0x0c,
-	0x61, 0x38, 0x5d, 0x2f, 0x69, 0x18, 0x82, 0x9d, 0x6f, 0x0a, 0x8f, 0x4f, 0x56, 0x0a, 0x4f, 0x4e,
-	0x56, 0x0a, 0xbf, 0x9f, 0xac, 0x14, 0xbe, 0x7d, 0xb6, 0x32, 0xf1, 0xe4, 0xd9, 0xca, 0xc4, 0xaf,
-	0xcf, 0x56, 0x26, 0x1e, 0xb2, 0x0e, 0x91, 0x51, 0x12, 0x34, 0x10, 0xeb, 0x35, 0x91, 0xcf, 0x43,
-	0x9f, 0xb2, 0xcd, 0x36, 0x4b, 0x68, 0xa8, 0x5f, 0x73, 0x43, 0x11, 0x09, 0xd0, 0x26, 0xa1, 0x28,
-	0x09, 0x7c, 0xc9, 0x78, 0x13, 0x31, 0xd1, 0x63, 0x62, 0xa8, 0xcc, 0x25, 0xb1, 0xa9, 0xf3, 0xdb,
-	0x34, 0x09, 0x6e, 0xf6, 0xb7, 0xde, 0x7c, 0x37, 0xa7, 0x0e, 0x4a, 0xfa, 0xc9, 0xf8, 0xd6, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x28, 0x2e, 0x51, 0x34, 0x0e, 0x00, 0x00,
+	// 2600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x37, 0x29, 0x4a, 0xa2, 0x1e, 0x91, 0x14, 0x35, 0x92, 0xe5, 0xb5, 0x62, 0x4b, 0xb2, 0xff,
+	0xff, 0x24, 0x4e, 0x1a, 0x49, 0x91, 0xf2, 0xda, 0xa4, 0x6f, 0x96, 0xac, 0xc0, 0x4e, 0x62, 0x45,
+	0x5d, 0x39, 0x49, 0x91, 0x1e, 0x36, 0xfb, 0x32, 0xe4, 0x4e, 0x45, 0xce, 0x30, 0x3b, 0xb3, 0x94,
+	0xd4, 0x73, 0x51, 0x24, 0x28, 0x50, 0xf4, 0xd8, 0x63, 0x6f, 0xbd, 0xe4, 0xde, 0x73, 0x4f, 0xcd,
+	0xa1, 0x05, 0x02, 0xf4, 0x52, 0xa0, 0x40, 0x5a, 0x38, 0x1f, 0xa1, 0x5f, 0xa0, 0x98, 0x67, 0x66,
+	0x97, 0x4b, 0x52, 0x76, 0x25, 0x27, 0xb9, 0x48, 0x9c, 0xe7, 0x6d, 0x5e, 0x9e, 0xb7, 0xdf, 0xb3,
+	0xf0, 0x0a, 0x0b, 0xc2, 0xcd, 0x0e, 0x6b, 0xc7, 0x2a, 0xec, 0x30, 0xca, 0x95, 0xdc, 0xec, 0x25,
+	0x22, 0xf0, 0x03, 0xd6, 0x61, 0x52, 0xb1, 0x70, 0xb3, 0xbf, 0x35, 0x4c, 0xd8, 0xe8, 0x25, 0x42,
+	0x09, 0x72, 0x83, 0x05, 0xe1, 0x46, 0x51, 0x6d, 0x63, 0x58, 0xaa, 0xbf, 0xb5, 0xbc, 0xd8, 0x16,
+	0x6d, 0x81, 0xd2, 0x9b, 0xfa, 0x97, 0x51, 0x5c, 0x5e, 0x69, 0x0b, 0xd1, 0xee, 0xd0, 0x4d, 0x5c,
+	0x05, 0x69, 0x6b, 0x33, 0x4a, 0x13, 0x5f, 0x31, 0xc1, 0x0d, 0xff, 0xe6, 0xc7, 0x30, 0x75, 0x97,
+	0x6a, 0xbb, 0xe4, 0x59, 0x98, 0x4b, 0x68, 0x9f, 0x49, 0x26, 0xb8, 0xc7, 0xd3, 0x6e, 0x40, 0x13,
+	0xa7, 0xb4, 0x56, 0xba, 0x55, 0x71, 0x1b, 0x19, 0x79, 0x1f, 0xa9, 0x43, 0x82, 0x31, 0xea, 0x3a,
+	0xe5, 0x61, 0x41, 0x63, 0xf1, 0x8d, 0xca, 0xa7, 0x7f, 0x58, 0xbd, 0x74, 0xf3, 0x8f, 0x25, 0x58,
+	0x3a, 0x54, 0xfe, 0x11, 0xbd, 0xc3, 0xa4, 0x4a, 0x58, 0x90, 0xea, 0xdd, 0xf7, 0xb8, 0x4a, 0x4e,
+	0xc9, 0x15, 0x98, 0xee, 0x09, 0xd1, 0xf1, 0x58, 0x84, 0x5b, 0xcd, 0xb8, 0x53, 0x7a, 0x79, 0x2f,
+	0x22, 0x8b, 0x30, 0x29, 0xb5, 0x8a, 0x35, 0x6c, 0x16, 0x64, 0x0d, 0x6a, 0xfd, 0xa4, 0xe5, 0x1d,
+	0xd1, 0x53, 0x2f, 0xf6, 0x65, 0xec, 0x4c, 0xac, 0x95, 0x6e, 0xd5, 0x5c, 0xe8, 0x27, 0xad, 0x77,
+	0xe8, 0xe9, 0x5d, 0x5f, 0xc6, 0xe4, 0x55, 0xb8, 0xd2, 0x62, 0x89, 0x54, 0x5e, 0x42, 0xdb, 0x7a,
+	0x37, 0xbc, 0xa9, 0x27, 0x3b, 0x42, 0x39, 0x15, 0xb4, 0x74, 0x19, 0xd9, 0x6e, 0x81, 0x7b, 0xd8,
+	0x11, 0xd9, 0x49, 0xff, 0x3c, 0x01, 0xb5, 0xbd, 0x9e, 0x08, 0xe3, 0x5d, 0xc1, 0x15, 0x3d, 0x51,
+	0xfa, 0x18, 0x54, 0xaf, 0xed, 0x43, 0x98, 0x05, 0x89, 0x81, 0xe0, 0x79, 0xbc, 0xa8, 0x70, 0x21,
+	0xa7, 0xbc, 0x36, 0x71, 0x6b, 0x76, 0xfb, 0xfb, 0x1b, 0xff, 0xd3, 0x51, 0x1b, 0x67, 0x3f, 0x86,
+	0x3b, 0x2f, 0x47, 0xe9, 0x64, 0x15, 0x66, 0x71, 0x4b, 0x8f, 0x0b, 0x1e, 0xd2, 0xec, 0xbe, 0x48,
+	0xda, 0xd7, 0x14, 0xb2, 0x09, 0x8b, 0xfa, 0x72, 0xd2, 0xeb, 0xd1, 0xc4, 0x3b, 0xa2, 0xf8, 0x9f,
+	0x89, 0xc8, 0x5e, 0x76, 0x1e, 0x79, 0x07, 0x34, 0x79, 0x87, 0xea, 0xbf, 0x4c, 0x44, 0xe4, 0x16,
+	0x34, 0x8d, 0x45, 0xa9, 0xfc, 0x44, 0x99, 0x97, 0x99, 0x34, 0xce, 0x43, 0xfa, 0xa1, 0x26, 0xeb,
+	0x27, 0x21, 0xaf, 0x81, 0x63, 0x24, 0x29, 0x8f, 0x50, 0xce, 0xa3, 0x27, 0x61, 0x27, 0x95, 0xac,
+	0x4f, 0x9d, 0x29, 0xf3, 0x96, 0xc8, 0xdf, 0xe3, 0x91, 0x96, 0xdf, 0xcb, 0x98, 0xda, 0x07, 0xe3,
+	0xcf, 0xe3, 0x25, 0x42, 0x28, 0x67, 0x1a, 0x2f, 0x70, 0x79, 0xec, 0xa2, 0xae, 0x10, 0x4a, 0x5f,
+	0x56, 0x09, 0xe5, 0x77, 0x3c, 0xe3, 0xf9, 0x2a, 0xee, 0x01, 0x48, 0xc2, 0x17, 0x23, 0xd7, 0x01,
+	0x30, 0x5a, 0x42, 0x91, 0x72, 0xe5, 0xcc, 0x20, 0x7f, 0x46, 0x53, 0x76, 0x35, 0xc1, 0xfa, 0xf0,
+	0x4f, 0x67, 0x45, 0xdb, 0x41, 0x22, 0x44, 0xeb, 0x11, 0xde, 0x7c, 0x0f, 0x26, 0xa9, 0x7e, 0x7f,
+	0x0c, 0xb5, 0x6f, 0xe4, 0x40, 0x63, 0x47, 0x6f, 0xc3, 0x78, 0x44, 0x4f, 0xd0, 0x5d, 0x15, 0xd7,
+	0x2c, 0xc8, 0x32, 0x54, 0x25, 0x0b, 0x3a, 0x8c, 0xb7, 0xa5, 0x53, 0x59, 0x9b, 0xb8, 0x55, 0x73,
+	0xf3, 0xb5, 0x3d, 0xf9, 0x7f, 0x26, 0xa0, 0x79, 0x3b, 0x0c, 0x69, 0x4f, 0xf9, 0x3c, 0xa4, 0x07,
+	0xa2, 0xc3, 0xc2, 0x53, 0x9d, 0x6b, 0x2a, 0x4e, 0xa8, 0x8c, 0x45, 0x27, 0xf2, 0x22, 0xda, 0x53,
+	0xd9, 0xe9, 0x1b, 0x39, 0xf9, 0x8e, 0xa6, 0x92, 0x97, 0x61, 0x69, 0x20, 0x98, 0x72, 0xf6, 0x49,
+	0x4a, 0x3d, 0xfd, 0x36, 0xd2, 0xa6, 0xd0, 0x62, 0xce, 0x7d, 0x1f, 0x99, 0x07, 0x9a, 0x47, 0xde,
+	0x84, 0xe5, 0x31, 0x2d, 0xe3, 0xbc, 0xa0, 0x27, 0xed, 0x05, 0xae, 0x8c, 0x68, 0xe2, 0xed, 0x77,
+	0x7a, 0x52, 0xc7, 0x12, 0x9e, 0xc8, 0x3b, 0xc6, 0x74, 0x47, 0x15, 0x13, 0x78, 0x0d, 0xa4, 0x7f,
+	0x88, 0x64, 0x2b, 0x89, 0x67, 0x29, 0x4a, 0xda, 0xa8, 0x43, 0xfa, 0x90, 0xa4, 0xd9, 0xbf, 0x20,
+	0x69, 0xa2, 0xad, 0x81, 0xf4, 0x81, 0xe4, 0x0f, 0xe1, 0xa9, 0xc1, 0xd1, 0xc3, 0xd8, 0x67, 0xdc,
+	0x8b, 0x28, 0x97, 0x4c, 0x9d, 0xa2, 0xd2, 0x34, 0x2a, 0x39, 0xb9, 0xc8, 0xae, 0x96, 0xb8, 0x63,
+	0x04, 0xac, 0xba, 0x1f, 0x2a, 0xd6, 0xa7, 0x26, 0xb6, 0x43, 0x41, 0x5b, 0x2d, 0x5d, 0xf6, 0x68,
+	0xe2, 0x2b, 0x91, 0xd8, 0xe8, 0x73, 0x8c, 0x88, 0x8e, 0xef, 0x5d, 0x2d, 0xb0, 0x9f, 0xf1, 0xc9,
+	0x6d, 0xb8, 0x3e, 0xae, 0x1e, 0x51, 0x2e, 0xba, 0x8c, 0xa3, 0x01, 0x13, 0x9e, 0xcb, 0x23, 0x06,
+	0xee, 0x0c, 0x24, 0xac, 0xd7, 0x3f, 0x2d, 0xc3, 0xc2, 0x5e, 0x9e, 0xd0, 0x7b, 0x7d, 0xd1, 0x31,
+	0xa9, 0x7f, 0x0f, 0x6e, 0x24, 0x3e, 0x8f, 0x44, 0x97, 0x53, 0x29, 0xb5, 0x4f, 0x74, 0x00, 0xaa,
+	0x53, 0xef, 0x98, 0xf1, 0x48, 0x1c, 0xe3, 0xae, 0xd2, 0x86, 0xc2, 0xca, 0x40, 0xf0, 0x30, 0x93,
+	0xfb, 0x10, 0xc5, 0xf4, 0xbe, 0x92, 0x3c, 0x0d, 0x0d, 0xda, 0x17, 0x9d, 0x3e, 0xe3, 0x6d, 0x5b,
+	0x48, 0xca, 0x98, 0x87, 0xf5, 0x8c, 0x6a, 0x6a, 0xc9, 0xb3, 0x30, 0x17, 0xfa, 0x3c, 0x62, 0x91,
+	0xaf, 0xe8, 0x50, 0xc1, 0x69, 0xe4, 0x64, 0x23, 0xf8, 0x14, 0xcc, 0x74, 0xfc, 0xc0, 0x8a, 0x54,
+	0x50, 0xa4, 0xda, 0xf1, 0x03, 0xc3, 0x7c, 0x09, 0x96, 0x3a, 0xbe, 0x54, 0x9e, 0xa9, 0x1d, 0x41,
+	0x47, 0x84, 0x47, 0x56, 0x72, 0x12, 0x25, 0x17, 0x34, 0x17, 0x2f, 0xbc, 0xa3, 0x79, 0xa8, 0x64,
+	0x9f, 0xe2, 0x9f, 0x65, 0xb8, 0x7e, 0x9f, 0xa9, 0x38, 0x61, 0x9d, 0xb1, 0x0c, 0x7b, 0x90, 0xa4,
+	0x52, 0x91, 0xe7, 0xa0, 0x19, 0xd2, 0x44, 0xb1, 0x16, 0x0b, 0xf5, 0x21, 0xb1, 0x09, 0x98, 0xc6,
+	0x31, 0x57, 0xa0, 0x63, 0x27, 0xc8, 0x93, 0xbd, 0x5c, 0x4c, 0xf6, 0x1f, 0xc0, 0xb2, 0xdf, 0x6e,
+	0x27, 0xb4, 0xad, 0xd5, 0xfb, 0x34, 0x31, 0x1a, 0xba, 0x3e, 0x1d, 0xd1, 0x53, 0xbc, 0xee, 0x8c,
+	0xeb, 0xe4, 0x12, 0x1f, 0x14, 0x04, 0xde, 0xa1, 0xa7, 0x64, 0x0f, 0x56, 0x39, 0x3d, 0x51, 0xde,
+	0x63, 0x4c, 0x54, 0xd0, 0xc4, 0x35, 0x2d, 0x76, 0xfb, 0x51, 0x66, 0x6a, 0x50, 0x3a, 0xb2, 0xe1,
+	0x5f, 0x3a, 0xd2, 0xab, 0xae, 0x0d, 0xf1, 0x52, 0x97, 0x3c, 0x03, 0x73, 0xbd, 0x98, 0x79, 0xc5,
+	0x50, 0x34, 0x91, 0x5c, 0xef, 0xc5, 0xec, 0xad, 0x41, 0xfc, 0x3d, 0x0f, 0xf3, 0x46, 0xae, 0x18,
+	0x73, 0x26, 0x68, 0xb5, 0x81, 0xb7, 0xc6, 0x03, 0xed, 0x57, 0x25, 0x80, 0xbd, 0xc4, 0x3f, 0x4c,
+	0xbb, 0x5d, 0x3f, 0x39, 0xd5, 0xc5, 0xb4, 0xd0, 0x02, 0x4c, 0x20, 0xcd, 0xc8, 0xbc, 0xfa, 0x7f,
+	0x0f, 0x7b, 0x5c, 0xa2, 0x3c, 0xc5, 0xba, 0x54, 0x57, 0x86, 0x13, 0x8f, 0x67, 0xa5, 0x64, 0x0e,
+	0x39, 0x0f, 0x58, 0x97, 0xbe, 0xcf, 0xd9, 0xc9, 0xbe, 0x24, 0xff, 0x0f, 0x0d, 0xcc, 0x82, 0x0e,
+	0xe5, 0x6d, 0x15, 0x6b, 0x41, 0x53, 0x39, 0x6a, 0x9a, 0xfa, 0x2e, 0x12, 0xf7, 0xb3, 0x2a, 0xf7,
+	0x79, 0x03, 0x66, 0x77, 0xb1, 0xb0, 0x1e, 0x2a, 0x5f, 0x51, 0x72, 0x15, 0xaa, 0x26, 0x79, 0x73,
+	0x0c, 0x30, 0x8d, 0xeb, 0x7b, 0x11, 0xd9, 0x87, 0x7a, 0xc7, 0x57, 0x54, 0xaa, 0x22, 0xca, 0x98,
+	0xdd, 0x7e, 0xee, 0x1c, 0x15, 0xda, 0x00, 0x10, 0xb7, 0x66, 0xf4, 0xcd, 0x4a, 0xdb, 0x6b, 0x25,
+	0xe2, 0x97, 0x34, 0x47, 0x2d, 0x13, 0x17, 0xb6, 0x67, 0xf4, 0xad, 0xbd, 0xff, 0x83, 0x7a, 0x98,
+	0x26, 0x09, 0xe5, 0x36, 0xda, 0x6d, 0xf1, 0xab, 0x59, 0x22, 0x06, 0x39, 0x79, 0x17, 0xe6, 0x94,
+	0x8e, 0x5d, 0x9d, 0x7c, 0xb6, 0x39, 0x4f, 0xe2, 0xb6, 0x57, 0x37, 0x0c, 0x32, 0xdb, 0xc8, 0x90,
+	0xd9, 0xc6, 0x1d, 0x8b, 0xcc, 0x76, 0xaa, 0x5f, 0x7c, 0xb5, 0x7a, 0xe9, 0xf7, 0xff, 0x5a, 0x2d,
+	0xb9, 0x8d, 0x4c, 0xd7, 0xb6, 0xef, 0x1b, 0x50, 0x4b, 0x7b, 0xed, 0xc4, 0x8f, 0xa8, 0xd7, 0xf3,
+	0x55, 0xec, 0x4c, 0xaf, 0x4d, 0xdc, 0x9a, 0x71, 0x67, 0x2d, 0xed, 0xc0, 0x57, 0x1a, 0x02, 0x39,
+	0xb1, 0x90, 0x4a, 0x97, 0x0c, 0x9d, 0xc7, 0x2d, 0xe5, 0xf5, 0xb0, 0x95, 0xe8, 0x07, 0xae, 0x62,
+	0x0a, 0x2e, 0x6a, 0x3e, 0xbe, 0xfe, 0x7e, 0x4b, 0x99, 0x3e, 0x73, 0x2f, 0x22, 0xaf, 0xc3, 0xd5,
+	0x11, 0x3d, 0x25, 0x8e, 0x28, 0xf7, 0xb8, 0xdf, 0xa5, 0x58, 0xcd, 0x6a, 0xee, 0xe5, 0xa2, 0xe2,
+	0x03, 0xcd, 0xdd, 0xf7, 0xbb, 0x94, 0x1c, 0x67, 0x48, 0xe1, 0x0c, 0x54, 0x04, 0xdf, 0x10, 0x15,
+	0xed, 0x94, 0x9d, 0x92, 0xbb, 0x94, 0x41, 0x93, 0xc7, 0xc3, 0xa3, 0xd9, 0x73, 0xc3, 0xa3, 0xda,
+	0xa3, 0xe0, 0xd1, 0x6b, 0xe0, 0x0c, 0xb9, 0xb4, 0x08, 0x93, 0xea, 0x06, 0xf4, 0x14, 0xbd, 0x3b,
+	0x40, 0x4b, 0x6f, 0xc1, 0xda, 0xb0, 0xe2, 0x19, 0xa8, 0xa9, 0x81, 0x06, 0xae, 0x15, 0x0d, 0x8c,
+	0x81, 0x27, 0x7d, 0xe2, 0x53, 0xa9, 0x68, 0xd7, 0xee, 0x9c, 0x65, 0xde, 0x9c, 0x3d, 0x31, 0xf2,
+	0x70, 0xdb, 0x47, 0xe6, 0x5e, 0x73, 0x3c, 0xf7, 0xc8, 0x07, 0x60, 0xe0, 0x9d, 0x17, 0x1a, 0x64,
+	0x2b, 0x9d, 0x79, 0x74, 0xcc, 0xe6, 0x39, 0x1c, 0x53, 0x44, 0xc4, 0x6e, 0x9d, 0x16, 0x56, 0x92,
+	0x84, 0xe0, 0xd8, 0x14, 0x0d, 0x63, 0x1a, 0x1e, 0xf5, 0x04, 0xe3, 0x79, 0xb6, 0x2e, 0x5c, 0x34,
+	0xbb, 0x96, 0x8c, 0xa9, 0xdd, 0xdc, 0x92, 0xcd, 0xb3, 0x1f, 0xc3, 0xb5, 0xf1, 0x4d, 0x4c, 0x67,
+	0xc1, 0x0e, 0xb0, 0x88, 0x65, 0xe3, 0xea, 0xa8, 0x36, 0xf6, 0x97, 0x6c, 0x2a, 0x18, 0x37, 0x60,
+	0x52, 0xf6, 0xb2, 0x71, 0xea, 0xa8, 0xae, 0xc9, 0xdd, 0x8f, 0x61, 0xde, 0xcf, 0x01, 0x99, 0x4d,
+	0x23, 0x67, 0x09, 0xaf, 0xf5, 0xd2, 0x39, 0xae, 0x35, 0x0a, 0xe6, 0xdc, 0xa6, 0x3f, 0x42, 0x21,
+	0xbf, 0x80, 0xcb, 0x85, 0x08, 0xf6, 0x68, 0xd6, 0xfe, 0x9d, 0x2b, 0xb8, 0xcb, 0xab, 0xe7, 0x75,
+	0xcf, 0x30, 0x78, 0x70, 0x17, 0xe8, 0x38, 0x91, 0x7c, 0x56, 0x82, 0xb5, 0xae, 0x69, 0xaf, 0x67,
+	0x64, 0xaa, 0x87, 0x95, 0xc6, 0x71, 0x70, 0xdf, 0x9f, 0x9c, 0x63, 0xdf, 0xc7, 0x76, 0x6a, 0xf7,
+	0x7a, 0xf7, 0x71, 0x6c, 0xf2, 0x53, 0x78, 0xa6, 0xeb, 0x9f, 0x78, 0xbd, 0x24, 0xe5, 0x34, 0xd2,
+	0x41, 0x29, 0x29, 0x97, 0xa9, 0x34, 0xc5, 0xc7, 0xa4, 0x6b, 0xda, 0xd3, 0x88, 0xc3, 0xb9, 0x8a,
+	0x0e, 0xba, 0xd1, 0xf5, 0x4f, 0x0e, 0x50, 0x78, 0x37, 0x93, 0xc5, 0x3a, 0xa4, 0xf3, 0xf6, 0x7d,
+	0x14, 0x24, 0xfb, 0x30, 0x4b, 0x13, 0xdf, 0x8b, 0x99, 0x54, 0x22, 0x39, 0x75, 0x96, 0x31, 0xbe,
+	0xd7, 0xcf, 0xf3, 0x80, 0x79, 0x53, 0x74, 0x81, 0x26, 0xfe, 0x5d, 0x63, 0x80, 0xb4, 0x60, 0xb9,
+	0x50, 0x0f, 0x65, 0x9c, 0xaa, 0x48, 0x1c, 0xe7, 0xad, 0xe3, 0xa9, 0x8b, 0x06, 0xf7, 0x95, 0xbc,
+	0x76, 0x1e, 0x5a, 0x53, 0xc5, 0x21, 0xf9, 0xed, 0x4a, 0x75, 0xaa, 0x39, 0xed, 0x36, 0x63, 0x9a,
+	0x26, 0xa8, 0xe9, 0xf5, 0xfc, 0xc4, 0xef, 0xca, 0x9b, 0x9f, 0x57, 0xa0, 0x31, 0x7c, 0x65, 0x72,
+	0x0d, 0x66, 0x74, 0x53, 0x96, 0xca, 0xef, 0xf6, 0xb2, 0xc6, 0x9d, 0x13, 0x74, 0x3d, 0x60, 0x41,
+	0x68, 0x4f, 0x8d, 0x43, 0x97, 0x01, 0x7b, 0x35, 0x16, 0x84, 0xa8, 0x8f, 0xb3, 0xd6, 0x06, 0x2c,
+	0x98, 0x58, 0xa4, 0x51, 0x31, 0x93, 0x0c, 0x00, 0x9a, 0xcf, 0x58, 0x83, 0x0c, 0x7a, 0x1a, 0x1a,
+	0xb9, 0x7c, 0xb1, 0xd7, 0xd5, 0x33, 0xaa, 0x49, 0x98, 0x17, 0x80, 0x14, 0x47, 0x0f, 0x3b, 0xa9,
+	0x19, 0xa8, 0xd3, 0x4c, 0x07, 0x73, 0x07, 0x0e, 0x6c, 0x1a, 0xeb, 0x8f, 0x8d, 0x1c, 0x16, 0xeb,
+	0xa7, 0xc3, 0x93, 0xc6, 0x0b, 0x40, 0x24, 0x0d, 0xd3, 0x44, 0x03, 0x60, 0x19, 0x8a, 0x84, 0x16,
+	0x20, 0x7e, 0x33, 0xe3, 0x1c, 0x6a, 0x86, 0x96, 0x7e, 0x1e, 0xe6, 0xc7, 0xe7, 0x01, 0x8b, 0x8d,
+	0xc2, 0x91, 0x31, 0xc0, 0x07, 0x52, 0xf0, 0x72, 0x87, 0x71, 0xea, 0xb7, 0x4d, 0xbb, 0x3b, 0x5f,
+	0x8e, 0xdf, 0xcd, 0xbc, 0xfa, 0xae, 0x51, 0x75, 0x9b, 0xf1, 0x08, 0x85, 0x44, 0xb0, 0x70, 0x46,
+	0x20, 0x39, 0x80, 0x7b, 0xbc, 0x7c, 0x91, 0x3d, 0xb2, 0xc8, 0x71, 0xe7, 0xc7, 0x82, 0xc9, 0xa2,
+	0xab, 0x1e, 0xcc, 0x8f, 0x49, 0x6b, 0x4c, 0xc9, 0x38, 0x53, 0xcc, 0xd7, 0xde, 0xf3, 0x95, 0xd7,
+	0xcd, 0x06, 0x87, 0x7a, 0x4e, 0xbe, 0xad, 0xee, 0x4b, 0xb2, 0x0e, 0x0b, 0xed, 0xc4, 0xd7, 0x95,
+	0x0e, 0x9b, 0x21, 0xb6, 0xb0, 0x6e, 0x06, 0xfa, 0x9a, 0xc8, 0x32, 0x6d, 0x72, 0x8f, 0x47, 0xf7,
+	0x33, 0x3c, 0xf7, 0xdb, 0x32, 0x34, 0x47, 0x1f, 0x41, 0x7f, 0xd7, 0x51, 0x27, 0x45, 0x78, 0x3e,
+	0xa5, 0x4e, 0x30, 0x8e, 0x6e, 0x40, 0x4d, 0xa4, 0xaa, 0x97, 0x2a, 0xcf, 0x8c, 0xc8, 0xda, 0x76,
+	0xdd, 0x9d, 0x35, 0xb4, 0x7b, 0x9a, 0x44, 0x1c, 0x98, 0xee, 0xd3, 0x44, 0xea, 0x22, 0x68, 0x50,
+	0x64, 0xb6, 0x24, 0x2f, 0xc2, 0x22, 0xc2, 0x6f, 0xf3, 0x50, 0x9e, 0xa4, 0x9f, 0xa4, 0x34, 0x1b,
+	0x41, 0x2a, 0x2e, 0xd1, 0x3c, 0x8b, 0x2c, 0x2d, 0x87, 0xbc, 0x0e, 0x8e, 0xd1, 0x10, 0x9c, 0xd3,
+	0xd0, 0x7c, 0x0b, 0xca, 0xb4, 0x4c, 0x54, 0x2e, 0xa1, 0x56, 0xce, 0xce, 0x35, 0xb7, 0xe1, 0xb2,
+	0xd1, 0x8c, 0x7d, 0xce, 0x69, 0x67, 0xa0, 0x66, 0x02, 0x74, 0x01, 0xd5, 0x0c, 0x2f, 0xd3, 0xb1,
+	0x0f, 0xf2, 0x97, 0x32, 0xd4, 0xee, 0x33, 0x19, 0xd0, 0xd8, 0xef, 0x33, 0x91, 0x26, 0x64, 0x15,
+	0x66, 0xec, 0x89, 0x33, 0x88, 0x8b, 0xa0, 0xa6, 0x6a, 0x88, 0xf7, 0x22, 0xf2, 0xeb, 0x12, 0x2c,
+	0x0d, 0x39, 0xdd, 0x8b, 0xa9, 0x1f, 0xd1, 0xc4, 0xdb, 0x72, 0xca, 0xe7, 0x6e, 0x03, 0x07, 0x45,
+	0xc2, 0x5d, 0xd4, 0xdf, 0x71, 0x1e, 0x7e, 0xb5, 0xba, 0x78, 0x06, 0x63, 0xcb, 0x5d, 0xec, 0x9d,
+	0x41, 0x7d, 0xf4, 0x41, 0xb6, 0x9d, 0x89, 0xef, 0xe4, 0x20, 0xdb, 0x67, 0x1e, 0x64, 0xdb, 0xbe,
+	0xe4, 0xdf, 0xca, 0xb0, 0x74, 0x88, 0x28, 0x46, 0x53, 0xf7, 0x3e, 0x49, 0x59, 0x5f, 0x98, 0x29,
+	0x8a, 0x7c, 0x04, 0xd3, 0xa6, 0x6c, 0x6d, 0xe1, 0x8b, 0xce, 0x6e, 0xbf, 0x72, 0xd1, 0x93, 0x61,
+	0x6d, 0xdb, 0x81, 0x87, 0x5f, 0xad, 0x4e, 0xe1, 0xcf, 0x2d, 0x77, 0x0a, 0x2d, 0x6e, 0x0d, 0x6c,
+	0x6f, 0x3b, 0xe5, 0x6f, 0xc7, 0xf6, 0xb6, 0xb5, 0xbd, 0x4d, 0x52, 0xb8, 0x7a, 0x46, 0xeb, 0xed,
+	0xe9, 0xcf, 0x53, 0x7a, 0x68, 0x7a, 0x62, 0xac, 0x8c, 0x1f, 0xb8, 0xdc, 0x2b, 0xf2, 0x4c, 0x7a,
+	0x96, 0xaa, 0xbf, 0x29, 0x03, 0x19, 0x3f, 0x27, 0xb9, 0x0d, 0x53, 0xb6, 0xa9, 0x95, 0x2e, 0xda,
+	0xd4, 0xac, 0x22, 0x21, 0x50, 0x41, 0x88, 0x6c, 0x4a, 0x05, 0xfe, 0xd6, 0xb4, 0x42, 0x4f, 0xa9,
+	0xc4, 0x43, 0x43, 0xf9, 0x64, 0x71, 0x28, 0x1f, 0x6a, 0x68, 0x53, 0xa3, 0x0d, 0xed, 0x3a, 0x80,
+	0x71, 0x47, 0x18, 0xd8, 0xcf, 0x2a, 0x35, 0x77, 0x06, 0x29, 0xbb, 0x81, 0xd0, 0xd9, 0x35, 0x6b,
+	0x83, 0x14, 0xf9, 0x80, 0x7c, 0x30, 0x24, 0x2d, 0x90, 0xf7, 0xd7, 0x4a, 0x73, 0xf2, 0xed, 0x4a,
+	0x75, 0xba, 0x59, 0x7d, 0xbb, 0x52, 0xad, 0x36, 0x67, 0x6e, 0xfe, 0xb5, 0x04, 0xc4, 0x7c, 0x87,
+	0x48, 0x58, 0xd4, 0xa6, 0x87, 0xb4, 0xdd, 0xa5, 0x5c, 0x91, 0x07, 0x50, 0x1f, 0x42, 0xca, 0xf6,
+	0x51, 0x2e, 0x0c, 0x94, 0x6b, 0x45, 0xa0, 0x4c, 0x3e, 0x82, 0x7a, 0x80, 0xdb, 0x98, 0x6e, 0x2b,
+	0xed, 0xd7, 0xe2, 0x27, 0x8b, 0x2c, 0xb7, 0x66, 0x6c, 0xe1, 0x22, 0x73, 0xee, 0xdf, 0xa7, 0x61,
+	0xe1, 0x8c, 0x0c, 0x23, 0x07, 0x60, 0x66, 0x48, 0x1a, 0x79, 0x4f, 0xea, 0xe5, 0xba, 0x35, 0x60,
+	0x96, 0xe4, 0x67, 0x50, 0xf3, 0x79, 0x18, 0x8b, 0xc4, 0xdc, 0xe5, 0x1b, 0x25, 0x89, 0x3b, 0x6b,
+	0x4c, 0xe1, 0x82, 0x04, 0x30, 0x1f, 0x51, 0x19, 0x52, 0x1e, 0xf9, 0x19, 0xc2, 0xcf, 0xb2, 0xe2,
+	0x09, 0xcd, 0x37, 0x07, 0xf6, 0x90, 0x20, 0xf5, 0x87, 0x8d, 0x42, 0x37, 0xce, 0xba, 0x94, 0xf9,
+	0x6c, 0x33, 0x97, 0xb7, 0xd5, 0x07, 0xa6, 0x5d, 0xbd, 0x31, 0x84, 0x01, 0xd5, 0x89, 0x37, 0xd4,
+	0xbc, 0xa6, 0xb0, 0x79, 0x2d, 0x15, 0x94, 0xde, 0x2b, 0xf4, 0xb1, 0x31, 0x97, 0xc3, 0xb7, 0xe6,
+	0x72, 0xf2, 0x73, 0x98, 0xe7, 0xf4, 0xd8, 0x1b, 0x0e, 0xd4, 0xd9, 0x27, 0x0b, 0xd4, 0x39, 0x4e,
+	0x8f, 0x8b, 0x04, 0xfd, 0x59, 0x83, 0xc9, 0xc2, 0xa4, 0x84, 0xd3, 0x72, 0xd5, 0xad, 0x31, 0x39,
+	0x98, 0x8f, 0x08, 0xcb, 0x06, 0x17, 0x7b, 0x47, 0x69, 0xd2, 0x47, 0x3a, 0xf5, 0x73, 0xdf, 0x72,
+	0x3c, 0xf9, 0xec, 0xdc, 0x32, 0x44, 0x93, 0x64, 0x0b, 0x16, 0xb3, 0xb1, 0xa5, 0xf0, 0x91, 0x4f,
+	0x3a, 0x0d, 0xfc, 0x8a, 0xbe, 0x60, 0x79, 0xbb, 0x05, 0xd6, 0xe3, 0xcb, 0xec, 0xdc, 0x77, 0x55,
+	0x66, 0xc9, 0x8f, 0xe0, 0xda, 0x38, 0x98, 0xd4, 0x61, 0x13, 0x88, 0x88, 0x51, 0x3d, 0x99, 0xeb,
+	0x13, 0x3b, 0xa3, 0x08, 0xf1, 0xc1, 0xc9, 0x0e, 0xf2, 0xf3, 0x52, 0x35, 0xd9, 0x9c, 0xc2, 0x52,
+	0x05, 0x3b, 0x9f, 0x95, 0xbe, 0x78, 0xb8, 0x52, 0xfa, 0xf2, 0xe1, 0x4a, 0xe9, 0xdf, 0x0f, 0x57,
+	0x4a, 0xbf, 0xfb, 0x7a, 0xe5, 0xd2, 0x97, 0x5f, 0xaf, 0x5c, 0xfa, 0xc7, 0xd7, 0x2b, 0x97, 0x3e,
+	0x12, 0x6d, 0xa6, 0xe2, 0x34, 0xd8, 0x08, 0x45, 0x77, 0x33, 0xf4, 0x93, 0xc8, 0xe7, 0x62, 0xbd,
+	0x25, 0x52, 0x1e, 0x61, 0x87, 0xcc, 0x49, 0x2c, 0x08, 0xd7, 0x19, 0x0f, 0xd3, 0xc0, 0x57, 0x22,
+	0xd9, 0x0c, 0x85, 0xec, 0x0a, 0x99, 0x33, 0x87, 0x6e, 0xbc, 0x8e, 0x8f, 0xb1, 0x6e, 0x5e, 0x63,
+	0xbd, 0xbf, 0xf5, 0xe2, 0x9b, 0x43, 0xec, 0x60, 0x0a, 0x3f, 0x54, 0xbd, 0xf4, 0xdf, 0x01, 0x00,
+	0x2b, 0x3a, 0x0a, 0x7b, 0xc2, 0x1c, 0x00, 0x00,
 }
 
 func (m *Height) Marshal() (dAtA []byte, err error) {
@@ -583,6 +1119,23 @@ func (m *EpochContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PoolCount != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.PoolCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TotalStake != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.TotalStake))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.StakeDistributionRoot) > 0 {
+		i -= len(m.StakeDistributionRoot)
+		copy(dAtA[i:], m.StakeDistributionRoot)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.StakeDistributionRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.EpochEndSlotExclusive != 0 {
 		i = encodeVarintProbabilistic(dAtA, i, uint64(m.EpochEndSlotExclusive))
 		i--
@@ -627,7 +1180,7 @@ func (m *EpochContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ClientState) Marshal() (dAtA []byte, err error) {
+func (m *StakeDistributionProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -637,35 +1190,33 @@ func (m *ClientState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClientState) MarshalTo(dAtA []byte) (int, error) {
+func (m *StakeDistributionProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StakeDistributionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LatestCheckpointEpoch != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.LatestCheckpointEpoch))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.LatestCheckpointBlockHash) > 0 {
-		i -= len(m.LatestCheckpointBlockHash)
-		copy(dAtA[i:], m.LatestCheckpointBlockHash)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.LatestCheckpointBlockHash)))
+	if m.Index != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Index))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x18
 	}
-	if m.LatestCheckpointHeight != nil {
+	if m.Entry != nil {
 		{
-			size, err := m.LatestCheckpointHeight.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -673,145 +1224,85 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.EpochContexts) > 0 {
-		for iNdEx := len(m.EpochContexts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochContexts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+		dAtA[i] = 0x12
 	}
-	if m.SlotLengthNs != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SlotLengthNs))
-		i--
-		dAtA[i] = 0x1
+	if m.Epoch != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Epoch))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x8
 	}
-	if m.SystemStartUnixNs != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SystemStartUnixNs))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.CurrentEpochEndSlotExclusive != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.CurrentEpochEndSlotExclusive))
-		i--
-		dAtA[i] = 0x70
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptancePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.CurrentEpochStartSlot != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.CurrentEpochStartSlot))
+	return dAtA[:n], nil
+}
+
+func (m *AcceptancePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptancePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveSlotCoeffDenominator != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.ActiveSlotCoeffDenominator))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x48
 	}
-	if m.SlotsPerKesPeriod != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SlotsPerKesPeriod))
+	if m.ActiveSlotCoeffNumerator != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.ActiveSlotCoeffNumerator))
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x40
 	}
-	if len(m.EpochNonce) > 0 {
-		i -= len(m.EpochNonce)
-		copy(dAtA[i:], m.EpochNonce)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.EpochNonce)))
+	if m.ThresholdChainDensityBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.ThresholdChainDensityBps))
 		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.EpochStakeDistribution) > 0 {
-		for iNdEx := len(m.EpochStakeDistribution) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochStakeDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+		dAtA[i] = 0x38
 	}
-	if len(m.HostStateNftTokenName) > 0 {
-		i -= len(m.HostStateNftTokenName)
-		copy(dAtA[i:], m.HostStateNftTokenName)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HostStateNftTokenName)))
+	if m.StakeWeightBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.StakeWeightBps))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x30
 	}
-	if len(m.HostStateNftPolicyId) > 0 {
-		i -= len(m.HostStateNftPolicyId)
-		copy(dAtA[i:], m.HostStateNftPolicyId)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HostStateNftPolicyId)))
+	if m.PoolsWeightBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.PoolsWeightBps))
 		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.UpgradePath) > 0 {
-		for iNdEx := len(m.UpgradePath) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UpgradePath[iNdEx])
-			copy(dAtA[i:], m.UpgradePath[iNdEx])
-			i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.UpgradePath[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
-	if err2 != nil {
-		return 0, err2
+		dAtA[i] = 0x28
 	}
-	i -= n2
-	i = encodeVarintProbabilistic(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x2a
-	if m.CurrentEpoch != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.CurrentEpoch))
+	if m.DepthWeightBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.DepthWeightBps))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.FrozenHeight != nil {
-		{
-			size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
-		}
+	if m.ThresholdUniqueStakeBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.ThresholdUniqueStakeBps))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.LatestHeight != nil {
-		{
-			size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
-		}
+	if m.ThresholdUniquePools != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.ThresholdUniquePools))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.ChainId)))
+	if m.ThresholdDepth != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.ThresholdDepth))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
+func (m *EpochNonceEvolution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -821,59 +1312,53 @@ func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsensusState) MarshalTo(dAtA []byte) (int, error) {
+func (m *EpochNonceEvolution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EpochNonceEvolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SecurityScoreBps != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SecurityScoreBps))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.UniqueStakeBps != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.UniqueStakeBps))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.UniquePoolsCount != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.UniquePoolsCount))
+	if len(m.LastEpochBlockNonce) > 0 {
+		i -= len(m.LastEpochBlockNonce)
+		copy(dAtA[i:], m.LastEpochBlockNonce)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.LastEpochBlockNonce)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if m.AcceptedEpoch != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.AcceptedEpoch))
+	if len(m.LabNonce) > 0 {
+		i -= len(m.LabNonce)
+		copy(dAtA[i:], m.LabNonce)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.LabNonce)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.AcceptedBlockHash) > 0 {
-		i -= len(m.AcceptedBlockHash)
-		copy(dAtA[i:], m.AcceptedBlockHash)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.AcceptedBlockHash)))
+	if len(m.CandidateNonce) > 0 {
+		i -= len(m.CandidateNonce)
+		copy(dAtA[i:], m.CandidateNonce)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.CandidateNonce)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.IbcStateRoot) > 0 {
-		i -= len(m.IbcStateRoot)
-		copy(dAtA[i:], m.IbcStateRoot)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.IbcStateRoot)))
+	if len(m.EvolvingNonce) > 0 {
+		i -= len(m.EvolvingNonce)
+		copy(dAtA[i:], m.EvolvingNonce)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.EvolvingNonce)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Timestamp))
+	if m.RandomnessStabilityWindowSlots != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.RandomnessStabilityWindowSlots))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
+func (m *MithrilStakeDistributionTrust) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -883,51 +1368,66 @@ func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Misbehaviour) MarshalTo(dAtA []byte) (int, error) {
+func (m *MithrilStakeDistributionTrust) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MithrilStakeDistributionTrust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProbabilisticHeader2 != nil {
-		{
-			size, err := m.ProbabilisticHeader2.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
-		}
+	if m.PhiFDenominator != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.PhiFDenominator))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PhiFNumerator != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.PhiFNumerator))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.M != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.M))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.K != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.K))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NextAggregateVerificationKey) > 0 {
+		i -= len(m.NextAggregateVerificationKey)
+		copy(dAtA[i:], m.NextAggregateVerificationKey)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.NextAggregateVerificationKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AggregateVerificationKey) > 0 {
+		i -= len(m.AggregateVerificationKey)
+		copy(dAtA[i:], m.AggregateVerificationKey)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.AggregateVerificationKey)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ProbabilisticHeader1 != nil {
-		{
-			size, err := m.ProbabilisticHeader1.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
-		}
+	if m.Epoch != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Epoch))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.ClientId)))
+	if len(m.CertificateHash) > 0 {
+		i -= len(m.CertificateHash)
+		copy(dAtA[i:], m.CertificateHash)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.CertificateHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProbabilisticBlock) Marshal() (dAtA []byte, err error) {
+func (m *EraSummary) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -937,61 +1437,35 @@ func (m *ProbabilisticBlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProbabilisticBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *EraSummary) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProbabilisticBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EraSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlockCbor) > 0 {
-		i -= len(m.BlockCbor)
-		copy(dAtA[i:], m.BlockCbor)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.BlockCbor)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.Hash)))
+	if m.SlotLengthNs != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SlotLengthNs))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.Slot != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Slot))
+	if m.StartTimeUnixNs != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.StartTimeUnixNs))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Height != nil {
-		{
-			size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
-		}
+	if m.StartSlot != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.StartSlot))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProbabilisticHeader) Marshal() (dAtA []byte, err error) {
+func (m *ClientState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1001,29 +1475,19 @@ func (m *ProbabilisticHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProbabilisticHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClientState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProbabilisticHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsCheckpoint {
-		i--
-		if m.IsCheckpoint {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.NewEpochContext != nil {
+	if m.HostStateShutdownHeight != nil {
 		{
-			size, err := m.NewEpochContext.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.HostStateShutdownHeight.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1031,12 +1495,14 @@ func (m *ProbabilisticHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	if len(m.BridgeBlocks) > 0 {
-		for iNdEx := len(m.BridgeBlocks) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.EraHistory) > 0 {
+		for iNdEx := len(m.EraHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BridgeBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.EraHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1044,38 +1510,35 @@ func (m *ProbabilisticHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if m.HostStateTxOutputIndex != 0 {
-		i = encodeVarintProbabilistic(dAtA, i, uint64(m.HostStateTxOutputIndex))
+	if m.MaxPrunedConsensusStatesPerUpdate != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.MaxPrunedConsensusStatesPerUpdate))
 		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.HostStateTxHash) > 0 {
-		i -= len(m.HostStateTxHash)
-		copy(dAtA[i:], m.HostStateTxHash)
-		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HostStateTxHash)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xc8
 	}
-	if len(m.DescendantBlocks) > 0 {
-		for iNdEx := len(m.DescendantBlocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DescendantBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+	if m.MithrilStakeDistributionTrust != nil {
+		{
+			size, err := m.MithrilStakeDistributionTrust.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if m.AnchorBlock != nil {
+	if m.EpochNonceEvolution != nil {
 		{
-			size, err := m.AnchorBlock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.EpochNonceEvolution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1083,11 +1546,13 @@ func (m *ProbabilisticHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.TrustedHeight != nil {
+	if m.AcceptancePolicy != nil {
 		{
-			size, err := m.TrustedHeight.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AcceptancePolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1095,339 +1560,3302 @@ func (m *ProbabilisticHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintProbabilistic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProbabilistic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Height) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LatestCheckpointEpoch != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.LatestCheckpointEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	var l int
-	_ = l
-	if m.RevisionNumber != 0 {
-		n += 1 + sovProbabilistic(uint64(m.RevisionNumber))
+	if len(m.LatestCheckpointBlockHash) > 0 {
+		i -= len(m.LatestCheckpointBlockHash)
+		copy(dAtA[i:], m.LatestCheckpointBlockHash)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.LatestCheckpointBlockHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.RevisionHeight != 0 {
-		n += 1 + sovProbabilistic(uint64(m.RevisionHeight))
+	if m.LatestCheckpointHeight != nil {
+		{
+			size, err := m.LatestCheckpointHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
-}
-
-func (m *StakeDistributionEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.EpochContexts) > 0 {
+		for iNdEx := len(m.EpochContexts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochContexts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.SlotLengthNs != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SlotLengthNs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.Stake != 0 {
-		n += 1 + sovProbabilistic(uint64(m.Stake))
+	if m.SystemStartUnixNs != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SystemStartUnixNs))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.VrfKeyHash)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.CurrentEpochEndSlotExclusive != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.CurrentEpochEndSlotExclusive))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.FirstRegistrationSlot != 0 {
-		n += 1 + sovProbabilistic(uint64(m.FirstRegistrationSlot))
+	if m.CurrentEpochStartSlot != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.CurrentEpochStartSlot))
+		i--
+		dAtA[i] = 0x68
 	}
-	return n
-}
-
-func (m *EpochContext) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SlotsPerKesPeriod != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SlotsPerKesPeriod))
+		i--
+		dAtA[i] = 0x60
 	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovProbabilistic(uint64(m.Epoch))
+	if len(m.EpochNonce) > 0 {
+		i -= len(m.EpochNonce)
+		copy(dAtA[i:], m.EpochNonce)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.EpochNonce)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.StakeDistribution) > 0 {
-		for _, e := range m.StakeDistribution {
-			l = e.Size()
-			n += 1 + l + sovProbabilistic(uint64(l))
+	if len(m.EpochStakeDistribution) > 0 {
+		for iNdEx := len(m.EpochStakeDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStakeDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
 	}
-	l = len(m.EpochNonce)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
-	}
-	if m.SlotsPerKesPeriod != 0 {
-		n += 1 + sovProbabilistic(uint64(m.SlotsPerKesPeriod))
-	}
-	if m.EpochStartSlot != 0 {
-		n += 1 + sovProbabilistic(uint64(m.EpochStartSlot))
+	if len(m.HostStateNftTokenName) > 0 {
+		i -= len(m.HostStateNftTokenName)
+		copy(dAtA[i:], m.HostStateNftTokenName)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HostStateNftTokenName)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.EpochEndSlotExclusive != 0 {
-		n += 1 + sovProbabilistic(uint64(m.EpochEndSlotExclusive))
+	if len(m.HostStateNftPolicyId) > 0 {
+		i -= len(m.HostStateNftPolicyId)
+		copy(dAtA[i:], m.HostStateNftPolicyId)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HostStateNftPolicyId)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *ClientState) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UpgradePath) > 0 {
+		for iNdEx := len(m.UpgradePath) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpgradePath[iNdEx])
+			copy(dAtA[i:], m.UpgradePath[iNdEx])
+			i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.UpgradePath[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	if m.LatestHeight != nil {
-		l = m.LatestHeight.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
+	i -= n7
+	i = encodeVarintProbabilistic(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.FrozenHeight != nil {
-		l = m.FrozenHeight.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
-	}
-	if m.CurrentEpoch != 0 {
-		n += 1 + sovProbabilistic(uint64(m.CurrentEpoch))
+		{
+			size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod)
-	n += 1 + l + sovProbabilistic(uint64(l))
-	if len(m.UpgradePath) > 0 {
-		for _, s := range m.UpgradePath {
-			l = len(s)
-			n += 1 + l + sovProbabilistic(uint64(l))
+	if m.LatestHeight != nil {
+		{
+			size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.HostStateNftPolicyId)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.HostStateNftTokenName)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.EpochStakeDistribution) > 0 {
-		for _, e := range m.EpochStakeDistribution {
-			l = e.Size()
-			n += 1 + l + sovProbabilistic(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HostStateShutdown != nil {
+		{
+			size, err := m.HostStateShutdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.EpochNonce)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
-	}
-	if m.SlotsPerKesPeriod != 0 {
-		n += 1 + sovProbabilistic(uint64(m.SlotsPerKesPeriod))
-	}
-	if m.CurrentEpochStartSlot != 0 {
-		n += 1 + sovProbabilistic(uint64(m.CurrentEpochStartSlot))
-	}
-	if m.CurrentEpochEndSlotExclusive != 0 {
-		n += 1 + sovProbabilistic(uint64(m.CurrentEpochEndSlotExclusive))
-	}
-	if m.SystemStartUnixNs != 0 {
-		n += 1 + sovProbabilistic(uint64(m.SystemStartUnixNs))
-	}
-	if m.SlotLengthNs != 0 {
-		n += 2 + sovProbabilistic(uint64(m.SlotLengthNs))
-	}
-	if len(m.EpochContexts) > 0 {
-		for _, e := range m.EpochContexts {
-			l = e.Size()
-			n += 2 + l + sovProbabilistic(uint64(l))
+	if m.HostStateLineage != nil {
+		{
+			size, err := m.HostStateLineage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.LatestCheckpointHeight != nil {
-		l = m.LatestCheckpointHeight.Size()
-		n += 2 + l + sovProbabilistic(uint64(l))
-	}
-	l = len(m.LatestCheckpointBlockHash)
-	if l > 0 {
-		n += 2 + l + sovProbabilistic(uint64(l))
-	}
-	if m.LatestCheckpointEpoch != 0 {
-		n += 2 + sovProbabilistic(uint64(m.LatestCheckpointEpoch))
-	}
-	return n
-}
-
-func (m *ConsensusState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChainDensityBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.ChainDensityBps))
+		i--
+		dAtA[i] = 0x40
 	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovProbabilistic(uint64(m.Timestamp))
+	if m.SecurityScoreBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.SecurityScoreBps))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.IbcStateRoot)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.UniqueStakeBps != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.UniqueStakeBps))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.AcceptedBlockHash)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.UniquePoolsCount != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.UniquePoolsCount))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.AcceptedEpoch != 0 {
-		n += 1 + sovProbabilistic(uint64(m.AcceptedEpoch))
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.AcceptedEpoch))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.UniquePoolsCount != 0 {
-		n += 1 + sovProbabilistic(uint64(m.UniquePoolsCount))
+	if len(m.AcceptedBlockHash) > 0 {
+		i -= len(m.AcceptedBlockHash)
+		copy(dAtA[i:], m.AcceptedBlockHash)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.AcceptedBlockHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UniqueStakeBps != 0 {
-		n += 1 + sovProbabilistic(uint64(m.UniqueStakeBps))
+	if len(m.IbcStateRoot) > 0 {
+		i -= len(m.IbcStateRoot)
+		copy(dAtA[i:], m.IbcStateRoot)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.IbcStateRoot)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SecurityScoreBps != 0 {
-		n += 1 + sovProbabilistic(uint64(m.SecurityScoreBps))
+	if m.Timestamp != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Misbehaviour) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HostStateShutdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HostStateShutdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostStateShutdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
-	}
-	if m.ProbabilisticHeader1 != nil {
-		l = m.ProbabilisticHeader1.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.GracePeriodEndMs != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.GracePeriodEndMs))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ProbabilisticHeader2 != nil {
-		l = m.ProbabilisticHeader2.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.InitiatedAtMs != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.InitiatedAtMs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProbabilisticBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HostStateLineage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HostStateLineage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostStateLineage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != nil {
-		l = m.Height.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.NextChannelSequence != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.NextChannelSequence))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Slot != 0 {
-		n += 1 + sovProbabilistic(uint64(m.Slot))
+	if m.NextConnectionSequence != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.NextConnectionSequence))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.NextClientSequence != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.NextClientSequence))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovProbabilistic(uint64(m.Epoch))
+	if m.Version != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovProbabilistic(uint64(m.Timestamp))
+	if m.OutputIndex != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BlockCbor)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProbabilisticHeader) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Misbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TrustedHeight != nil {
-		l = m.TrustedHeight.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
-	}
-	if m.AnchorBlock != nil {
-		l = m.AnchorBlock.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
-	}
-	if len(m.DescendantBlocks) > 0 {
-		for _, e := range m.DescendantBlocks {
-			l = e.Size()
-			n += 1 + l + sovProbabilistic(uint64(l))
+	if m.ProbabilisticHeader2 != nil {
+		{
+			size, err := m.ProbabilisticHeader2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.HostStateTxHash)
-	if l > 0 {
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.ProbabilisticHeader1 != nil {
+		{
+			size, err := m.ProbabilisticHeader1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.HostStateTxOutputIndex != 0 {
-		n += 1 + sovProbabilistic(uint64(m.HostStateTxOutputIndex))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.BridgeBlocks) > 0 {
-		for _, e := range m.BridgeBlocks {
-			l = e.Size()
-			n += 1 + l + sovProbabilistic(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *SlotLeaderEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotLeaderEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlotLeaderEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakeDistributionProofs) > 0 {
+		for iNdEx := len(m.StakeDistributionProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeDistributionProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.NewEpochContext != nil {
-		l = m.NewEpochContext.Size()
-		n += 1 + l + sovProbabilistic(uint64(l))
+	if m.Block2 != nil {
+		{
+			size, err := m.Block2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsCheckpoint {
-		n += 2
+	if m.Block1 != nil {
+		{
+			size, err := m.Block1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovProbabilistic(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ProbabilisticBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozProbabilistic(x uint64) (n int) {
-	return sovProbabilistic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ProbabilisticBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Height) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProbabilistic
+
+func (m *ProbabilisticBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderCbor) > 0 {
+		i -= len(m.HeaderCbor)
+		copy(dAtA[i:], m.HeaderCbor)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HeaderCbor)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.BlockCbor) > 0 {
+		i -= len(m.BlockCbor)
+		copy(dAtA[i:], m.BlockCbor)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.BlockCbor)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Slot != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != nil {
+		{
+			size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochBridgeSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochBridgeSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochBridgeSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BridgeBlocks) > 0 {
+		for iNdEx := len(m.BridgeBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochContext != nil {
+		{
+			size, err := m.EpochContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProbabilisticHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbabilisticHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbabilisticHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostStateLineageTxBodies) > 0 {
+		for iNdEx := len(m.HostStateLineageTxBodies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HostStateLineageTxBodies[iNdEx])
+			copy(dAtA[i:], m.HostStateLineageTxBodies[iNdEx])
+			i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HostStateLineageTxBodies[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.StakeDistributionProofs) > 0 {
+		for iNdEx := len(m.StakeDistributionProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeDistributionProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MithrilCertificates) > 0 {
+		for iNdEx := len(m.MithrilCertificates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MithrilCertificates[iNdEx])
+			copy(dAtA[i:], m.MithrilCertificates[iNdEx])
+			i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.MithrilCertificates[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.EpochBridgeSegments) > 0 {
+		for iNdEx := len(m.EpochBridgeSegments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochBridgeSegments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.IsCheckpoint {
+		i--
+		if m.IsCheckpoint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.NewEpochContext != nil {
+		{
+			size, err := m.NewEpochContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.BridgeBlocks) > 0 {
+		for iNdEx := len(m.BridgeBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.HostStateTxOutputIndex != 0 {
+		i = encodeVarintProbabilistic(dAtA, i, uint64(m.HostStateTxOutputIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.HostStateTxHash) > 0 {
+		i -= len(m.HostStateTxHash)
+		copy(dAtA[i:], m.HostStateTxHash)
+		i = encodeVarintProbabilistic(dAtA, i, uint64(len(m.HostStateTxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DescendantBlocks) > 0 {
+		for iNdEx := len(m.DescendantBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DescendantBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AnchorBlock != nil {
+		{
+			size, err := m.AnchorBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TrustedHeight != nil {
+		{
+			size, err := m.TrustedHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProbabilistic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProbabilistic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProbabilistic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Height) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevisionNumber != 0 {
+		n += 1 + sovProbabilistic(uint64(m.RevisionNumber))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovProbabilistic(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *StakeDistributionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.Stake != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Stake))
+	}
+	l = len(m.VrfKeyHash)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.FirstRegistrationSlot != 0 {
+		n += 1 + sovProbabilistic(uint64(m.FirstRegistrationSlot))
+	}
+	return n
+}
+
+func (m *EpochContext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Epoch))
+	}
+	if len(m.StakeDistribution) > 0 {
+		for _, e := range m.StakeDistribution {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	l = len(m.EpochNonce)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.SlotsPerKesPeriod != 0 {
+		n += 1 + sovProbabilistic(uint64(m.SlotsPerKesPeriod))
+	}
+	if m.EpochStartSlot != 0 {
+		n += 1 + sovProbabilistic(uint64(m.EpochStartSlot))
+	}
+	if m.EpochEndSlotExclusive != 0 {
+		n += 1 + sovProbabilistic(uint64(m.EpochEndSlotExclusive))
+	}
+	l = len(m.StakeDistributionRoot)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.TotalStake != 0 {
+		n += 1 + sovProbabilistic(uint64(m.TotalStake))
+	}
+	if m.PoolCount != 0 {
+		n += 1 + sovProbabilistic(uint64(m.PoolCount))
+	}
+	return n
+}
+
+func (m *StakeDistributionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Epoch))
+	}
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Index))
+	}
+	if len(m.Siblings) > 0 {
+		for _, b := range m.Siblings {
+			l = len(b)
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AcceptancePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdDepth != 0 {
+		n += 1 + sovProbabilistic(uint64(m.ThresholdDepth))
+	}
+	if m.ThresholdUniquePools != 0 {
+		n += 1 + sovProbabilistic(uint64(m.ThresholdUniquePools))
+	}
+	if m.ThresholdUniqueStakeBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.ThresholdUniqueStakeBps))
+	}
+	if m.DepthWeightBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.DepthWeightBps))
+	}
+	if m.PoolsWeightBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.PoolsWeightBps))
+	}
+	if m.StakeWeightBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.StakeWeightBps))
+	}
+	if m.ThresholdChainDensityBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.ThresholdChainDensityBps))
+	}
+	if m.ActiveSlotCoeffNumerator != 0 {
+		n += 1 + sovProbabilistic(uint64(m.ActiveSlotCoeffNumerator))
+	}
+	if m.ActiveSlotCoeffDenominator != 0 {
+		n += 1 + sovProbabilistic(uint64(m.ActiveSlotCoeffDenominator))
+	}
+	return n
+}
+
+func (m *EpochNonceEvolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RandomnessStabilityWindowSlots != 0 {
+		n += 1 + sovProbabilistic(uint64(m.RandomnessStabilityWindowSlots))
+	}
+	l = len(m.EvolvingNonce)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.CandidateNonce)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.LabNonce)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.LastEpochBlockNonce)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	return n
+}
+
+func (m *MithrilStakeDistributionTrust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CertificateHash)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Epoch))
+	}
+	l = len(m.AggregateVerificationKey)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.NextAggregateVerificationKey)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.K != 0 {
+		n += 1 + sovProbabilistic(uint64(m.K))
+	}
+	if m.M != 0 {
+		n += 1 + sovProbabilistic(uint64(m.M))
+	}
+	if m.PhiFNumerator != 0 {
+		n += 1 + sovProbabilistic(uint64(m.PhiFNumerator))
+	}
+	if m.PhiFDenominator != 0 {
+		n += 1 + sovProbabilistic(uint64(m.PhiFDenominator))
+	}
+	return n
+}
+
+func (m *EraSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovProbabilistic(uint64(m.StartSlot))
+	}
+	if m.StartTimeUnixNs != 0 {
+		n += 1 + sovProbabilistic(uint64(m.StartTimeUnixNs))
+	}
+	if m.SlotLengthNs != 0 {
+		n += 1 + sovProbabilistic(uint64(m.SlotLengthNs))
+	}
+	return n
+}
+
+func (m *ClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.LatestHeight != nil {
+		l = m.LatestHeight.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.FrozenHeight != nil {
+		l = m.FrozenHeight.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovProbabilistic(uint64(m.CurrentEpoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod)
+	n += 1 + l + sovProbabilistic(uint64(l))
+	if len(m.UpgradePath) > 0 {
+		for _, s := range m.UpgradePath {
+			l = len(s)
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	l = len(m.HostStateNftPolicyId)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.HostStateNftTokenName)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if len(m.EpochStakeDistribution) > 0 {
+		for _, e := range m.EpochStakeDistribution {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	l = len(m.EpochNonce)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.SlotsPerKesPeriod != 0 {
+		n += 1 + sovProbabilistic(uint64(m.SlotsPerKesPeriod))
+	}
+	if m.CurrentEpochStartSlot != 0 {
+		n += 1 + sovProbabilistic(uint64(m.CurrentEpochStartSlot))
+	}
+	if m.CurrentEpochEndSlotExclusive != 0 {
+		n += 1 + sovProbabilistic(uint64(m.CurrentEpochEndSlotExclusive))
+	}
+	if m.SystemStartUnixNs != 0 {
+		n += 1 + sovProbabilistic(uint64(m.SystemStartUnixNs))
+	}
+	if m.SlotLengthNs != 0 {
+		n += 2 + sovProbabilistic(uint64(m.SlotLengthNs))
+	}
+	if len(m.EpochContexts) > 0 {
+		for _, e := range m.EpochContexts {
+			l = e.Size()
+			n += 2 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	if m.LatestCheckpointHeight != nil {
+		l = m.LatestCheckpointHeight.Size()
+		n += 2 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.LatestCheckpointBlockHash)
+	if l > 0 {
+		n += 2 + l + sovProbabilistic(uint64(l))
+	}
+	if m.LatestCheckpointEpoch != 0 {
+		n += 2 + sovProbabilistic(uint64(m.LatestCheckpointEpoch))
+	}
+	if m.AcceptancePolicy != nil {
+		l = m.AcceptancePolicy.Size()
+		n += 2 + l + sovProbabilistic(uint64(l))
+	}
+	if m.EpochNonceEvolution != nil {
+		l = m.EpochNonceEvolution.Size()
+		n += 2 + l + sovProbabilistic(uint64(l))
+	}
+	if m.MithrilStakeDistributionTrust != nil {
+		l = m.MithrilStakeDistributionTrust.Size()
+		n += 2 + l + sovProbabilistic(uint64(l))
+	}
+	if m.MaxPrunedConsensusStatesPerUpdate != 0 {
+		n += 2 + sovProbabilistic(uint64(m.MaxPrunedConsensusStatesPerUpdate))
+	}
+	if len(m.EraHistory) > 0 {
+		for _, e := range m.EraHistory {
+			l = e.Size()
+			n += 2 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	if m.HostStateShutdownHeight != nil {
+		l = m.HostStateShutdownHeight.Size()
+		n += 2 + l + sovProbabilistic(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Timestamp))
+	}
+	l = len(m.IbcStateRoot)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.AcceptedBlockHash)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.AcceptedEpoch != 0 {
+		n += 1 + sovProbabilistic(uint64(m.AcceptedEpoch))
+	}
+	if m.UniquePoolsCount != 0 {
+		n += 1 + sovProbabilistic(uint64(m.UniquePoolsCount))
+	}
+	if m.UniqueStakeBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.UniqueStakeBps))
+	}
+	if m.SecurityScoreBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.SecurityScoreBps))
+	}
+	if m.ChainDensityBps != 0 {
+		n += 1 + sovProbabilistic(uint64(m.ChainDensityBps))
+	}
+	if m.HostStateLineage != nil {
+		l = m.HostStateLineage.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.HostStateShutdown != nil {
+		l = m.HostStateShutdown.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	return n
+}
+
+func (m *HostStateShutdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiatedAtMs != 0 {
+		n += 1 + sovProbabilistic(uint64(m.InitiatedAtMs))
+	}
+	if m.GracePeriodEndMs != 0 {
+		n += 1 + sovProbabilistic(uint64(m.GracePeriodEndMs))
+	}
+	return n
+}
+
+func (m *HostStateLineage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.OutputIndex != 0 {
+		n += 1 + sovProbabilistic(uint64(m.OutputIndex))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Version))
+	}
+	if m.NextClientSequence != 0 {
+		n += 1 + sovProbabilistic(uint64(m.NextClientSequence))
+	}
+	if m.NextConnectionSequence != 0 {
+		n += 1 + sovProbabilistic(uint64(m.NextConnectionSequence))
+	}
+	if m.NextChannelSequence != 0 {
+		n += 1 + sovProbabilistic(uint64(m.NextChannelSequence))
+	}
+	return n
+}
+
+func (m *Misbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.ProbabilisticHeader1 != nil {
+		l = m.ProbabilisticHeader1.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.ProbabilisticHeader2 != nil {
+		l = m.ProbabilisticHeader2.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	return n
+}
+
+func (m *SlotLeaderEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block1 != nil {
+		l = m.Block1.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.Block2 != nil {
+		l = m.Block2.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if len(m.StakeDistributionProofs) > 0 {
+		for _, e := range m.StakeDistributionProofs {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProbabilisticBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != nil {
+		l = m.Height.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Slot))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Epoch))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovProbabilistic(uint64(m.Timestamp))
+	}
+	l = len(m.BlockCbor)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	l = len(m.HeaderCbor)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochBridgeSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochContext != nil {
+		l = m.EpochContext.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if len(m.BridgeBlocks) > 0 {
+		for _, e := range m.BridgeBlocks {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProbabilisticHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrustedHeight != nil {
+		l = m.TrustedHeight.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.AnchorBlock != nil {
+		l = m.AnchorBlock.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if len(m.DescendantBlocks) > 0 {
+		for _, e := range m.DescendantBlocks {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	l = len(m.HostStateTxHash)
+	if l > 0 {
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.HostStateTxOutputIndex != 0 {
+		n += 1 + sovProbabilistic(uint64(m.HostStateTxOutputIndex))
+	}
+	if len(m.BridgeBlocks) > 0 {
+		for _, e := range m.BridgeBlocks {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	if m.NewEpochContext != nil {
+		l = m.NewEpochContext.Size()
+		n += 1 + l + sovProbabilistic(uint64(l))
+	}
+	if m.IsCheckpoint {
+		n += 2
+	}
+	if len(m.EpochBridgeSegments) > 0 {
+		for _, e := range m.EpochBridgeSegments {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	if len(m.MithrilCertificates) > 0 {
+		for _, b := range m.MithrilCertificates {
+			l = len(b)
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	if len(m.StakeDistributionProofs) > 0 {
+		for _, e := range m.StakeDistributionProofs {
+			l = e.Size()
+			n += 1 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	if len(m.HostStateLineageTxBodies) > 0 {
+		for _, b := range m.HostStateLineageTxBodies {
+			l = len(b)
+			n += 2 + l + sovProbabilistic(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProbabilistic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProbabilistic(x uint64) (n int) {
+	return sovProbabilistic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Height) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Height: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeDistributionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeDistributionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeDistributionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			m.Stake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfKeyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfKeyHash = append(m.VrfKeyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.VrfKeyHash == nil {
+				m.VrfKeyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstRegistrationSlot", wireType)
+			}
+			m.FirstRegistrationSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstRegistrationSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeDistribution = append(m.StakeDistribution, &StakeDistributionEntry{})
+			if err := m.StakeDistribution[len(m.StakeDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochNonce = append(m.EpochNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.EpochNonce == nil {
+				m.EpochNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerKesPeriod", wireType)
+			}
+			m.SlotsPerKesPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerKesPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartSlot", wireType)
+			}
+			m.EpochStartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndSlotExclusive", wireType)
+			}
+			m.EpochEndSlotExclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochEndSlotExclusive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeDistributionRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeDistributionRoot = append(m.StakeDistributionRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakeDistributionRoot == nil {
+				m.StakeDistributionRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			m.TotalStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCount", wireType)
+			}
+			m.PoolCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeDistributionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeDistributionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeDistributionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &StakeDistributionEntry{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, make([]byte, postIndex-iNdEx))
+			copy(m.Siblings[len(m.Siblings)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptancePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptancePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptancePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdDepth", wireType)
+			}
+			m.ThresholdDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdUniquePools", wireType)
+			}
+			m.ThresholdUniquePools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdUniquePools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdUniqueStakeBps", wireType)
+			}
+			m.ThresholdUniqueStakeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdUniqueStakeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepthWeightBps", wireType)
+			}
+			m.DepthWeightBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepthWeightBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolsWeightBps", wireType)
+			}
+			m.PoolsWeightBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolsWeightBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeightBps", wireType)
+			}
+			m.StakeWeightBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakeWeightBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdChainDensityBps", wireType)
+			}
+			m.ThresholdChainDensityBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdChainDensityBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSlotCoeffNumerator", wireType)
+			}
+			m.ActiveSlotCoeffNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSlotCoeffNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSlotCoeffDenominator", wireType)
+			}
+			m.ActiveSlotCoeffDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSlotCoeffDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochNonceEvolution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochNonceEvolution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochNonceEvolution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomnessStabilityWindowSlots", wireType)
+			}
+			m.RandomnessStabilityWindowSlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomnessStabilityWindowSlots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvolvingNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvolvingNonce = append(m.EvolvingNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvolvingNonce == nil {
+				m.EvolvingNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandidateNonce = append(m.CandidateNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.CandidateNonce == nil {
+				m.CandidateNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabNonce = append(m.LabNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.LabNonce == nil {
+				m.LabNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochBlockNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEpochBlockNonce = append(m.LastEpochBlockNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastEpochBlockNonce == nil {
+				m.LastEpochBlockNonce = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MithrilStakeDistributionTrust) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MithrilStakeDistributionTrust: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MithrilStakeDistributionTrust: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertificateHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVerificationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateVerificationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAggregateVerificationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextAggregateVerificationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			}
+			m.K = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.K |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			m.M = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.M |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhiFNumerator", wireType)
+			}
+			m.PhiFNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhiFNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhiFDenominator", wireType)
+			}
+			m.PhiFDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhiFDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EraSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EraSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EraSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeUnixNs", wireType)
+			}
+			m.StartTimeUnixNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimeUnixNs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotLengthNs", wireType)
+			}
+			m.SlotLengthNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotLengthNs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestHeight == nil {
+				m.LatestHeight = &Height{}
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FrozenHeight == nil {
+				m.FrozenHeight = &Height{}
+			}
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradePath = append(m.UpgradePath, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftPolicyId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostStateNftPolicyId = append(m.HostStateNftPolicyId[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostStateNftPolicyId == nil {
+				m.HostStateNftPolicyId = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftTokenName", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostStateNftTokenName = append(m.HostStateNftTokenName[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostStateNftTokenName == nil {
+				m.HostStateNftTokenName = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStakeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStakeDistribution = append(m.EpochStakeDistribution, &StakeDistributionEntry{})
+			if err := m.EpochStakeDistribution[len(m.EpochStakeDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochNonce = append(m.EpochNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.EpochNonce == nil {
+				m.EpochNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerKesPeriod", wireType)
+			}
+			m.SlotsPerKesPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerKesPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartSlot", wireType)
+			}
+			m.CurrentEpochStartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochStartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochEndSlotExclusive", wireType)
+			}
+			m.CurrentEpochEndSlotExclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochEndSlotExclusive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemStartUnixNs", wireType)
+			}
+			m.SystemStartUnixNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SystemStartUnixNs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotLengthNs", wireType)
+			}
+			m.SlotLengthNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotLengthNs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochContexts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochContexts = append(m.EpochContexts, &EpochContext{})
+			if err := m.EpochContexts[len(m.EpochContexts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.LatestCheckpointHeight == nil {
+				m.LatestCheckpointHeight = &Height{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Height: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			if err := m.LatestCheckpointHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RevisionNumber = 0
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointBlockHash", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1437,16 +4865,29 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevisionNumber |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestCheckpointBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointEpoch", wireType)
 			}
-			m.RevisionHeight = 0
+			m.LatestCheckpointEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1456,66 +4897,88 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevisionHeight |= uint64(b&0x7F) << shift
+				m.LatestCheckpointEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProbabilistic(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptancePolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StakeDistributionEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProbabilistic
+			if m.AcceptancePolicy == nil {
+				m.AcceptancePolicy = &AcceptancePolicy{}
 			}
-			if iNdEx >= l {
+			if err := m.AcceptancePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNonceEvolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.EpochNonceEvolution == nil {
+				m.EpochNonceEvolution = &EpochNonceEvolution{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StakeDistributionEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeDistributionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.EpochNonceEvolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MithrilStakeDistributionTrust", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1525,29 +4988,33 @@ func (m *StakeDistributionEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = string(dAtA[iNdEx:postIndex])
+			if m.MithrilStakeDistributionTrust == nil {
+				m.MithrilStakeDistributionTrust = &MithrilStakeDistributionTrust{}
+			}
+			if err := m.MithrilStakeDistributionTrust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedConsensusStatesPerUpdate", wireType)
 			}
-			m.Stake = 0
+			m.MaxPrunedConsensusStatesPerUpdate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1557,16 +5024,16 @@ func (m *StakeDistributionEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Stake |= uint64(b&0x7F) << shift
+				m.MaxPrunedConsensusStatesPerUpdate |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VrfKeyHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EraHistory", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1576,31 +5043,31 @@ func (m *StakeDistributionEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VrfKeyHash = append(m.VrfKeyHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.VrfKeyHash == nil {
-				m.VrfKeyHash = []byte{}
+			m.EraHistory = append(m.EraHistory, &EraSummary{})
+			if err := m.EraHistory[len(m.EraHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstRegistrationSlot", wireType)
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateShutdownHeight", wireType)
 			}
-			m.FirstRegistrationSlot = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1610,11 +5077,28 @@ func (m *StakeDistributionEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FirstRegistrationSlot |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HostStateShutdownHeight == nil {
+				m.HostStateShutdownHeight = &Height{}
+			}
+			if err := m.HostStateShutdownHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProbabilistic(dAtA[iNdEx:])
@@ -1636,7 +5120,7 @@ func (m *StakeDistributionEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EpochContext) Unmarshal(dAtA []byte) error {
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1659,17 +5143,17 @@ func (m *EpochContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Epoch = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1679,16 +5163,16 @@ func (m *EpochContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeDistribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcStateRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1698,31 +5182,31 @@ func (m *EpochContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeDistribution = append(m.StakeDistribution, &StakeDistributionEntry{})
-			if err := m.StakeDistribution[len(m.StakeDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IbcStateRoot = append(m.IbcStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcStateRoot == nil {
+				m.IbcStateRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBlockHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1732,31 +5216,29 @@ func (m *EpochContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EpochNonce = append(m.EpochNonce[:0], dAtA[iNdEx:postIndex]...)
-			if m.EpochNonce == nil {
-				m.EpochNonce = []byte{}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AcceptedBlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerKesPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedEpoch", wireType)
 			}
-			m.SlotsPerKesPeriod = 0
+			m.AcceptedEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1766,16 +5248,16 @@ func (m *EpochContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotsPerKesPeriod |= uint64(b&0x7F) << shift
+				m.AcceptedEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UniquePoolsCount", wireType)
 			}
-			m.EpochStartSlot = 0
+			m.UniquePoolsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1785,16 +5267,16 @@ func (m *EpochContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochStartSlot |= uint64(b&0x7F) << shift
+				m.UniquePoolsCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndSlotExclusive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueStakeBps", wireType)
 			}
-			m.EpochEndSlotExclusive = 0
+			m.UniqueStakeBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1804,66 +5286,16 @@ func (m *EpochContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochEndSlotExclusive |= uint64(b&0x7F) << shift
+				m.UniqueStakeBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProbabilistic(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClientState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProbabilistic
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityScoreBps", wireType)
 			}
-			var stringLen uint64
+			m.SecurityScoreBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1873,27 +5305,33 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SecurityScoreBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainDensityBps", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ChainDensityBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainDensityBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateLineage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1920,16 +5358,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestHeight == nil {
-				m.LatestHeight = &Height{}
+			if m.HostStateLineage == nil {
+				m.HostStateLineage = &HostStateLineage{}
 			}
-			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HostStateLineage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateShutdown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1956,18 +5394,68 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FrozenHeight == nil {
-				m.FrozenHeight = &Height{}
+			if m.HostStateShutdown == nil {
+				m.HostStateShutdown = &HostStateShutdown{}
 			}
-			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HostStateShutdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostStateShutdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostStateShutdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostStateShutdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedAtMs", wireType)
 			}
-			m.CurrentEpoch = 0
+			m.InitiatedAtMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1977,16 +5465,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				m.InitiatedAtMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodEndMs", wireType)
 			}
-			var msglen int
+			m.GracePeriodEndMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -1996,62 +5484,66 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GracePeriodEndMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProbabilistic
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostStateLineage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpgradePath = append(m.UpgradePath, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostStateLineage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostStateLineage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftPolicyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2061,31 +5553,29 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostStateNftPolicyId = append(m.HostStateNftPolicyId[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostStateNftPolicyId == nil {
-				m.HostStateNftPolicyId = []byte{}
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostStateNftTokenName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 			}
-			var byteLen int
+			m.OutputIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2095,31 +5585,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OutputIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostStateNftTokenName = append(m.HostStateNftTokenName[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostStateNftTokenName == nil {
-				m.HostStateNftTokenName = []byte{}
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStakeDistribution", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2129,31 +5604,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EpochStakeDistribution = append(m.EpochStakeDistribution, &StakeDistributionEntry{})
-			if err := m.EpochStakeDistribution[len(m.EpochStakeDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNonce", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClientSequence", wireType)
 			}
-			var byteLen int
+			m.NextClientSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2163,31 +5623,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NextClientSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EpochNonce = append(m.EpochNonce[:0], dAtA[iNdEx:postIndex]...)
-			if m.EpochNonce == nil {
-				m.EpochNonce = []byte{}
-			}
-			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerKesPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextConnectionSequence", wireType)
 			}
-			m.SlotsPerKesPeriod = 0
+			m.NextConnectionSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2197,16 +5642,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotsPerKesPeriod |= uint64(b&0x7F) << shift
+				m.NextConnectionSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelSequence", wireType)
 			}
-			m.CurrentEpochStartSlot = 0
+			m.NextChannelSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2216,16 +5661,66 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpochStartSlot |= uint64(b&0x7F) << shift
+				m.NextChannelSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochEndSlotExclusive", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CurrentEpochEndSlotExclusive = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2235,16 +5730,29 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpochEndSlotExclusive |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemStartUnixNs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProbabilistic
 			}
-			m.SystemStartUnixNs = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilisticHeader1", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2254,16 +5762,33 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SystemStartUnixNs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotLengthNs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
 			}
-			m.SlotLengthNs = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProbabilisticHeader1 == nil {
+				m.ProbabilisticHeader1 = &ProbabilisticHeader{}
+			}
+			if err := m.ProbabilisticHeader1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilisticHeader2", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2273,14 +5798,81 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotLengthNs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProbabilisticHeader2 == nil {
+				m.ProbabilisticHeader2 = &ProbabilisticHeader{}
+			}
+			if err := m.ProbabilisticHeader2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProbabilistic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotLeaderEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProbabilistic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotLeaderEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotLeaderEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochContexts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block1", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2307,14 +5899,16 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochContexts = append(m.EpochContexts, &EpochContext{})
-			if err := m.EpochContexts[len(m.EpochContexts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Block1 == nil {
+				m.Block1 = &ProbabilisticBlock{}
+			}
+			if err := m.Block1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block2", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2341,18 +5935,18 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestCheckpointHeight == nil {
-				m.LatestCheckpointHeight = &Height{}
+			if m.Block2 == nil {
+				m.Block2 = &ProbabilisticBlock{}
 			}
-			if err := m.LatestCheckpointHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Block2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointBlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeDistributionProofs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2362,43 +5956,26 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestCheckpointBlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointEpoch", wireType)
-			}
-			m.LatestCheckpointEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LatestCheckpointEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.StakeDistributionProofs = append(m.StakeDistributionProofs, &StakeDistributionProof{})
+			if err := m.StakeDistributionProofs[len(m.StakeDistributionProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProbabilistic(dAtA[iNdEx:])
@@ -2420,7 +5997,7 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+func (m *ProbabilisticBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2443,17 +6020,17 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProbabilisticBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProbabilisticBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2463,16 +6040,33 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Height == nil {
+				m.Height = &Height{}
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcStateRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			var byteLen int
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2482,29 +6076,14 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcStateRoot = append(m.IbcStateRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.IbcStateRoot == nil {
-				m.IbcStateRoot = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2532,32 +6111,13 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptedBlockHash = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedEpoch", wireType)
-			}
-			m.AcceptedEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AcceptedEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniquePoolsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.UniquePoolsCount = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2567,104 +6127,16 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UniquePoolsCount |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniqueStakeBps", wireType)
-			}
-			m.UniqueStakeBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UniqueStakeBps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityScoreBps", wireType)
-			}
-			m.SecurityScoreBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SecurityScoreBps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProbabilistic(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProbabilistic
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2674,29 +6146,16 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilisticHeader1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCbor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2706,33 +6165,31 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProbabilisticHeader1 == nil {
-				m.ProbabilisticHeader1 = &ProbabilisticHeader{}
-			}
-			if err := m.ProbabilisticHeader1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockCbor = append(m.BlockCbor[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockCbor == nil {
+				m.BlockCbor = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilisticHeader2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderCbor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2742,26 +6199,24 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProbabilisticHeader2 == nil {
-				m.ProbabilisticHeader2 = &ProbabilisticHeader{}
-			}
-			if err := m.ProbabilisticHeader2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.HeaderCbor = append(m.HeaderCbor[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderCbor == nil {
+				m.HeaderCbor = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2785,7 +6240,7 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProbabilisticBlock) Unmarshal(dAtA []byte) error {
+func (m *EpochBridgeSegment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2808,15 +6263,15 @@ func (m *ProbabilisticBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProbabilisticBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochBridgeSegment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProbabilisticBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochBridgeSegment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2843,107 +6298,18 @@ func (m *ProbabilisticBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Height == nil {
-				m.Height = &Height{}
+			if m.EpochContext == nil {
+				m.EpochContext = &EpochContext{}
 			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProbabilistic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProbabilistic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockCbor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeBlocks", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProbabilistic
@@ -2953,24 +6319,24 @@ func (m *ProbabilisticBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProbabilistic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockCbor = append(m.BlockCbor[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockCbor == nil {
-				m.BlockCbor = []byte{}
+			m.BridgeBlocks = append(m.BridgeBlocks, &ProbabilisticBlock{})
+			if err := m.BridgeBlocks[len(m.BridgeBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3270,6 +6636,138 @@ func (m *ProbabilisticHeader) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsCheckpoint = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBridgeSegments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochBridgeSegments = append(m.EpochBridgeSegments, &EpochBridgeSegment{})
+			if err := m.EpochBridgeSegments[len(m.EpochBridgeSegments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MithrilCertificates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MithrilCertificates = append(m.MithrilCertificates, make([]byte, postIndex-iNdEx))
+			copy(m.MithrilCertificates[len(m.MithrilCertificates)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeDistributionProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeDistributionProofs = append(m.StakeDistributionProofs, &StakeDistributionProof{})
+			if err := m.StakeDistributionProofs[len(m.StakeDistributionProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStateLineageTxBodies", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProbabilistic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProbabilistic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostStateLineageTxBodies = append(m.HostStateLineageTxBodies, make([]byte, postIndex-iNdEx))
+			copy(m.HostStateLineageTxBodies[len(m.HostStateLineageTxBodies)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProbabilistic(dAtA[iNdEx:])
@@ -141,6 +141,56 @@ func TestCheckSubstituteAndUpdateStateAcceptsDifferentEpochContext(t *testing.T)
 	require.EqualValues(t, 123456789, processedTime)
 }
 
+func TestCheckSubstituteAndUpdateStateRequiresExtendingEraHistory(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	subject := newProbabilisticTestClientState()
+	legacyEra := &EraSummary{StartSlot: 0, StartTimeUnixNs: subject.SystemStartUnixNs, SlotLengthNs: subject.SlotLengthNs}
+
+	testCases := []struct {
+		name       string
+		eraHistory []*EraSummary
+		wantErr    bool
+	}{
+		{
+			name: "appends an era",
+			eraHistory: []*EraSummary{
+				legacyEra,
+				{StartSlot: 1_000, StartTimeUnixNs: subject.SystemStartUnixNs + 1_000*subject.SlotLengthNs, SlotLengthNs: 2_000_000_000},
+			},
+		},
+		{
+			name:       "rewrites an era",
+			eraHistory: []*EraSummary{{StartSlot: 0, StartTimeUnixNs: subject.SystemStartUnixNs, SlotLengthNs: 2_000_000_000}},
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, subjectStore := newProbabilisticTestClientStore(t, "probabilistic-era-subject")
+			_, substituteStore := newProbabilisticTestClientStore(t, "probabilistic-era-substitute")
+			setClientState(subjectStore, cdc, subject)
+
+			substitute := newProbabilisticTestClientState()
+			substitute.LatestHeight = NewHeight(0, 20)
+			substitute.EraHistory = tc.eraHistory
+			setClientState(substituteStore, cdc, substitute)
+			setConsensusState(substituteStore, cdc, newProbabilisticTestConsensusState("hash-20"), substitute.LatestHeight)
+			setConsensusMetadataWithValues(substituteStore, substitute.LatestHeight, clienttypes.NewHeight(0, 50), 123456789)
+
+			err := subject.CheckSubstituteAndUpdateState(ctx, cdc, subjectStore, substituteStore, substitute)
+			if tc.wantErr {
+				require.ErrorIs(t, err, clienttypes.ErrInvalidSubstitute)
+				require.ErrorContains(t, err, "does not extend subject era history")
+				return
+			}
+			require.NoError(t, err)
+			recoveredClient, found := getClientState(subjectStore, cdc)
+			require.True(t, found)
+			require.Equal(t, tc.eraHistory, recoveredClient.EraHistory)
+		})
+	}
+}
+
 func makeRecoveryEpochContext(epoch, startSlot, endSlot uint64, seed byte) *EpochContext {
 	return &EpochContext{
 		Epoch:                 epoch,
@@ -1,6 +1,8 @@
 package probabilistic
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -9,14 +11,116 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-func (ClientState) VerifyUpgradeAndUpdateState(
-	sdk.Context,
-	codec.BinaryCodec,
-	storetypes.KVStore,
-	exported.ClientState,
-	exported.ConsensusState,
-	[]byte,
-	[]byte,
+const (
+	KeyUpgradedClient    = "upgradedClient"
+	KeyUpgradedConsState = "upgradedConsState"
+)
+
+// VerifyUpgradeAndUpdateState verifies that the upgraded client and consensus
+// state are committed under the upgrade path in the latest authenticated
+// HostState root, then swaps in the chain parameters of the upgraded client.
+//
+// The commitment is keyed by the upgrade height, the latest height of the
+// upgraded client, which is scheduled in advance and must be above the current
+// height. The HostState cannot know which block will anchor the client when
+// the upgrade is committed, so the client keeps the checkpoint, consensus
+// states and epoch verification state it already verified. The committed
+// consensus state is proven alongside the client but not adopted.
+func (cs ClientState) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	clientStore storetypes.KVStore,
+	upgradedClient exported.ClientState,
+	upgradedConsState exported.ConsensusState,
+	upgradeClientProof []byte,
+	upgradeConsStateProof []byte,
 ) error {
-	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade probabilistic client")
+	if len(cs.UpgradePath) == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade client, no upgrade path set")
+	}
+
+	upgradedClientState, ok := upgradedClient.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "upgraded client must be probabilistic client. expected: %T got: %T", &ClientState{}, upgradedClient)
+	}
+	upgradedConsensusState, ok := upgradedConsState.(*ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "upgraded consensus state must be probabilistic consensus state. expected %T, got: %T", &ConsensusState{}, upgradedConsState)
+	}
+	if cs.LatestHeight == nil || cs.LatestHeight.IsZero() {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "client latest height must be present")
+	}
+	upgradeHeight := upgradedClientState.LatestHeight
+	if upgradeHeight == nil || !upgradeHeight.GT(cs.LatestHeight) {
+		return errorsmod.Wrapf(
+			clienttypes.ErrInvalidUpgradeClient,
+			"upgraded client height %s must be greater than current client height %s",
+			heightString(upgradedClientState.GetLatestHeight()),
+			cs.LatestHeight.String(),
+		)
+	}
+	if err := upgradedConsensusState.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, err.Error())
+	}
+	// The nonce evolution state follows the checkpoint the client keeps, but its
+	// stability window is a chain parameter an authenticating client must keep.
+	if cs.EpochNonceEvolution != nil && upgradedClientState.EpochNonceEvolution == nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "upgraded client must carry epoch nonce evolution parameters")
+	}
+
+	// Proofs must be checked against the latest consensus state so the upgrade
+	// is the one currently scheduled by the Cardano HostState.
+	lastHeight := cs.LatestHeight
+	consState, found := GetConsensusState(clientStore, cdc, lastHeight)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "could not retrieve consensus state for lastHeight")
+	}
+
+	bz, err := cdc.MarshalInterface(upgradedClientState.ZeroCustomFields())
+	if err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "could not marshal client state: %v", err)
+	}
+	upgradeClientKey := upgradeClientStateKey(cs.UpgradePath, upgradeHeight)
+	if err := VerifyIbcStateMembership(consState.IbcStateRoot, upgradeClientKey, bz, upgradeClientProof); err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidUpgradeClient, "client state proof failed. Key: %s: %v", upgradeClientKey, err)
+	}
+
+	bz, err = cdc.MarshalInterface(upgradedConsensusState)
+	if err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "could not marshal consensus state: %v", err)
+	}
+	upgradeConsStateKey := upgradeConsensusStateKey(cs.UpgradePath, upgradeHeight)
+	if err := VerifyIbcStateMembership(consState.IbcStateRoot, upgradeConsStateKey, bz, upgradeConsStateProof); err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidUpgradeClient, "consensus state proof failed. Key: %s: %v", upgradeConsStateKey, err)
+	}
+
+	newClientState := cs
+	newClientState.ChainId = upgradedClientState.ChainId
+	newClientState.UpgradePath = append([]string(nil), upgradedClientState.UpgradePath...)
+	newClientState.HostStateNftPolicyId = append([]byte(nil), upgradedClientState.HostStateNftPolicyId...)
+	newClientState.HostStateNftTokenName = append([]byte(nil), upgradedClientState.HostStateNftTokenName...)
+	newClientState.SystemStartUnixNs = upgradedClientState.SystemStartUnixNs
+	newClientState.SlotLengthNs = upgradedClientState.SlotLengthNs
+	newClientState.EraHistory = cloneEraHistory(upgradedClientState.EraHistory)
+	if cs.EpochNonceEvolution != nil {
+		newClientState.EpochNonceEvolution = cloneEpochNonceEvolution(cs.EpochNonceEvolution)
+		newClientState.EpochNonceEvolution.RandomnessStabilityWindowSlots = upgradedClientState.EpochNonceEvolution.RandomnessStabilityWindowSlots
+	}
+	if err := newClientState.Validate(); err != nil {
+		return errorsmod.Wrap(err, "updated client state failed basic validation")
+	}
+
+	setClientState(clientStore, cdc, &newClientState)
+	return nil
+}
+
+// upgradeClientStateKey returns the Cardano IBC state key for the upgraded
+// client committed for upgradeHeight. Cardano commits a flat key space, so only
+// the final upgrade path element is used, mirroring ibcStateKeyFromPath.
+func upgradeClientStateKey(upgradePath []string, upgradeHeight exported.Height) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s", upgradePath[len(upgradePath)-1], upgradeHeight.GetRevisionHeight(), KeyUpgradedClient))
+}
+
+func upgradeConsensusStateKey(upgradePath []string, upgradeHeight exported.Height) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s", upgradePath[len(upgradePath)-1], upgradeHeight.GetRevisionHeight(), KeyUpgradedConsState))
 }
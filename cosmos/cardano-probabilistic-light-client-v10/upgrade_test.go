@@ -0,0 +1,284 @@
+package probabilistic
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyUpgradeAndUpdateStateReplacesChainParameters(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-upgrade")
+	cs, upgradedClient, upgradedConsensus := newTestUpgrade()
+	policy := DefaultAcceptancePolicy()
+	policy.ThresholdDepth = 48
+	cs.AcceptancePolicy = &policy
+	cs.EpochNonceEvolution = &EpochNonceEvolution{
+		RandomnessStabilityWindowSlots: 129_600,
+		EvolvingNonce:                  bytes.Repeat([]byte{0x04}, 32),
+		LabNonce:                       bytes.Repeat([]byte{0x05}, 32),
+	}
+	upgradedClient.EpochNonceEvolution = &EpochNonceEvolution{RandomnessStabilityWindowSlots: 172_800}
+
+	// The client is anchored at a real block, and the chain goes on after the
+	// upgrade was committed.
+	accepted := makeTestProbabilisticBlock(t, 10, 100, hex.EncodeToString(bytes.Repeat([]byte{0x11}, 32)))
+	next := makeTestProbabilisticBlock(t, 11, 110, accepted.Hash)
+
+	clientValue, err := cdc.MarshalInterface(upgradedClient.ZeroCustomFields())
+	require.NoError(t, err)
+	consensusValue, err := cdc.MarshalInterface(upgradedConsensus)
+	require.NoError(t, err)
+	clientKey := upgradeClientStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+	consensusKey := upgradeConsensusStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+	require.Equal(t, "upgradedIBCState/20/upgradedClient", string(clientKey))
+
+	root, paths := buildTestIbcStateTree(map[string][]byte{
+		string(clientKey):    clientValue,
+		string(consensusKey): consensusValue,
+	})
+	initialConsensus := newProbabilisticTestConsensusState(accepted.Hash)
+	initialConsensus.IbcStateRoot = root
+	require.NoError(t, cs.Initialize(ctx, cdc, clientStore, initialConsensus))
+	current, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+
+	err = current.VerifyUpgradeAndUpdateState(
+		ctx, cdc, clientStore, upgradedClient, upgradedConsensus,
+		mustTestExistenceProof(t, clientKey, clientValue, paths[string(clientKey)]),
+		mustTestExistenceProof(t, consensusKey, consensusValue, paths[string(consensusKey)]),
+	)
+	require.NoError(t, err)
+
+	stored, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+	require.Equal(t, "cardano-test-upgraded", stored.ChainId)
+	require.Equal(t, uint64(2_000_000_000), stored.SlotLengthNs)
+	require.Equal(t, upgradedClient.EraHistory, stored.EraHistory)
+	require.Equal(t, cs.TrustingPeriod, stored.TrustingPeriod)
+	require.Equal(t, &policy, stored.AcceptancePolicy)
+	require.Equal(t, mustTestEpochContexts(t, cs), stored.EpochContexts)
+	require.Equal(t, uint64(172_800), stored.EpochNonceEvolution.RandomnessStabilityWindowSlots)
+	require.Equal(t, cs.EpochNonceEvolution.EvolvingNonce, stored.EpochNonceEvolution.EvolvingNonce)
+	require.Equal(t, cs.EpochNonceEvolution.LabNonce, stored.EpochNonceEvolution.LabNonce)
+
+	// The verified checkpoint and consensus state are kept.
+	require.Equal(t, NewHeight(0, 10), stored.LatestHeight)
+	require.Equal(t, NewHeight(0, 10), stored.LatestCheckpointHeight)
+	require.Equal(t, accepted.Hash, stored.LatestCheckpointBlockHash)
+	storedConsensus, found := GetConsensusState(clientStore, cdc, NewHeight(0, 10))
+	require.True(t, found)
+	require.Equal(t, initialConsensus, storedConsensus)
+	_, found = GetConsensusState(clientStore, cdc, NewHeight(0, 20))
+	require.False(t, found)
+
+	// The next block of the chain still extends the checkpoint.
+	trustedBlock, err := stored.latestTrustedBlockState(clientStore, cdc)
+	require.NoError(t, err)
+	decoded, err := decodeProbabilisticBlockWitness(next, "anchor")
+	require.NoError(t, err)
+	prevHash, err := blockPrevHash(decoded)
+	require.NoError(t, err)
+	require.NoError(t, verifyBridgeContinuity(&authenticatedProbabilisticHeader{
+		anchorBlock: &authenticatedProbabilisticBlock{height: 11, hash: next.Hash, prevHash: prevHash, epoch: 7},
+	}, trustedBlock))
+}
+
+func TestVerifyUpgradeAndUpdateStateAcceptsCborWrappedCommitments(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-upgrade-cbor")
+	cs, upgradedClient, upgradedConsensus := newTestUpgrade()
+
+	clientValue, err := cdc.MarshalInterface(upgradedClient.ZeroCustomFields())
+	require.NoError(t, err)
+	consensusValue, err := cdc.MarshalInterface(upgradedConsensus)
+	require.NoError(t, err)
+	committedClientValue, err := cbor.Marshal(clientValue)
+	require.NoError(t, err)
+	committedConsensusValue, err := cbor.Marshal(consensusValue)
+	require.NoError(t, err)
+	clientKey := upgradeClientStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+	consensusKey := upgradeConsensusStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+
+	root, paths := buildTestIbcStateTree(map[string][]byte{
+		string(clientKey):    committedClientValue,
+		string(consensusKey): committedConsensusValue,
+	})
+	initialConsensus := newProbabilisticTestConsensusState("trusted-10")
+	initialConsensus.IbcStateRoot = root
+	require.NoError(t, cs.Initialize(ctx, cdc, clientStore, initialConsensus))
+	current, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+
+	err = current.VerifyUpgradeAndUpdateState(
+		ctx, cdc, clientStore, upgradedClient, upgradedConsensus,
+		mustTestExistenceProof(t, clientKey, committedClientValue, paths[string(clientKey)]),
+		mustTestExistenceProof(t, consensusKey, committedConsensusValue, paths[string(consensusKey)]),
+	)
+	require.NoError(t, err)
+}
+
+func TestVerifyUpgradeAndUpdateStateRejectsUncommittedClient(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-upgrade-uncommitted")
+	cs, upgradedClient, upgradedConsensus := newTestUpgrade()
+
+	clientValue, err := cdc.MarshalInterface(upgradedClient.ZeroCustomFields())
+	require.NoError(t, err)
+	consensusValue, err := cdc.MarshalInterface(upgradedConsensus)
+	require.NoError(t, err)
+	clientKey := upgradeClientStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+	consensusKey := upgradeConsensusStateKey(cs.UpgradePath, upgradedClient.LatestHeight)
+	root, paths := buildTestIbcStateTree(map[string][]byte{
+		string(clientKey):    clientValue,
+		string(consensusKey): consensusValue,
+	})
+	initialConsensus := newProbabilisticTestConsensusState("trusted-10")
+	initialConsensus.IbcStateRoot = root
+	require.NoError(t, cs.Initialize(ctx, cdc, clientStore, initialConsensus))
+
+	clientProof := mustTestExistenceProof(t, clientKey, clientValue, paths[string(clientKey)])
+	consensusProof := mustTestExistenceProof(t, consensusKey, consensusValue, paths[string(consensusKey)])
+
+	tampered := *upgradedClient
+	tampered.HostStateNftTokenName = []byte("other-host-state")
+	err = cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, &tampered, upgradedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "client state proof failed")
+
+	tamperedConsensus := *upgradedConsensus
+	tamperedConsensus.AcceptedBlockHash = "other-anchor"
+	err = cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, upgradedClient, &tamperedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "consensus state proof failed")
+
+	stale := *upgradedClient
+	stale.LatestHeight = NewHeight(0, 10)
+	err = cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, &stale, upgradedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "must be greater than current client height")
+
+	noPath := *cs
+	noPath.UpgradePath = nil
+	err = noPath.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, upgradedClient, upgradedConsensus, clientProof, consensusProof)
+	require.ErrorContains(t, err, "no upgrade path set")
+
+	stored, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+	require.Equal(t, cs.ChainId, stored.ChainId)
+}
+
+func newTestUpgrade() (*ClientState, *ClientState, *ConsensusState) {
+	cs := newProbabilisticTestClientState()
+	cs.UpgradePath = []string{"upgrade", "upgradedIBCState"}
+
+	upgradedClient := newProbabilisticTestClientState()
+	upgradedClient.ChainId = "cardano-test-upgraded"
+	upgradedClient.LatestHeight = NewHeight(0, 20)
+	upgradedClient.UpgradePath = []string{"upgrade", "upgradedIBCState"}
+	upgradedClient.SlotLengthNs = 2_000_000_000
+	upgradedClient.EraHistory = []*EraSummary{
+		{StartSlot: 0, StartTimeUnixNs: upgradedClient.SystemStartUnixNs, SlotLengthNs: 1_000_000_000},
+		{StartSlot: 1_000, StartTimeUnixNs: upgradedClient.SystemStartUnixNs + 1_000_000_000_000, SlotLengthNs: 2_000_000_000},
+	}
+
+	upgradedConsensus := newProbabilisticTestConsensusState("upgrade-committed")
+	return cs, upgradedClient, upgradedConsensus
+}
+
+// buildTestIbcStateTree builds the fixed-depth commitment tree used by the
+// Cardano HostState and returns its root with the 64-step path for each key.
+func buildTestIbcStateTree(entries map[string][]byte) ([]byte, map[string][]*ics23.InnerOp) {
+	leaves := make([]testIbcStateLeaf, 0, len(entries))
+	for key, value := range entries {
+		keyHash := sha256.Sum256([]byte(key))
+		leaves = append(leaves, testIbcStateLeaf{
+			index: binary.BigEndian.Uint64(keyHash[0:8]),
+			hash:  testIbcStateLeafHash([]byte(key), value),
+		})
+	}
+
+	paths := make(map[string][]*ics23.InnerOp, len(entries))
+	for key := range entries {
+		keyHash := sha256.Sum256([]byte(key))
+		index := binary.BigEndian.Uint64(keyHash[0:8])
+		path := make([]*ics23.InnerOp, 0, 64)
+		for depth := 0; depth < 64; depth++ {
+			siblings := make([]testIbcStateLeaf, 0)
+			for _, leaf := range leaves {
+				if leaf.index>>uint(depth+1) == index>>uint(depth+1) && (leaf.index>>uint(depth))&1 != (index>>uint(depth))&1 {
+					siblings = append(siblings, leaf)
+				}
+			}
+			sibling := testIbcStateSubtreeHash(depth, siblings)
+			if (index>>uint(depth))&1 == 0 {
+				path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{0x01}, Suffix: sibling})
+			} else {
+				path = append(path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{0x01}, sibling...), Suffix: []byte{}})
+			}
+		}
+		paths[key] = path
+	}
+	return testIbcStateSubtreeHash(64, leaves), paths
+}
+
+type testIbcStateLeaf struct {
+	index uint64
+	hash  []byte
+}
+
+func testIbcStateSubtreeHash(level int, leaves []testIbcStateLeaf) []byte {
+	if len(leaves) == 0 {
+		return make([]byte, 32)
+	}
+	if level == 0 {
+		return leaves[0].hash
+	}
+	var left, right []testIbcStateLeaf
+	for _, leaf := range leaves {
+		if (leaf.index>>uint(level-1))&1 == 0 {
+			left = append(left, leaf)
+		} else {
+			right = append(right, leaf)
+		}
+	}
+	leftHash := testIbcStateSubtreeHash(level-1, left)
+	rightHash := testIbcStateSubtreeHash(level-1, right)
+	empty := make([]byte, 32)
+	if bytes.Equal(leftHash, empty) && bytes.Equal(rightHash, empty) {
+		return empty
+	}
+	h := sha256.Sum256(append(append([]byte{0x01}, leftHash...), rightHash...))
+	return h[:]
+}
+
+func testIbcStateLeafHash(key, value []byte) []byte {
+	if len(value) == 0 {
+		return make([]byte, 32)
+	}
+	keyHash := sha256.Sum256(key)
+	valueHash := sha256.Sum256(value)
+	h := sha256.Sum256(append(append([]byte{0x00}, keyHash[:]...), valueHash[:]...))
+	return h[:]
+}
+
+func mustTestExistenceProof(t *testing.T, key, value []byte, path []*ics23.InnerOp) []byte {
+	t.Helper()
+
+	proof := commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{
+			{
+				Proof: &ics23.CommitmentProof_Exist{
+					Exist: &ics23.ExistenceProof{Key: key, Value: value, Path: path},
+				},
+			},
+		},
+	}
+	bz, err := proof.Marshal()
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,101 @@
+package probabilistic
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+)
+
+// newTestIbcStateMultiProof commits items, and nothing else, to an IBC state
+// tree and returns its root with a JSON multi-proof for every item.
+func newTestIbcStateMultiProof(t *testing.T, items []probabilisticcore.IbcStateMembershipItem) ([]byte, []byte) {
+	t.Helper()
+
+	leafIndex := func(key []byte) uint64 {
+		keyHash := sha256.Sum256(key)
+		return binary.BigEndian.Uint64(keyHash[0:8])
+	}
+	proof := &probabilisticcore.IbcStateMultiProof{}
+	for _, item := range items {
+		proof.Leaves = append(proof.Leaves, probabilisticcore.IbcStateMultiProofLeaf{Key: item.Key, Value: item.Value})
+	}
+	sort.Slice(proof.Leaves, func(i, j int) bool {
+		return leafIndex(proof.Leaves[i].Key) < leafIndex(proof.Leaves[j].Key)
+	})
+
+	// Every sibling outside the committed paths is an empty subtree.
+	positions := make([]uint64, 0, len(proof.Leaves))
+	for _, leaf := range proof.Leaves {
+		positions = append(positions, leafIndex(leaf.Key))
+	}
+	for depth := 0; depth < probabilisticcore.IbcStateTreeDepth; depth++ {
+		parents := make([]uint64, 0, len(positions))
+		for i := 0; i < len(positions); i++ {
+			if positions[i]&1 == 0 && i+1 < len(positions) && positions[i+1] == positions[i]|1 {
+				i++
+			} else {
+				proof.Siblings = append(proof.Siblings, []byte{})
+			}
+			parents = append(parents, positions[i]>>1)
+		}
+		positions = parents
+	}
+
+	root, err := probabilisticcore.ComputeRootFromMultiProof(proof)
+	require.NoError(t, err)
+	proofBytes, err := probabilisticcore.EncodeJSONIbcStateMultiProof(proof)
+	require.NoError(t, err)
+	return root, proofBytes
+}
+
+func TestVerifyBatchMembership(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-batch-membership")
+	clientState := newProbabilisticTestClientState()
+
+	commitment := []byte("packet-commitment")
+	committedCommitment, err := cbor.Marshal(commitment)
+	require.NoError(t, err)
+	channel := probabilisticcore.IbcStateMembershipItem{Key: []byte("channelEnds/ports/transfer/channels/channel-0"), Value: []byte("channel")}
+	commitmentKey := []byte("commitments/ports/transfer/channels/channel-0/sequences/1")
+	root, proof := newTestIbcStateMultiProof(t, []probabilisticcore.IbcStateMembershipItem{
+		channel,
+		{Key: commitmentKey, Value: committedCommitment},
+	})
+
+	consensusState := newProbabilisticTestConsensusState("batch-block-hash")
+	consensusState.IbcStateRoot = root
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 10))
+	setConsensusMetadata(ctx, clientStore, NewHeight(0, 10))
+
+	// Packet commitments are compared with their CBOR-committed bytes.
+	items := []probabilisticcore.IbcStateMembershipItem{channel, {Key: commitmentKey, Value: commitment}}
+	require.NoError(t, clientState.verifyBatchMembershipAtHeight(clientStore, cdc, NewHeight(0, 10), items, proof))
+
+	wrongKey := []probabilisticcore.IbcStateMembershipItem{channel, {Key: []byte("commitments/ports/transfer/channels/channel-0/sequences/2"), Value: commitment}}
+	require.ErrorIs(t, clientState.verifyBatchMembershipAtHeight(clientStore, cdc, NewHeight(0, 10), wrongKey, proof), clienttypes.ErrFailedMembershipVerification)
+
+	wrongValue := []probabilisticcore.IbcStateMembershipItem{channel, {Key: commitmentKey, Value: []byte("other-commitment")}}
+	require.ErrorIs(t, clientState.verifyBatchMembershipAtHeight(clientStore, cdc, NewHeight(0, 10), wrongValue, proof), clienttypes.ErrFailedMembershipVerification)
+
+	extra := append(append([]probabilisticcore.IbcStateMembershipItem(nil), items...), probabilisticcore.IbcStateMembershipItem{Key: []byte("receipts/ports/transfer/channels/channel-0/sequences/1"), Value: []byte{0x01}})
+	require.ErrorIs(t, clientState.verifyBatchMembershipAtHeight(clientStore, cdc, NewHeight(0, 10), extra, proof), clienttypes.ErrFailedMembershipVerification)
+
+	require.ErrorIs(t, clientState.verifyBatchMembershipAtHeight(clientStore, cdc, NewHeight(0, 10), nil, proof), clienttypes.ErrFailedMembershipVerification)
+	require.ErrorIs(t, clientState.verifyBatchMembershipAtHeight(clientStore, cdc, NewHeight(0, 11), items, proof), clienttypes.ErrConsensusStateNotFound)
+
+	err = clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), 0, 0, proof, nil, [][]byte{commitment})
+	require.ErrorIs(t, err, clienttypes.ErrFailedMembershipVerification)
+	err = clientState.VerifyBatchMembership(ctx, clientStore, cdc, NewHeight(0, 10), uint64(ctx.BlockTime().UnixNano()), 0, proof, nil, nil)
+	require.ErrorIs(t, err, ErrDelayPeriodNotPassed)
+
+	clientState.recordHostStateShutdown(NewHeight(0, 10))
+	require.ErrorIs(t, clientState.verifyBatchMembershipAtHeight(clientStore, cdc, NewHeight(0, 10), items, proof), ErrHostStateShutdown)
+}
@@ -2,6 +2,7 @@ package probabilistic
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -19,10 +20,18 @@ type authenticatedProbabilisticBlock struct {
 	epoch      uint64
 	timestamp  uint64
 	slotLeader string
+	vrfOutput  []byte
+	stakeEntry *StakeDistributionEntry
+}
+
+type authenticatedEpochSegment struct {
+	epoch        uint64
+	bridgeBlocks []*authenticatedProbabilisticBlock
 }
 
 type authenticatedProbabilisticHeader struct {
 	anchorBlock      *authenticatedProbabilisticBlock
+	epochSegments    []*authenticatedEpochSegment
 	bridgeBlocks     []*authenticatedProbabilisticBlock
 	descendantBlocks []*authenticatedProbabilisticBlock
 }
@@ -32,7 +41,7 @@ func (cs *ClientState) authenticateHeaderBlocks(header *ProbabilisticHeader) (*a
 	if err != nil {
 		return nil, err
 	}
-	epochContexts, err := mergeEpochContexts(baseEpochContexts, header.NewEpochContext)
+	epochContexts, err := mergeHeaderEpochContexts(baseEpochContexts, header)
 	if err != nil {
 		return nil, err
 	}
@@ -44,14 +53,38 @@ func (cs *ClientState) authenticateHeaderBlocksWithContexts(header *Probabilisti
 		return nil, errorsmod.Wrap(ErrInvalidHeader, "probabilistic header missing")
 	}
 
-	anchorBlock, err := cs.authenticateProbabilisticBlock(header.AnchorBlock, "anchor", epochContexts)
+	// The anchor's HostState transaction is read from its body, so the anchor
+	// cannot be relayed header-only.
+	if header.AnchorBlock != nil && len(header.AnchorBlock.HeaderCbor) != 0 {
+		return nil, errorsmod.Wrap(ErrInvalidAcceptedBlock, "anchor block must carry block_cbor, not header_cbor")
+	}
+	anchorBlock, err := cs.authenticateProbabilisticBlock(header.AnchorBlock, "anchor", epochContexts, header.StakeDistributionProofs)
 	if err != nil {
 		return nil, err
 	}
 
+	epochSegments := make([]*authenticatedEpochSegment, 0, len(header.EpochBridgeSegments))
+	for i, segment := range header.EpochBridgeSegments {
+		if segment == nil || segment.EpochContext == nil {
+			return nil, errorsmod.Wrapf(ErrInvalidHeader, "epoch bridge segment %d must carry an epoch context", i)
+		}
+		segmentBlocks := make([]*authenticatedProbabilisticBlock, 0, len(segment.BridgeBlocks))
+		for _, block := range segment.BridgeBlocks {
+			authenticatedBlock, authErr := cs.authenticateProbabilisticBlock(block, "bridge", epochContexts, header.StakeDistributionProofs)
+			if authErr != nil {
+				return nil, authErr
+			}
+			segmentBlocks = append(segmentBlocks, authenticatedBlock)
+		}
+		epochSegments = append(epochSegments, &authenticatedEpochSegment{
+			epoch:        segment.EpochContext.Epoch,
+			bridgeBlocks: segmentBlocks,
+		})
+	}
+
 	bridgeBlocks := make([]*authenticatedProbabilisticBlock, 0, len(header.BridgeBlocks))
 	for _, block := range header.BridgeBlocks {
-		authenticatedBlock, authErr := cs.authenticateProbabilisticBlock(block, "bridge", epochContexts)
+		authenticatedBlock, authErr := cs.authenticateProbabilisticBlock(block, "bridge", epochContexts, header.StakeDistributionProofs)
 		if authErr != nil {
 			return nil, authErr
 		}
@@ -60,7 +93,7 @@ func (cs *ClientState) authenticateHeaderBlocksWithContexts(header *Probabilisti
 
 	descendantBlocks := make([]*authenticatedProbabilisticBlock, 0, len(header.DescendantBlocks))
 	for _, block := range header.DescendantBlocks {
-		authenticatedBlock, authErr := cs.authenticateProbabilisticBlock(block, "descendant", epochContexts)
+		authenticatedBlock, authErr := cs.authenticateProbabilisticBlock(block, "descendant", epochContexts, header.StakeDistributionProofs)
 		if authErr != nil {
 			return nil, authErr
 		}
@@ -75,22 +108,25 @@ func (cs *ClientState) authenticateHeaderBlocksWithContexts(header *Probabilisti
 
 	return &authenticatedProbabilisticHeader{
 		anchorBlock:      anchorBlock,
+		epochSegments:    epochSegments,
 		bridgeBlocks:     bridgeBlocks,
 		descendantBlocks: descendantBlocks,
 	}, nil
 }
 
-func (cs *ClientState) authenticateProbabilisticBlock(block *ProbabilisticBlock, label string, epochContexts []*EpochContext) (*authenticatedProbabilisticBlock, error) {
+func (cs *ClientState) authenticateProbabilisticBlock(
+	block *ProbabilisticBlock,
+	label string,
+	epochContexts []*EpochContext,
+	stakeDistributionProofs []*StakeDistributionProof,
+) (*authenticatedProbabilisticBlock, error) {
 	if block == nil || block.Height == nil {
 		return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "%s block missing height", label)
 	}
-	if len(block.BlockCbor) == 0 {
-		return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "%s block missing block_cbor", label)
-	}
 
-	decodedBlock, err := decodeLedgerBlock(block.BlockCbor)
+	decodedBlock, err := decodeProbabilisticBlockWitness(block, label)
 	if err != nil {
-		return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "failed to decode %s block: %v", label, err)
+		return nil, err
 	}
 
 	if !strings.EqualFold(decodedBlock.Hash(), block.Hash) {
@@ -164,9 +200,14 @@ func (cs *ClientState) authenticateProbabilisticBlock(block *ProbabilisticBlock,
 		return nil, err
 	}
 
-	stakeEntry, err := findStakeDistributionEntryInContext(epochContext, decodedPoolID)
+	vrfOutput, err := probabilisticcore.BlockVrfOutput(decodedBlock)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "%s block VRF output: %v", label, err)
+	}
+
+	stakeEntry, err := findStakeDistributionEntryInContext(epochContext, decodedPoolID, stakeDistributionProofs)
 	if err != nil {
-		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "%s block issuer %s is not trusted for epoch %d", label, decodedPoolID, epochContext.Epoch)
+		return nil, errorsmod.Wrapf(err, "%s block issuer %s is not trusted for epoch %d", label, decodedPoolID, epochContext.Epoch)
 	}
 	if !bytes.Equal(stakeEntry.VrfKeyHash, decodedVrfKeyHash) {
 		return nil, errorsmod.Wrapf(
@@ -185,11 +226,15 @@ func (cs *ClientState) authenticateProbabilisticBlock(block *ProbabilisticBlock,
 		epoch:      epochContext.Epoch,
 		timestamp:  expectedTimestamp,
 		slotLeader: decodedPoolID,
+		vrfOutput:  vrfOutput,
+		stakeEntry: stakeEntry,
 	}, nil
 }
 
+// verifyNativeProbabilisticBlock checks the header signature, VRF and KES of a
+// block, and its body hash when the full block was relayed.
 func (cs *ClientState) verifyNativeProbabilisticBlock(
-	decodedBlock ledger.Block,
+	decodedBlock ledger.BlockHeader,
 	label string,
 	epochContext *EpochContext,
 ) (poolID string, vrfKeyHash []byte, err error) {
@@ -199,11 +244,24 @@ func (cs *ClientState) verifyNativeProbabilisticBlock(
 		}
 	}()
 
-	isValid, vrfKeyBytes, verifyErr := probabilisticcore.VerifyNativeBlock(
-		decodedBlock,
-		epochContext.EpochNonce,
-		int(epochContext.SlotsPerKesPeriod),
+	var (
+		isValid     bool
+		vrfKeyBytes []byte
+		verifyErr   error
 	)
+	if fullBlock, ok := decodedBlock.(ledger.Block); ok {
+		isValid, vrfKeyBytes, verifyErr = probabilisticcore.VerifyNativeBlock(
+			fullBlock,
+			epochContext.EpochNonce,
+			int(epochContext.SlotsPerKesPeriod),
+		)
+	} else {
+		isValid, vrfKeyBytes, verifyErr = probabilisticcore.VerifyNativeBlockHeader(
+			decodedBlock,
+			epochContext.EpochNonce,
+			int(epochContext.SlotsPerKesPeriod),
+		)
+	}
 	if verifyErr != nil {
 		return "", nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "native verification failed for %s block: %v", label, verifyErr)
 	}
@@ -219,7 +277,7 @@ func buildBlockVerificationArtifacts(decodedBlock ledger.Block) (string, string,
 	return probabilisticcore.BuildBlockVerificationArtifacts(decodedBlock)
 }
 
-func blockPrevHash(decodedBlock ledger.Block) (string, error) {
+func blockPrevHash(decodedBlock ledger.BlockHeader) (string, error) {
 	prevHash, err := probabilisticcore.BlockPrevHash(decodedBlock)
 	if err != nil {
 		return "", errorsmod.Wrap(ErrInvalidAcceptedBlock, err.Error())
@@ -236,24 +294,57 @@ func encodeNativeVerifiedBlockBodyHex(
 	return probabilisticcore.EncodeNativeVerifiedBlockBodyHex(txCount, bodyCborAt, witnessCborAt, transactionMetadataSet)
 }
 
-func findStakeDistributionEntryInContext(epochContext *EpochContext, poolID string) (*StakeDistributionEntry, error) {
+// findStakeDistributionEntryInContext returns the stake distribution entry of
+// poolID proven against the epoch context's stake distribution root by one of
+// the supplied inclusion proofs.
+func findStakeDistributionEntryInContext(
+	epochContext *EpochContext,
+	poolID string,
+	proofs []*StakeDistributionProof,
+) (*StakeDistributionEntry, error) {
 	if epochContext == nil {
 		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch context missing while resolving pool %s", poolID)
 	}
-	for _, entry := range epochContext.StakeDistribution {
-		if entry != nil && strings.EqualFold(entry.PoolId, poolID) {
-			return entry, nil
+	for _, proof := range proofs {
+		if proof == nil || proof.Entry == nil || proof.Epoch != epochContext.Epoch ||
+			!strings.EqualFold(proof.Entry.PoolId, poolID) {
+			continue
+		}
+		if len(proof.Entry.VrfKeyHash) != 32 {
+			return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionProof, "vrf_key_hash for pool %s must be 32 bytes", poolID)
+		}
+		if err := probabilisticcore.VerifyStakeDistributionProof(
+			epochContext.StakeDistributionRoot,
+			stakeDistributionLeafHash(proof.Entry),
+			proof.Index,
+			epochContext.PoolCount,
+			proof.Siblings,
+		); err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionProof, "pool %s in epoch %d: %v", poolID, epochContext.Epoch, err)
 		}
+		return cloneStakeDistributionEntries([]*StakeDistributionEntry{proof.Entry})[0], nil
 	}
-	return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "pool %s not present in epoch %d stake distribution", poolID, epochContext.Epoch)
+	return nil, errorsmod.Wrapf(ErrInvalidStakeDistributionProof, "missing stake distribution proof for pool %s in epoch %d", poolID, epochContext.Epoch)
 }
 
-func (cs *ClientState) findStakeDistributionEntry(poolID string) (*StakeDistributionEntry, error) {
-	epochContexts, err := cs.normalizedEpochContexts()
-	if err != nil {
-		return nil, err
+func validateStakeDistributionProofs(proofs []*StakeDistributionProof) error {
+	seen := make(map[string]struct{}, len(proofs))
+	for i, proof := range proofs {
+		if proof == nil || proof.Entry == nil {
+			return errorsmod.Wrapf(ErrInvalidStakeDistributionProof, "stake distribution proof %d must carry an entry", i)
+		}
+		key := fmt.Sprintf("%d/%s", proof.Epoch, strings.ToLower(proof.Entry.PoolId))
+		if _, exists := seen[key]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidStakeDistributionProof,
+				"duplicate stake distribution proof for pool %s in epoch %d",
+				proof.Entry.PoolId,
+				proof.Epoch,
+			)
+		}
+		seen[key] = struct{}{}
 	}
-	return findStakeDistributionEntryInContext(epochContextByEpoch(epochContexts, cs.CurrentEpoch), poolID)
+	return nil
 }
 
 func verifySlotWithinEpochContext(slot uint64, epochContext *EpochContext, label string) error {
@@ -306,3 +397,27 @@ func extractTransactionBodyCbor(tx ledger.Transaction) ([]byte, error) {
 func decodeLedgerBlock(blockCbor []byte) (ledger.Block, error) {
 	return probabilisticcore.DecodeLedgerBlock(blockCbor)
 }
+
+// decodeProbabilisticBlockWitness decodes the relayed block, which is either a
+// full block or a bare header. A bare header decodes to a ledger.BlockHeader
+// that is not a ledger.Block.
+func decodeProbabilisticBlockWitness(block *ProbabilisticBlock, label string) (ledger.BlockHeader, error) {
+	switch {
+	case len(block.BlockCbor) != 0 && len(block.HeaderCbor) != 0:
+		return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "%s block must not carry both block_cbor and header_cbor", label)
+	case len(block.BlockCbor) != 0:
+		decodedBlock, err := decodeLedgerBlock(block.BlockCbor)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "failed to decode %s block: %v", label, err)
+		}
+		return decodedBlock, nil
+	case len(block.HeaderCbor) != 0:
+		decodedHeader, err := probabilisticcore.DecodeLedgerBlockHeader(block.HeaderCbor)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "failed to decode %s block header: %v", label, err)
+		}
+		return decodedHeader, nil
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "%s block missing block_cbor", label)
+	}
+}
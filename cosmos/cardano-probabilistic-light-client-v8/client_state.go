@@ -2,7 +2,6 @@ package probabilistic
 
 import (
 	"fmt"
-	"math"
 	"strings"
 	"time"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
 	cmttypes "github.com/cometbft/cometbft/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
@@ -58,6 +58,12 @@ func (cs ClientState) Status(ctx sdk.Context, clientStore storetypes.KVStore, cd
 	if cs.FrozenHeight != nil && !cs.FrozenHeight.IsZero() {
 		return exported.Frozen
 	}
+	// A shut-down HostState never returns to Active. Proofs below the shutdown
+	// height stay verifiable until the grace period ends, so that packets can
+	// still time out and be refunded; the client is frozen afterwards.
+	if cs.isHostStateShutdown() && cs.hostStateGracePeriodEnded(ctx, clientStore, cdc) {
+		return exported.Frozen
+	}
 	if cs.LatestHeight == nil {
 		return exported.Expired
 	}
@@ -95,15 +101,27 @@ func (cs ClientState) Validate() error {
 	if len(cs.HostStateNftTokenName) == 0 {
 		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "host_state_nft_token_name must not be empty")
 	}
-	if cs.SystemStartUnixNs == 0 {
-		return errorsmod.Wrapf(ErrInvalidTimestamp, "system_start_unix_ns must be greater than zero")
-	}
-	if cs.SlotLengthNs == 0 {
-		return errorsmod.Wrapf(ErrInvalidTimestamp, "slot_length_ns must be greater than zero")
+	if _, err := cs.eraHistory(); err != nil {
+		return err
 	}
 	if err := cs.validateCheckpointFields(); err != nil {
 		return err
 	}
+	if cs.AcceptancePolicy != nil {
+		if err := cs.AcceptancePolicy.Validate(); err != nil {
+			return err
+		}
+	}
+	if cs.EpochNonceEvolution != nil {
+		if err := cs.EpochNonceEvolution.Validate(); err != nil {
+			return err
+		}
+	}
+	if cs.MithrilStakeDistributionTrust != nil {
+		if err := cs.MithrilStakeDistributionTrust.Validate(); err != nil {
+			return err
+		}
+	}
 
 	contexts, err := cs.normalizedEpochContexts()
 	if err != nil {
@@ -127,20 +145,26 @@ func (cs ClientState) ZeroCustomFields() exported.ClientState {
 		HostStateNftTokenName: append([]byte(nil), cs.HostStateNftTokenName...),
 		SystemStartUnixNs:     cs.SystemStartUnixNs,
 		SlotLengthNs:          cs.SlotLengthNs,
+		EpochNonceEvolution:   epochNonceEvolutionParameters(cs.EpochNonceEvolution),
+		EraHistory:            cloneEraHistory(cs.EraHistory),
 	}
 }
 
-func (cs ClientState) DeriveTimestampFromSlot(slot uint64) (uint64, error) {
-	if cs.SystemStartUnixNs == 0 {
-		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "system_start_unix_ns must be greater than zero")
-	}
-	if cs.SlotLengthNs == 0 {
-		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "slot_length_ns must be greater than zero")
+// epochNonceEvolutionParameters keeps only the chain parameters of the nonce
+// evolution state; the nonces follow the blocks a client has verified.
+func epochNonceEvolutionParameters(evolution *EpochNonceEvolution) *EpochNonceEvolution {
+	if evolution == nil {
+		return nil
 	}
-	if slot > (math.MaxUint64-cs.SystemStartUnixNs)/cs.SlotLengthNs {
-		return 0, errorsmod.Wrapf(ErrInvalidTimestamp, "slot-derived timestamp overflows uint64 for slot %d", slot)
+	return &EpochNonceEvolution{RandomnessStabilityWindowSlots: evolution.RandomnessStabilityWindowSlots}
+}
+
+func (cs ClientState) DeriveTimestampFromSlot(slot uint64) (uint64, error) {
+	eras, err := cs.eraHistory()
+	if err != nil {
+		return 0, err
 	}
-	return cs.SystemStartUnixNs + slot*cs.SlotLengthNs, nil
+	return eraSlotTime(eraForSlot(eras, slot), slot)
 }
 
 func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, consState exported.ConsensusState) error {
@@ -151,12 +175,21 @@ func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientS
 	if err := cs.initializeCheckpoint(consensusState); err != nil {
 		return err
 	}
+	// Stake distributions supplied at creation are stored as commitments.
+	contexts, err := cs.normalizedEpochContexts()
+	if err != nil {
+		return err
+	}
+	if err := syncCurrentEpochFields(&cs, contexts, cs.CurrentEpoch); err != nil {
+		return err
+	}
 	setClientState(clientStore, cdc, &cs)
 	setConsensusState(clientStore, cdc, consensusState, cs.LatestHeight)
 	setConsensusMetadata(ctx, clientStore, cs.LatestHeight)
 	clientStore.Set(ProbabilisticScoreKey(cs.LatestHeight.RevisionHeight), sdk.Uint64ToBigEndian(consensusState.SecurityScoreBps))
 	clientStore.Set(UniquePoolsKey(cs.LatestHeight.RevisionHeight), sdk.Uint64ToBigEndian(consensusState.UniquePoolsCount))
 	clientStore.Set(UniqueStakeKey(cs.LatestHeight.RevisionHeight), sdk.Uint64ToBigEndian(consensusState.UniqueStakeBps))
+	clientStore.Set(ChainDensityKey(cs.LatestHeight.RevisionHeight), sdk.Uint64ToBigEndian(consensusState.ChainDensityBps))
 	clientStore.Set(AcceptedBlockHashKey(cs.LatestHeight.RevisionHeight), []byte(consensusState.AcceptedBlockHash))
 	return nil
 }
@@ -190,6 +223,9 @@ func (cs ClientState) VerifyMembership(
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
 	}
+	if err := cs.verifyHostStateNotShutdown(height, consState); err != nil {
+		return err
+	}
 	key, err := ibcStateKeyFromPath(path)
 	if err != nil {
 		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
@@ -217,6 +253,9 @@ func (cs ClientState) VerifyNonMembership(
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
 	}
+	if err := cs.verifyHostStateNotShutdown(height, consState); err != nil {
+		return err
+	}
 	key, err := ibcStateKeyFromPath(path)
 	if err != nil {
 		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
@@ -227,6 +266,57 @@ func (cs ClientState) VerifyNonMembership(
 	return nil
 }
 
+// VerifyBatchMembership verifies that every path holds the value at the same
+// index under the root of the consensus state at height, with a single
+// multi-proof. The delay period and shutdown checks match VerifyMembership.
+func (cs ClientState) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(clienttypes.ErrFailedMembershipVerification, "got %d paths and %d values", len(paths), len(values))
+	}
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+	items := make([]probabilisticcore.IbcStateMembershipItem, 0, len(paths))
+	for i, path := range paths {
+		key, err := ibcStateKeyFromPath(path)
+		if err != nil {
+			return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
+		}
+		items = append(items, probabilisticcore.IbcStateMembershipItem{Key: key, Value: values[i]})
+	}
+	return cs.verifyBatchMembershipAtHeight(clientStore, cdc, height, items, proof)
+}
+
+func (cs ClientState) verifyBatchMembershipAtHeight(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	items []probabilisticcore.IbcStateMembershipItem,
+	proof []byte,
+) error {
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
+	}
+	if err := cs.verifyHostStateNotShutdown(height, consState); err != nil {
+		return err
+	}
+	if err := VerifyIbcStateBatchMembership(consState.IbcStateRoot, items, proof); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrFailedMembershipVerification, err.Error())
+	}
+	return nil
+}
+
 func ibcStateKeyFromPath(path exported.Path) ([]byte, error) {
 	mpath, ok := path.(commitmenttypes.MerklePath)
 	if !ok {
@@ -34,5 +34,15 @@ func (cs ConsensusState) ValidateBasic() error {
 	if cs.AcceptedBlockHash == "" {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "accepted_block_hash must be set")
 	}
+	if cs.HostStateLineage != nil {
+		if err := cs.HostStateLineage.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+		}
+	}
+	if cs.HostStateShutdown != nil {
+		if err := cs.HostStateShutdown.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+		}
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
@@ -22,20 +23,12 @@ func cloneEpochContext(ctx *EpochContext) *EpochContext {
 		SlotsPerKesPeriod:     ctx.SlotsPerKesPeriod,
 		EpochStartSlot:        ctx.EpochStartSlot,
 		EpochEndSlotExclusive: ctx.EpochEndSlotExclusive,
+		StakeDistributionRoot: bytes.Clone(ctx.StakeDistributionRoot),
+		TotalStake:            ctx.TotalStake,
+		PoolCount:             ctx.PoolCount,
 	}
 	if len(ctx.StakeDistribution) > 0 {
-		cloned.StakeDistribution = make([]*StakeDistributionEntry, 0, len(ctx.StakeDistribution))
-		for _, entry := range ctx.StakeDistribution {
-			if entry == nil {
-				continue
-			}
-			cloned.StakeDistribution = append(cloned.StakeDistribution, &StakeDistributionEntry{
-				PoolId:                entry.PoolId,
-				Stake:                 entry.Stake,
-				VrfKeyHash:            bytes.Clone(entry.VrfKeyHash),
-				FirstRegistrationSlot: entry.FirstRegistrationSlot,
-			})
-		}
+		cloned.StakeDistribution = cloneStakeDistributionEntries(ctx.StakeDistribution)
 	}
 	return cloned
 }
@@ -67,47 +60,121 @@ func cloneStakeDistributionEntries(entries []*StakeDistributionEntry) []*StakeDi
 	return cloned
 }
 
+func cloneMithrilStakeDistributionTrust(trust *MithrilStakeDistributionTrust) *MithrilStakeDistributionTrust {
+	if trust == nil {
+		return nil
+	}
+	cloned := *trust
+	return &cloned
+}
+
 func validateEpochContext(ctx *EpochContext) error {
+	_, err := compactEpochContext(ctx)
+	return err
+}
+
+// compactEpochContext validates ctx and returns a copy that keeps only the
+// commitment to its stake distribution, which is what the client stores. A
+// context may carry the full stake distribution, only its commitment, or
+// both, in which case they must agree.
+func compactEpochContext(ctx *EpochContext) (*EpochContext, error) {
 	if ctx == nil {
-		return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch context must not be nil")
-	}
-	if len(ctx.StakeDistribution) == 0 {
-		return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution must not be empty", ctx.Epoch)
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch context must not be nil")
 	}
 	if len(ctx.EpochNonce) != 32 {
-		return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d nonce must be 32 bytes", ctx.Epoch)
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d nonce must be 32 bytes", ctx.Epoch)
 	}
 	if ctx.SlotsPerKesPeriod == 0 {
-		return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d slots per KES period must be greater than zero", ctx.Epoch)
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d slots per KES period must be greater than zero", ctx.Epoch)
 	}
 	if ctx.EpochEndSlotExclusive <= ctx.EpochStartSlot {
-		return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d slot bounds must be increasing", ctx.Epoch)
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d slot bounds must be increasing", ctx.Epoch)
+	}
+
+	compacted := cloneEpochContext(ctx)
+	compacted.StakeDistribution = nil
+	if len(ctx.StakeDistribution) == 0 {
+		if len(ctx.StakeDistributionRoot) != probabilisticcore.StakeDistributionHashLength {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidCurrentEpoch,
+				"epoch %d must carry a stake distribution or a %d-byte stake distribution root",
+				ctx.Epoch,
+				probabilisticcore.StakeDistributionHashLength,
+			)
+		}
+		if ctx.PoolCount == 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution must not be empty", ctx.Epoch)
+		}
+		if ctx.TotalStake == 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution must have positive total stake", ctx.Epoch)
+		}
+		return compacted, nil
 	}
 
-	totalStake := uint64(0)
 	seenPools := make(map[string]struct{}, len(ctx.StakeDistribution))
 	for _, entry := range ctx.StakeDistribution {
 		if entry == nil {
-			return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution entry must not be nil", ctx.Epoch)
+			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution entry must not be nil", ctx.Epoch)
 		}
 		if strings.TrimSpace(entry.PoolId) == "" {
-			return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution pool id must not be empty", ctx.Epoch)
+			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution pool id must not be empty", ctx.Epoch)
 		}
 		if len(entry.VrfKeyHash) != 32 {
-			return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d vrf_key_hash for pool %s must be 32 bytes", ctx.Epoch, entry.PoolId)
+			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d vrf_key_hash for pool %s must be 32 bytes", ctx.Epoch, entry.PoolId)
 		}
 		poolKey := strings.ToLower(entry.PoolId)
 		if _, exists := seenPools[poolKey]; exists {
-			return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "duplicate epoch %d stake distribution pool id %s", ctx.Epoch, entry.PoolId)
+			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "duplicate epoch %d stake distribution pool id %s", ctx.Epoch, entry.PoolId)
 		}
 		seenPools[poolKey] = struct{}{}
-		totalStake += entry.Stake
+	}
+
+	root, totalStake, poolCount, err := stakeDistributionCommitment(ctx.StakeDistribution)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution: %v", ctx.Epoch, err)
 	}
 	if totalStake == 0 {
-		return errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution must have positive total stake", ctx.Epoch)
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution must have positive total stake", ctx.Epoch)
+	}
+	if len(ctx.StakeDistributionRoot) != 0 && !bytes.Equal(ctx.StakeDistributionRoot, root) {
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution does not match its root", ctx.Epoch)
+	}
+	if ctx.TotalStake != 0 && ctx.TotalStake != totalStake {
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d total stake %d does not match stake distribution total %d", ctx.Epoch, ctx.TotalStake, totalStake)
+	}
+	if ctx.PoolCount != 0 && ctx.PoolCount != poolCount {
+		return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d pool count %d does not match stake distribution size %d", ctx.Epoch, ctx.PoolCount, poolCount)
 	}
 
-	return nil
+	compacted.StakeDistributionRoot = root
+	compacted.TotalStake = totalStake
+	compacted.PoolCount = poolCount
+	return compacted, nil
+}
+
+// stakeDistributionCommitment returns the Merkle root, total stake and pool
+// count of a stake distribution, with leaves ordered by lower-cased pool id.
+func stakeDistributionCommitment(entries []*StakeDistributionEntry) ([]byte, uint64, uint64, error) {
+	sorted := slices.Clone(entries)
+	slices.SortFunc(sorted, func(a, b *StakeDistributionEntry) int {
+		return strings.Compare(strings.ToLower(a.PoolId), strings.ToLower(b.PoolId))
+	})
+
+	totalStake := uint64(0)
+	leafHashes := make([][]byte, 0, len(sorted))
+	for _, entry := range sorted {
+		leafHashes = append(leafHashes, stakeDistributionLeafHash(entry))
+		totalStake += entry.Stake
+	}
+	root, err := probabilisticcore.StakeDistributionRoot(leafHashes)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return root, totalStake, uint64(len(sorted)), nil
+}
+
+func stakeDistributionLeafHash(entry *StakeDistributionEntry) []byte {
+	return probabilisticcore.StakeDistributionLeafHash(entry.PoolId, entry.Stake, entry.VrfKeyHash, entry.FirstRegistrationSlot)
 }
 
 func normalizeEpochContexts(contexts []*EpochContext) ([]*EpochContext, error) {
@@ -116,13 +183,14 @@ func normalizeEpochContexts(contexts []*EpochContext) ([]*EpochContext, error) {
 		if ctx == nil {
 			continue
 		}
-		if err := validateEpochContext(ctx); err != nil {
+		compacted, err := compactEpochContext(ctx)
+		if err != nil {
 			return nil, err
 		}
-		if existing := contextByEpoch[ctx.Epoch]; existing != nil && !epochContextsEqual(existing, ctx) {
+		if existing := contextByEpoch[ctx.Epoch]; existing != nil && !epochContextsEqual(existing, compacted) {
 			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "conflicting epoch context for epoch %d", ctx.Epoch)
 		}
-		contextByEpoch[ctx.Epoch] = cloneEpochContext(ctx)
+		contextByEpoch[ctx.Epoch] = compacted
 	}
 
 	epochs := make([]uint64, 0, len(contextByEpoch))
@@ -150,7 +218,8 @@ func mergeEpochContexts(base []*EpochContext, candidate *EpochContext) ([]*Epoch
 	if candidate == nil {
 		return contexts, nil
 	}
-	if err := validateEpochContext(candidate); err != nil {
+	compacted, err := compactEpochContext(candidate)
+	if err != nil {
 		return nil, err
 	}
 
@@ -159,15 +228,47 @@ func mergeEpochContexts(base []*EpochContext, candidate *EpochContext) ([]*Epoch
 	// disagrees with the one already stored for the epoch.
 	for i, ctx := range contexts {
 		if ctx != nil && ctx.Epoch == candidate.Epoch {
-			contexts[i] = cloneEpochContext(candidate)
+			contexts[i] = compacted
 			return contexts, nil
 		}
 	}
 
-	contexts = append(contexts, cloneEpochContext(candidate))
+	contexts = append(contexts, compacted)
 	return normalizeEpochContexts(contexts)
 }
 
+// headerEpochContexts returns the epoch contexts carried by a header in the
+// order its bridge steps enter them.
+func headerEpochContexts(header *ProbabilisticHeader) []*EpochContext {
+	if header == nil {
+		return nil
+	}
+	contexts := make([]*EpochContext, 0, len(header.EpochBridgeSegments)+1)
+	for _, segment := range header.EpochBridgeSegments {
+		if segment != nil && segment.EpochContext != nil {
+			contexts = append(contexts, segment.EpochContext)
+		}
+	}
+	if header.NewEpochContext != nil {
+		contexts = append(contexts, header.NewEpochContext)
+	}
+	return contexts
+}
+
+func mergeHeaderEpochContexts(base []*EpochContext, header *ProbabilisticHeader) ([]*EpochContext, error) {
+	contexts, err := mergeEpochContexts(base, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range headerEpochContexts(header) {
+		contexts, err = mergeEpochContexts(contexts, candidate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return contexts, nil
+}
+
 func epochContextByEpoch(contexts []*EpochContext, epoch uint64) *EpochContext {
 	for _, ctx := range contexts {
 		if ctx != nil && ctx.Epoch == epoch {
@@ -187,39 +288,25 @@ func epochContextForSlot(contexts []*EpochContext, slot uint64) *EpochContext {
 	return match
 }
 
+// epochContextsEqual compares epoch contexts by their stake distribution
+// commitments, so a header's full context equals its stored compact form.
 func epochContextsEqual(left, right *EpochContext) bool {
 	if left == nil || right == nil {
 		return left == right
 	}
-	if left.Epoch != right.Epoch ||
-		left.SlotsPerKesPeriod != right.SlotsPerKesPeriod ||
-		left.EpochStartSlot != right.EpochStartSlot ||
-		left.EpochEndSlotExclusive != right.EpochEndSlotExclusive ||
-		!bytes.Equal(left.EpochNonce, right.EpochNonce) ||
-		len(left.StakeDistribution) != len(right.StakeDistribution) {
+	left, leftErr := compactEpochContext(left)
+	right, rightErr := compactEpochContext(right)
+	if leftErr != nil || rightErr != nil {
 		return false
 	}
-
-	rightByPool := make(map[string]*StakeDistributionEntry, len(right.StakeDistribution))
-	for _, entry := range right.StakeDistribution {
-		if entry == nil {
-			return false
-		}
-		rightByPool[strings.ToLower(entry.PoolId)] = entry
-	}
-
-	for _, leftEntry := range left.StakeDistribution {
-		if leftEntry == nil {
-			return false
-		}
-		rightEntry := rightByPool[strings.ToLower(leftEntry.PoolId)]
-		if rightEntry == nil ||
-			leftEntry.Stake != rightEntry.Stake ||
-			!bytes.Equal(leftEntry.VrfKeyHash, rightEntry.VrfKeyHash) {
-			return false
-		}
-	}
-	return true
+	return left.Epoch == right.Epoch &&
+		left.SlotsPerKesPeriod == right.SlotsPerKesPeriod &&
+		left.EpochStartSlot == right.EpochStartSlot &&
+		left.EpochEndSlotExclusive == right.EpochEndSlotExclusive &&
+		bytes.Equal(left.EpochNonce, right.EpochNonce) &&
+		bytes.Equal(left.StakeDistributionRoot, right.StakeDistributionRoot) &&
+		left.TotalStake == right.TotalStake &&
+		left.PoolCount == right.PoolCount
 }
 
 func syncCurrentEpochFields(cs *ClientState, contexts []*EpochContext, currentEpoch uint64) error {
@@ -230,7 +317,9 @@ func syncCurrentEpochFields(cs *ClientState, contexts []*EpochContext, currentEp
 
 	cs.CurrentEpoch = currentEpoch
 	cs.EpochContexts = cloneEpochContexts(contexts)
-	cs.EpochStakeDistribution = cloneStakeDistributionEntries(currentCtx.StakeDistribution)
+	// Stored epoch contexts only commit to their stake distribution, so the
+	// legacy inline list is cleared rather than mirrored.
+	cs.EpochStakeDistribution = nil
 	cs.EpochNonce = bytes.Clone(currentCtx.EpochNonce)
 	cs.SlotsPerKesPeriod = currentCtx.SlotsPerKesPeriod
 	cs.CurrentEpochStartSlot = currentCtx.EpochStartSlot
@@ -0,0 +1,120 @@
+package probabilistic
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+)
+
+func (e EpochNonceEvolution) Validate() error {
+	if e.RandomnessStabilityWindowSlots == 0 {
+		return errorsmod.Wrap(ErrInvalidEpochNonce, "randomness_stability_window_slots must be greater than zero")
+	}
+	for _, nonce := range []struct {
+		name  string
+		value []byte
+	}{
+		{"evolving_nonce", e.EvolvingNonce},
+		{"candidate_nonce", e.CandidateNonce},
+		{"lab_nonce", e.LabNonce},
+		{"last_epoch_block_nonce", e.LastEpochBlockNonce},
+	} {
+		if len(nonce.value) != 0 && len(nonce.value) != probabilisticcore.NonceLength {
+			return errorsmod.Wrapf(
+				ErrInvalidEpochNonce,
+				"%s must be empty or %d bytes, got %d",
+				nonce.name,
+				probabilisticcore.NonceLength,
+				len(nonce.value),
+			)
+		}
+	}
+	return nil
+}
+
+func cloneEpochNonceEvolution(evolution *EpochNonceEvolution) *EpochNonceEvolution {
+	if evolution == nil {
+		return nil
+	}
+	return &EpochNonceEvolution{
+		RandomnessStabilityWindowSlots: evolution.RandomnessStabilityWindowSlots,
+		EvolvingNonce:                  bytes.Clone(evolution.EvolvingNonce),
+		CandidateNonce:                 bytes.Clone(evolution.CandidateNonce),
+		LabNonce:                       bytes.Clone(evolution.LabNonce),
+		LastEpochBlockNonce:            bytes.Clone(evolution.LastEpochBlockNonce),
+	}
+}
+
+// evolveEpochNonce replays the Praos nonce evolution over the blocks that move
+// the checkpoint cursor forward, in chain order, starting from the trusted
+// epoch. Descendant blocks are not replayed: they are not yet behind the
+// cursor and reappear as bridge blocks of a later update. Entering an epoch
+// whose context carries a nonce other than the derived one rejects the header.
+// It returns nil when the client trusts epoch nonces from new_epoch_context.
+func (cs *ClientState) evolveEpochNonce(
+	trustedEpoch uint64,
+	header *authenticatedProbabilisticHeader,
+	epochContexts []*EpochContext,
+) (*EpochNonceEvolution, error) {
+	if cs.EpochNonceEvolution == nil {
+		return nil, nil
+	}
+	if header == nil || header.anchorBlock == nil {
+		return nil, errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated anchor block missing")
+	}
+
+	blocks := make([]*authenticatedProbabilisticBlock, 0, len(header.bridgeBlocks)+1)
+	for _, segment := range header.epochSegments {
+		if segment != nil {
+			blocks = append(blocks, segment.bridgeBlocks...)
+		}
+	}
+	blocks = append(blocks, header.bridgeBlocks...)
+	blocks = append(blocks, header.anchorBlock)
+
+	state := cloneEpochNonceEvolution(cs.EpochNonceEvolution)
+	epoch := trustedEpoch
+	for _, block := range blocks {
+		if block == nil {
+			return nil, errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated block missing")
+		}
+		epochContext := epochContextByEpoch(epochContexts, block.epoch)
+		if epochContext == nil {
+			return nil, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "missing epoch context for epoch %d", block.epoch)
+		}
+
+		if block.epoch != epoch {
+			derivedNonce := probabilisticcore.CombineNonces(state.CandidateNonce, state.LastEpochBlockNonce)
+			if !bytes.Equal(epochContext.EpochNonce, derivedNonce) {
+				return nil, errorsmod.Wrapf(
+					ErrInvalidEpochNonce,
+					"epoch %d nonce %x does not match nonce %x derived from authenticated epoch %d blocks",
+					block.epoch,
+					epochContext.EpochNonce,
+					derivedNonce,
+					epoch,
+				)
+			}
+			state.LastEpochBlockNonce = state.LabNonce
+			epoch = block.epoch
+		}
+
+		state.EvolvingNonce = probabilisticcore.CombineNonces(
+			state.EvolvingNonce,
+			probabilisticcore.VrfNonceValue(block.vrfOutput),
+		)
+		// The candidate nonce stops following the evolving nonce once the
+		// block is within the stability window of the end of its epoch.
+		if block.slot < epochContext.EpochEndSlotExclusive &&
+			epochContext.EpochEndSlotExclusive-block.slot > state.RandomnessStabilityWindowSlots {
+			state.CandidateNonce = bytes.Clone(state.EvolvingNonce)
+		}
+		labNonce, err := probabilisticcore.PrevHashNonce(block.prevHash)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidAcceptedBlock, "block %s: %v", block.hash, err)
+		}
+		state.LabNonce = labNonce
+	}
+	return state, nil
+}
@@ -8,26 +8,32 @@ import (
 )
 
 const (
-	EventTypeProbabilisticHeaderAccepted = "probabilistic_header_accepted"
-	EventTypeProbabilisticHeaderRejected = "probabilistic_header_rejected"
-	EventTypeProbabilisticClientFrozen   = "probabilistic_client_frozen"
+	EventTypeProbabilisticHeaderAccepted    = "probabilistic_header_accepted"
+	EventTypeProbabilisticHeaderRejected    = "probabilistic_header_rejected"
+	EventTypeProbabilisticClientFrozen      = "probabilistic_client_frozen"
+	EventTypeProbabilisticHostStateShutdown = "probabilistic_host_state_shutdown"
 
-	AttributeKeyClientID                = "client_id"
-	AttributeKeyReason                  = "reason"
-	AttributeKeyTrustedHeight           = "trusted_height"
-	AttributeKeyAcceptedHeight          = "accepted_height"
-	AttributeKeyAcceptedBlockHash       = "accepted_block_hash"
-	AttributeKeyAcceptedEpoch           = "accepted_epoch"
-	AttributeKeyPreviousEpoch           = "previous_epoch"
-	AttributeKeyRollover                = "rollover"
-	AttributeKeyDescendantDepth         = "descendant_depth"
-	AttributeKeyUniquePoolsCount        = "unique_pools_count"
-	AttributeKeyUniqueStakeBps          = "unique_stake_bps"
-	AttributeKeySecurityScoreBps        = "security_score_bps"
-	AttributeKeyThresholdDepth          = "threshold_depth"
-	AttributeKeyThresholdUniquePools    = "threshold_unique_pools"
-	AttributeKeyThresholdUniqueStakeBps = "threshold_unique_stake_bps"
-	AttributeKeyFrozenHeight            = "frozen_height"
+	AttributeKeyClientID                 = "client_id"
+	AttributeKeyReason                   = "reason"
+	AttributeKeyTrustedHeight            = "trusted_height"
+	AttributeKeyAcceptedHeight           = "accepted_height"
+	AttributeKeyAcceptedBlockHash        = "accepted_block_hash"
+	AttributeKeyAcceptedEpoch            = "accepted_epoch"
+	AttributeKeyPreviousEpoch            = "previous_epoch"
+	AttributeKeyRollover                 = "rollover"
+	AttributeKeyDescendantDepth          = "descendant_depth"
+	AttributeKeyUniquePoolsCount         = "unique_pools_count"
+	AttributeKeyUniqueStakeBps           = "unique_stake_bps"
+	AttributeKeySecurityScoreBps         = "security_score_bps"
+	AttributeKeyChainDensityBps          = "chain_density_bps"
+	AttributeKeyThresholdDepth           = "threshold_depth"
+	AttributeKeyThresholdUniquePools     = "threshold_unique_pools"
+	AttributeKeyThresholdUniqueStakeBps  = "threshold_unique_stake_bps"
+	AttributeKeyThresholdChainDensityBps = "threshold_chain_density_bps"
+	AttributeKeyFrozenHeight             = "frozen_height"
+	AttributeKeyShutdownHeight           = "shutdown_height"
+	AttributeKeyShutdownInitiatedAtMs    = "shutdown_initiated_at_ms"
+	AttributeKeyShutdownGracePeriodEndMs = "shutdown_grace_period_end_ms"
 )
 
 func emitProbabilisticHeaderAcceptedEvent(
@@ -42,6 +48,7 @@ func emitProbabilisticHeaderAcceptedEvent(
 		return
 	}
 
+	policy := clientState.effectiveAcceptancePolicy()
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeProbabilisticHeaderAccepted,
@@ -56,9 +63,11 @@ func emitProbabilisticHeaderAcceptedEvent(
 			sdk.NewAttribute(AttributeKeyUniquePoolsCount, strconv.FormatUint(consensusState.UniquePoolsCount, 10)),
 			sdk.NewAttribute(AttributeKeyUniqueStakeBps, strconv.FormatUint(consensusState.UniqueStakeBps, 10)),
 			sdk.NewAttribute(AttributeKeySecurityScoreBps, strconv.FormatUint(consensusState.SecurityScoreBps, 10)),
-			sdk.NewAttribute(AttributeKeyThresholdDepth, strconv.FormatUint(DefaultThresholdDepth, 10)),
-			sdk.NewAttribute(AttributeKeyThresholdUniquePools, strconv.FormatUint(DefaultThresholdUniquePools, 10)),
-			sdk.NewAttribute(AttributeKeyThresholdUniqueStakeBps, strconv.FormatUint(DefaultThresholdUniqueStakeBps, 10)),
+			sdk.NewAttribute(AttributeKeyChainDensityBps, strconv.FormatUint(consensusState.ChainDensityBps, 10)),
+			sdk.NewAttribute(AttributeKeyThresholdDepth, strconv.FormatUint(policy.ThresholdDepth, 10)),
+			sdk.NewAttribute(AttributeKeyThresholdUniquePools, strconv.FormatUint(policy.ThresholdUniquePools, 10)),
+			sdk.NewAttribute(AttributeKeyThresholdUniqueStakeBps, strconv.FormatUint(policy.ThresholdUniqueStakeBps, 10)),
+			sdk.NewAttribute(AttributeKeyThresholdChainDensityBps, strconv.FormatUint(policy.ThresholdChainDensityBps, 10)),
 		),
 	)
 }
@@ -95,6 +104,23 @@ func emitProbabilisticClientFrozenEvent(ctx sdk.Context, clientID string, frozen
 	)
 }
 
+func emitProbabilisticHostStateShutdownEvent(
+	ctx sdk.Context,
+	clientID string,
+	shutdownHeight exported.Height,
+	shutdown *HostStateShutdown,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeProbabilisticHostStateShutdown,
+			sdk.NewAttribute(AttributeKeyClientID, clientID),
+			sdk.NewAttribute(AttributeKeyShutdownHeight, heightString(shutdownHeight)),
+			sdk.NewAttribute(AttributeKeyShutdownInitiatedAtMs, strconv.FormatUint(shutdown.InitiatedAtMs, 10)),
+			sdk.NewAttribute(AttributeKeyShutdownGracePeriodEndMs, strconv.FormatUint(shutdown.GracePeriodEndMs, 10)),
+		),
+	)
+}
+
 func heightString(height exported.Height) string {
 	if height == nil {
 		return ""
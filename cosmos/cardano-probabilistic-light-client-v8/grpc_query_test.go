@@ -0,0 +1,165 @@
+package probabilistic
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+)
+
+func TestQuerySecurityMetrics(t *testing.T) {
+	ctx, queryServer, clientStore := newTestQueryServer(t, "probabilistic-query-metrics")
+	clientStore.Set(ProbabilisticScoreKey(12), sdk.Uint64ToBigEndian(8_000))
+	clientStore.Set(UniquePoolsKey(12), sdk.Uint64ToBigEndian(6))
+	clientStore.Set(UniqueStakeKey(12), sdk.Uint64ToBigEndian(700))
+	clientStore.Set(AcceptedBlockHashKey(12), []byte("anchor-12"))
+	clientStore.Set(ChainDensityKey(12), sdk.Uint64ToBigEndian(6_500))
+
+	res, err := queryServer.SecurityMetrics(ctx, &QuerySecurityMetricsRequest{ClientId: "08-cardano-probabilistic-0", RevisionHeight: 12})
+	require.NoError(t, err)
+	require.Equal(t, &QuerySecurityMetricsResponse{
+		SecurityScoreBps:  8_000,
+		UniquePoolsCount:  6,
+		UniqueStakeBps:    700,
+		AcceptedBlockHash: "anchor-12",
+		ChainDensityBps:   6_500,
+	}, res)
+
+	_, err = queryServer.SecurityMetrics(ctx, &QuerySecurityMetricsRequest{ClientId: "08-cardano-probabilistic-0", RevisionHeight: 13})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQueryEpochContextsAndCheckpointCursor(t *testing.T) {
+	ctx, queryServer, _ := newTestQueryServer(t, "probabilistic-query-epochs")
+
+	epochs, err := queryServer.EpochContexts(ctx, &QueryEpochContextsRequest{ClientId: "08-cardano-probabilistic-0"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), epochs.CurrentEpoch)
+	require.Len(t, epochs.EpochContexts, 1)
+	require.Equal(t, uint64(7), epochs.EpochContexts[0].EpochContext.Epoch)
+	require.Equal(t, uint64(10_000), epochs.EpochContexts[0].TotalStake)
+	require.Equal(t, uint64(1), epochs.EpochContexts[0].PoolCount)
+
+	cursor, err := queryServer.CheckpointCursor(ctx, &QueryCheckpointCursorRequest{ClientId: "08-cardano-probabilistic-0"})
+	require.NoError(t, err)
+	require.Equal(t, NewHeight(0, 10), cursor.LatestCheckpointHeight)
+	require.Equal(t, "trusted-10", cursor.LatestCheckpointBlockHash)
+	require.Equal(t, uint64(7), cursor.LatestCheckpointEpoch)
+	require.Equal(t, NewHeight(0, 10), cursor.LatestHeight)
+
+	_, err = queryServer.CheckpointCursor(ctx, &QueryCheckpointCursorRequest{ClientId: ""})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryVerifyHeaderReportsRejection(t *testing.T) {
+	ctx, queryServer, clientStore := newTestQueryServer(t, "probabilistic-query-verify")
+	header := newVerifiedTestHeader(t)
+	header.TrustedHeight = NewHeight(0, 9)
+
+	res, err := queryServer.VerifyHeader(ctx, &QueryVerifyHeaderRequest{ClientId: "08-cardano-probabilistic-0", Header: header})
+	require.NoError(t, err)
+	require.False(t, res.Accepted)
+	require.Contains(t, res.RejectionReason, "trusted consensus state not found")
+
+	stored, found := getClientState(clientStore, newProbabilisticTestCodec())
+	require.True(t, found)
+	require.Equal(t, NewHeight(0, 10), stored.LatestHeight)
+
+	_, err = queryServer.VerifyHeader(ctx, &QueryVerifyHeaderRequest{ClientId: "08-cardano-probabilistic-0"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryVerifyMembershipBatch(t *testing.T) {
+	ctx, queryServer, clientStore := newTestQueryServer(t, "probabilistic-query-batch-membership")
+	cdc := newProbabilisticTestCodec()
+
+	items := []*IbcStateMembershipItem{
+		{Key: []byte("connections/connection-0"), Value: []byte("connection")},
+		{Key: []byte("channelEnds/ports/transfer/channels/channel-0"), Value: []byte("channel")},
+	}
+	root, proof := newTestIbcStateMultiProof(t, []probabilisticcore.IbcStateMembershipItem{
+		{Key: items[0].Key, Value: items[0].Value},
+		{Key: items[1].Key, Value: items[1].Value},
+	})
+	consensusState, found := GetConsensusState(clientStore, cdc, NewHeight(0, 10))
+	require.True(t, found)
+	consensusState.IbcStateRoot = root
+	setConsensusState(clientStore, cdc, consensusState, NewHeight(0, 10))
+
+	res, err := queryServer.VerifyMembershipBatch(ctx, &QueryVerifyMembershipBatchRequest{
+		ClientId:       "08-cardano-probabilistic-0",
+		RevisionHeight: 10,
+		Items:          items,
+		Proof:          proof,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &QueryVerifyMembershipBatchResponse{Verified: true}, res)
+
+	res, err = queryServer.VerifyMembershipBatch(ctx, &QueryVerifyMembershipBatchRequest{
+		ClientId:       "08-cardano-probabilistic-0",
+		RevisionHeight: 10,
+		Items:          items[:1],
+		Proof:          proof,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+	require.NotEmpty(t, res.RejectionReason)
+
+	res, err = queryServer.VerifyMembershipBatch(ctx, &QueryVerifyMembershipBatchRequest{
+		ClientId:       "08-cardano-probabilistic-0",
+		RevisionHeight: 11,
+		Items:          items,
+		Proof:          proof,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+	require.Contains(t, res.RejectionReason, "consensus state not found")
+
+	res, err = queryServer.VerifyMembershipBatch(ctx, &QueryVerifyMembershipBatchRequest{
+		ClientId:       "08-cardano-probabilistic-0",
+		RevisionNumber: 1,
+		RevisionHeight: 10,
+		Items:          items,
+		Proof:          proof,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+	require.Contains(t, res.RejectionReason, "consensus state not found", "the revision number is part of the height")
+
+	_, err = queryServer.VerifyMembershipBatch(ctx, &QueryVerifyMembershipBatchRequest{ClientId: "08-cardano-probabilistic-0", RevisionHeight: 10})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	clientState, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+	clientState.FrozenHeight = FrozenHeight
+	setClientState(clientStore, cdc, clientState)
+	_, err = queryServer.VerifyMembershipBatch(ctx, &QueryVerifyMembershipBatchRequest{
+		ClientId:       "08-cardano-probabilistic-0",
+		RevisionHeight: 10,
+		Items:          items,
+		Proof:          proof,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "a frozen client verifies no proofs")
+}
+
+type testClientStoreProvider struct {
+	clientStore storetypes.KVStore
+}
+
+func (p testClientStoreProvider) ClientStore(sdk.Context, string) storetypes.KVStore {
+	return p.clientStore
+}
+
+func newTestQueryServer(t *testing.T, keyName string) (sdk.Context, QueryServer, storetypes.KVStore) {
+	t.Helper()
+
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, keyName)
+	require.NoError(t, newProbabilisticTestClientState().Initialize(ctx, cdc, clientStore, newProbabilisticTestConsensusState("trusted-10")))
+	return ctx, NewQueryServer(cdc, testClientStoreProvider{clientStore: clientStore}), clientStore
+}
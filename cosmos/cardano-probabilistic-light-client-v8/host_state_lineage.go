@@ -0,0 +1,167 @@
+package probabilistic
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func hostStateLineageFromOutput(output *probabilisticcore.HostStateOutput) *HostStateLineage {
+	return &HostStateLineage{
+		TxHash:                 strings.ToLower(output.Outpoint.TxHash),
+		OutputIndex:            output.Outpoint.OutputIndex,
+		Version:                output.Datum.State.Version,
+		NextClientSequence:     output.Datum.State.NextClientSequence,
+		NextConnectionSequence: output.Datum.State.NextConnectionSeq,
+		NextChannelSequence:    output.Datum.State.NextChannelSeq,
+	}
+}
+
+func (l *HostStateLineage) outpoint() probabilisticcore.HostStateOutpoint {
+	return probabilisticcore.HostStateOutpoint{TxHash: strings.ToLower(l.TxHash), OutputIndex: l.OutputIndex}
+}
+
+func (l *HostStateLineage) ValidateBasic() error {
+	if len(l.TxHash) != 64 {
+		return errorsmod.Wrapf(ErrInvalidHostStateLineage, "HostState tx hash must be 64 hex characters, got %d", len(l.TxHash))
+	}
+	return nil
+}
+
+// hostStateLineageBaseline returns the lineage of the last root accepted at
+// or before trustedHeight. A checkpoint carries no root, so a header trusting
+// the checkpoint cursor extends the latest consensus state.
+func (cs ClientState) hostStateLineageBaseline(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	trustedHeight *Height,
+) *HostStateLineage {
+	height := trustedHeight
+	if cs.LatestCheckpointHeight != nil &&
+		!cs.LatestCheckpointHeight.IsZero() &&
+		trustedHeight.EQ(cs.LatestCheckpointHeight) {
+		height = cs.LatestHeight
+	}
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil
+	}
+	return consensusState.HostStateLineage
+}
+
+// verifyHostStateLineage returns the lineage of the header's HostState output.
+// It fails when the header's lineage transactions do not link the output back
+// to baseline. It reports a conflict, rather than an error, when the linked
+// output provably forks from baseline: HostState versions increase by exactly
+// one per transaction, so a later output with no higher version, or whose
+// lineage does not start by spending baseline, cannot follow it.
+func (cs ClientState) verifyHostStateLineage(
+	baseline *HostStateLineage,
+	header *ProbabilisticHeader,
+) (*HostStateLineage, bool, error) {
+	anchorOutput, err := cs.ExtractHostStateOutputFromHostStateTx(header)
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(ErrInvalidHostStateCommitment, "invalid host state tx body: %v", err)
+	}
+
+	outputs := make([]*probabilisticcore.HostStateOutput, 0, len(header.HostStateLineageTxBodies)+1)
+	for i, txBody := range header.HostStateLineageTxBodies {
+		output, err := probabilisticcore.FindHostStateOutputInTransactionBody(txBody, cs.HostStateNftPolicyId, cs.HostStateNftTokenName)
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(ErrInvalidHostStateLineage, "lineage tx %d: %v", i, err)
+		}
+		outputs = append(outputs, output)
+	}
+	outputs = append(outputs, anchorOutput)
+	return linkHostStateLineage(baseline, outputs)
+}
+
+// linkHostStateLineage checks outputs, ending with the header's HostState
+// output, against baseline.
+func linkHostStateLineage(
+	baseline *HostStateLineage,
+	outputs []*probabilisticcore.HostStateOutput,
+) (*HostStateLineage, bool, error) {
+	for i := 1; i < len(outputs); i++ {
+		prev, next := outputs[i-1], outputs[i]
+		if !next.SpendsOutpoint(prev.Outpoint) {
+			return nil, false, errorsmod.Wrapf(
+				ErrInvalidHostStateLineage,
+				"HostState tx %s does not spend HostState output %s#%d",
+				next.Outpoint.TxHash,
+				prev.Outpoint.TxHash,
+				prev.Outpoint.OutputIndex,
+			)
+		}
+		if next.Datum.State.Version != prev.Datum.State.Version+1 {
+			return nil, false, errorsmod.Wrapf(
+				ErrInvalidHostStateLineage,
+				"HostState version %d does not follow version %d",
+				next.Datum.State.Version,
+				prev.Datum.State.Version,
+			)
+		}
+	}
+
+	lineage := hostStateLineageFromOutput(outputs[len(outputs)-1])
+	if baseline == nil {
+		return lineage, false, nil
+	}
+	if lineage.outpoint() == baseline.outpoint() {
+		if len(outputs) != 1 {
+			return nil, false, errorsmod.Wrap(ErrInvalidHostStateLineage, "unchanged HostState output must not carry lineage transactions")
+		}
+		return lineage, !hostStateLineagesEqual(lineage, baseline), nil
+	}
+	if lineage.Version <= baseline.Version ||
+		lineage.NextClientSequence < baseline.NextClientSequence ||
+		lineage.NextConnectionSequence < baseline.NextConnectionSequence ||
+		lineage.NextChannelSequence < baseline.NextChannelSequence {
+		return lineage, true, nil
+	}
+
+	first := outputs[0]
+	if first.Datum.State.Version != baseline.Version+1 {
+		return nil, false, errorsmod.Wrapf(
+			ErrInvalidHostStateLineage,
+			"HostState lineage starts at version %d, need %d",
+			first.Datum.State.Version,
+			baseline.Version+1,
+		)
+	}
+	if !first.SpendsOutpoint(baseline.outpoint()) {
+		return lineage, true, nil
+	}
+	return lineage, false, nil
+}
+
+// headerHostStateLineageConflictsWithStored reports whether a root-bearing
+// header's HostState output provably forks from the lineage it is trusted
+// from.
+func (cs ClientState) headerHostStateLineageConflictsWithStored(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	header *ProbabilisticHeader,
+) bool {
+	if header == nil || header.IsCheckpoint || header.TrustedHeight == nil {
+		return false
+	}
+	baseline := cs.hostStateLineageBaseline(clientStore, cdc, header.TrustedHeight)
+	if baseline == nil {
+		return false
+	}
+	_, conflict, err := cs.verifyHostStateLineage(baseline, header)
+	return err == nil && conflict
+}
+
+func hostStateLineagesEqual(a, b *HostStateLineage) bool {
+	return strings.EqualFold(a.TxHash, b.TxHash) &&
+		a.OutputIndex == b.OutputIndex &&
+		a.Version == b.Version &&
+		a.NextClientSequence == b.NextClientSequence &&
+		a.NextConnectionSequence == b.NextConnectionSequence &&
+		a.NextChannelSequence == b.NextChannelSequence
+}
@@ -8,6 +8,7 @@ const (
 	KeyUniquePoolsPrefix        = "uniquePools"
 	KeyUniqueStakePrefix        = "uniqueStake"
 	KeyAcceptedBlockHashPrefix  = "acceptedBlockHash"
+	KeyChainDensityPrefix       = "chainDensity"
 )
 
 func ProbabilisticScoreKey(height uint64) []byte {
@@ -25,3 +26,7 @@ func UniqueStakeKey(height uint64) []byte {
 func AcceptedBlockHashKey(height uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%d", KeyAcceptedBlockHashPrefix, height))
 }
+
+func ChainDensityKey(height uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%d", KeyChainDensityPrefix, height))
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ exported.ClientMessage = (*Misbehaviour)(nil)
+var (
+	_ exported.ClientMessage = (*Misbehaviour)(nil)
+	_ exported.ClientMessage = (*SlotLeaderEquivocation)(nil)
+)
 
 var FrozenHeight = NewHeight(0, 1)
 
@@ -55,3 +58,29 @@ func (misbehaviour Misbehaviour) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewSlotLeaderEquivocation(block1, block2 *ProbabilisticBlock) *SlotLeaderEquivocation {
+	return &SlotLeaderEquivocation{
+		Block1: block1,
+		Block2: block2,
+	}
+}
+
+func (SlotLeaderEquivocation) ClientType() string {
+	return ModuleName
+}
+
+func (equivocation SlotLeaderEquivocation) ValidateBasic() error {
+	for i, block := range []*ProbabilisticBlock{equivocation.Block1, equivocation.Block2} {
+		if block == nil || block.Height == nil {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidMisbehaviour, "equivocation block %d must be present", i+1)
+		}
+		if err := validateBlockWitness(block, "equivocation"); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+		}
+	}
+	if err := validateStakeDistributionProofs(equivocation.StakeDistributionProofs); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+	}
+	return nil
+}
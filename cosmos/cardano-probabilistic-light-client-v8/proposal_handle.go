@@ -63,6 +63,17 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 	if err != nil {
 		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, err.Error())
 	}
+	subjectEras, err := cs.eraHistory()
+	if err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, err.Error())
+	}
+	substituteEras, err := substituteClientState.eraHistory()
+	if err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, err.Error())
+	}
+	if !eraHistoryExtends(subjectEras, substituteEras) {
+		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, "substitute era history does not extend subject era history")
+	}
 	setConsensusState(subjectClientStore, cdc, consensusState, height)
 	setConsensusMetadataWithValues(subjectClientStore, height, processedHeight, processedTime)
 	cs.LatestHeight = substituteClientState.LatestHeight
@@ -77,6 +88,15 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 	}
 	cs.ChainId = substituteClientState.ChainId
 	cs.TrustingPeriod = substituteClientState.TrustingPeriod
+	cs.AcceptancePolicy = cloneAcceptancePolicy(substituteClientState.AcceptancePolicy)
+	cs.MaxPrunedConsensusStatesPerUpdate = substituteClientState.MaxPrunedConsensusStatesPerUpdate
+	cs.EpochNonceEvolution = cloneEpochNonceEvolution(substituteClientState.EpochNonceEvolution)
+	cs.MithrilStakeDistributionTrust = cloneMithrilStakeDistributionTrust(substituteClientState.MithrilStakeDistributionTrust)
+	cs.EraHistory = cloneEraHistory(substituteClientState.EraHistory)
+	cs.HostStateShutdownHeight = nil
+	if substituteClientState.isHostStateShutdown() {
+		cs.recordHostStateShutdown(substituteClientState.HostStateShutdownHeight)
+	}
 	if err := syncCurrentEpochFields(&cs, contexts, substituteClientState.CurrentEpoch); err != nil {
 		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, err.Error())
 	}
@@ -0,0 +1,3505 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/probabilistic/v1/query.proto
+
+package probabilistic
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QuerySecurityMetricsRequest struct {
+	ClientId       string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RevisionHeight uint64 `protobuf:"varint,2,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (m *QuerySecurityMetricsRequest) Reset()         { *m = QuerySecurityMetricsRequest{} }
+func (m *QuerySecurityMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySecurityMetricsRequest) ProtoMessage()    {}
+func (*QuerySecurityMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{0}
+}
+func (m *QuerySecurityMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecurityMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecurityMetricsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySecurityMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecurityMetricsRequest.Merge(m, src)
+}
+func (m *QuerySecurityMetricsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecurityMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecurityMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySecurityMetricsRequest proto.InternalMessageInfo
+
+func (m *QuerySecurityMetricsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QuerySecurityMetricsRequest) GetRevisionHeight() uint64 {
+	if m != nil {
+		return m.RevisionHeight
+	}
+	return 0
+}
+
+type QuerySecurityMetricsResponse struct {
+	SecurityScoreBps  uint64 `protobuf:"varint,1,opt,name=security_score_bps,json=securityScoreBps,proto3" json:"security_score_bps,omitempty"`
+	UniquePoolsCount  uint64 `protobuf:"varint,2,opt,name=unique_pools_count,json=uniquePoolsCount,proto3" json:"unique_pools_count,omitempty"`
+	UniqueStakeBps    uint64 `protobuf:"varint,3,opt,name=unique_stake_bps,json=uniqueStakeBps,proto3" json:"unique_stake_bps,omitempty"`
+	AcceptedBlockHash string `protobuf:"bytes,4,opt,name=accepted_block_hash,json=acceptedBlockHash,proto3" json:"accepted_block_hash,omitempty"`
+	// Zero for heights accepted before chain density was recorded.
+	ChainDensityBps uint64 `protobuf:"varint,5,opt,name=chain_density_bps,json=chainDensityBps,proto3" json:"chain_density_bps,omitempty"`
+}
+
+func (m *QuerySecurityMetricsResponse) Reset()         { *m = QuerySecurityMetricsResponse{} }
+func (m *QuerySecurityMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySecurityMetricsResponse) ProtoMessage()    {}
+func (*QuerySecurityMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{1}
+}
+func (m *QuerySecurityMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecurityMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecurityMetricsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySecurityMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecurityMetricsResponse.Merge(m, src)
+}
+func (m *QuerySecurityMetricsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecurityMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecurityMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySecurityMetricsResponse proto.InternalMessageInfo
+
+func (m *QuerySecurityMetricsResponse) GetSecurityScoreBps() uint64 {
+	if m != nil {
+		return m.SecurityScoreBps
+	}
+	return 0
+}
+
+func (m *QuerySecurityMetricsResponse) GetUniquePoolsCount() uint64 {
+	if m != nil {
+		return m.UniquePoolsCount
+	}
+	return 0
+}
+
+func (m *QuerySecurityMetricsResponse) GetUniqueStakeBps() uint64 {
+	if m != nil {
+		return m.UniqueStakeBps
+	}
+	return 0
+}
+
+func (m *QuerySecurityMetricsResponse) GetAcceptedBlockHash() string {
+	if m != nil {
+		return m.AcceptedBlockHash
+	}
+	return ""
+}
+
+func (m *QuerySecurityMetricsResponse) GetChainDensityBps() uint64 {
+	if m != nil {
+		return m.ChainDensityBps
+	}
+	return 0
+}
+
+type QueryEpochContextsRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryEpochContextsRequest) Reset()         { *m = QueryEpochContextsRequest{} }
+func (m *QueryEpochContextsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochContextsRequest) ProtoMessage()    {}
+func (*QueryEpochContextsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{2}
+}
+func (m *QueryEpochContextsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochContextsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochContextsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochContextsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochContextsRequest.Merge(m, src)
+}
+func (m *QueryEpochContextsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochContextsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochContextsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochContextsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochContextsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type EpochContextSummary struct {
+	EpochContext *EpochContext `protobuf:"bytes,1,opt,name=epoch_context,json=epochContext,proto3" json:"epoch_context,omitempty"`
+	TotalStake   uint64        `protobuf:"varint,2,opt,name=total_stake,json=totalStake,proto3" json:"total_stake,omitempty"`
+	PoolCount    uint64        `protobuf:"varint,3,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+}
+
+func (m *EpochContextSummary) Reset()         { *m = EpochContextSummary{} }
+func (m *EpochContextSummary) String() string { return proto.CompactTextString(m) }
+func (*EpochContextSummary) ProtoMessage()    {}
+func (*EpochContextSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{3}
+}
+func (m *EpochContextSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochContextSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochContextSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochContextSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochContextSummary.Merge(m, src)
+}
+func (m *EpochContextSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochContextSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochContextSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochContextSummary proto.InternalMessageInfo
+
+func (m *EpochContextSummary) GetEpochContext() *EpochContext {
+	if m != nil {
+		return m.EpochContext
+	}
+	return nil
+}
+
+func (m *EpochContextSummary) GetTotalStake() uint64 {
+	if m != nil {
+		return m.TotalStake
+	}
+	return 0
+}
+
+func (m *EpochContextSummary) GetPoolCount() uint64 {
+	if m != nil {
+		return m.PoolCount
+	}
+	return 0
+}
+
+type QueryEpochContextsResponse struct {
+	EpochContexts []*EpochContextSummary `protobuf:"bytes,1,rep,name=epoch_contexts,json=epochContexts,proto3" json:"epoch_contexts,omitempty"`
+	CurrentEpoch  uint64                 `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+}
+
+func (m *QueryEpochContextsResponse) Reset()         { *m = QueryEpochContextsResponse{} }
+func (m *QueryEpochContextsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochContextsResponse) ProtoMessage()    {}
+func (*QueryEpochContextsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{4}
+}
+func (m *QueryEpochContextsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochContextsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochContextsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochContextsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochContextsResponse.Merge(m, src)
+}
+func (m *QueryEpochContextsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochContextsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochContextsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochContextsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochContextsResponse) GetEpochContexts() []*EpochContextSummary {
+	if m != nil {
+		return m.EpochContexts
+	}
+	return nil
+}
+
+func (m *QueryEpochContextsResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+type QueryCheckpointCursorRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryCheckpointCursorRequest) Reset()         { *m = QueryCheckpointCursorRequest{} }
+func (m *QueryCheckpointCursorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointCursorRequest) ProtoMessage()    {}
+func (*QueryCheckpointCursorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{5}
+}
+func (m *QueryCheckpointCursorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointCursorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointCursorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointCursorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointCursorRequest.Merge(m, src)
+}
+func (m *QueryCheckpointCursorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointCursorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointCursorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointCursorRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointCursorRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type QueryCheckpointCursorResponse struct {
+	LatestCheckpointHeight    *Height `protobuf:"bytes,1,opt,name=latest_checkpoint_height,json=latestCheckpointHeight,proto3" json:"latest_checkpoint_height,omitempty"`
+	LatestCheckpointBlockHash string  `protobuf:"bytes,2,opt,name=latest_checkpoint_block_hash,json=latestCheckpointBlockHash,proto3" json:"latest_checkpoint_block_hash,omitempty"`
+	LatestCheckpointEpoch     uint64  `protobuf:"varint,3,opt,name=latest_checkpoint_epoch,json=latestCheckpointEpoch,proto3" json:"latest_checkpoint_epoch,omitempty"`
+	LatestHeight              *Height `protobuf:"bytes,4,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
+}
+
+func (m *QueryCheckpointCursorResponse) Reset()         { *m = QueryCheckpointCursorResponse{} }
+func (m *QueryCheckpointCursorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointCursorResponse) ProtoMessage()    {}
+func (*QueryCheckpointCursorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{6}
+}
+func (m *QueryCheckpointCursorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointCursorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointCursorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointCursorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointCursorResponse.Merge(m, src)
+}
+func (m *QueryCheckpointCursorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointCursorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointCursorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointCursorResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointCursorResponse) GetLatestCheckpointHeight() *Height {
+	if m != nil {
+		return m.LatestCheckpointHeight
+	}
+	return nil
+}
+
+func (m *QueryCheckpointCursorResponse) GetLatestCheckpointBlockHash() string {
+	if m != nil {
+		return m.LatestCheckpointBlockHash
+	}
+	return ""
+}
+
+func (m *QueryCheckpointCursorResponse) GetLatestCheckpointEpoch() uint64 {
+	if m != nil {
+		return m.LatestCheckpointEpoch
+	}
+	return 0
+}
+
+func (m *QueryCheckpointCursorResponse) GetLatestHeight() *Height {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return nil
+}
+
+type QueryVerifyHeaderRequest struct {
+	ClientId string               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Header   *ProbabilisticHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *QueryVerifyHeaderRequest) Reset()         { *m = QueryVerifyHeaderRequest{} }
+func (m *QueryVerifyHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyHeaderRequest) ProtoMessage()    {}
+func (*QueryVerifyHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{7}
+}
+func (m *QueryVerifyHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyHeaderRequest.Merge(m, src)
+}
+func (m *QueryVerifyHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyHeaderRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyHeaderRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryVerifyHeaderRequest) GetHeader() *ProbabilisticHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type QueryVerifyHeaderResponse struct {
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Set when the header is rejected.
+	RejectionReason string `protobuf:"bytes,2,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	// Metrics of the header's anchor, set when the header is accepted.
+	SecurityScoreBps uint64 `protobuf:"varint,3,opt,name=security_score_bps,json=securityScoreBps,proto3" json:"security_score_bps,omitempty"`
+	UniquePoolsCount uint64 `protobuf:"varint,4,opt,name=unique_pools_count,json=uniquePoolsCount,proto3" json:"unique_pools_count,omitempty"`
+	UniqueStakeBps   uint64 `protobuf:"varint,5,opt,name=unique_stake_bps,json=uniqueStakeBps,proto3" json:"unique_stake_bps,omitempty"`
+	DescendantDepth  uint64 `protobuf:"varint,6,opt,name=descendant_depth,json=descendantDepth,proto3" json:"descendant_depth,omitempty"`
+	ChainDensityBps  uint64 `protobuf:"varint,7,opt,name=chain_density_bps,json=chainDensityBps,proto3" json:"chain_density_bps,omitempty"`
+}
+
+func (m *QueryVerifyHeaderResponse) Reset()         { *m = QueryVerifyHeaderResponse{} }
+func (m *QueryVerifyHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyHeaderResponse) ProtoMessage()    {}
+func (*QueryVerifyHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{8}
+}
+func (m *QueryVerifyHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyHeaderResponse.Merge(m, src)
+}
+func (m *QueryVerifyHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyHeaderResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyHeaderResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *QueryVerifyHeaderResponse) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func (m *QueryVerifyHeaderResponse) GetSecurityScoreBps() uint64 {
+	if m != nil {
+		return m.SecurityScoreBps
+	}
+	return 0
+}
+
+func (m *QueryVerifyHeaderResponse) GetUniquePoolsCount() uint64 {
+	if m != nil {
+		return m.UniquePoolsCount
+	}
+	return 0
+}
+
+func (m *QueryVerifyHeaderResponse) GetUniqueStakeBps() uint64 {
+	if m != nil {
+		return m.UniqueStakeBps
+	}
+	return 0
+}
+
+func (m *QueryVerifyHeaderResponse) GetDescendantDepth() uint64 {
+	if m != nil {
+		return m.DescendantDepth
+	}
+	return 0
+}
+
+func (m *QueryVerifyHeaderResponse) GetChainDensityBps() uint64 {
+	if m != nil {
+		return m.ChainDensityBps
+	}
+	return 0
+}
+
+type IbcStateMembershipItem struct {
+	// Cardano IBC state key, e.g. commitments/ports/{port}/channels/{channel}/sequences/{sequence}.
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *IbcStateMembershipItem) Reset()         { *m = IbcStateMembershipItem{} }
+func (m *IbcStateMembershipItem) String() string { return proto.CompactTextString(m) }
+func (*IbcStateMembershipItem) ProtoMessage()    {}
+func (*IbcStateMembershipItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{9}
+}
+func (m *IbcStateMembershipItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcStateMembershipItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcStateMembershipItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcStateMembershipItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcStateMembershipItem.Merge(m, src)
+}
+func (m *IbcStateMembershipItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcStateMembershipItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcStateMembershipItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcStateMembershipItem proto.InternalMessageInfo
+
+func (m *IbcStateMembershipItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *IbcStateMembershipItem) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type QueryVerifyMembershipBatchRequest struct {
+	ClientId       string                    `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RevisionHeight uint64                    `protobuf:"varint,2,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+	RevisionNumber uint64                    `protobuf:"varint,5,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	Items          []*IbcStateMembershipItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// JSON-encoded IBC state multi-proof covering exactly the keys of items.
+	Proof []byte `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryVerifyMembershipBatchRequest) Reset()         { *m = QueryVerifyMembershipBatchRequest{} }
+func (m *QueryVerifyMembershipBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMembershipBatchRequest) ProtoMessage()    {}
+func (*QueryVerifyMembershipBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{10}
+}
+func (m *QueryVerifyMembershipBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyMembershipBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyMembershipBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyMembershipBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMembershipBatchRequest.Merge(m, src)
+}
+func (m *QueryVerifyMembershipBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyMembershipBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMembershipBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyMembershipBatchRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyMembershipBatchRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryVerifyMembershipBatchRequest) GetRevisionHeight() uint64 {
+	if m != nil {
+		return m.RevisionHeight
+	}
+	return 0
+}
+
+func (m *QueryVerifyMembershipBatchRequest) GetRevisionNumber() uint64 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+func (m *QueryVerifyMembershipBatchRequest) GetItems() []*IbcStateMembershipItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *QueryVerifyMembershipBatchRequest) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type QueryVerifyMembershipBatchResponse struct {
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Set when the proof is rejected.
+	RejectionReason string `protobuf:"bytes,2,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+}
+
+func (m *QueryVerifyMembershipBatchResponse) Reset()         { *m = QueryVerifyMembershipBatchResponse{} }
+func (m *QueryVerifyMembershipBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMembershipBatchResponse) ProtoMessage()    {}
+func (*QueryVerifyMembershipBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3e730a4e61c3321, []int{11}
+}
+func (m *QueryVerifyMembershipBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyMembershipBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyMembershipBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyMembershipBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMembershipBatchResponse.Merge(m, src)
+}
+func (m *QueryVerifyMembershipBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyMembershipBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMembershipBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyMembershipBatchResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyMembershipBatchResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *QueryVerifyMembershipBatchResponse) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QuerySecurityMetricsRequest)(nil), "ibc.lightclients.probabilistic.v1.QuerySecurityMetricsRequest")
+	proto.RegisterType((*QuerySecurityMetricsResponse)(nil), "ibc.lightclients.probabilistic.v1.QuerySecurityMetricsResponse")
+	proto.RegisterType((*QueryEpochContextsRequest)(nil), "ibc.lightclients.probabilistic.v1.QueryEpochContextsRequest")
+	proto.RegisterType((*EpochContextSummary)(nil), "ibc.lightclients.probabilistic.v1.EpochContextSummary")
+	proto.RegisterType((*QueryEpochContextsResponse)(nil), "ibc.lightclients.probabilistic.v1.QueryEpochContextsResponse")
+	proto.RegisterType((*QueryCheckpointCursorRequest)(nil), "ibc.lightclients.probabilistic.v1.QueryCheckpointCursorRequest")
+	proto.RegisterType((*QueryCheckpointCursorResponse)(nil), "ibc.lightclients.probabilistic.v1.QueryCheckpointCursorResponse")
+	proto.RegisterType((*QueryVerifyHeaderRequest)(nil), "ibc.lightclients.probabilistic.v1.QueryVerifyHeaderRequest")
+	proto.RegisterType((*QueryVerifyHeaderResponse)(nil), "ibc.lightclients.probabilistic.v1.QueryVerifyHeaderResponse")
+	proto.RegisterType((*IbcStateMembershipItem)(nil), "ibc.lightclients.probabilistic.v1.IbcStateMembershipItem")
+	proto.RegisterType((*QueryVerifyMembershipBatchRequest)(nil), "ibc.lightclients.probabilistic.v1.QueryVerifyMembershipBatchRequest")
+	proto.RegisterType((*QueryVerifyMembershipBatchResponse)(nil), "ibc.lightclients.probabilistic.v1.QueryVerifyMembershipBatchResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/probabilistic/v1/query.proto", fileDescriptor_f3e730a4e61c3321)
+}
+
+var fileDescriptor_f3e730a4e61c3321 = []byte{
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xee, 0x3a, 0x76, 0x48, 0x5e, 0xec, 0xc6, 0x9d, 0xd2, 0xe2, 0xb8, 0xad, 0x69, 0x97, 0x03,
+	0x09, 0xc2, 0xb6, 0x1a, 0x44, 0x55, 0x94, 0x42, 0x2b, 0x27, 0x95, 0x92, 0x43, 0x43, 0x59, 0x23,
+	0x0e, 0x48, 0x68, 0xb5, 0x3b, 0x3b, 0xe9, 0x0e, 0xb6, 0x77, 0x36, 0x3b, 0xb3, 0x16, 0xbe, 0x71,
+	0x03, 0x24, 0x0e, 0x08, 0x89, 0x3b, 0x07, 0x6e, 0xfc, 0x11, 0x5c, 0xb9, 0x20, 0xf5, 0xc8, 0x11,
+	0x25, 0xff, 0x03, 0x12, 0x37, 0x34, 0x3f, 0xfc, 0x2b, 0xdd, 0xb4, 0xeb, 0xc0, 0xcd, 0xf3, 0xbd,
+	0x79, 0xdf, 0x7b, 0xef, 0x7b, 0x6f, 0xdf, 0x18, 0x9a, 0xd4, 0xc7, 0xed, 0x3e, 0x7d, 0x16, 0x0a,
+	0xdc, 0xa7, 0x24, 0x12, 0xbc, 0x1d, 0x27, 0xcc, 0xf7, 0x7c, 0xda, 0xa7, 0x5c, 0x50, 0xdc, 0x1e,
+	0xde, 0x6d, 0x1f, 0xa7, 0x24, 0x19, 0xb5, 0xe2, 0x84, 0x09, 0x86, 0xee, 0x50, 0x1f, 0xb7, 0x66,
+	0xaf, 0xb7, 0xe6, 0xae, 0xb7, 0x86, 0x77, 0xeb, 0xef, 0xbf, 0x9a, 0x71, 0xde, 0x47, 0x31, 0xdb,
+	0x18, 0x6e, 0x7c, 0x22, 0x03, 0x75, 0x09, 0x4e, 0x13, 0x2a, 0x46, 0x4f, 0x88, 0x48, 0x28, 0xe6,
+	0x0e, 0x39, 0x4e, 0x09, 0x17, 0xe8, 0x06, 0xac, 0x6a, 0x3a, 0x97, 0x06, 0x35, 0xeb, 0xb6, 0xb5,
+	0xb9, 0xea, 0xac, 0x68, 0xe0, 0x20, 0x40, 0x6f, 0xc3, 0x7a, 0x42, 0x86, 0x94, 0x53, 0x16, 0xb9,
+	0x21, 0x91, 0xa1, 0x6b, 0x85, 0xdb, 0xd6, 0x66, 0xd1, 0xb9, 0x3c, 0x86, 0xf7, 0x15, 0x6a, 0x7f,
+	0x5d, 0x80, 0x9b, 0xd9, 0x51, 0x78, 0xcc, 0x22, 0x4e, 0xd0, 0xbb, 0x80, 0xb8, 0x31, 0xb9, 0x1c,
+	0xb3, 0x84, 0xb8, 0x7e, 0xcc, 0x55, 0xbc, 0xa2, 0x53, 0x1d, 0x5b, 0xba, 0xd2, 0xd0, 0x89, 0xb9,
+	0xbc, 0x9d, 0x46, 0xf4, 0x38, 0x25, 0x6e, 0xcc, 0x58, 0x9f, 0xbb, 0x98, 0xa5, 0xd1, 0x38, 0x74,
+	0x55, 0x5b, 0x9e, 0x4a, 0xc3, 0xae, 0xc4, 0xd1, 0x26, 0x18, 0xcc, 0xe5, 0xc2, 0xeb, 0x69, 0xe6,
+	0x25, 0x9d, 0xa6, 0xc6, 0xbb, 0x12, 0x96, 0xbc, 0x2d, 0xb8, 0xea, 0x61, 0x4c, 0x62, 0x41, 0x02,
+	0xd7, 0xef, 0x33, 0xdc, 0x73, 0x43, 0x8f, 0x87, 0xb5, 0xa2, 0x2a, 0xfb, 0xca, 0xd8, 0xd4, 0x91,
+	0x96, 0x7d, 0x8f, 0x87, 0xe8, 0x1d, 0xb8, 0x82, 0x43, 0x8f, 0x46, 0x6e, 0x40, 0x22, 0x2e, 0x53,
+	0x97, 0xd4, 0x25, 0x45, 0xbd, 0xae, 0x0c, 0x7b, 0x1a, 0xef, 0xc4, 0xdc, 0xbe, 0x0f, 0x1b, 0x4a,
+	0x81, 0xc7, 0x31, 0xc3, 0xe1, 0x2e, 0x8b, 0x04, 0xf9, 0x4a, 0xe4, 0x52, 0xd9, 0xfe, 0xd5, 0x82,
+	0xab, 0xb3, 0x5e, 0xdd, 0x74, 0x30, 0xf0, 0x92, 0x11, 0xfa, 0x14, 0x2a, 0x44, 0xc2, 0x2e, 0xd6,
+	0xb8, 0x72, 0x5c, 0xdb, 0x6e, 0xb7, 0x5e, 0x39, 0x2b, 0xad, 0x59, 0x3a, 0xa7, 0x4c, 0x66, 0x4e,
+	0xe8, 0x4d, 0x58, 0x13, 0x4c, 0x78, 0x7d, 0x2d, 0x96, 0x11, 0x15, 0x14, 0xa4, 0x74, 0x42, 0xb7,
+	0x00, 0xa4, 0xea, 0x46, 0x74, 0x2d, 0xe4, 0xaa, 0x44, 0x94, 0xda, 0xf6, 0xcf, 0x16, 0xd4, 0xb3,
+	0x0a, 0x35, 0x8d, 0xfe, 0x02, 0x2e, 0xcf, 0x25, 0x2d, 0x9b, 0xbc, 0xb4, 0xb9, 0xb6, 0x7d, 0x6f,
+	0xc1, 0xac, 0x8d, 0x08, 0x4e, 0x65, 0x36, 0x79, 0x8e, 0xde, 0x82, 0x0a, 0x4e, 0x93, 0x44, 0x2a,
+	0xa9, 0x0c, 0x26, 0xff, 0xb2, 0x01, 0x15, 0x83, 0xbd, 0x63, 0x86, 0x71, 0x37, 0x24, 0xb8, 0x17,
+	0x33, 0x1a, 0x89, 0xdd, 0x34, 0xe1, 0x2c, 0xc9, 0xd5, 0x8d, 0x3f, 0x0a, 0x70, 0xeb, 0x1c, 0x6f,
+	0x53, 0x22, 0x86, 0x5a, 0xdf, 0x13, 0x84, 0x0b, 0x17, 0x4f, 0xae, 0x8c, 0x3f, 0x0f, 0xdd, 0xa2,
+	0xad, 0x1c, 0xc5, 0xea, 0x2f, 0xc7, 0xb9, 0xae, 0xa9, 0xa6, 0xc1, 0x34, 0x8e, 0x1e, 0xc2, 0xcd,
+	0x17, 0x83, 0xcc, 0xcc, 0x6c, 0x41, 0xa5, 0xbd, 0x71, 0xd6, 0x7b, 0x3a, 0xbb, 0xf7, 0xe0, 0x8d,
+	0x17, 0x09, 0xb4, 0x66, 0xba, 0xa7, 0xd7, 0xce, 0xfa, 0x2a, 0xf1, 0xd0, 0x21, 0x54, 0x8c, 0x9f,
+	0x29, 0xa9, 0xb8, 0x68, 0x49, 0x65, 0xed, 0x6f, 0x56, 0xc3, 0x37, 0x16, 0xd4, 0x94, 0x9e, 0x9f,
+	0x91, 0x84, 0x1e, 0x8d, 0xf6, 0x89, 0x17, 0x90, 0x5c, 0x9d, 0x40, 0x87, 0xb0, 0x1c, 0xaa, 0xdb,
+	0xaa, 0xd8, 0x7c, 0x23, 0xf4, 0x74, 0x16, 0x30, 0xb1, 0x0c, 0x8b, 0xfd, 0x5b, 0x01, 0x36, 0x32,
+	0x32, 0x31, 0x5d, 0xad, 0xc3, 0xca, 0x78, 0x01, 0xa8, 0x4c, 0x56, 0x9c, 0xc9, 0x19, 0x6d, 0x41,
+	0x35, 0x21, 0x5f, 0x12, 0x2c, 0xe4, 0x22, 0x4c, 0x88, 0xc7, 0x59, 0x64, 0x1a, 0xb0, 0x3e, 0xc1,
+	0x1d, 0x05, 0x9f, 0xb3, 0xe8, 0x96, 0x16, 0x5a, 0x74, 0xc5, 0x05, 0x16, 0x5d, 0x29, 0x73, 0xd1,
+	0x6d, 0x41, 0x35, 0x20, 0x1c, 0x93, 0x28, 0xf0, 0x22, 0xe1, 0x06, 0x24, 0x16, 0x61, 0x6d, 0x59,
+	0xef, 0xad, 0x29, 0xbe, 0x27, 0xe1, 0xec, 0x1d, 0xf7, 0x5a, 0xf6, 0x8e, 0x7b, 0x04, 0xd7, 0x0f,
+	0x7c, 0xdc, 0x15, 0x9e, 0x20, 0x4f, 0xc8, 0xc0, 0x27, 0x09, 0x0f, 0x69, 0x7c, 0x20, 0xc8, 0x00,
+	0x55, 0x61, 0xa9, 0x47, 0x46, 0x4a, 0xb8, 0xb2, 0x23, 0x7f, 0xa2, 0xd7, 0xa1, 0x34, 0xf4, 0xfa,
+	0xa9, 0xde, 0x30, 0x65, 0x47, 0x1f, 0xec, 0x7f, 0x2c, 0xb8, 0x33, 0xd3, 0x83, 0x29, 0x4b, 0xc7,
+	0x13, 0x38, 0xfc, 0x5f, 0x1f, 0xa5, 0xb9, 0x8b, 0x51, 0x2a, 0x03, 0x8d, 0xd5, 0x1a, 0xc3, 0x87,
+	0x0a, 0x45, 0x1f, 0x43, 0x89, 0x0a, 0x32, 0x90, 0x6d, 0x92, 0xab, 0xea, 0x83, 0x1c, 0x73, 0x96,
+	0x2d, 0x83, 0xa3, 0x79, 0x64, 0xed, 0x71, 0xc2, 0xd8, 0x91, 0xea, 0x64, 0xd9, 0xd1, 0x07, 0xbb,
+	0x07, 0xf6, 0xcb, 0x4a, 0x9f, 0xce, 0xe1, 0x50, 0x5e, 0xa0, 0xd3, 0x39, 0x1c, 0x9f, 0x17, 0x98,
+	0xc3, 0xed, 0xbf, 0x4b, 0x50, 0x52, 0xd1, 0xd0, 0x8f, 0x16, 0xac, 0x9f, 0x79, 0x96, 0xd1, 0x47,
+	0x39, 0x4a, 0x7c, 0xc9, 0xbf, 0x86, 0xfa, 0xc3, 0x0b, 0xfb, 0x9b, 0x2a, 0xbf, 0xb7, 0xa0, 0x32,
+	0xf7, 0x80, 0xa0, 0x07, 0x79, 0x29, 0xb3, 0x1e, 0xd8, 0xfa, 0x87, 0x17, 0xf4, 0x36, 0xe9, 0xfc,
+	0x64, 0x41, 0xf5, 0xec, 0xbe, 0x47, 0xb9, 0x8b, 0x3c, 0xe7, 0x9d, 0xa9, 0x3f, 0xba, 0x38, 0x81,
+	0xc9, 0xeb, 0x3b, 0x0b, 0xca, 0xb3, 0xdb, 0x0a, 0xed, 0xe4, 0xa5, 0xcc, 0xd8, 0xb6, 0xf5, 0x07,
+	0x17, 0x73, 0x36, 0xb9, 0xfc, 0x62, 0xc1, 0xb5, 0xcc, 0xd1, 0x45, 0x7b, 0x8b, 0xf1, 0x66, 0x7f,
+	0xf4, 0xf5, 0xc7, 0xff, 0x91, 0x45, 0xa7, 0xd9, 0xf9, 0xd6, 0xfa, 0xfd, 0xa4, 0x61, 0x3d, 0x3f,
+	0x69, 0x58, 0x7f, 0x9d, 0x34, 0xac, 0x1f, 0x4e, 0x1b, 0x97, 0x9e, 0x9f, 0x36, 0x2e, 0xfd, 0x79,
+	0xda, 0xb8, 0xf4, 0x79, 0xf4, 0x8c, 0x8a, 0x30, 0xf5, 0x5b, 0x98, 0x0d, 0xda, 0xd8, 0x4b, 0x02,
+	0x2f, 0x62, 0xcd, 0x23, 0x96, 0x46, 0x81, 0x27, 0x3f, 0x9c, 0x09, 0x44, 0x7d, 0xdc, 0xa4, 0x11,
+	0x4e, 0x7d, 0x4f, 0xb0, 0xa4, 0x8d, 0x19, 0x1f, 0x30, 0x3e, 0x31, 0xce, 0xa5, 0xd4, 0x54, 0xd9,
+	0x36, 0x75, 0xba, 0xcd, 0xe1, 0xfd, 0x9d, 0x39, 0xab, 0xbf, 0xac, 0xfe, 0x81, 0xbf, 0xf7, 0xef,
+	0x00, 0xef, 0xcb, 0x93, 0xb9, 0x0c, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SecurityMetrics returns the metrics recorded when the consensus state at
+	// a height was accepted.
+	SecurityMetrics(ctx context.Context, in *QuerySecurityMetricsRequest, opts ...grpc.CallOption) (*QuerySecurityMetricsResponse, error)
+	// EpochContexts returns the epoch contexts retained by a client.
+	EpochContexts(ctx context.Context, in *QueryEpochContextsRequest, opts ...grpc.CallOption) (*QueryEpochContextsResponse, error)
+	// CheckpointCursor returns the latest authenticated Cardano block of a
+	// client.
+	CheckpointCursor(ctx context.Context, in *QueryCheckpointCursorRequest, opts ...grpc.CallOption) (*QueryCheckpointCursorResponse, error)
+	// VerifyHeader checks a candidate header against the client's trusted state
+	// without updating the client.
+	VerifyHeader(ctx context.Context, in *QueryVerifyHeaderRequest, opts ...grpc.CallOption) (*QueryVerifyHeaderResponse, error)
+	// VerifyMembershipBatch checks a multi-proof for several IBC state keys
+	// against the consensus state root at a height.
+	VerifyMembershipBatch(ctx context.Context, in *QueryVerifyMembershipBatchRequest, opts ...grpc.CallOption) (*QueryVerifyMembershipBatchResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SecurityMetrics(ctx context.Context, in *QuerySecurityMetricsRequest, opts ...grpc.CallOption) (*QuerySecurityMetricsResponse, error) {
+	out := new(QuerySecurityMetricsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.probabilistic.v1.Query/SecurityMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochContexts(ctx context.Context, in *QueryEpochContextsRequest, opts ...grpc.CallOption) (*QueryEpochContextsResponse, error) {
+	out := new(QueryEpochContextsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.probabilistic.v1.Query/EpochContexts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointCursor(ctx context.Context, in *QueryCheckpointCursorRequest, opts ...grpc.CallOption) (*QueryCheckpointCursorResponse, error) {
+	out := new(QueryCheckpointCursorResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.probabilistic.v1.Query/CheckpointCursor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyHeader(ctx context.Context, in *QueryVerifyHeaderRequest, opts ...grpc.CallOption) (*QueryVerifyHeaderResponse, error) {
+	out := new(QueryVerifyHeaderResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.probabilistic.v1.Query/VerifyHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyMembershipBatch(ctx context.Context, in *QueryVerifyMembershipBatchRequest, opts ...grpc.CallOption) (*QueryVerifyMembershipBatchResponse, error) {
+	out := new(QueryVerifyMembershipBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.probabilistic.v1.Query/VerifyMembershipBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SecurityMetrics returns the metrics recorded when the consensus state at
+	// a height was accepted.
+	SecurityMetrics(context.Context, *QuerySecurityMetricsRequest) (*QuerySecurityMetricsResponse, error)
+	// EpochContexts returns the epoch contexts retained by a client.
+	EpochContexts(context.Context, *QueryEpochContextsRequest) (*QueryEpochContextsResponse, error)
+	// CheckpointCursor returns the latest authenticated Cardano block of a
+	// client.
+	CheckpointCursor(context.Context, *QueryCheckpointCursorRequest) (*QueryCheckpointCursorResponse, error)
+	// VerifyHeader checks a candidate header against the client's trusted state
+	// without updating the client.
+	VerifyHeader(context.Context, *QueryVerifyHeaderRequest) (*QueryVerifyHeaderResponse, error)
+	// VerifyMembershipBatch checks a multi-proof for several IBC state keys
+	// against the consensus state root at a height.
+	VerifyMembershipBatch(context.Context, *QueryVerifyMembershipBatchRequest) (*QueryVerifyMembershipBatchResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SecurityMetrics(ctx context.Context, req *QuerySecurityMetricsRequest) (*QuerySecurityMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecurityMetrics not implemented")
+}
+func (*UnimplementedQueryServer) EpochContexts(ctx context.Context, req *QueryEpochContextsRequest) (*QueryEpochContextsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochContexts not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointCursor(ctx context.Context, req *QueryCheckpointCursorRequest) (*QueryCheckpointCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointCursor not implemented")
+}
+func (*UnimplementedQueryServer) VerifyHeader(ctx context.Context, req *QueryVerifyHeaderRequest) (*QueryVerifyHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyHeader not implemented")
+}
+func (*UnimplementedQueryServer) VerifyMembershipBatch(ctx context.Context, req *QueryVerifyMembershipBatchRequest) (*QueryVerifyMembershipBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMembershipBatch not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SecurityMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecurityMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SecurityMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.probabilistic.v1.Query/SecurityMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SecurityMetrics(ctx, req.(*QuerySecurityMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochContexts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochContextsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochContexts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.probabilistic.v1.Query/EpochContexts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochContexts(ctx, req.(*QueryEpochContextsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.probabilistic.v1.Query/CheckpointCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointCursor(ctx, req.(*QueryCheckpointCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.probabilistic.v1.Query/VerifyHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyHeader(ctx, req.(*QueryVerifyHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyMembershipBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyMembershipBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyMembershipBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.probabilistic.v1.Query/VerifyMembershipBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyMembershipBatch(ctx, req.(*QueryVerifyMembershipBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.probabilistic.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SecurityMetrics",
+			Handler:    _Query_SecurityMetrics_Handler,
+		},
+		{
+			MethodName: "EpochContexts",
+			Handler:    _Query_EpochContexts_Handler,
+		},
+		{
+			MethodName: "CheckpointCursor",
+			Handler:    _Query_CheckpointCursor_Handler,
+		},
+		{
+			MethodName: "VerifyHeader",
+			Handler:    _Query_VerifyHeader_Handler,
+		},
+		{
+			MethodName: "VerifyMembershipBatch",
+			Handler:    _Query_VerifyMembershipBatch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/probabilistic/v1/query.proto",
+}
+
+func (m *QuerySecurityMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecurityMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecurityMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevisionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecurityMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecurityMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecurityMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainDensityBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainDensityBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AcceptedBlockHash) > 0 {
+		i -= len(m.AcceptedBlockHash)
+		copy(dAtA[i:], m.AcceptedBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AcceptedBlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UniqueStakeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UniqueStakeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UniquePoolsCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UniquePoolsCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SecurityScoreBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecurityScoreBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochContextsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochContextsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochContextsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochContextSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochContextSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochContextSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalStake != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalStake))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochContext != nil {
+		{
+			size, err := m.EpochContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochContextsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochContextsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochContextsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochContexts) > 0 {
+		for iNdEx := len(m.EpochContexts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochContexts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointCursorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointCursorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointCursorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointCursorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointCursorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointCursorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestHeight != nil {
+		{
+			size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LatestCheckpointEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestCheckpointEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LatestCheckpointBlockHash) > 0 {
+		i -= len(m.LatestCheckpointBlockHash)
+		copy(dAtA[i:], m.LatestCheckpointBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LatestCheckpointBlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LatestCheckpointHeight != nil {
+		{
+			size, err := m.LatestCheckpointHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainDensityBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainDensityBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DescendantDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DescendantDepth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UniqueStakeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UniqueStakeBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UniquePoolsCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UniquePoolsCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SecurityScoreBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecurityScoreBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcStateMembershipItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcStateMembershipItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcStateMembershipItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyMembershipBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyMembershipBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyMembershipBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevisionNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RevisionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyMembershipBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyMembershipBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyMembershipBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySecurityMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *QuerySecurityMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecurityScoreBps != 0 {
+		n += 1 + sovQuery(uint64(m.SecurityScoreBps))
+	}
+	if m.UniquePoolsCount != 0 {
+		n += 1 + sovQuery(uint64(m.UniquePoolsCount))
+	}
+	if m.UniqueStakeBps != 0 {
+		n += 1 + sovQuery(uint64(m.UniqueStakeBps))
+	}
+	l = len(m.AcceptedBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainDensityBps != 0 {
+		n += 1 + sovQuery(uint64(m.ChainDensityBps))
+	}
+	return n
+}
+
+func (m *QueryEpochContextsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochContextSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochContext != nil {
+		l = m.EpochContext.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalStake != 0 {
+		n += 1 + sovQuery(uint64(m.TotalStake))
+	}
+	if m.PoolCount != 0 {
+		n += 1 + sovQuery(uint64(m.PoolCount))
+	}
+	return n
+}
+
+func (m *QueryEpochContextsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochContexts) > 0 {
+		for _, e := range m.EpochContexts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *QueryCheckpointCursorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointCursorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestCheckpointHeight != nil {
+		l = m.LatestCheckpointHeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LatestCheckpointBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LatestCheckpointEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.LatestCheckpointEpoch))
+	}
+	if m.LatestHeight != nil {
+		l = m.LatestHeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SecurityScoreBps != 0 {
+		n += 1 + sovQuery(uint64(m.SecurityScoreBps))
+	}
+	if m.UniquePoolsCount != 0 {
+		n += 1 + sovQuery(uint64(m.UniquePoolsCount))
+	}
+	if m.UniqueStakeBps != 0 {
+		n += 1 + sovQuery(uint64(m.UniqueStakeBps))
+	}
+	if m.DescendantDepth != 0 {
+		n += 1 + sovQuery(uint64(m.DescendantDepth))
+	}
+	if m.ChainDensityBps != 0 {
+		n += 1 + sovQuery(uint64(m.ChainDensityBps))
+	}
+	return n
+}
+
+func (m *IbcStateMembershipItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyMembershipBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionHeight))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RevisionNumber != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionNumber))
+	}
+	return n
+}
+
+func (m *QueryVerifyMembershipBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySecurityMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecurityMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecurityMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecurityMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecurityMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecurityMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityScoreBps", wireType)
+			}
+			m.SecurityScoreBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecurityScoreBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniquePoolsCount", wireType)
+			}
+			m.UniquePoolsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniquePoolsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueStakeBps", wireType)
+			}
+			m.UniqueStakeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueStakeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainDensityBps", wireType)
+			}
+			m.ChainDensityBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainDensityBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochContextsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochContextsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochContextsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochContextSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochContextSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochContextSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochContext == nil {
+				m.EpochContext = &EpochContext{}
+			}
+			if err := m.EpochContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			m.TotalStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCount", wireType)
+			}
+			m.PoolCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochContextsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochContextsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochContextsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochContexts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochContexts = append(m.EpochContexts, &EpochContextSummary{})
+			if err := m.EpochContexts[len(m.EpochContexts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointCursorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointCursorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointCursorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointCursorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointCursorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointCursorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestCheckpointHeight == nil {
+				m.LatestCheckpointHeight = &Height{}
+			}
+			if err := m.LatestCheckpointHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestCheckpointBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpointEpoch", wireType)
+			}
+			m.LatestCheckpointEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestCheckpointEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestHeight == nil {
+				m.LatestHeight = &Height{}
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ProbabilisticHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityScoreBps", wireType)
+			}
+			m.SecurityScoreBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecurityScoreBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniquePoolsCount", wireType)
+			}
+			m.UniquePoolsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniquePoolsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueStakeBps", wireType)
+			}
+			m.UniqueStakeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueStakeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescendantDepth", wireType)
+			}
+			m.DescendantDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DescendantDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainDensityBps", wireType)
+			}
+			m.ChainDensityBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainDensityBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcStateMembershipItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcStateMembershipItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcStateMembershipItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyMembershipBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyMembershipBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyMembershipBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &IbcStateMembershipItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyMembershipBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyMembershipBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyMembershipBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -208,6 +208,15 @@ func deleteConsensusMetadata(clientStore storetypes.KVStore, height exported.Hei
 	deleteIterationKey(clientStore, height)
 }
 
+func deleteConsensusSecurityMetrics(clientStore storetypes.KVStore, height exported.Height) {
+	revisionHeight := height.GetRevisionHeight()
+	clientStore.Delete(ProbabilisticScoreKey(revisionHeight))
+	clientStore.Delete(UniquePoolsKey(revisionHeight))
+	clientStore.Delete(UniqueStakeKey(revisionHeight))
+	clientStore.Delete(ChainDensityKey(revisionHeight))
+	clientStore.Delete(AcceptedBlockHashKey(revisionHeight))
+}
+
 func normalizeConsensusKeyForCardano(path string) string {
 	if !strings.Contains(path, "/consensusStates/") {
 		return path
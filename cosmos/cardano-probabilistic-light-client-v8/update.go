@@ -2,7 +2,6 @@ package probabilistic
 
 import (
 	"fmt"
-	"math"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -25,6 +24,8 @@ func (cs *ClientState) VerifyClientMessage(
 		return cs.verifyHeader(ctx, clientStore, cdc, msg)
 	case *Misbehaviour:
 		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
+	case *SlotLeaderEquivocation:
+		return cs.verifySlotLeaderEquivocation(msg)
 	default:
 		return clienttypes.ErrInvalidClientType
 	}
@@ -38,6 +39,11 @@ func (cs *ClientState) verifyHeader(
 	_ sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
 	header *ProbabilisticHeader,
 ) error {
+	// A shut-down HostState commits to no further IBC state. The client stays
+	// Active for proofs during the grace period but accepts no new headers.
+	if cs.isHostStateShutdown() {
+		return errorsmod.Wrapf(ErrHostStateShutdown, "client was shut down at height %s", cs.HostStateShutdownHeight)
+	}
 	return cs.verifyHeaderWithMode(clientStore, cdc, header, headerVerificationMode{
 		enforceForwardUpdate: true,
 	})
@@ -112,7 +118,7 @@ func (cs *ClientState) verifyHeaderWithMode(
 	if err != nil {
 		return err
 	}
-	epochContexts, err := mergeEpochContexts(currentEpochContexts, header.NewEpochContext)
+	epochContexts, err := mergeHeaderEpochContexts(currentEpochContexts, header)
 	if err != nil {
 		return err
 	}
@@ -130,6 +136,18 @@ func (cs *ClientState) verifyHeaderWithMode(
 		return err
 	}
 
+	// Nonce evolution state follows the checkpoint cursor, so it can only be
+	// replayed for forward updates that extend from it. Mithril trust likewise
+	// only advances with forward updates.
+	if mode.enforceForwardUpdate {
+		if _, err := cs.evolveEpochNonce(trustedBlock.epoch, authenticatedHeader, epochContexts); err != nil {
+			return err
+		}
+		if _, err := cs.authenticateEpochStakeDistributions(header, currentEpochContexts); err != nil {
+			return err
+		}
+	}
+
 	anchorEpochContext := epochContextByEpoch(epochContexts, authenticatedHeader.anchorBlock.epoch)
 	if anchorEpochContext == nil {
 		return errorsmod.Wrapf(
@@ -139,9 +157,10 @@ func (cs *ClientState) verifyHeaderWithMode(
 		)
 	}
 
+	policy := cs.effectiveAcceptancePolicy()
 	depth := uint64(len(authenticatedHeader.descendantBlocks))
-	if depth < DefaultThresholdDepth {
-		return errorsmod.Wrapf(ErrInvalidProbabilisticScore, "insufficient descendant depth: got %d, need %d", depth, DefaultThresholdDepth)
+	if depth < policy.ThresholdDepth {
+		return errorsmod.Wrapf(ErrInvalidProbabilisticScore, "insufficient descendant depth: got %d, need %d", depth, policy.ThresholdDepth)
 	}
 
 	qualifiedUniquePools, qualifiedUniqueStakeBps, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, anchorEpochContext)
@@ -149,17 +168,30 @@ func (cs *ClientState) verifyHeaderWithMode(
 		return err
 	}
 
-	if qualifiedUniquePools < DefaultThresholdUniquePools {
-		return errorsmod.Wrapf(ErrInvalidUniquePools, "insufficient qualified unique pools: got %d, need %d", qualifiedUniquePools, DefaultThresholdUniquePools)
+	if qualifiedUniquePools < policy.ThresholdUniquePools {
+		return errorsmod.Wrapf(ErrInvalidUniquePools, "insufficient qualified unique pools: got %d, need %d", qualifiedUniquePools, policy.ThresholdUniquePools)
+	}
+	if qualifiedUniqueStakeBps < policy.ThresholdUniqueStakeBps {
+		return errorsmod.Wrapf(ErrInvalidUniqueStake, "insufficient qualified unique stake bps: got %d, need %d", qualifiedUniqueStakeBps, policy.ThresholdUniqueStakeBps)
+	}
+
+	chainDensityBps, err := cs.computeChainDensityBps(authenticatedHeader)
+	if err != nil {
+		return err
 	}
-	if qualifiedUniqueStakeBps < DefaultThresholdUniqueStakeBps {
-		return errorsmod.Wrapf(ErrInvalidUniqueStake, "insufficient qualified unique stake bps: got %d, need %d", qualifiedUniqueStakeBps, DefaultThresholdUniqueStakeBps)
+	if chainDensityBps < policy.ThresholdChainDensityBps {
+		return errorsmod.Wrapf(ErrInvalidChainDensity, "insufficient chain density bps: got %d, need %d", chainDensityBps, policy.ThresholdChainDensityBps)
 	}
 
 	if !header.IsCheckpoint {
 		if _, err := cs.ExtractIbcStateRootFromHostStateTx(header); err != nil {
 			return errorsmod.Wrapf(ErrInvalidHostStateCommitment, "invalid host state tx body: %v", err)
 		}
+		// A lineage that provably forks is left to CheckForMisbehaviour.
+		baseline := cs.hostStateLineageBaseline(clientStore, cdc, header.TrustedHeight)
+		if _, _, err := cs.verifyHostStateLineage(baseline, header); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -180,7 +212,38 @@ func verifyHeaderEpochTransition(
 		return errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated anchor block missing")
 	}
 
+	// Each epoch bridge segment is an adjacent rollover from the epoch reached
+	// by the previous step, so the final step below is checked exactly like a
+	// single-epoch update from the last segment's epoch.
 	trustedEpoch := trustedBlock.epoch
+	for i, segment := range authenticatedHeader.epochSegments {
+		if segment == nil {
+			return errorsmod.Wrapf(ErrInvalidAcceptedBlock, "authenticated epoch bridge segment %d missing", i)
+		}
+		if segment.epoch != trustedEpoch+1 {
+			return errorsmod.Wrapf(
+				ErrInvalidCurrentEpoch,
+				"epoch bridge segment %d must roll over from epoch %d to %d, got epoch %d",
+				i,
+				trustedEpoch,
+				trustedEpoch+1,
+				segment.epoch,
+			)
+		}
+		if err := verifyBridgeBlockEpochs(segment.bridgeBlocks, trustedEpoch, segment.epoch); err != nil {
+			return err
+		}
+		if len(segment.bridgeBlocks) == 0 || segment.bridgeBlocks[len(segment.bridgeBlocks)-1].epoch != segment.epoch {
+			return errorsmod.Wrapf(
+				ErrInvalidCurrentEpoch,
+				"epoch bridge segment %d must end in epoch %d",
+				i,
+				segment.epoch,
+			)
+		}
+		trustedEpoch = segment.epoch
+	}
+
 	anchorEpoch := authenticatedHeader.anchorBlock.epoch
 
 	switch {
@@ -220,43 +283,50 @@ func verifyHeaderEpochTransition(
 	default:
 		return errorsmod.Wrapf(
 			ErrInvalidCurrentEpoch,
-			"probabilistic rollover currently supports only adjacent epoch transitions; trusted epoch %d, accepted epoch %d",
+			"epochs between trusted epoch %d and accepted epoch %d must be covered by epoch bridge segments",
 			trustedEpoch,
 			anchorEpoch,
 		)
 	}
 
-	for _, block := range authenticatedHeader.bridgeBlocks {
+	if err := verifyBridgeBlockEpochs(authenticatedHeader.bridgeBlocks, trustedEpoch, anchorEpoch); err != nil {
+		return err
+	}
+
+	for _, block := range authenticatedHeader.descendantBlocks {
 		if block == nil {
-			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated bridge block missing")
+			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated descendant block missing")
 		}
-		if block.epoch != trustedEpoch && block.epoch != anchorEpoch {
+		if block.epoch != anchorEpoch {
 			return errorsmod.Wrapf(
 				ErrInvalidCurrentEpoch,
-				"bridge block %d crosses unsupported epoch %d for transition %d -> %d",
+				"descendant block %d must remain in accepted epoch %d, got epoch %d",
 				block.height,
-				block.epoch,
-				trustedEpoch,
 				anchorEpoch,
+				block.epoch,
 			)
 		}
 	}
 
-	for _, block := range authenticatedHeader.descendantBlocks {
+	return nil
+}
+
+func verifyBridgeBlockEpochs(blocks []*authenticatedProbabilisticBlock, fromEpoch, toEpoch uint64) error {
+	for _, block := range blocks {
 		if block == nil {
-			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated descendant block missing")
+			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated bridge block missing")
 		}
-		if block.epoch != anchorEpoch {
+		if block.epoch != fromEpoch && block.epoch != toEpoch {
 			return errorsmod.Wrapf(
 				ErrInvalidCurrentEpoch,
-				"descendant block %d must remain in accepted epoch %d, got epoch %d",
+				"bridge block %d crosses unsupported epoch %d for transition %d -> %d",
 				block.height,
-				anchorEpoch,
 				block.epoch,
+				fromEpoch,
+				toEpoch,
 			)
 		}
 	}
-
 	return nil
 }
 
@@ -274,29 +344,19 @@ func verifyBridgeContinuity(
 	expectedPrevHash := trustedBlock.blockHash
 	expectedHeight := trustedBlock.height.RevisionHeight + 1
 
-	for _, block := range authenticatedHeader.bridgeBlocks {
-		if block == nil {
-			return errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated bridge block missing")
-		}
-		if block.prevHash != expectedPrevHash {
-			return errorsmod.Wrapf(
-				ErrInvalidAcceptedBlock,
-				"bridge block %s does not connect to trusted chain",
-				block.hash,
-			)
+	var err error
+	for i, segment := range authenticatedHeader.epochSegments {
+		if segment == nil {
+			return errorsmod.Wrapf(ErrInvalidAcceptedBlock, "authenticated epoch bridge segment %d missing", i)
 		}
-		if block.height != expectedHeight {
-			return errorsmod.Wrapf(
-				ErrInvalidAcceptedBlock,
-				"bridge height gap at block %s: got %d expected %d",
-				block.hash,
-				block.height,
-				expectedHeight,
-			)
+		expectedPrevHash, expectedHeight, err = verifyBridgeSegmentContinuity(segment.bridgeBlocks, expectedPrevHash, expectedHeight)
+		if err != nil {
+			return err
 		}
-
-		expectedPrevHash = block.hash
-		expectedHeight++
+	}
+	expectedPrevHash, expectedHeight, err = verifyBridgeSegmentContinuity(authenticatedHeader.bridgeBlocks, expectedPrevHash, expectedHeight)
+	if err != nil {
+		return err
 	}
 
 	if authenticatedHeader.anchorBlock.prevHash != expectedPrevHash {
@@ -318,6 +378,38 @@ func verifyBridgeContinuity(
 	return nil
 }
 
+func verifyBridgeSegmentContinuity(
+	blocks []*authenticatedProbabilisticBlock,
+	expectedPrevHash string,
+	expectedHeight uint64,
+) (string, uint64, error) {
+	for _, block := range blocks {
+		if block == nil {
+			return "", 0, errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated bridge block missing")
+		}
+		if block.prevHash != expectedPrevHash {
+			return "", 0, errorsmod.Wrapf(
+				ErrInvalidAcceptedBlock,
+				"bridge block %s does not connect to trusted chain",
+				block.hash,
+			)
+		}
+		if block.height != expectedHeight {
+			return "", 0, errorsmod.Wrapf(
+				ErrInvalidAcceptedBlock,
+				"bridge height gap at block %s: got %d expected %d",
+				block.hash,
+				block.height,
+				expectedHeight,
+			)
+		}
+
+		expectedPrevHash = block.hash
+		expectedHeight++
+	}
+	return expectedPrevHash, expectedHeight, nil
+}
+
 func (cs *ClientState) computeHeaderSecurityMetrics(
 	header *authenticatedProbabilisticHeader,
 	epochContext *EpochContext,
@@ -325,24 +417,11 @@ func (cs *ClientState) computeHeaderSecurityMetrics(
 	seenPools := make(map[string]struct{})
 	qualifiedUniquePools := uint64(0)
 	qualifiedUniqueStake := uint64(0)
-	totalActiveStake := uint64(0)
-	stakeByPool := make(map[string]*StakeDistributionEntry)
 
 	if epochContext == nil {
 		return 0, 0, 0, errorsmod.Wrap(ErrInvalidCurrentEpoch, "anchor epoch context must be present")
 	}
-
-	if len(epochContext.StakeDistribution) == 0 {
-		return 0, 0, 0, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution must not be empty", epochContext.Epoch)
-	}
-
-	for _, entry := range epochContext.StakeDistribution {
-		if entry == nil {
-			continue
-		}
-		stakeByPool[strings.ToLower(entry.PoolId)] = entry
-		totalActiveStake += entry.Stake
-	}
+	totalActiveStake := epochContext.TotalStake
 	if totalActiveStake == 0 {
 		return 0, 0, 0, errorsmod.Wrapf(ErrInvalidCurrentEpoch, "epoch %d stake distribution must have positive total stake", epochContext.Epoch)
 	}
@@ -382,7 +461,7 @@ func (cs *ClientState) computeHeaderSecurityMetrics(
 		if poolID != "" {
 			if _, exists := seenPools[poolID]; !exists {
 				seenPools[poolID] = struct{}{}
-				entry := stakeByPool[poolID]
+				entry := block.stakeEntry
 				eligible, err := poolRegisteredBeforeCutoff(poolRegistrationCutoffSlot, entry)
 				if err != nil {
 					return 0, 0, 0, err
@@ -405,25 +484,49 @@ func (cs *ClientState) computeHeaderSecurityMetrics(
 	return qualifiedUniquePools, qualifiedUniqueStakeBps, score, nil
 }
 
-func (cs *ClientState) poolRegistrationCutoffSlotExclusive() (uint64, error) {
-	if cs == nil {
-		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "client state missing")
-	}
-	if cs.SystemStartUnixNs == 0 {
-		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "system_start_unix_ns must be greater than zero")
-	}
-	if cs.SlotLengthNs == 0 {
-		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "slot_length_ns must be greater than zero")
+// computeChainDensityBps measures how densely the descendant window fills the
+// slots after the anchor, up to and including the last descendant's slot. A
+// private fork minted by a minority of the stake is sparser than the honest
+// chain over the same slots.
+func (cs *ClientState) computeChainDensityBps(header *authenticatedProbabilisticHeader) (uint64, error) {
+	if header == nil || header.anchorBlock == nil {
+		return 0, errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated anchor block missing")
 	}
-	if poolRegistrationCutoffUnixNs <= cs.SystemStartUnixNs {
+	if len(header.descendantBlocks) == 0 {
 		return 0, nil
 	}
 
-	delta := poolRegistrationCutoffUnixNs - cs.SystemStartUnixNs
-	if delta > math.MaxUint64-(cs.SlotLengthNs-1) {
-		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "pool registration cutoff slot overflows uint64")
+	prevSlot := header.anchorBlock.slot
+	for _, block := range header.descendantBlocks {
+		if block == nil {
+			return 0, errorsmod.Wrap(ErrInvalidAcceptedBlock, "authenticated descendant block missing")
+		}
+		if block.slot <= prevSlot {
+			return 0, errorsmod.Wrapf(
+				ErrInvalidAcceptedBlock,
+				"descendant block %s slot %d does not advance past slot %d",
+				block.hash,
+				block.slot,
+				prevSlot,
+			)
+		}
+		prevSlot = block.slot
+	}
+
+	policy := cs.effectiveAcceptancePolicy()
+	slotSpan := prevSlot - header.anchorBlock.slot
+	return policy.chainDensityBps(uint64(len(header.descendantBlocks)), slotSpan), nil
+}
+
+func (cs *ClientState) poolRegistrationCutoffSlotExclusive() (uint64, error) {
+	if cs == nil {
+		return 0, errorsmod.Wrap(ErrInvalidTimestamp, "client state missing")
+	}
+	eras, err := cs.eraHistory()
+	if err != nil {
+		return 0, err
 	}
-	return (delta + cs.SlotLengthNs - 1) / cs.SlotLengthNs, nil
+	return firstSlotAtOrAfter(eras, poolRegistrationCutoffUnixNs)
 }
 
 func poolRegisteredBeforeCutoff(cutoffSlotExclusive uint64, entry *StakeDistributionEntry) (bool, error) {
@@ -441,13 +544,14 @@ func poolRegisteredBeforeCutoff(cutoffSlotExclusive uint64, entry *StakeDistribu
 }
 
 func (cs *ClientState) computeSecurityScore(depth, qualifiedUniquePools, qualifiedUniqueStakeBps uint64) uint64 {
-	depthScore := minBps(depth, DefaultThresholdDepth)
-	poolsScore := minBps(qualifiedUniquePools, DefaultThresholdUniquePools)
-	qualifiedStakeScore := minBps(qualifiedUniqueStakeBps, DefaultThresholdUniqueStakeBps)
+	policy := cs.effectiveAcceptancePolicy()
+	depthScore := minBps(depth, policy.ThresholdDepth)
+	poolsScore := minBps(qualifiedUniquePools, policy.ThresholdUniquePools)
+	qualifiedStakeScore := minBps(qualifiedUniqueStakeBps, policy.ThresholdUniqueStakeBps)
 	return min(
-		(DefaultDepthWeightBps*depthScore+
-			DefaultPoolsWeightBps*poolsScore+
-			DefaultStakeWeightBps*qualifiedStakeScore)/10_000,
+		(policy.DepthWeightBps*depthScore+
+			policy.PoolsWeightBps*poolsScore+
+			policy.StakeWeightBps*qualifiedStakeScore)/10_000,
 		10_000,
 	)
 }
@@ -483,7 +587,7 @@ func (cs *ClientState) UpdateState(
 	if err != nil {
 		panic(fmt.Errorf("failed to normalize epoch contexts for verified ProbabilisticHeader: %w", err))
 	}
-	epochContexts, err := mergeEpochContexts(currentEpochContexts, header.NewEpochContext)
+	epochContexts, err := mergeHeaderEpochContexts(currentEpochContexts, header)
 	if err != nil {
 		panic(fmt.Errorf("failed to merge epoch contexts for verified ProbabilisticHeader: %w", err))
 	}
@@ -500,11 +604,28 @@ func (cs *ClientState) UpdateState(
 		panic(fmt.Errorf("verified ProbabilisticHeader violated epoch transition rules: %w", err))
 	}
 
+	epochNonceEvolution, err := cs.evolveEpochNonce(trustedBlock.epoch, authenticatedHeader, epochContexts)
+	if err != nil {
+		panic(fmt.Errorf("verified ProbabilisticHeader violated epoch nonce evolution: %w", err))
+	}
+	cs.EpochNonceEvolution = epochNonceEvolution
+	mithrilTrust, err := cs.authenticateEpochStakeDistributions(header, currentEpochContexts)
+	if err != nil {
+		panic(fmt.Errorf("verified ProbabilisticHeader violated Mithril stake distribution trust: %w", err))
+	}
+	if mithrilTrust != nil {
+		cs.MithrilStakeDistributionTrust = mithrilTrust
+	}
+
 	anchorEpochContext := epochContextByEpoch(epochContexts, authenticatedHeader.anchorBlock.epoch)
 	if anchorEpochContext == nil {
 		panic(fmt.Errorf("missing anchor epoch context for verified ProbabilisticHeader epoch %d", authenticatedHeader.anchorBlock.epoch))
 	}
 
+	// Pruning runs before epoch contexts are reconciled so contexts only
+	// referenced by pruned consensus states are dropped.
+	cs.pruneExpiredConsensusStates(ctx, cdc, clientStore)
+
 	height := NewHeight(0, header.AnchorBlock.Height.RevisionHeight)
 	if header.IsCheckpoint {
 		if err := cs.persistCheckpoint(clientStore, cdc, epochContexts, authenticatedHeader); err != nil {
@@ -513,26 +634,38 @@ func (cs *ClientState) UpdateState(
 		return nil
 	}
 
-	cs.pruneOldestConsensusState(ctx, cdc, clientStore)
-
-	ibcStateRoot, err := cs.ExtractIbcStateRootFromHostStateTx(header)
+	hostStateOutput, err := cs.ExtractHostStateOutputFromHostStateTx(header)
 	if err != nil {
 		panic(fmt.Errorf("failed to extract ibc_state_root from verified ProbabilisticHeader: %w", err))
 	}
+	hostStateLineage, _, err := cs.verifyHostStateLineage(cs.hostStateLineageBaseline(clientStore, cdc, header.TrustedHeight), header)
+	if err != nil {
+		panic(fmt.Errorf("failed to extract HostState lineage from verified ProbabilisticHeader: %w", err))
+	}
 	qualifiedUniquePools, qualifiedUniqueStakeBps, securityScoreBps, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, anchorEpochContext)
 	if err != nil {
 		panic(fmt.Errorf("failed to recompute probabilistic metrics from verified ProbabilisticHeader: %w", err))
 	}
+	chainDensityBps, err := cs.computeChainDensityBps(authenticatedHeader)
+	if err != nil {
+		panic(fmt.Errorf("failed to recompute chain density from verified ProbabilisticHeader: %w", err))
+	}
 	consensusTimestamp := authenticatedHeader.anchorBlock.timestamp
 
 	newConsensusState := &ConsensusState{
 		Timestamp:         consensusTimestamp,
-		IbcStateRoot:      ibcStateRoot,
+		IbcStateRoot:      hostStateOutput.Datum.State.IbcStateRoot,
 		AcceptedBlockHash: authenticatedHeader.anchorBlock.hash,
 		AcceptedEpoch:     authenticatedHeader.anchorBlock.epoch,
 		UniquePoolsCount:  qualifiedUniquePools,
 		UniqueStakeBps:    qualifiedUniqueStakeBps,
 		SecurityScoreBps:  securityScoreBps,
+		ChainDensityBps:   chainDensityBps,
+		HostStateLineage:  hostStateLineage,
+		HostStateShutdown: hostStateShutdownFromDatum(hostStateOutput.Datum.Shutdown),
+	}
+	if newConsensusState.HostStateShutdown != nil {
+		cs.recordHostStateShutdown(height)
 	}
 
 	setConsensusState(clientStore, cdc, newConsensusState, header.GetHeight())
@@ -540,6 +673,7 @@ func (cs *ClientState) UpdateState(
 	clientStore.Set(ProbabilisticScoreKey(height.RevisionHeight), sdk.Uint64ToBigEndian(securityScoreBps))
 	clientStore.Set(UniquePoolsKey(height.RevisionHeight), sdk.Uint64ToBigEndian(qualifiedUniquePools))
 	clientStore.Set(UniqueStakeKey(height.RevisionHeight), sdk.Uint64ToBigEndian(qualifiedUniqueStakeBps))
+	clientStore.Set(ChainDensityKey(height.RevisionHeight), sdk.Uint64ToBigEndian(chainDensityBps))
 	clientStore.Set(AcceptedBlockHashKey(height.RevisionHeight), []byte(authenticatedHeader.anchorBlock.hash))
 
 	keepEpochs := collectReferencedConsensusEpochs(clientStore, cdc)
@@ -554,22 +688,39 @@ func (cs *ClientState) UpdateState(
 	return []exported.Height{height}
 }
 
-func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore) {
-	var pruneHeight exported.Height
+// DefaultMaxPrunedConsensusStatesPerUpdate bounds the pruning work of one
+// update for clients that leave max_pruned_consensus_states_per_update unset.
+const DefaultMaxPrunedConsensusStatesPerUpdate = 10
+
+func (cs ClientState) maxPrunedConsensusStatesPerUpdate() uint64 {
+	if cs.MaxPrunedConsensusStatesPerUpdate == 0 {
+		return DefaultMaxPrunedConsensusStatesPerUpdate
+	}
+	return cs.MaxPrunedConsensusStatesPerUpdate
+}
+
+// pruneExpiredConsensusStates deletes expired consensus states in ascending
+// height order, together with all of their per-height entries. It stops at the
+// first unexpired state or once the per-update limit is reached.
+func (cs ClientState) pruneExpiredConsensusStates(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore) {
+	limit := cs.maxPrunedConsensusStatesPerUpdate()
+	var pruneHeights []exported.Height
 	pruneCb := func(height exported.Height) bool {
 		consState, found := GetConsensusState(clientStore, cdc, height)
 		if !found {
 			panic(errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "failed to retrieve consensus state at height: %s", height))
 		}
-		if cs.IsExpired(consState.GetTimestamp(), ctx.BlockTime()) {
-			pruneHeight = height
+		if !cs.IsExpired(consState.GetTimestamp(), ctx.BlockTime()) {
+			return true
 		}
-		return true
+		pruneHeights = append(pruneHeights, height)
+		return uint64(len(pruneHeights)) >= limit
 	}
 	IterateConsensusStateAscending(clientStore, pruneCb)
-	if pruneHeight != nil {
-		deleteConsensusState(clientStore, pruneHeight)
-		deleteConsensusMetadata(clientStore, pruneHeight)
+	for _, height := range pruneHeights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+		deleteConsensusSecurityMetrics(clientStore, height)
 	}
 }
 
@@ -3,6 +3,9 @@ package probabilistic
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	store "cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	probabilisticcore "github.com/cardano-foundation/cardano-ibc-incubator/cosmos/cardano-probabilistic-light-client-core"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -90,7 +94,7 @@ func TestAuthenticateProbabilisticBlockRejectsMismatchedClaims(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			block := cloneTestProbabilisticBlock(valid)
 			tc.mutate(block)
-			_, err := cs.authenticateProbabilisticBlock(block, "anchor", mustTestEpochContexts(t, cs))
+			_, err := cs.authenticateProbabilisticBlock(block, "anchor", mustTestEpochContexts(t, cs), nil)
 			require.ErrorContains(t, err, tc.want)
 		})
 	}
@@ -102,11 +106,106 @@ func TestAuthenticateProbabilisticBlockDoesNotMutateInput(t *testing.T) {
 	block.Hash = "deadbeef"
 	clone := cloneTestProbabilisticBlock(block)
 
-	_, err := cs.authenticateProbabilisticBlock(block, "anchor", mustTestEpochContexts(t, cs))
+	_, err := cs.authenticateProbabilisticBlock(block, "anchor", mustTestEpochContexts(t, cs), nil)
 	require.Error(t, err)
 	require.Equal(t, clone, block)
 }
 
+func TestAuthenticateProbabilisticBlockAcceptsHeaderOnlyWitness(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	block := makeTestHeaderOnlyProbabilisticBlock(t, 21, 210, hex.EncodeToString(bytes.Repeat([]byte{0x22}, 32)))
+
+	// The claims check out, so the unsigned test header fails only once its
+	// VRF proof is verified.
+	_, err := cs.authenticateProbabilisticBlock(block, "descendant", mustTestEpochContexts(t, cs), nil)
+	require.ErrorContains(t, err, "native verification failed for descendant block: VRF invalid")
+
+	mismatched := cloneTestProbabilisticBlock(block)
+	mismatched.Hash = "deadbeef"
+	_, err = cs.authenticateProbabilisticBlock(mismatched, "descendant", mustTestEpochContexts(t, cs), nil)
+	require.ErrorContains(t, err, "block hash mismatch")
+
+	both := cloneTestProbabilisticBlock(block)
+	both.BlockCbor = makeTestProbabilisticBlock(t, 21, 210, "").BlockCbor
+	_, err = cs.authenticateProbabilisticBlock(both, "descendant", mustTestEpochContexts(t, cs), nil)
+	require.ErrorContains(t, err, "must not carry both block_cbor and header_cbor")
+}
+
+func TestFindStakeDistributionEntryInContextVerifiesInclusionProof(t *testing.T) {
+	full := &EpochContext{
+		Epoch:                 7,
+		EpochNonce:            bytes.Repeat([]byte{0x03}, 32),
+		SlotsPerKesPeriod:     129600,
+		EpochEndSlotExclusive: 1_000_000,
+	}
+	for i, poolID := range []string{"pool-c", "pool-a", "POOL-B"} {
+		full.StakeDistribution = append(full.StakeDistribution, &StakeDistributionEntry{
+			PoolId:                poolID,
+			Stake:                 uint64(1_000 * (i + 1)),
+			VrfKeyHash:            bytes.Repeat([]byte{byte(i + 1)}, 32),
+			FirstRegistrationSlot: 1,
+		})
+	}
+	epochContext := mustCompactTestEpochContext(t, full)
+	require.Empty(t, epochContext.StakeDistribution)
+	require.Equal(t, uint64(6_000), epochContext.TotalStake)
+	require.Equal(t, uint64(3), epochContext.PoolCount)
+
+	proofs := makeTestStakeDistributionProofs(t, full)
+	entry, err := findStakeDistributionEntryInContext(epochContext, "pool-b", proofs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3_000), entry.Stake)
+
+	_, err = findStakeDistributionEntryInContext(epochContext, "pool-d", proofs)
+	require.ErrorIs(t, err, ErrInvalidStakeDistributionProof)
+	require.ErrorContains(t, err, "missing stake distribution proof for pool pool-d")
+
+	wrongEpoch := cloneEpochContext(epochContext)
+	wrongEpoch.Epoch = 8
+	_, err = findStakeDistributionEntryInContext(wrongEpoch, "pool-b", proofs)
+	require.ErrorContains(t, err, "missing stake distribution proof")
+
+	tampered := makeTestStakeDistributionProofs(t, full)
+	for _, proof := range tampered {
+		proof.Entry.Stake++
+	}
+	_, err = findStakeDistributionEntryInContext(epochContext, "pool-b", tampered)
+	require.ErrorIs(t, err, ErrInvalidStakeDistributionProof)
+	require.ErrorContains(t, err, "does not match root")
+}
+
+func TestAuthenticateHeaderBlocksRejectsHeaderOnlyAnchor(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	header := &ProbabilisticHeader{
+		AnchorBlock: makeTestHeaderOnlyProbabilisticBlock(t, 21, 210, hex.EncodeToString(bytes.Repeat([]byte{0x22}, 32))),
+	}
+
+	_, err := cs.authenticateHeaderBlocks(header)
+	require.ErrorIs(t, err, ErrInvalidAcceptedBlock)
+	require.ErrorContains(t, err, "anchor block must carry block_cbor")
+}
+
+func TestHeaderValidateBasicAcceptsHeaderOnlyBridgeAndDescendants(t *testing.T) {
+	header := &ProbabilisticHeader{
+		TrustedHeight:    &Height{RevisionHeight: 10},
+		AnchorBlock:      &ProbabilisticBlock{Height: &Height{RevisionHeight: 12}, Hash: "anchor-12", BlockCbor: []byte{0x01}},
+		BridgeBlocks:     []*ProbabilisticBlock{{Height: &Height{RevisionHeight: 11}, HeaderCbor: []byte{0x01}}},
+		DescendantBlocks: []*ProbabilisticBlock{{Height: &Height{RevisionHeight: 13}, HeaderCbor: []byte{0x01}}},
+		HostStateTxHash:  "host-state-tx",
+	}
+	require.NoError(t, header.ValidateBasic())
+
+	header.DescendantBlocks[0].BlockCbor = []byte{0x01}
+	require.ErrorContains(t, header.ValidateBasic(), "descendant block must not carry both")
+
+	header.DescendantBlocks[0] = &ProbabilisticBlock{Height: &Height{RevisionHeight: 13}}
+	require.ErrorContains(t, header.ValidateBasic(), "descendant block must carry block_cbor or header_cbor")
+
+	header.DescendantBlocks = nil
+	header.AnchorBlock.HeaderCbor = []byte{0x01}
+	require.ErrorContains(t, header.ValidateBasic(), "anchor block must not carry header_cbor")
+}
+
 func TestVerifyHostStateTxIncludedInAnchorBlockRejectsMissingTx(t *testing.T) {
 	header := &ProbabilisticHeader{
 		AnchorBlock:     makeTestProbabilisticBlock(t, 30, 300, hex.EncodeToString(bytes.Repeat([]byte{0x33}, 32))),
@@ -173,7 +272,7 @@ func TestComputeHeaderSecurityMetricsRejectsEmptyEpochStakeDistribution(t *testi
 	}
 
 	_, _, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, epochContext)
-	require.ErrorContains(t, err, "stake distribution must not be empty")
+	require.ErrorContains(t, err, "stake distribution must have positive total stake")
 }
 
 func TestComputeHeaderSecurityMetricsExcludesPoolsRegisteredAfterCutoff(t *testing.T) {
@@ -209,12 +308,12 @@ func TestComputeHeaderSecurityMetricsExcludesPoolsRegisteredAfterCutoff(t *testi
 			epoch:  cs.CurrentEpoch,
 		},
 		descendantBlocks: []*authenticatedProbabilisticBlock{
-			{height: 13, hash: "descendant-13", prevHash: "anchor-12", epoch: cs.CurrentEpoch, slotLeader: "pool-a"},
-			{height: 14, hash: "descendant-14", prevHash: "descendant-13", epoch: cs.CurrentEpoch, slotLeader: "pool-b"},
+			{height: 13, hash: "descendant-13", prevHash: "anchor-12", epoch: cs.CurrentEpoch, slotLeader: "pool-a", stakeEntry: epochContext.StakeDistribution[0]},
+			{height: 14, hash: "descendant-14", prevHash: "descendant-13", epoch: cs.CurrentEpoch, slotLeader: "pool-b", stakeEntry: epochContext.StakeDistribution[1]},
 		},
 	}
 
-	qualifiedUniquePools, qualifiedUniqueStakeBps, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, epochContext)
+	qualifiedUniquePools, qualifiedUniqueStakeBps, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, mustCompactTestEpochContext(t, epochContext))
 
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), qualifiedUniquePools)
@@ -248,11 +347,11 @@ func TestComputeHeaderSecurityMetricsIgnoresPoolRegistrationCutoffEnv(t *testing
 			epoch:  cs.CurrentEpoch,
 		},
 		descendantBlocks: []*authenticatedProbabilisticBlock{
-			{height: 13, hash: "descendant-13", prevHash: "anchor-12", epoch: cs.CurrentEpoch, slotLeader: "pool-a"},
+			{height: 13, hash: "descendant-13", prevHash: "anchor-12", epoch: cs.CurrentEpoch, slotLeader: "pool-a", stakeEntry: epochContext.StakeDistribution[0]},
 		},
 	}
 
-	qualifiedUniquePools, qualifiedUniqueStakeBps, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, epochContext)
+	qualifiedUniquePools, qualifiedUniqueStakeBps, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, mustCompactTestEpochContext(t, epochContext))
 
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), qualifiedUniquePools)
@@ -283,15 +382,124 @@ func TestComputeHeaderSecurityMetricsFailsClosedWhenPoolAgeIsMissing(t *testing.
 			epoch:  cs.CurrentEpoch,
 		},
 		descendantBlocks: []*authenticatedProbabilisticBlock{
-			{height: 13, hash: "descendant-13", prevHash: "anchor-12", epoch: cs.CurrentEpoch, slotLeader: "pool-a"},
+			{height: 13, hash: "descendant-13", prevHash: "anchor-12", epoch: cs.CurrentEpoch, slotLeader: "pool-a", stakeEntry: epochContext.StakeDistribution[0]},
 		},
 	}
 
-	_, _, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, epochContext)
+	_, _, _, err := cs.computeHeaderSecurityMetrics(authenticatedHeader, mustCompactTestEpochContext(t, epochContext))
 
 	require.ErrorContains(t, err, "first registration slot missing")
 }
 
+func TestComputeSecurityScoreUsesClientAcceptancePolicy(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	require.Equal(t, uint64(6_000), cs.computeSecurityScore(0, 0, DefaultThresholdUniqueStakeBps))
+
+	cs.AcceptancePolicy = &AcceptancePolicy{
+		ThresholdDepth:          4,
+		ThresholdUniquePools:    2,
+		ThresholdUniqueStakeBps: 5_000,
+		DepthWeightBps:          5_000,
+		PoolsWeightBps:          5_000,
+		StakeWeightBps:          0,
+	}
+	require.Equal(t, uint64(5_000), cs.computeSecurityScore(2, 1, 0))
+	require.Equal(t, uint64(10_000), cs.computeSecurityScore(4, 2, 0))
+}
+
+func TestClientStateValidateRejectsInvalidAcceptancePolicy(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	require.NoError(t, cs.Validate())
+
+	policy := DefaultAcceptancePolicy()
+	cs.AcceptancePolicy = &policy
+	require.NoError(t, cs.Validate())
+
+	cs.AcceptancePolicy.ThresholdDepth = 0
+	require.ErrorIs(t, cs.Validate(), ErrInvalidAcceptancePolicy)
+
+	policy = DefaultAcceptancePolicy()
+	policy.ThresholdUniqueStakeBps = 10_001
+	cs.AcceptancePolicy = &policy
+	require.ErrorContains(t, cs.Validate(), "threshold_unique_stake_bps")
+
+	policy = DefaultAcceptancePolicy()
+	policy.StakeWeightBps++
+	cs.AcceptancePolicy = &policy
+	require.ErrorContains(t, cs.Validate(), "score weights must sum to 10000 bps")
+
+	policy = DefaultAcceptancePolicy()
+	policy.ThresholdChainDensityBps = 10_001
+	cs.AcceptancePolicy = &policy
+	require.ErrorContains(t, cs.Validate(), "threshold_chain_density_bps")
+
+	policy = DefaultAcceptancePolicy()
+	policy.ActiveSlotCoeffNumerator = 0
+	cs.AcceptancePolicy = &policy
+	require.ErrorContains(t, cs.Validate(), "active slot coefficient")
+
+	policy = DefaultAcceptancePolicy()
+	policy.ActiveSlotCoeffNumerator = 21
+	cs.AcceptancePolicy = &policy
+	require.ErrorContains(t, cs.Validate(), "active slot coefficient")
+}
+
+func TestComputeChainDensityBps(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	header := &authenticatedProbabilisticHeader{
+		anchorBlock: &authenticatedProbabilisticBlock{hash: "anchor", slot: 100},
+	}
+
+	density, err := cs.computeChainDensityBps(header)
+	require.NoError(t, err)
+	require.Zero(t, density)
+
+	// 4 blocks over 80 slots with f = 1/20 is exactly the expected rate.
+	for _, slot := range []uint64{120, 140, 160, 180} {
+		header.descendantBlocks = append(header.descendantBlocks, &authenticatedProbabilisticBlock{hash: "descendant", slot: slot})
+	}
+	density, err = cs.computeChainDensityBps(header)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10_000), density)
+
+	header.descendantBlocks[3].slot = 260
+	density, err = cs.computeChainDensityBps(header)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5_000), density)
+
+	header.descendantBlocks[3].slot = 161
+	density, err = cs.computeChainDensityBps(header)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10_000), density, "density above the expected rate is capped")
+
+	header.descendantBlocks[3].slot = 160
+	_, err = cs.computeChainDensityBps(header)
+	require.ErrorIs(t, err, ErrInvalidAcceptedBlock)
+
+	cs.AcceptancePolicy = &AcceptancePolicy{
+		ThresholdDepth:             4,
+		ThresholdUniquePools:       1,
+		DepthWeightBps:             DefaultDepthWeightBps,
+		PoolsWeightBps:             DefaultPoolsWeightBps,
+		StakeWeightBps:             DefaultStakeWeightBps,
+		ActiveSlotCoeffNumerator:   1,
+		ActiveSlotCoeffDenominator: 10,
+	}
+	header.descendantBlocks[3].slot = 260
+	density, err = cs.computeChainDensityBps(header)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2_500), density)
+}
+
+func TestDefaultAcceptancePolicyDoesNotCheckChainDensity(t *testing.T) {
+	// Clients stored without a policy predate the chain-density check and must
+	// keep accepting the sparse descendant windows they accepted before.
+	cs := newProbabilisticTestClientState()
+	require.Nil(t, cs.AcceptancePolicy)
+	require.Zero(t, cs.effectiveAcceptancePolicy().ThresholdChainDensityBps)
+	require.Equal(t, DefaultAcceptancePolicy(), cs.effectiveAcceptancePolicy())
+}
+
 func TestVerifyHeaderEpochTransitionAcceptsAdjacentEpochRollover(t *testing.T) {
 	header := &ProbabilisticHeader{
 		NewEpochContext: &EpochContext{Epoch: 8},
@@ -344,11 +552,134 @@ func TestVerifyHeaderEpochTransitionRejectsMismatchedSameEpochContext(t *testing
 	require.ErrorContains(t, err, "same-epoch new_epoch_context epoch 8 must match accepted epoch 7")
 }
 
+func TestVerifyHeaderEpochTransitionAcceptsMultiEpochCatchUp(t *testing.T) {
+	header := &ProbabilisticHeader{
+		NewEpochContext: &EpochContext{Epoch: 10},
+	}
+	trustedBlock := &trustedBlockState{epoch: 7}
+	authenticatedHeader := &authenticatedProbabilisticHeader{
+		epochSegments: []*authenticatedEpochSegment{
+			{epoch: 8, bridgeBlocks: []*authenticatedProbabilisticBlock{{epoch: 7}, {epoch: 8}}},
+			{epoch: 9, bridgeBlocks: []*authenticatedProbabilisticBlock{{epoch: 8}, {epoch: 9}}},
+		},
+		anchorBlock:      &authenticatedProbabilisticBlock{epoch: 10},
+		bridgeBlocks:     []*authenticatedProbabilisticBlock{{epoch: 9}, {epoch: 10}},
+		descendantBlocks: []*authenticatedProbabilisticBlock{{epoch: 10}},
+	}
+
+	err := verifyHeaderEpochTransition(header, trustedBlock, authenticatedHeader)
+	require.NoError(t, err)
+}
+
+func TestVerifyHeaderEpochTransitionRejectsUnbridgedEpochGap(t *testing.T) {
+	header := &ProbabilisticHeader{
+		NewEpochContext: &EpochContext{Epoch: 9},
+	}
+	trustedBlock := &trustedBlockState{epoch: 7}
+	authenticatedHeader := &authenticatedProbabilisticHeader{
+		anchorBlock:      &authenticatedProbabilisticBlock{epoch: 9},
+		bridgeBlocks:     []*authenticatedProbabilisticBlock{{epoch: 7}, {epoch: 9}},
+		descendantBlocks: []*authenticatedProbabilisticBlock{{epoch: 9}},
+	}
+
+	err := verifyHeaderEpochTransition(header, trustedBlock, authenticatedHeader)
+	require.ErrorContains(t, err, "must be covered by epoch bridge segments")
+}
+
+func TestVerifyHeaderEpochTransitionRejectsSkippedEpochSegment(t *testing.T) {
+	header := &ProbabilisticHeader{
+		NewEpochContext: &EpochContext{Epoch: 10},
+	}
+	trustedBlock := &trustedBlockState{epoch: 7}
+	authenticatedHeader := &authenticatedProbabilisticHeader{
+		epochSegments: []*authenticatedEpochSegment{
+			{epoch: 9, bridgeBlocks: []*authenticatedProbabilisticBlock{{epoch: 7}, {epoch: 9}}},
+		},
+		anchorBlock:      &authenticatedProbabilisticBlock{epoch: 10},
+		bridgeBlocks:     []*authenticatedProbabilisticBlock{{epoch: 10}},
+		descendantBlocks: []*authenticatedProbabilisticBlock{{epoch: 10}},
+	}
+
+	err := verifyHeaderEpochTransition(header, trustedBlock, authenticatedHeader)
+	require.ErrorContains(t, err, "epoch bridge segment 0 must roll over from epoch 7 to 8, got epoch 9")
+}
+
+func TestVerifyHeaderEpochTransitionRejectsSegmentEndingBeforeItsEpoch(t *testing.T) {
+	header := &ProbabilisticHeader{
+		NewEpochContext: &EpochContext{Epoch: 9},
+	}
+	trustedBlock := &trustedBlockState{epoch: 7}
+	authenticatedHeader := &authenticatedProbabilisticHeader{
+		epochSegments: []*authenticatedEpochSegment{
+			{epoch: 8, bridgeBlocks: []*authenticatedProbabilisticBlock{{epoch: 7}}},
+		},
+		anchorBlock:      &authenticatedProbabilisticBlock{epoch: 9},
+		bridgeBlocks:     []*authenticatedProbabilisticBlock{{epoch: 8}, {epoch: 9}},
+		descendantBlocks: []*authenticatedProbabilisticBlock{{epoch: 9}},
+	}
+
+	err := verifyHeaderEpochTransition(header, trustedBlock, authenticatedHeader)
+	require.ErrorContains(t, err, "epoch bridge segment 0 must end in epoch 8")
+}
+
+func TestVerifyBridgeContinuityChecksEachEpochSegment(t *testing.T) {
+	trustedBlock := &trustedBlockState{
+		height:    &Height{RevisionHeight: 10},
+		blockHash: "trusted-hash",
+	}
+	authenticatedHeader := &authenticatedProbabilisticHeader{
+		epochSegments: []*authenticatedEpochSegment{
+			{epoch: 8, bridgeBlocks: []*authenticatedProbabilisticBlock{{height: 11, hash: "bridge-11", prevHash: "trusted-hash"}}},
+			{epoch: 9, bridgeBlocks: []*authenticatedProbabilisticBlock{{height: 12, hash: "bridge-12", prevHash: "bridge-11"}}},
+		},
+		bridgeBlocks: []*authenticatedProbabilisticBlock{{height: 13, hash: "bridge-13", prevHash: "bridge-12"}},
+		anchorBlock:  &authenticatedProbabilisticBlock{height: 14, hash: "anchor-14", prevHash: "bridge-13"},
+	}
+	require.NoError(t, verifyBridgeContinuity(authenticatedHeader, trustedBlock))
+
+	authenticatedHeader.epochSegments[1].bridgeBlocks[0].prevHash = "wrong-prev"
+	require.ErrorContains(t, verifyBridgeContinuity(authenticatedHeader, trustedBlock), "bridge block bridge-12 does not connect to trusted chain")
+
+	authenticatedHeader.epochSegments[1].bridgeBlocks[0].prevHash = "bridge-11"
+	authenticatedHeader.epochSegments[1].bridgeBlocks[0].height = 13
+	require.ErrorContains(t, verifyBridgeContinuity(authenticatedHeader, trustedBlock), "bridge height gap at block bridge-12")
+}
+
+func TestHeaderValidateBasicRejectsInvalidEpochBridgeSegments(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	header := newVerifiedTestHeader(t)
+	epoch8 := cloneEpochContext(mustCurrentTestEpochContext(t, cs))
+	epoch8.Epoch = 8
+	header.EpochBridgeSegments = []*EpochBridgeSegment{{EpochContext: epoch8}}
+	require.ErrorContains(t, header.ValidateBasic(), "epoch bridge segment 0 must contain bridge blocks")
+
+	header.EpochBridgeSegments[0].BridgeBlocks = []*ProbabilisticBlock{header.BridgeBlocks[0]}
+	require.NoError(t, header.ValidateBasic())
+
+	header.NewEpochContext = cloneEpochContext(epoch8)
+	header.NewEpochContext.EpochNonce = bytes.Repeat([]byte{0x09}, 32)
+	require.ErrorContains(t, header.ValidateBasic(), "conflicts with final epoch bridge segment epoch 8")
+}
+
+func TestCheckForMisbehaviourDetectsConflictingEpochBridgeSegmentContext(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	_, clientStore := newProbabilisticTestClientStore(t, "probabilistic-segment-context-conflict")
+	cs := newProbabilisticTestClientState()
+	stored := cloneEpochContext(mustCurrentTestEpochContext(t, cs))
+
+	header := newVerifiedTestHeader(t)
+	conflicting := cloneEpochContext(stored)
+	conflicting.EpochNonce = bytes.Repeat([]byte{0x09}, 32)
+	header.EpochBridgeSegments = []*EpochBridgeSegment{{EpochContext: conflicting, BridgeBlocks: header.BridgeBlocks}}
+
+	require.True(t, cs.CheckForMisbehaviour(sdk.Context{}, cdc, clientStore, header))
+}
+
 func TestNormalizeEpochContextsRejectsConflictingDuplicateEpoch(t *testing.T) {
 	cs := newProbabilisticTestClientState()
 	first := cloneEpochContext(mustCurrentTestEpochContext(t, cs))
 	second := cloneEpochContext(first)
-	second.StakeDistribution[0].Stake++
+	second.StakeDistributionRoot = bytes.Repeat([]byte{0x09}, 32)
 
 	_, err := normalizeEpochContexts([]*EpochContext{first, second})
 	require.ErrorContains(t, err, "conflicting epoch context for epoch 7")
@@ -358,12 +689,12 @@ func TestMergeEpochContextsAllowsCandidateForStoredEpoch(t *testing.T) {
 	cs := newProbabilisticTestClientState()
 	stored := cloneEpochContext(mustCurrentTestEpochContext(t, cs))
 	candidate := cloneEpochContext(stored)
-	candidate.StakeDistribution[0].Stake++
+	candidate.TotalStake++
 
 	contexts, err := mergeEpochContexts([]*EpochContext{stored}, candidate)
 	require.NoError(t, err)
 	require.Len(t, contexts, 1)
-	require.Equal(t, candidate.StakeDistribution[0].Stake, contexts[0].StakeDistribution[0].Stake)
+	require.Equal(t, candidate.TotalStake, contexts[0].TotalStake)
 }
 
 func TestCheckForMisbehaviourDetectsConflictingHeaderAtSameHeight(t *testing.T) {
@@ -386,7 +717,7 @@ func TestCheckForMisbehaviourDetectsConflictingEpochContext(t *testing.T) {
 
 	cs := newProbabilisticTestClientState()
 	header := newVerifiedTestHeader(t)
-	header.NewEpochContext = cloneEpochContext(mustCurrentTestEpochContext(t, cs))
+	header.NewEpochContext = cloneEpochContext(cs.EpochContexts[0])
 	header.NewEpochContext.StakeDistribution[0].Stake++
 
 	require.True(t, cs.CheckForMisbehaviour(ctx, cdc, clientStore, header))
@@ -399,7 +730,11 @@ func TestCheckForMisbehaviourIgnoresMatchingEpochContext(t *testing.T) {
 	cs := newProbabilisticTestClientState()
 	header := newVerifiedTestHeader(t)
 	header.NewEpochContext = cloneEpochContext(mustCurrentTestEpochContext(t, cs))
+	require.False(t, cs.CheckForMisbehaviour(ctx, cdc, clientStore, header))
 
+	// A header carrying the full stake distribution matches its stored
+	// commitment.
+	header.NewEpochContext = cloneEpochContext(cs.EpochContexts[0])
 	require.False(t, cs.CheckForMisbehaviour(ctx, cdc, clientStore, header))
 }
 
@@ -431,7 +766,7 @@ func TestCheckForMisbehaviourDetectsConflictingEpochContextsInMisbehaviourMessag
 	cs := newProbabilisticTestClientState()
 	header1 := newVerifiedTestHeader(t)
 	header2 := newVerifiedTestHeader(t)
-	header1.NewEpochContext = cloneEpochContext(mustCurrentTestEpochContext(t, cs))
+	header1.NewEpochContext = cloneEpochContext(cs.EpochContexts[0])
 	header2.NewEpochContext = cloneEpochContext(header1.NewEpochContext)
 	header2.NewEpochContext.StakeDistribution[0].Stake++
 
@@ -472,6 +807,61 @@ func TestVerifyMisbehaviourDoesNotRejectStoredHeadersAsStale(t *testing.T) {
 	require.Contains(t, err.Error(), "outside available epoch context bounds")
 }
 
+func TestSlotLeaderEquivocationValidateBasic(t *testing.T) {
+	block := makeTestHeaderOnlyProbabilisticBlock(t, 21, 210, "")
+	require.NoError(t, NewSlotLeaderEquivocation(block, block).ValidateBasic())
+
+	err := NewSlotLeaderEquivocation(block, nil).ValidateBasic()
+	require.ErrorIs(t, err, clienttypes.ErrInvalidMisbehaviour)
+	require.ErrorContains(t, err, "equivocation block 2 must be present")
+
+	empty := &ProbabilisticBlock{Height: NewHeight(0, 21)}
+	require.ErrorContains(t, NewSlotLeaderEquivocation(block, empty).ValidateBasic(), "must carry block_cbor or header_cbor")
+}
+
+func TestSlotLeaderEquivocationConflict(t *testing.T) {
+	sameSlot1 := makeTestEquivocationBlock(t, 21, 210, func(header *ledger.BabbageBlockHeader) {
+		header.Body.OpCert.SequenceNumber = 1
+	})
+	sameSlot2 := makeTestEquivocationBlock(t, 22, 210, func(header *ledger.BabbageBlockHeader) {
+		header.Body.OpCert.SequenceNumber = 1
+	})
+	require.NoError(t, slotLeaderEquivocationConflict(NewSlotLeaderEquivocation(sameSlot1, sameSlot2)))
+	require.True(t, newProbabilisticTestClientState().CheckForMisbehaviour(sdk.Context{}, nil, nil, NewSlotLeaderEquivocation(sameSlot1, sameSlot2)))
+
+	err := slotLeaderEquivocationConflict(NewSlotLeaderEquivocation(sameSlot1, cloneTestProbabilisticBlock(sameSlot1)))
+	require.ErrorContains(t, err, "are the same block")
+
+	otherIssuer := makeTestEquivocationBlock(t, 22, 210, func(header *ledger.BabbageBlockHeader) {
+		header.Body.IssuerVkey[0] = 0x01
+	})
+	err = slotLeaderEquivocationConflict(NewSlotLeaderEquivocation(sameSlot1, otherIssuer))
+	require.ErrorContains(t, err, "different issuers")
+
+	sameCertLater := makeTestEquivocationBlock(t, 22, 211, func(header *ledger.BabbageBlockHeader) {
+		header.Body.OpCert.SequenceNumber = 1
+	})
+	err = slotLeaderEquivocationConflict(NewSlotLeaderEquivocation(sameSlot1, sameCertLater))
+	require.ErrorContains(t, err, "neither share a slot nor reuse an operational certificate counter")
+	require.False(t, newProbabilisticTestClientState().CheckForMisbehaviour(sdk.Context{}, nil, nil, NewSlotLeaderEquivocation(sameSlot1, sameCertLater)))
+
+	reusedCounter := makeTestEquivocationBlock(t, 22, 211, func(header *ledger.BabbageBlockHeader) {
+		header.Body.OpCert.SequenceNumber = 1
+		header.Body.OpCert.HotVkey = bytes.Repeat([]byte{0x09}, 32)
+	})
+	require.NoError(t, slotLeaderEquivocationConflict(NewSlotLeaderEquivocation(sameSlot1, reusedCounter)))
+}
+
+func TestVerifySlotLeaderEquivocationRequiresAuthenticatedBlocks(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	block1 := makeTestEquivocationBlock(t, 21, 210, nil)
+	block2 := makeTestEquivocationBlock(t, 22, 210, nil)
+
+	err := cs.VerifyClientMessage(sdk.Context{}, nil, nil, NewSlotLeaderEquivocation(block1, block2))
+	require.ErrorIs(t, err, clienttypes.ErrInvalidMisbehaviour)
+	require.ErrorContains(t, err, "native verification failed for equivocation block 1")
+}
+
 func TestHeadersConflictRejectsNonConflictingHeaders(t *testing.T) {
 	header1 := newVerifiedTestHeader(t)
 	header2 := newVerifiedTestHeader(t)
@@ -512,12 +902,28 @@ func TestHeaderValidateBasicRejectsTrustedHeightEdgeCases(t *testing.T) {
 	require.ErrorContains(t, err, "trusted height")
 }
 
+func TestHeaderValidateBasicRejectsDuplicateStakeDistributionProofs(t *testing.T) {
+	cs := newProbabilisticTestClientState()
+	header := newVerifiedTestHeader(t)
+	header.StakeDistributionProofs = makeTestStakeDistributionProofs(t, cs.EpochContexts[0])
+	require.NoError(t, header.ValidateBasic())
+
+	duplicate := makeTestStakeDistributionProofs(t, cs.EpochContexts[0])[0]
+	duplicate.Entry.PoolId = "POOL-A"
+	header.StakeDistributionProofs = append(header.StakeDistributionProofs, duplicate)
+	require.ErrorIs(t, header.ValidateBasic(), ErrInvalidStakeDistributionProof)
+
+	header.StakeDistributionProofs = []*StakeDistributionProof{{Epoch: 7}}
+	require.ErrorContains(t, header.ValidateBasic(), "must carry an entry")
+}
+
 func TestPruneOldestConsensusStateRemovesLowestExpiredHeight(t *testing.T) {
 	cdc := newProbabilisticTestCodec()
 	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-prune-oldest")
 
 	cs := newProbabilisticTestClientState()
 	cs.TrustingPeriod = time.Second
+	cs.MaxPrunedConsensusStatesPerUpdate = 1
 
 	expiredAt := uint64(ctx.BlockTime().Add(-2 * time.Second).UnixNano())
 	freshAt := uint64(ctx.BlockTime().UnixNano())
@@ -553,7 +959,7 @@ func TestPruneOldestConsensusStateRemovesLowestExpiredHeight(t *testing.T) {
 	require.True(t, found11Before)
 	require.True(t, found12Before)
 
-	cs.pruneOldestConsensusState(ctx, cdc, clientStore)
+	cs.pruneExpiredConsensusStates(ctx, cdc, clientStore)
 
 	_, found10 := GetConsensusState(clientStore, cdc, NewHeight(0, 10))
 	_, found11 := GetConsensusState(clientStore, cdc, NewHeight(0, 11))
@@ -564,6 +970,45 @@ func TestPruneOldestConsensusStateRemovesLowestExpiredHeight(t *testing.T) {
 	require.True(t, found12)
 }
 
+func TestPruneExpiredConsensusStatesStopsAtFirstUnexpiredHeight(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-prune-batch")
+
+	cs := newProbabilisticTestClientState()
+	cs.TrustingPeriod = time.Second
+
+	expiredAt := uint64(ctx.BlockTime().Add(-2 * time.Second).UnixNano())
+	freshAt := uint64(ctx.BlockTime().UnixNano())
+	for height, timestamp := range map[uint64]uint64{10: expiredAt, 11: expiredAt, 12: freshAt, 13: expiredAt} {
+		consensusState := newProbabilisticTestConsensusState(fmt.Sprintf("hash-%d", height))
+		consensusState.Timestamp = timestamp
+		setConsensusState(clientStore, cdc, consensusState, NewHeight(0, height))
+		setConsensusMetadataWithValues(clientStore, NewHeight(0, height), NewHeight(0, height), timestamp)
+		clientStore.Set(ProbabilisticScoreKey(height), sdk.Uint64ToBigEndian(10_000))
+		clientStore.Set(UniquePoolsKey(height), sdk.Uint64ToBigEndian(1))
+		clientStore.Set(UniqueStakeKey(height), sdk.Uint64ToBigEndian(10_000))
+		clientStore.Set(AcceptedBlockHashKey(height), []byte(consensusState.AcceptedBlockHash))
+	}
+
+	cs.pruneExpiredConsensusStates(ctx, cdc, clientStore)
+
+	for height, pruned := range map[uint64]bool{10: true, 11: true, 12: false, 13: false} {
+		_, found := GetConsensusState(clientStore, cdc, NewHeight(0, height))
+		require.Equal(t, !pruned, found, "consensus state at height %d", height)
+		_, found = GetProcessedTime(clientStore, NewHeight(0, height))
+		require.Equal(t, !pruned, found, "processed time at height %d", height)
+		require.Equal(t, !pruned, clientStore.Has(IterationKey(NewHeight(0, height))), "iteration key at height %d", height)
+		for _, key := range [][]byte{
+			ProbabilisticScoreKey(height),
+			UniquePoolsKey(height),
+			UniqueStakeKey(height),
+			AcceptedBlockHashKey(height),
+		} {
+			require.Equal(t, !pruned, clientStore.Has(key), "%s", key)
+		}
+	}
+}
+
 func TestCollectReferencedConsensusEpochsCollectsAllStoredEpochs(t *testing.T) {
 	cdc := newProbabilisticTestCodec()
 	_, clientStore := newProbabilisticTestClientStore(t, "probabilistic-collect-epochs")
@@ -619,6 +1064,23 @@ func TestInitializeCreatesCheckpointCursorAtInitialConsensusState(t *testing.T)
 	require.Equal(t, uint64(7), stored.LatestCheckpointEpoch)
 }
 
+func TestInitializeStoresStakeDistributionCommitment(t *testing.T) {
+	cdc := newProbabilisticTestCodec()
+	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-initial-stake-commitment")
+	clientState := newProbabilisticTestClientState()
+
+	require.NoError(t, clientState.Initialize(ctx, cdc, clientStore, newProbabilisticTestConsensusState("initial-block-hash")))
+
+	stored, found := getClientState(clientStore, cdc)
+	require.True(t, found)
+	require.Empty(t, stored.EpochStakeDistribution)
+	require.Len(t, stored.EpochContexts, 1)
+	require.Empty(t, stored.EpochContexts[0].StakeDistribution)
+	require.Len(t, stored.EpochContexts[0].StakeDistributionRoot, 32)
+	require.Equal(t, uint64(10_000), stored.EpochContexts[0].TotalStake)
+	require.Equal(t, uint64(1), stored.EpochContexts[0].PoolCount)
+}
+
 func TestCheckpointCursorDoesNotCreateConsensusStateOrRenewTrust(t *testing.T) {
 	cdc := newProbabilisticTestCodec()
 	ctx, clientStore := newProbabilisticTestClientStore(t, "probabilistic-checkpoint-trust")
@@ -944,6 +1406,44 @@ func makeTestProbabilisticBlock(t *testing.T, blockNumber, slot uint64, prevHash
 	}
 }
 
+func makeTestHeaderOnlyProbabilisticBlock(t *testing.T, blockNumber, slot uint64, prevHashHex string) *ProbabilisticBlock {
+	t.Helper()
+
+	return makeTestEquivocationBlock(t, blockNumber, slot, func(header *ledger.BabbageBlockHeader) {
+		if prevHashHex != "" {
+			prevHashBytes, err := hex.DecodeString(prevHashHex)
+			require.NoError(t, err)
+			header.Body.PrevHash = ledger.NewBlake2b256(prevHashBytes)
+		}
+	})
+}
+
+func makeTestEquivocationBlock(t *testing.T, blockNumber, slot uint64, mutate func(*ledger.BabbageBlockHeader)) *ProbabilisticBlock {
+	t.Helper()
+
+	header := ledger.BabbageBlockHeader{Signature: bytes.Repeat([]byte{0x01}, 448)}
+	header.Body.BlockNumber = blockNumber
+	header.Body.Slot = slot
+	header.Body.VrfResult = []interface{}{bytes.Repeat([]byte{0x02}, 64), bytes.Repeat([]byte{0x03}, 80)}
+	if mutate != nil {
+		mutate(&header)
+	}
+
+	headerCbor, err := cbor.Encode(header)
+	require.NoError(t, err)
+	_, err = cbor.Decode(headerCbor, &header)
+	require.NoError(t, err)
+
+	return &ProbabilisticBlock{
+		Height:     &Height{RevisionHeight: header.BlockNumber()},
+		Hash:       header.Hash(),
+		Slot:       header.SlotNumber(),
+		Epoch:      7,
+		Timestamp:  1_700_000_000_000_000_000 + header.SlotNumber()*1_000_000_000,
+		HeaderCbor: headerCbor,
+	}
+}
+
 func mustTestBlockPrevHash(t *testing.T, block *ProbabilisticBlock) string {
 	t.Helper()
 
@@ -968,6 +1468,9 @@ func cloneTestProbabilisticBlock(block *ProbabilisticBlock) *ProbabilisticBlock
 	if block.BlockCbor != nil {
 		clone.BlockCbor = append([]byte(nil), block.BlockCbor...)
 	}
+	if block.HeaderCbor != nil {
+		clone.HeaderCbor = append([]byte(nil), block.HeaderCbor...)
+	}
 	return &clone
 }
 
@@ -979,6 +1482,41 @@ func mustTestEpochContexts(t *testing.T, cs *ClientState) []*EpochContext {
 	return contexts
 }
 
+// makeTestStakeDistributionProofs builds an inclusion proof for every entry of
+// a full epoch context, as a relayer would.
+func makeTestStakeDistributionProofs(t *testing.T, epochContext *EpochContext) []*StakeDistributionProof {
+	t.Helper()
+
+	entries := cloneStakeDistributionEntries(epochContext.StakeDistribution)
+	slices.SortFunc(entries, func(a, b *StakeDistributionEntry) int {
+		return strings.Compare(strings.ToLower(a.PoolId), strings.ToLower(b.PoolId))
+	})
+	leafHashes := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		leafHashes = append(leafHashes, stakeDistributionLeafHash(entry))
+	}
+	proofs := make([]*StakeDistributionProof, 0, len(entries))
+	for i, entry := range entries {
+		siblings, err := probabilisticcore.StakeDistributionProof(leafHashes, i)
+		require.NoError(t, err)
+		proofs = append(proofs, &StakeDistributionProof{
+			Epoch:    epochContext.Epoch,
+			Entry:    entry,
+			Index:    uint64(i),
+			Siblings: siblings,
+		})
+	}
+	return proofs
+}
+
+func mustCompactTestEpochContext(t *testing.T, epochContext *EpochContext) *EpochContext {
+	t.Helper()
+
+	compacted, err := compactEpochContext(epochContext)
+	require.NoError(t, err)
+	return compacted
+}
+
 func mustCurrentTestEpochContext(t *testing.T, cs *ClientState) *EpochContext {
 	t.Helper()
 
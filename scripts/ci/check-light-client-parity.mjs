@@ -12,6 +12,7 @@ const v8Dir = path.join(root, "cosmos/cardano-probabilistic-light-client-v8");
 const v10Dir = path.join(root, "cosmos/cardano-probabilistic-light-client-v10");
 
 const sharedSourceFiles = [
+  "batch_membership_test.go",
   "block_authentication.go",
   "checkpoint.go",
   "client_state.go",
@@ -19,29 +20,44 @@ const sharedSourceFiles = [
   "consensus_state.go",
   "epoch_context.go",
   "epoch_context_test.go",
+  "epoch_nonce.go",
+  "epoch_nonce_test.go",
+  "era_history.go",
+  "era_history_test.go",
   "errors.go",
   "events.go",
+  "grpc_query.go",
+  "grpc_query_test.go",
   "header.go",
   "height.go",
   "heuristic_policy.go",
   "host_state_commitment.go",
   "host_state_datum.go",
+  "host_state_lineage.go",
+  "host_state_lineage_test.go",
+  "host_state_shutdown.go",
+  "host_state_shutdown_test.go",
   "ibc_state_proof.go",
   "internal/cardanodatum/tm_helper.go",
   "internal/cardanodatum/types.go",
   "keys.go",
   "misbehaviour_handle.go",
   "misbehavour.go",
+  "mithril_stake_distribution.go",
+  "mithril_stake_distribution_test.go",
   "probabilistic.pb.go",
   "proposal_handle.go",
   "proposal_handle_test.go",
+  "query.pb.go",
   "store.go",
   "update.go",
   "upgrade.go",
+  "upgrade_test.go",
   "verifier_test.go",
 ];
 
 const protoFile = "proto/ibc/lightclients/probabilistic/v1/probabilistic.proto";
+const queryProtoFile = "proto/ibc/lightclients/probabilistic/v1/query.proto";
 const expectedTypeUrls = [
   "/ibc.lightclients.probabilistic.v1.ClientState",
   "/ibc.lightclients.probabilistic.v1.ConsensusState",
@@ -218,13 +234,20 @@ function listFiles(dir, predicate, base = dir) {
 function assertFileInventory() {
   const relevant = (filePath) =>
     filePath.endsWith(".go") || filePath.endsWith(".proto");
-  const expectedV8 = [...sharedSourceFiles, "module.go", protoFile].sort();
+  const expectedV8 = [
+    ...sharedSourceFiles,
+    "module.go",
+    protoFile,
+    queryProtoFile,
+  ].sort();
   const expectedV10 = [
     ...sharedSourceFiles,
     "events_test.go",
     "light_client_module.go",
+    "light_client_module_test.go",
     "module.go",
     protoFile,
+    queryProtoFile,
   ].sort();
 
   assertEqual(
@@ -307,11 +330,13 @@ function assertCoreSourceParity() {
     assertEqual(relativePath, normalizeGo(v8File), normalizeGo(v10File));
   }
 
-  assertEqual(
-    protoFile,
-    normalizeProto(path.join(v8Dir, protoFile)),
-    normalizeProto(path.join(v10Dir, protoFile)),
-  );
+  for (const relativePath of [protoFile, queryProtoFile]) {
+    assertEqual(
+      relativePath,
+      normalizeProto(path.join(v8Dir, relativePath)),
+      normalizeProto(path.join(v10Dir, relativePath)),
+    );
+  }
 }
 
 function assertAdapterBoundaries() {